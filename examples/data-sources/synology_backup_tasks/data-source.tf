@@ -0,0 +1,15 @@
+data "synology_backup_tasks" "all" {
+  lifecycle {
+    postcondition {
+      condition = alltrue([
+        for t in self.tasks :
+        t.last_success_time != "" && timecmp(timeadd(t.last_success_time, "26h"), plantimestamp()) > 0
+      ])
+      error_message = "Every backup task must have succeeded within the last 26 hours."
+    }
+  }
+}
+
+output "backup_sizes" {
+  value = { for t in data.synology_backup_tasks.all.tasks : "${t.kind}/${t.name}" => t.size_bytes }
+}
@@ -0,0 +1,8 @@
+data "synology_core_certificates" "all" {}
+
+output "expiring_certificates" {
+  value = {
+    for c in data.synology_core_certificates.all.certificates :
+    c.common_name => c.expires if c.days_until_expiry < 30
+  }
+}
@@ -0,0 +1,11 @@
+data "synology_core_external_access" "this" {}
+
+check "dsm_reachable" {
+  assert {
+    condition = anytrue([
+      for s in data.synology_core_external_access.this.services :
+      s.reachable if s.nas_ports == "5001"
+    ])
+    error_message = "DSM is not reachable through ${data.synology_core_external_access.this.external_ip}."
+  }
+}
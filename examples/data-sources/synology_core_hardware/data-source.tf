@@ -0,0 +1,12 @@
+data "synology_core_hardware" "this" {}
+
+# Surveillance Station is only worth installing with hardware transcoding.
+resource "synology_core_package" "surveillance" {
+  count = data.synology_core_hardware.this.hardware_transcoding ? 1 : 0
+
+  name = "SurveillanceStation"
+}
+
+output "ssd_cache_possible" {
+  value = data.synology_core_hardware.this.nvme_drives >= 2
+}
@@ -0,0 +1,13 @@
+data "synology_core_health" "this" {}
+
+resource "synology_core_share_settings" "docker" {
+  share       = "docker"
+  recycle_bin = true
+
+  lifecycle {
+    precondition {
+      condition     = data.synology_core_health.this.healthy
+      error_message = "${data.synology_core_health.this.hostname} is unhealthy, degraded volumes: ${join(", ", data.synology_core_health.this.degraded_volumes)}, failed drives: ${join(", ", data.synology_core_health.this.failed_disks)}."
+    }
+  }
+}
@@ -0,0 +1,16 @@
+data "synology_core_ping" "this" {}
+
+resource "synology_core_task" "cleanup" {
+  name   = "Clean up downloads"
+  user   = "root"
+  script = "find /volume1/downloads -mtime +30 -delete"
+
+  schedule = "0 2 * * *"
+
+  lifecycle {
+    precondition {
+      condition     = data.synology_core_ping.this.admin
+      error_message = "Scheduled tasks need an administrator, ${data.synology_core_ping.this.user} has ${data.synology_core_ping.this.privilege} rights on ${data.synology_core_ping.this.dsm_version}."
+    }
+  }
+}
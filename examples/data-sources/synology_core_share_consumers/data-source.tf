@@ -0,0 +1,14 @@
+data "synology_core_share_consumers" "media" {
+  share = "media"
+}
+
+resource "synology_filestation_folder" "media" {
+  path = "/media/library"
+
+  lifecycle {
+    precondition {
+      condition     = !data.synology_core_share_consumers.media.in_use
+      error_message = "The media shared folder is in use by ${join(", ", [for c in data.synology_core_share_consumers.media.consumers : "${c.type} ${c.name}" if c.running])}."
+    }
+  }
+}
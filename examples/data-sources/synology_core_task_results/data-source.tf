@@ -0,0 +1,19 @@
+resource "synology_core_task" "backup" {
+  name   = "Backup database"
+  user   = "root"
+  script = "/volume1/scripts/backup-db.sh"
+
+  schedule = "0 1 * * *"
+}
+
+data "synology_core_task_results" "backup" {
+  task_id = synology_core_task.backup.id
+  limit   = 1
+
+  lifecycle {
+    postcondition {
+      condition     = alltrue([for r in self.results : r.running || r.exit_code == 0])
+      error_message = "The last database backup failed."
+    }
+  }
+}
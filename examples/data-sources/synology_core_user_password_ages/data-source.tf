@@ -0,0 +1,7 @@
+data "synology_core_user_password_ages" "stale" {
+  min_age_days = 90
+}
+
+output "stale_passwords" {
+  value = [for u in data.synology_core_user_password_ages.stale.users : u.name if !u.password_never_expires]
+}
@@ -0,0 +1,9 @@
+data "synology_filestation_checksum" "nginx_conf" {
+  path      = "/docker/nginx/nginx.conf"
+  algorithm = "sha256"
+}
+
+# Changes with the file on the NAS, for use in replace_triggered_by.
+resource "terraform_data" "nginx_conf" {
+  input = data.synology_filestation_checksum.nginx_conf.checksum
+}
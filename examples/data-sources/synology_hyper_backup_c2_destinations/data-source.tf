@@ -0,0 +1,5 @@
+data "synology_hyper_backup_c2_destinations" "all" {}
+
+output "offsite_repository_id" {
+  value = data.synology_hyper_backup_c2_destinations.all.ids["offsite"]
+}
@@ -0,0 +1,5 @@
+data "synology_hyper_backup_vault_destinations" "all" {}
+
+output "vault_hosts" {
+  value = [for d in data.synology_hyper_backup_vault_destinations.all.destinations : d.host]
+}
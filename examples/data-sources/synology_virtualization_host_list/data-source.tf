@@ -0,0 +1,13 @@
+data "synology_virtualization_host_list" "cluster" {}
+
+locals {
+  # The host with the most free memory.
+  roomiest_host = [
+    for h in data.synology_virtualization_host_list.cluster.host : h.name
+    if h.free_memory_mb == max(data.synology_virtualization_host_list.cluster.host[*].free_memory_mb...)
+  ][0]
+}
+
+output "roomiest_host" {
+  value = local.roomiest_host
+}
@@ -41,3 +41,46 @@ resource "synology_container_project" "foo" {
     }
   }
 }
+
+resource "synology_container_project" "postgres" {
+  name = "postgres"
+
+  environment = {
+    POSTGRES_USER = "app"
+  }
+
+  # Written to the .env file of the project instead of the compose file.
+  sensitive_environment = {
+    POSTGRES_PASSWORD = var.postgres_password
+  }
+
+  services = {
+    "postgres" = {
+      image = "postgres:16"
+
+      environment = {
+        POSTGRES_USER     = "$${POSTGRES_USER}"
+        POSTGRES_PASSWORD = "$${POSTGRES_PASSWORD}"
+      }
+    }
+  }
+}
+
+variable "postgres_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "synology_container_project" "plex" {
+  name = "plex"
+
+  services = {
+    "plex" = {
+      image        = "plexinc/pms-docker"
+      network_mode = "host"
+
+      # Hardware transcoding with the integrated GPU.
+      gpu = true
+    }
+  }
+}
@@ -0,0 +1,32 @@
+resource "synology_container_volume" "media" {
+  name = "media"
+
+  driver_opts = {
+    type   = "nfs"
+    o      = "addr=10.0.0.2,ro"
+    device = ":/export/media"
+  }
+}
+
+resource "synology_container_project" "jellyfin" {
+  name = "jellyfin"
+
+  services = {
+    "jellyfin" = {
+      image = "jellyfin/jellyfin"
+
+      volumes = [{
+        type   = "volume"
+        source = "media"
+        target = "/media"
+      }]
+    }
+  }
+
+  volumes = {
+    media = {
+      name     = synology_container_volume.media.name
+      external = true
+    }
+  }
+}
@@ -0,0 +1,9 @@
+resource "synology_core_admin_role" "helpdesk" {
+  role   = "user_management"
+  groups = ["helpdesk"]
+}
+
+resource "synology_core_admin_role" "monitoring" {
+  role  = "system_monitoring"
+  users = ["svc-monitoring"]
+}
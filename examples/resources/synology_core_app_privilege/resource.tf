@@ -0,0 +1,12 @@
+resource "synology_core_app_privilege" "file_station" {
+  app      = "SYNO.SDS.App.FileStation3.Instance"
+  everyone = "deny"
+
+  users = {
+    admin = "allow"
+  }
+
+  groups = {
+    staff = "allow"
+  }
+}
@@ -0,0 +1,13 @@
+resource "time_rotating" "weekly" {
+  rotation_days = 7
+}
+
+resource "synology_core_config_backup" "weekly" {
+  nas_path       = "/backup/dsm"
+  local_path     = "${path.root}/backups/nas.dss"
+  c2_auto_backup = true
+
+  triggers = {
+    rotation = time_rotating.weekly.id
+  }
+}
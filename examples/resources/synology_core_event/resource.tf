@@ -7,3 +7,10 @@ resource "synology_core_event" "test" {
   run  = true
   when = "apply"
 }
+
+resource "synology_core_event" "bootstrap" {
+  name = "Mount backups"
+
+  script = "mount -t nfs backup.example.com:/backups /volume1/backups"
+  event  = "bootup"
+}
@@ -0,0 +1,9 @@
+resource "synology_core_firewall_rule" "ssh" {
+  name      = "ssh"
+  protocol  = "tcp"
+  ports     = "22"
+  source_ip = "192.168.1.0/24"
+
+  # Evaluate before any other rule of the adapter.
+  position = 0
+}
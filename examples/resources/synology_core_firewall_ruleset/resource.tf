@@ -0,0 +1,12 @@
+resource "synology_core_firewall_ruleset" "global" {
+  rules = [
+    {
+      name      = "lan"
+      source_ip = "192.168.1.0/24"
+    },
+    {
+      name   = "deny-all"
+      policy = "deny"
+    },
+  ]
+}
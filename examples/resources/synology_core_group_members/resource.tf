@@ -0,0 +1,7 @@
+resource "synology_core_group_members" "support" {
+  group = "support"
+  members = [
+    "alice",
+    "bob",
+  ]
+}
@@ -0,0 +1,4 @@
+resource "synology_core_group_membership" "backup_operators" {
+  group = "backup-operators"
+  user  = synology_core_user.backup.name
+}
@@ -0,0 +1,9 @@
+resource "synology_core_letsencrypt_certificate" "nas" {
+  domains     = ["nas.example.com", "photos.example.com"]
+  email       = "admin@example.com"
+  description = "NAS"
+}
+
+output "certificate_expires" {
+  value = synology_core_letsencrypt_certificate.nas.expires
+}
@@ -0,0 +1,7 @@
+resource "synology_core_login_style" "customer" {
+  directory       = "/web/login"
+  background      = filebase64("${path.module}/background.jpg")
+  logo            = filebase64("${path.module}/logo.png")
+  title           = "Example Corp Storage"
+  welcome_message = "Authorized users only."
+}
@@ -0,0 +1,4 @@
+resource "synology_core_nfs_rule" "lan" {
+  share  = "docker"
+  client = "192.168.1.0/24"
+}
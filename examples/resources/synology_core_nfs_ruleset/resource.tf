@@ -0,0 +1,13 @@
+resource "synology_core_nfs_ruleset" "docker" {
+  share = "docker"
+  rules = [
+    {
+      client    = "192.168.1.10"
+      privilege = "rw"
+    },
+    {
+      client    = "192.168.1.0/24"
+      privilege = "ro"
+    },
+  ]
+}
@@ -0,0 +1,18 @@
+resource "synology_core_notification_webhook" "siem" {
+  name = "siem"
+  url  = "https://siem.example.com/hooks/synology"
+
+  headers = {
+    Authorization = "Bearer ${var.siem_token}"
+  }
+
+  template = jsonencode({
+    source  = "nas01"
+    message = "@@TEXT@@"
+  })
+
+  events = [
+    "LoginFail",
+    "VolumeDegraded",
+  ]
+}
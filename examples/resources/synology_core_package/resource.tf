@@ -1,5 +1,6 @@
 resource "synology_core_package" "mariadb" {
-  name = "MariaDB10"
+  name   = "MariaDB10"
+  volume = "/volume1"
 
   wizard = {
     port              = 3306
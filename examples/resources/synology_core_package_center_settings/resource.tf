@@ -0,0 +1,3 @@
+resource "synology_core_package_center_settings" "this" {
+  trust_level = "any"
+}
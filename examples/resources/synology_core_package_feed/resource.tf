@@ -0,0 +1,17 @@
+resource "synology_core_package_feed" "synocommunity" {
+  name = "SynoCommunity"
+  url  = "https://packages.synocommunity.com"
+}
+
+resource "synology_core_package_center_settings" "this" {
+  trust_level = "any"
+}
+
+resource "synology_core_package" "transmission" {
+  name = "transmission"
+
+  depends_on = [
+    synology_core_package_feed.synocommunity,
+    synology_core_package_center_settings.this,
+  ]
+}
@@ -0,0 +1,15 @@
+resource "synology_core_password_policy" "baseline" {
+  min_length       = 12
+  mixed_case       = true
+  numeric          = true
+  special          = true
+  exclude_username = true
+  exclude_common   = true
+  history          = 5
+
+  max_age       = 180
+  reminder_days = 14
+
+  otp_enforce = "custom"
+  otp_groups  = ["administrators", "it"]
+}
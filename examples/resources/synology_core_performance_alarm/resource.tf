@@ -0,0 +1,14 @@
+resource "synology_core_performance_alarm" "cpu" {
+  name          = "CPU saturated"
+  metric        = "cpu"
+  threshold     = 95
+  duration      = 10
+  notifications = ["mail", "webhook"]
+}
+
+resource "synology_core_performance_alarm" "volume1" {
+  name      = "volume1 almost full"
+  metric    = "volume"
+  volume    = "volume1"
+  threshold = 85
+}
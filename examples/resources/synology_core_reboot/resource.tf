@@ -0,0 +1,12 @@
+resource "synology_core_package" "virtualization" {
+  name = "Virtualization"
+}
+
+resource "synology_core_reboot" "maintenance" {
+  when            = ["settings_changed"]
+  window          = "0 2 * * 6"
+  window_duration = 180
+  wait_for_window = true
+
+  depends_on = [synology_core_package.virtualization]
+}
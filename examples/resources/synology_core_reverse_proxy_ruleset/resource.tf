@@ -0,0 +1,11 @@
+resource "synology_core_reverse_proxy_ruleset" "all" {
+  rules = [
+    {
+      description          = "app"
+      source_hostname      = "app.example.com"
+      source_port          = 443
+      destination_hostname = "localhost"
+      destination_port     = 8080
+    },
+  ]
+}
@@ -0,0 +1,6 @@
+resource "synology_core_security_settings" "this" {
+  hsts                    = true
+  clickjacking_protection = true
+  csrf_protection         = true
+  tls_profile             = "intermediate"
+}
@@ -0,0 +1,10 @@
+resource "synology_core_service_access" "seats" {
+  users = {
+    alice = ["drive", "photos", "mailplus"]
+    bob   = ["drive", "mailplus"]
+  }
+
+  groups = {
+    staff = ["drive"]
+  }
+}
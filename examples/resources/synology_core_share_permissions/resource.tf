@@ -0,0 +1,12 @@
+resource "synology_core_share_permissions" "docker" {
+  share = "docker"
+
+  users = {
+    admin = "read_write"
+    guest = "no_access"
+  }
+
+  groups = {
+    users = "read_only"
+  }
+}
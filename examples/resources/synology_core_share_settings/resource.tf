@@ -0,0 +1,10 @@
+resource "synology_core_share_settings" "docker" {
+  share = "docker"
+
+  access_based_enumeration = true
+  hide_in_network_places   = true
+  recycle_bin              = true
+  recycle_bin_admin_only   = true
+  snapshot_browsing        = false
+  compression              = true
+}
@@ -0,0 +1,9 @@
+resource "synology_core_shared_folder_sync_task" "mirror" {
+  name           = "mirror"
+  shares         = ["docker", "photo"]
+  server         = "nas2.example.com"
+  username       = "sync"
+  password       = var.sync_password
+  sync_on_change = true
+  schedule       = "0 1 * * *"
+}
@@ -0,0 +1,8 @@
+resource "synology_core_sso_client" "keycloak" {
+  protocol       = "oidc"
+  name           = "Keycloak"
+  well_known_url = "https://id.example.com/realms/corp/.well-known/openid-configuration"
+  client_id      = "dsm"
+  client_secret  = var.dsm_client_secret
+  redirect_uri   = "https://nas.example.com:5001"
+}
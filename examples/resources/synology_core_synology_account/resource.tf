@@ -0,0 +1,9 @@
+variable "synology_account_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "synology_core_synology_account" "this" {
+  account = "admin@example.com"
+  token   = var.synology_account_token
+}
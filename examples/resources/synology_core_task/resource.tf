@@ -0,0 +1,40 @@
+resource "synology_core_task" "cleanup" {
+  name   = "Clean up downloads"
+  user   = "root"
+  script = "find /volume1/downloads -mtime +30 -delete"
+
+  schedule = "0 2 * * *"
+}
+
+resource "synology_core_task" "recycle_bin" {
+  name = "Empty recycle bins"
+  user = "root"
+
+  recycle_bin = {
+    older_than_days = 30
+  }
+
+  schedule = "0 3 * * 0"
+}
+
+resource "synology_core_task" "beep" {
+  name = "Beep"
+  user = "root"
+
+  beep = {
+    duration = 10
+  }
+}
+
+resource "synology_core_task" "poll" {
+  name   = "Poll queue"
+  user   = "root"
+  script = "/volume1/scripts/poll.sh"
+
+  recurrence = {
+    at             = "08:00"
+    days           = ["mon", "tue", "wed", "thu", "fri"]
+    repeat_minutes = 15
+    last_hour      = 18
+  }
+}
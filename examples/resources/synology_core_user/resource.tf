@@ -0,0 +1,15 @@
+variable "backup_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "synology_core_user" "backup" {
+  name        = "svc-backup"
+  description = "Hyper Backup service account"
+  email       = "ops@example.com"
+
+  # Bump the version to rotate the password.
+  password_wo         = var.backup_password
+  password_wo_version = 2
+}
@@ -0,0 +1,4 @@
+resource "synology_core_volume_deduplication" "volume1" {
+  volume  = "/volume1"
+  enabled = true
+}
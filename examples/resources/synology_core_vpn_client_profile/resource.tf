@@ -0,0 +1,18 @@
+resource "synology_core_vpn_client_profile" "office" {
+  name      = "office"
+  protocol  = "openvpn"
+  config    = file("${path.module}/office.ovpn")
+  username  = "nas"
+  password  = var.vpn_password
+  connected = true
+}
+
+resource "synology_core_vpn_client_profile" "backup_site" {
+  name          = "backup-site"
+  protocol      = "l2tp"
+  server        = "vpn.example.com"
+  username      = "nas"
+  password      = var.vpn_password
+  preshared_key = var.vpn_psk
+  reconnect     = false
+}
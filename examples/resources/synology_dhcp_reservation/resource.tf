@@ -0,0 +1,6 @@
+resource "synology_dhcp_reservation" "printer" {
+  interface = synology_dhcp_scope.lan.interface
+  mac       = "00:11:32:aa:bb:cc"
+  ip        = "192.168.1.20"
+  hostname  = "printer"
+}
@@ -0,0 +1,9 @@
+resource "synology_dhcp_scope" "lan" {
+  interface   = "ovs_eth0"
+  start_ip    = "192.168.1.100"
+  end_ip      = "192.168.1.199"
+  netmask     = "255.255.255.0"
+  gateway     = "192.168.1.1"
+  dns_servers = ["192.168.1.2"]
+  domain_name = "home.example.com"
+}
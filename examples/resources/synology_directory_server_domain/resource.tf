@@ -0,0 +1,6 @@
+resource "synology_directory_server_domain" "corp" {
+  name           = "corp.example.com"
+  netbios_name   = "CORP"
+  admin_password = var.domain_admin_password
+  dns_forwarder  = "192.168.1.1"
+}
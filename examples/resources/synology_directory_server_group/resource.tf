@@ -0,0 +1,6 @@
+resource "synology_directory_server_group" "engineering" {
+  name        = "engineering"
+  container   = synology_directory_server_ou.staff.dn
+  description = "Engineering team"
+  members     = [synology_directory_server_user.alice.name]
+}
@@ -0,0 +1,5 @@
+resource "synology_directory_server_ou" "staff" {
+  name        = "Staff"
+  container   = synology_directory_server_domain.corp.base_dn
+  description = "Employees"
+}
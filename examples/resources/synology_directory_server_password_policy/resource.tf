@@ -0,0 +1,6 @@
+resource "synology_directory_server_password_policy" "corp" {
+  min_length        = 12
+  max_age           = 90
+  lockout_threshold = 5
+  lockout_duration  = 30
+}
@@ -0,0 +1,8 @@
+resource "synology_directory_server_user" "alice" {
+  name                 = "alice"
+  container            = synology_directory_server_ou.staff.dn
+  password             = var.alice_password
+  display_name         = "Alice Example"
+  email                = "alice@example.com"
+  must_change_password = true
+}
@@ -0,0 +1,21 @@
+resource "synology_dns_record" "nas" {
+  zone  = synology_dns_zone.home.name
+  name  = "nas"
+  type  = "A"
+  value = "192.168.1.2"
+}
+
+resource "synology_dns_record" "www" {
+  zone  = synology_dns_zone.home.name
+  name  = "www"
+  type  = "CNAME"
+  value = "nas.home.example.com."
+  ttl   = 3600
+}
+
+resource "synology_dns_record" "nas_ptr" {
+  zone  = synology_dns_zone.reverse.name
+  name  = "2"
+  type  = "PTR"
+  value = "nas.home.example.com."
+}
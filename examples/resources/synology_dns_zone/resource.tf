@@ -0,0 +1,14 @@
+resource "synology_dns_zone" "home" {
+  name           = "home.example.com"
+  allow_transfer = ["192.168.1.0/24"]
+}
+
+resource "synology_dns_zone" "reverse" {
+  name = "1.168.192.in-addr.arpa"
+}
+
+resource "synology_dns_zone" "office" {
+  name            = "office.example.com"
+  type            = "secondary"
+  primary_servers = ["10.0.0.53"]
+}
@@ -0,0 +1,7 @@
+resource "synology_download_station_settings" "this" {
+  default_destination = "downloads"
+  bt_max_download     = 0
+  bt_max_upload       = 500
+  http_max_download   = 10240
+  schedule_enabled    = true
+}
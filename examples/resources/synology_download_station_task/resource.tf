@@ -0,0 +1,4 @@
+resource "synology_download_station_task" "ubuntu" {
+  uri         = "magnet:?xt=urn:btih:3b245504cf5f11bbdbe1201cea6a6bf45aee1bc0&dn=ubuntu-24.04-live-server-amd64.iso"
+  destination = "downloads/iso"
+}
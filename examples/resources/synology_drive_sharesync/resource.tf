@@ -0,0 +1,20 @@
+resource "synology_drive_sharesync" "branch_office" {
+  server   = "branch.example.com"
+  username = "sharesync"
+  password = var.sharesync_password
+
+  folders = [
+    {
+      local_path  = "/projects"
+      remote_path = "/projects"
+    },
+    {
+      local_path  = "/templates"
+      remote_path = "/shared/templates"
+      direction   = "download_only"
+    },
+  ]
+
+  # Sync every 2 hours
+  schedule = "0 */2 * * *"
+}
@@ -0,0 +1,14 @@
+resource "synology_filestation_folder" "invoices" {
+  path = "/intake/invoices"
+}
+
+resource "synology_file_request" "invoices" {
+  folder  = synology_filestation_folder.invoices.path
+  name    = "Invoices"
+  message = "Upload your invoices as PDF."
+  expires = "2025-12-31"
+}
+
+output "invoice_upload_url" {
+  value = synology_file_request.invoices.url
+}
@@ -0,0 +1,16 @@
+# Let the backup group read the configuration folder and everything in it.
+resource "synology_filestation_acl" "config_read" {
+  path           = "/docker/config"
+  principal_type = "group"
+  principal      = "backup"
+  permissions    = ["read_data", "exe_file", "read_attr", "read_ext_attr", "read_perm"]
+}
+
+# Keep guests out of the secrets file only.
+resource "synology_filestation_acl" "secrets_deny" {
+  path        = "/docker/config/secrets.env"
+  principal   = "guest"
+  type        = "deny"
+  permissions = ["read_data", "write_data", "append_data", "delete"]
+  inheritance = []
+}
@@ -0,0 +1,4 @@
+resource "synology_filestation_authorized_key" "backup" {
+  user = "backup"
+  key  = file("~/.ssh/id_ed25519.pub")
+}
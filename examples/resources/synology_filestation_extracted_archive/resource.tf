@@ -0,0 +1,9 @@
+resource "synology_filestation_file" "site" {
+  path = "/web/releases/site.zip"
+  url  = "https://github.com/example/site/releases/latest/download/site.zip"
+}
+
+resource "synology_filestation_extracted_archive" "site" {
+  archive     = synology_filestation_file.site.path
+  destination = "/web/site"
+}
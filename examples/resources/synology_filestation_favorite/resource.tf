@@ -0,0 +1,4 @@
+resource "synology_filestation_favorite" "logs" {
+  path = "/docker/logs"
+  name = "Container logs"
+}
@@ -0,0 +1,12 @@
+resource "synology_filestation_folder" "remote" {
+  path = "/backup/remote"
+}
+
+resource "synology_filestation_remote_folder" "backup" {
+  protocol      = "cifs"
+  server        = "fileserver.local"
+  remote_folder = "backup"
+  mount_point   = synology_filestation_folder.remote.path
+  username      = "nas"
+  password      = var.fileserver_password
+}
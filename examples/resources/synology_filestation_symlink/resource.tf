@@ -0,0 +1,4 @@
+resource "synology_filestation_symlink" "media" {
+  path   = "/web/media"
+  target = "/video/library"
+}
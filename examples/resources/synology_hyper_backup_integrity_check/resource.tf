@@ -0,0 +1,6 @@
+resource "synology_hyper_backup_integrity_check" "weekly" {
+  task_id    = 1
+  schedule   = "0 3 * * 0"
+  time_limit = 4
+  check_data = true
+}
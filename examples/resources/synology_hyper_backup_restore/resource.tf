@@ -0,0 +1,10 @@
+resource "synology_hyper_backup_restore" "dr_test" {
+  task_id     = 1
+  paths       = ["/docker/gitea"]
+  target_path = "/restore-test"
+
+  # Restore the latest version again every month.
+  triggers = {
+    month = formatdate("YYYY-MM", plantimestamp())
+  }
+}
@@ -0,0 +1,5 @@
+resource "synology_ldap_server_group" "engineering" {
+  name        = "engineering"
+  description = "Engineering team"
+  members     = [synology_ldap_server_user.alice.name]
+}
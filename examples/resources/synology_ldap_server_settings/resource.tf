@@ -0,0 +1,4 @@
+resource "synology_ldap_server_settings" "example" {
+  fqdn     = "example.com"
+  password = var.ldap_manager_password
+}
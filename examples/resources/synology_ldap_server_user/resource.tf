@@ -0,0 +1,6 @@
+resource "synology_ldap_server_user" "alice" {
+  name      = "alice"
+  password  = var.alice_password
+  full_name = "Alice Example"
+  email     = "alice@example.com"
+}
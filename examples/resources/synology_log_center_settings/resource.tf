@@ -0,0 +1,21 @@
+resource "synology_log_center_settings" "this" {
+  remote_syslog = {
+    host     = "siem.example.com"
+    port     = 6514
+    protocol = "tcp"
+    format   = "ietf"
+    tls      = true
+  }
+
+  archive = {
+    path        = "/volume1/logs"
+    max_entries = 500000
+    keep        = 12
+  }
+
+  notification = {
+    severity = "crit"
+    count    = 1
+    period   = 5
+  }
+}
@@ -0,0 +1,3 @@
+resource "synology_mailplus_account" "alice" {
+  user = "alice"
+}
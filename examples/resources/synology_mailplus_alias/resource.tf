@@ -0,0 +1,7 @@
+resource "synology_mailplus_alias" "sales" {
+  domain    = synology_mailplus_domain.example.name
+  name      = "sales"
+  users     = [synology_mailplus_account.alice.user]
+  groups    = ["sales"]
+  addresses = ["sales-archive@example.org"]
+}
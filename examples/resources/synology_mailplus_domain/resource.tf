@@ -0,0 +1,4 @@
+resource "synology_mailplus_domain" "example" {
+  name        = "example.com"
+  description = "Company mail"
+}
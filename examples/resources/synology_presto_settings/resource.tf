@@ -0,0 +1,4 @@
+resource "synology_presto_settings" "example" {
+  shares      = ["media", "projects"]
+  license_key = var.presto_license_key
+}
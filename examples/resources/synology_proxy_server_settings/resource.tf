@@ -0,0 +1,11 @@
+resource "synology_proxy_server_settings" "this" {
+  port            = 3128
+  cache_enabled   = true
+  cache_size      = 4096
+  allowed_clients = ["192.168.1.0/24"]
+
+  parent_proxy = {
+    host = "proxy.example.com"
+    port = 8080
+  }
+}
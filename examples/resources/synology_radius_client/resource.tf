@@ -0,0 +1,5 @@
+resource "synology_radius_client" "access_points" {
+  name    = "access-points"
+  address = "192.168.10.0/24"
+  secret  = var.radius_secret
+}
@@ -0,0 +1,4 @@
+resource "synology_radius_settings" "example" {
+  local_users  = false
+  domain_users = true
+}
@@ -0,0 +1,11 @@
+resource "synology_replication_task" "docker" {
+  share           = "docker"
+  target_host     = "backup.example.com"
+  target_user     = "replicator"
+  target_password = var.replication_password
+  target_volume   = "/volume1"
+
+  # Every four hours, keeping a day worth of snapshots on the target.
+  schedule     = "0 */4 * * *"
+  retain_count = 6
+}
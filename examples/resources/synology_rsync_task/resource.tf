@@ -0,0 +1,11 @@
+resource "synology_rsync_task" "offsite" {
+  name     = "offsite"
+  sources  = ["/docker", "/photo"]
+  host     = "backup.example.com"
+  path     = "/srv/backup/nas"
+  username = "backup"
+  ssh_key  = file("~/.ssh/nas_backup")
+
+  schedule        = "0 2 * * *"
+  bandwidth_limit = 10240
+}
@@ -0,0 +1,16 @@
+resource "synology_sharing_link" "release" {
+  path      = "/public/release.zip"
+  password  = var.download_password
+  expires   = "2025-12-31"
+  sensitive = true
+}
+
+resource "synology_sharing_link" "dropbox" {
+  path         = "/public/incoming"
+  allow_upload = true
+}
+
+output "release_url" {
+  value     = synology_sharing_link.release.sensitive_url
+  sensitive = true
+}
@@ -0,0 +1,10 @@
+# Snapshot the share before the container project below changes its data.
+resource "synology_snapshot" "before_upgrade" {
+  share       = "docker"
+  description = "Before gitea upgrade"
+  lock        = true
+
+  triggers = {
+    image = var.gitea_image
+  }
+}
@@ -0,0 +1,8 @@
+resource "synology_snapshot" "before_upgrade" {
+  share = "docker"
+}
+
+resource "synology_snapshot_restore" "rollback" {
+  share    = synology_snapshot.before_upgrade.share
+  snapshot = synology_snapshot.before_upgrade.id
+}
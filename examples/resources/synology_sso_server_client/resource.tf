@@ -0,0 +1,8 @@
+resource "synology_sso_server_client" "grafana" {
+  name          = "grafana"
+  redirect_uris = ["https://grafana.example.com/login/generic_oauth"]
+}
+
+output "grafana_client_id" {
+  value = synology_sso_server_client.grafana.client_id
+}
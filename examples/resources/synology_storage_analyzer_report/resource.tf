@@ -0,0 +1,8 @@
+resource "synology_storage_analyzer_report" "weekly" {
+  name        = "weekly"
+  shares      = ["/volume1/docker", "/volume1/media"]
+  top_files   = 100
+  report_path = "/volume1/reports"
+  recipients  = ["ops@example.com"]
+  schedule    = "0 3 * * 1"
+}
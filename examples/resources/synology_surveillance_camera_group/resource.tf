@@ -0,0 +1,5 @@
+resource "synology_surveillance_camera_group" "entrance" {
+  name        = "entrance"
+  description = "Cameras covering the main entrance"
+  camera_ids  = [1, 2, 5]
+}
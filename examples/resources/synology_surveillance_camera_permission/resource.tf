@@ -0,0 +1,12 @@
+resource "synology_surveillance_privilege_profile" "guards" {
+  name = "guards"
+}
+
+# Guards may watch and steer the entrance camera, but not play back
+# recordings.
+resource "synology_surveillance_camera_permission" "entrance" {
+  profile   = synology_surveillance_privilege_profile.guards.name
+  camera_id = 3
+  playback  = false
+  ptz       = true
+}
@@ -0,0 +1,17 @@
+locals {
+  # Camera positions on the ground floor plan, in pixels of the map image.
+  ground_floor = {
+    1 = { x = 120, y = 80 }
+    2 = { x = 640, y = 80 }
+    5 = { x = 380, y = 410 }
+  }
+}
+
+resource "synology_surveillance_emap_placement" "ground_floor" {
+  for_each = local.ground_floor
+
+  emap      = "ground floor"
+  camera_id = each.key
+  x         = each.value.x
+  y         = each.value.y
+}
@@ -0,0 +1,4 @@
+resource "synology_surveillance_privilege_profile" "guards" {
+  name        = "guards"
+  description = "Security guards: live view of the entrance cameras"
+}
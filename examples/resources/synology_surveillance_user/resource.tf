@@ -0,0 +1,8 @@
+resource "synology_surveillance_privilege_profile" "guards" {
+  name = "guards"
+}
+
+resource "synology_surveillance_user" "alice" {
+  user    = "alice"
+  profile = synology_surveillance_privilege_profile.guards.name
+}
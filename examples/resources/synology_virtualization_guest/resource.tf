@@ -14,3 +14,20 @@ resource "synology_virtualization_guest" "foo" {
     size        = 20000
   }
 }
+
+# Pin a guest to a host of a Virtual Machine Manager cluster. Changing the host
+# live-migrates the running guest.
+resource "synology_virtualization_guest" "pinned" {
+  name         = "pinned"
+  storage_name = "default"
+  host         = "nas-02"
+  run          = true
+
+  network {
+    name = "default"
+  }
+
+  disk {
+    size = 20000
+  }
+}
@@ -0,0 +1,22 @@
+variable "maintenance" {
+  type    = bool
+  default = false
+}
+
+locals {
+  state = var.maintenance ? "shutdown" : "running"
+}
+
+# Changes apply to the database first, so it is started before the
+# application.
+resource "synology_virtualization_power_state" "database" {
+  guest = "db01"
+  state = local.state
+}
+
+resource "synology_virtualization_power_state" "app" {
+  guest = "app01"
+  state = local.state
+
+  depends_on = [synology_virtualization_power_state.database]
+}
@@ -0,0 +1,10 @@
+resource "synology_webstation_php_profile" "wordpress" {
+  name       = "wordpress"
+  version    = "php82"
+  extensions = ["curl", "gd", "mysqli", "zip"]
+
+  settings = {
+    memory_limit        = "256M"
+    upload_max_filesize = "64M"
+  }
+}
@@ -0,0 +1,7 @@
+resource "synology_webstation_vhost" "blog" {
+  hostname      = "blog.example.com"
+  document_root = "/volume1/web/blog"
+  backend       = "nginx"
+  php_profile   = synology_webstation_php_profile.wordpress.id
+  hsts          = true
+}
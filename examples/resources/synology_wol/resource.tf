@@ -0,0 +1,8 @@
+resource "synology_wol" "backup_nas" {
+  mac  = "00:11:32:aa:bb:cc"
+  host = "backup-nas.example.com:5001"
+
+  triggers = {
+    run = plantimestamp()
+  }
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/synology-community/terraform-provider-synology/synology/client"
 	"github.com/synology-community/terraform-provider-synology/synology/provider"
 )
 
@@ -28,7 +29,20 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(), opts)
+	// Telemetry is only exported when the OTEL_* environment variables ask
+	// for it, and flushed when Terraform stops the provider.
+	shutdown, err := client.StartTelemetry(context.Background())
+	if err != nil {
+		log.Printf("[WARN] Unable to start OpenTelemetry: %s", err)
+	}
+
+	err = providerserver.Serve(context.Background(), provider.New(), opts)
+	if lerr := provider.ReleaseLocks(context.Background()); lerr != nil {
+		log.Printf("[WARN] Unable to release the lock file: %s", lerr)
+	}
+	if serr := shutdown(context.Background()); serr != nil {
+		log.Printf("[WARN] Unable to flush OpenTelemetry: %s", serr)
+	}
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -0,0 +1,268 @@
+package mock
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const defaultVolume = "/volume1"
+
+type node struct {
+	isDir   bool
+	content []byte
+	mtime   time.Time
+}
+
+func (s *Server) registerFileStation() {
+	s.Handle("SYNO.FileStation.List", 2, "list", s.fsList)
+	s.Handle("SYNO.FileStation.List", 2, "list_share", s.fsListShare)
+	s.Handle("SYNO.FileStation.CreateFolder", 2, "create", s.fsCreateFolder)
+	s.Handle("SYNO.FileStation.Upload", 2, "upload", s.fsUpload)
+	s.Handle("SYNO.FileStation.Delete", 2, "start", s.fsDeleteStart)
+	s.Handle("SYNO.FileStation.Delete", 2, "status", s.taskStatus)
+	s.Handle("SYNO.FileStation.MD5", 2, "start", s.fsMD5Start)
+	s.Handle("SYNO.FileStation.MD5", 2, "status", s.taskStatus)
+	s.Handle("SYNO.FileStation.Download", 2, "download", s.fsDownload)
+	s.Handle("SYNO.FileStation.Rename", 2, "rename", s.fsRename)
+}
+
+// WriteFile stores a file, creating its parent folders. The first path
+// element must be an existing shared folder.
+func (s *Server) WriteFile(p string, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mkdirAll(path.Dir(p))
+	s.files[p] = &node{content: content, mtime: time.Now()}
+}
+
+// ReadFile returns the content of a file and whether it exists.
+func (s *Server) ReadFile(p string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.files[p]
+	if !ok || n.isDir {
+		return nil, false
+	}
+	return n.content, true
+}
+
+// Exists reports whether a file or folder exists.
+func (s *Server) Exists(p string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.files[p]
+	return ok
+}
+
+func (s *Server) mkdirAll(p string) {
+	for p != "/" && p != "." && p != "" {
+		if _, ok := s.files[p]; !ok {
+			s.files[p] = &node{isDir: true, mtime: time.Now()}
+		}
+		p = path.Dir(p)
+	}
+}
+
+// within reports whether p is dir or a path below it.
+func within(p, dir string) bool {
+	return p == dir || strings.HasPrefix(p, dir+"/")
+}
+
+func (s *Server) shareOf(p string) *Share {
+	name := strings.SplitN(strings.TrimPrefix(p, "/"), "/", 2)[0]
+	return s.shares[name]
+}
+
+func (s *Server) fileInfo(p string, n *node) map[string]any {
+	volume := defaultVolume
+	if sh := s.shareOf(p); sh != nil {
+		volume = sh.VolPath
+	}
+
+	ts := n.mtime.Unix()
+	size := len(n.content)
+
+	return map[string]any{
+		"path":  p,
+		"name":  path.Base(p),
+		"isdir": n.isDir,
+		"additional": map[string]any{
+			"real_path": volume + p,
+			"size":      size,
+			"type":      strings.TrimPrefix(path.Ext(p), "."),
+			"owner":     map[string]any{"user": s.Username, "group": "users"},
+			"time": map[string]any{
+				"atime":  ts,
+				"crtime": ts,
+				"ctime":  ts,
+				"mtime":  ts,
+			},
+		},
+	}
+}
+
+func (s *Server) fsList(r *Request) (any, error) {
+	dir := path.Clean(r.Get("folder_path"))
+
+	if n, ok := s.files[dir]; !ok || !n.isDir {
+		return nil, Errorf(408)
+	}
+
+	files := []map[string]any{}
+	for p, n := range s.files {
+		if path.Dir(p) == dir {
+			files = append(files, s.fileInfo(p, n))
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i]["path"].(string) < files[j]["path"].(string)
+	})
+
+	return map[string]any{"files": files, "total": len(files), "offset": 0}, nil
+}
+
+func (s *Server) fsListShare(_ *Request) (any, error) {
+	shares := []map[string]any{}
+	for _, sh := range s.sortedShares() {
+		p := "/" + sh.Name
+		shares = append(shares, map[string]any{
+			"name":  sh.Name,
+			"path":  p,
+			"isdir": true,
+			"additional": map[string]any{
+				"real_path": sh.VolPath + p,
+			},
+		})
+	}
+
+	return map[string]any{"shares": shares, "total": len(shares), "offset": 0}, nil
+}
+
+func (s *Server) fsCreateFolder(r *Request) (any, error) {
+	parents := r.List("folder_path")
+	names := r.List("name")
+	if len(parents) == 0 || len(parents) != len(names) {
+		return nil, Errorf(400)
+	}
+
+	folders := []map[string]any{}
+	for i, parent := range parents {
+		p := path.Join(parent, names[i])
+		if s.shareOf(p) == nil {
+			return nil, Errorf(408)
+		}
+		if n, ok := s.files[parent]; !ok || !n.isDir {
+			if !r.Bool("force_parent") {
+				return nil, Errorf(408)
+			}
+		}
+		if n, ok := s.files[p]; ok && !n.isDir {
+			return nil, Errorf(414)
+		}
+		s.mkdirAll(p)
+		folders = append(folders, s.fileInfo(p, s.files[p]))
+	}
+
+	return map[string]any{"folders": folders}, nil
+}
+
+func (s *Server) fsUpload(r *Request) (any, error) {
+	dir := path.Clean(r.Get("path"))
+	if s.shareOf(dir) == nil {
+		return nil, Errorf(408)
+	}
+	if n, ok := s.files[dir]; !ok || !n.isDir {
+		if !r.Bool("create_parents") {
+			return nil, Errorf(408)
+		}
+		s.mkdirAll(dir)
+	}
+
+	for name, content := range r.Files {
+		p := path.Join(dir, name)
+		if _, ok := s.files[p]; ok && !r.Bool("overwrite") {
+			return nil, Errorf(414)
+		}
+		s.files[p] = &node{content: content, mtime: time.Now()}
+	}
+
+	return nil, nil
+}
+
+func (s *Server) fsDeleteStart(r *Request) (any, error) {
+	for _, p := range r.List("path") {
+		p = path.Clean(p)
+		for f := range s.files {
+			if within(f, p) {
+				delete(s.files, f)
+			}
+		}
+	}
+
+	id := "delete-" + s.nextID()
+	s.tasks[id] = map[string]any{"finished": true, "progress": 100}
+
+	return map[string]any{"taskid": id}, nil
+}
+
+func (s *Server) fsMD5Start(r *Request) (any, error) {
+	n, ok := s.files[path.Clean(r.Get("file_path"))]
+	if !ok || n.isDir {
+		return nil, Errorf(408)
+	}
+
+	sum := md5.Sum(n.content)
+	id := "md5-" + s.nextID()
+	s.tasks[id] = map[string]any{"finished": true, "md5": hex.EncodeToString(sum[:])}
+
+	return map[string]any{"taskid": id}, nil
+}
+
+func (s *Server) taskStatus(r *Request) (any, error) {
+	t, ok := s.tasks[r.Get("taskid")]
+	if !ok {
+		return nil, Errorf(599)
+	}
+	return t, nil
+}
+
+func (s *Server) fsDownload(r *Request) (any, error) {
+	n, ok := s.files[path.Clean(r.Get("path"))]
+	if !ok || n.isDir {
+		return nil, Errorf(408)
+	}
+	return download(n.content), nil
+}
+
+func (s *Server) fsRename(r *Request) (any, error) {
+	src := path.Clean(r.Get("path"))
+	if _, ok := s.files[src]; !ok {
+		return nil, Errorf(408)
+	}
+
+	name := r.Get("new_name")
+	if name == "" {
+		name = r.Get("name")
+	}
+
+	dst := path.Join(path.Dir(src), name)
+	if _, ok := s.files[dst]; ok {
+		return nil, Errorf(414)
+	}
+
+	for f, n := range s.files {
+		if within(f, src) {
+			delete(s.files, f)
+			s.files[dst+strings.TrimPrefix(f, src)] = n
+		}
+	}
+
+	return map[string]any{"files": []map[string]any{s.fileInfo(dst, s.files[dst])}}, nil
+}
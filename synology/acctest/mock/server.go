@@ -0,0 +1,348 @@
+// Package mock implements an in-memory subset of the Synology DSM web API.
+//
+// The server is used by the acceptance tests when no real NAS is configured.
+// It speaks the same wire format as DSM (entry.cgi with api, method and
+// version parameters) and currently implements SYNO.API.Info, SYNO.API.Auth,
+// the File Station APIs used by the provider and SYNO.Core.Share.
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Handler handles a single DSM API method. The returned value is encoded as
+// the data member of a successful response.
+type Handler func(r *Request) (any, error)
+
+// Request is a decoded DSM API request.
+type Request struct {
+	API     string
+	Method  string
+	Version int
+	Params  url.Values
+	Files   map[string][]byte
+
+	HTTP *http.Request
+}
+
+// Get returns the named parameter, stripping the JSON quotes DSM clients use
+// for some string values.
+func (r *Request) Get(name string) string {
+	v := r.Params.Get(name)
+	if strings.HasPrefix(v, `"`) {
+		if s, err := strconv.Unquote(v); err == nil {
+			return s
+		}
+	}
+	return v
+}
+
+// List returns the named parameter as a list. Both JSON encoded arrays and
+// repeated parameters are accepted.
+func (r *Request) List(name string) []string {
+	v := r.Params[name]
+	if len(v) == 1 && strings.HasPrefix(v[0], "[") {
+		var res []string
+		if err := json.Unmarshal([]byte(v[0]), &res); err == nil {
+			return res
+		}
+	}
+	if len(v) == 1 && strings.Contains(v[0], ",") {
+		return strings.Split(v[0], ",")
+	}
+	return v
+}
+
+// Bool returns the named parameter as a bool, defaulting to false.
+func (r *Request) Bool(name string) bool {
+	b, _ := strconv.ParseBool(r.Get(name))
+	return b
+}
+
+// Error is a DSM API error response.
+type Error struct {
+	Code int
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("dsm error %d", e.Code)
+}
+
+// Errorf returns a DSM API error with the given code.
+func Errorf(code int) error {
+	return &Error{Code: code}
+}
+
+// Server is an in-memory DSM web API server.
+type Server struct {
+	*httptest.Server
+
+	Username string
+	Password string
+	// Admin is whether the account belongs to the administrators group.
+	Admin bool
+
+	mu       sync.Mutex
+	sessions map[string]bool
+	handlers map[string]Handler
+	apis     map[string]int
+	seq      int
+
+	files  map[string]*node
+	shares map[string]*Share
+	tasks  map[string]any
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithCredentials sets the account the server accepts on login.
+func WithCredentials(username, password string) Option {
+	return func(s *Server) {
+		s.Username = username
+		s.Password = password
+	}
+}
+
+// WithoutAdmin removes the account from the administrators group.
+func WithoutAdmin() Option {
+	return func(s *Server) {
+		s.Admin = false
+	}
+}
+
+// WithShare creates a shared folder when the server starts.
+func WithShare(name string) Option {
+	return func(s *Server) {
+		s.createShare(Share{Name: name, VolPath: defaultVolume})
+	}
+}
+
+// NewServer starts a TLS mock DSM server. The caller must call Close when
+// finished with it.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		Username: "admin",
+		Password: "password",
+		Admin:    true,
+		sessions: map[string]bool{},
+		handlers: map[string]Handler{},
+		apis:     map[string]int{},
+		files:    map[string]*node{},
+		shares:   map[string]*Share{},
+		tasks:    map[string]any{},
+	}
+
+	s.Handle("SYNO.API.Info", 1, "query", s.apiInfo)
+	s.Handle("SYNO.API.Auth", 7, "login", s.login)
+	s.Handle("SYNO.API.Auth", 7, "logout", s.logout)
+	s.Handle("SYNO.Core.Desktop.Initdata", 1, "get", s.session)
+	s.registerFileStation()
+	s.registerShare()
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Host returns the server URL in the form expected by the provider host
+// setting.
+func (s *Server) Host() string {
+	return s.URL
+}
+
+// Handle registers a handler for an API method, replacing any existing
+// handler. It is the extension point for tests which need additional APIs.
+func (s *Server) Handle(api string, maxVersion int, method string, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[api+"."+method] = h
+	if maxVersion > s.apis[api] {
+		s.apis[api] = maxVersion
+	}
+}
+
+func (s *Server) nextID() string {
+	s.seq++
+	return strconv.Itoa(s.seq)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, hr *http.Request) {
+	if !strings.HasPrefix(hr.URL.Path, "/webapi/") {
+		http.NotFound(w, hr)
+		return
+	}
+
+	req, err := decodeRequest(hr)
+	if err != nil {
+		writeError(w, 101)
+		return
+	}
+
+	s.mu.Lock()
+	h, ok := s.handlers[req.API+"."+req.Method]
+	_, known := s.apis[req.API]
+	authed := s.sessions[req.Get("_sid")]
+	if c, err := hr.Cookie("id"); err == nil && s.sessions[c.Value] {
+		authed = true
+	}
+	s.mu.Unlock()
+
+	switch {
+	case !known:
+		writeError(w, 102)
+		return
+	case !ok:
+		writeError(w, 103)
+		return
+	case !authed && req.API != "SYNO.API.Info" && req.API != "SYNO.API.Auth":
+		writeError(w, 119)
+		return
+	}
+
+	s.mu.Lock()
+	data, err := h(req)
+	s.mu.Unlock()
+
+	if err != nil {
+		if e, ok := err.(*Error); ok {
+			writeError(w, e.Code)
+		} else {
+			writeError(w, 100)
+		}
+		return
+	}
+
+	if d, ok := data.(download); ok {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(d)
+		return
+	}
+
+	writeJSON(w, map[string]any{"success": true, "data": data})
+}
+
+func decodeRequest(hr *http.Request) (*Request, error) {
+	params := url.Values{}
+	for k, v := range hr.URL.Query() {
+		params[k] = v
+	}
+
+	files := map[string][]byte{}
+
+	if hr.Method == http.MethodPost {
+		ct := hr.Header.Get("Content-Type")
+		if strings.HasPrefix(ct, "multipart/form-data") {
+			if err := hr.ParseMultipartForm(32 << 20); err != nil {
+				return nil, err
+			}
+			for k, v := range hr.MultipartForm.Value {
+				params[k] = v
+			}
+			for _, fhs := range hr.MultipartForm.File {
+				for _, fh := range fhs {
+					f, err := fh.Open()
+					if err != nil {
+						return nil, err
+					}
+					b, err := io.ReadAll(f)
+					_ = f.Close()
+					if err != nil {
+						return nil, err
+					}
+					files[fh.Filename] = b
+				}
+			}
+		} else {
+			if err := hr.ParseForm(); err != nil {
+				return nil, err
+			}
+			for k, v := range hr.PostForm {
+				params[k] = v
+			}
+		}
+	}
+
+	req := &Request{
+		Params: params,
+		Files:  files,
+		HTTP:   hr,
+	}
+	req.API = req.Get("api")
+	req.Method = req.Get("method")
+	req.Version, _ = strconv.Atoi(req.Get("version"))
+
+	// Post requests carry the API name in the path as well.
+	if req.API == "" {
+		if i := strings.LastIndex(hr.URL.Path, "/"); i >= 0 {
+			req.API = hr.URL.Path[i+1:]
+		}
+	}
+
+	return req, nil
+}
+
+type download []byte
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int) {
+	writeJSON(w, map[string]any{"success": false, "error": map[string]any{"code": code}})
+}
+
+func (s *Server) apiInfo(_ *Request) (any, error) {
+	res := map[string]any{}
+	for api, v := range s.apis {
+		res[api] = map[string]any{
+			"path":          "entry.cgi",
+			"minVersion":    1,
+			"maxVersion":    v,
+			"requestFormat": "JSON",
+		}
+	}
+	return res, nil
+}
+
+func (s *Server) login(r *Request) (any, error) {
+	if r.Get("account") != s.Username || r.Get("passwd") != s.Password {
+		return nil, Errorf(400)
+	}
+
+	sid := "sid-" + s.nextID()
+	s.sessions[sid] = true
+
+	return map[string]any{
+		"sid":       sid,
+		"synotoken": "token-" + sid,
+	}, nil
+}
+
+func (s *Server) logout(r *Request) (any, error) {
+	delete(s.sessions, r.Get("_sid"))
+	return nil, nil
+}
+
+func (s *Server) session(_ *Request) (any, error) {
+	return map[string]any{
+		"session": map[string]any{
+			"user":     s.Username,
+			"is_admin": s.Admin,
+		},
+	}, nil
+}
@@ -0,0 +1,197 @@
+package mock_test
+
+import (
+	"context"
+	"testing"
+
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/go-synology/pkg/api/core"
+	"github.com/synology-community/go-synology/pkg/util/form"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+func newClient(t *testing.T, s *mock.Server) client.Api {
+	t.Helper()
+
+	c, err := client.New(api.Options{Host: s.Host(), VerifyCert: false})
+	if err != nil {
+		t.Fatalf("client.New() error = %v", err)
+	}
+
+	if _, err := c.Login(context.Background(), api.LoginOptions{
+		Username: s.Username,
+		Password: s.Password,
+	}); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	return c
+}
+
+func TestLogin(t *testing.T) {
+	s := mock.NewServer(mock.WithCredentials("bob", "secret"))
+	defer s.Close()
+
+	c, err := client.New(api.Options{Host: s.Host()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Login(context.Background(), api.LoginOptions{
+		Username: "bob",
+		Password: "wrong",
+	}); err == nil {
+		t.Error("Login() with a wrong password succeeded")
+	}
+
+	newClient(t, s)
+}
+
+func TestUnauthenticated(t *testing.T) {
+	s := mock.NewServer(mock.WithShare("docker"))
+	defer s.Close()
+
+	c, err := client.New(api.Options{Host: s.Host()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.FileStationAPI().List(context.Background(), "/docker"); err == nil {
+		t.Error("List() without a session succeeded")
+	}
+}
+
+func TestFileStation(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer(mock.WithShare("docker"))
+	defer s.Close()
+
+	fs := newClient(t, s).FileStationAPI()
+
+	if _, err := fs.CreateFolder(ctx, []string{"/docker/foo"}, []string{"bar"}, true); err != nil {
+		t.Fatalf("CreateFolder() error = %v", err)
+	}
+
+	if _, err := fs.CreateFolder(ctx, []string{"/missing"}, []string{"bar"}, true); err == nil {
+		t.Error("CreateFolder() outside of a share succeeded")
+	}
+
+	folder, err := fs.Get(ctx, "/docker/foo/bar")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if !folder.IsDir || folder.Additional.RealPath != "/volume1/docker/foo/bar" {
+		t.Errorf("Get() = %+v", folder)
+	}
+
+	if _, err := fs.Upload(ctx, "/docker/foo/bar", form.File{
+		Name:    "hello.txt",
+		Content: "hello",
+	}, true, true); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	if b, ok := s.ReadFile("/docker/foo/bar/hello.txt"); !ok || string(b) != "hello" {
+		t.Errorf("uploaded content = %q, %v", b, ok)
+	}
+
+	sum, err := fs.MD5(ctx, "/docker/foo/bar/hello.txt")
+	if err != nil {
+		t.Fatalf("MD5() error = %v", err)
+	}
+	if sum.MD5 != "5d41402abc4b2a76b9719d911017c592" {
+		t.Errorf("MD5() = %s", sum.MD5)
+	}
+
+	if _, err := fs.Delete(ctx, []string{"/docker/foo"}, true); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if s.Exists("/docker/foo/bar/hello.txt") || s.Exists("/docker/foo") {
+		t.Error("Delete() left files behind")
+	}
+}
+
+func TestShare(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer()
+	defer s.Close()
+
+	c := newClient(t, s).CoreAPI()
+
+	if err := c.ShareCreate(ctx, core.ShareInfo{
+		Name:    "data",
+		VolPath: "/volume2",
+		Desc:    "Data",
+	}); err != nil {
+		t.Fatalf("ShareCreate() error = %v", err)
+	}
+
+	share, err := c.ShareGet(ctx, "data")
+	if err != nil {
+		t.Fatalf("ShareGet() error = %v", err)
+	}
+	if share.VolPath != "/volume2" || share.Desc != "Data" || share.UUID == "" {
+		t.Errorf("ShareGet() = %+v", share)
+	}
+
+	list, err := c.ShareList(ctx)
+	if err != nil {
+		t.Fatalf("ShareList() error = %v", err)
+	}
+	if len(list.Shares) != 1 {
+		t.Errorf("ShareList() returned %d shares", len(list.Shares))
+	}
+
+	if err := c.ShareDelete(ctx, "data"); err != nil {
+		t.Fatalf("ShareDelete() error = %v", err)
+	}
+
+	if _, err := c.ShareGet(ctx, "data"); err == nil {
+		t.Error("ShareGet() after delete succeeded")
+	} else if _, ok := err.(api.NotFoundError); !ok {
+		t.Errorf("ShareGet() after delete error = %T, want api.NotFoundError", err)
+	}
+}
+
+func TestShareSnapshot(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer(mock.WithShare("docker"))
+	defer s.Close()
+
+	c := dsm.New(newClient(t, s))
+
+	name, err := c.ShareSnapshotCreate(ctx, "docker", dsm.ShareSnapshotInfo{Description: "before", Lock: true})
+	if err != nil {
+		t.Fatalf("ShareSnapshotCreate() error = %v", err)
+	}
+
+	if err := c.ShareSnapshotDelete(ctx, "docker", name); err == nil {
+		t.Error("ShareSnapshotDelete() of a locked snapshot succeeded")
+	}
+
+	if err := c.ShareSnapshotSet(ctx, "docker", name, dsm.ShareSnapshotInfo{Description: "before"}); err != nil {
+		t.Fatalf("ShareSnapshotSet() error = %v", err)
+	}
+
+	if err := c.ShareSnapshotRestore(ctx, "docker", name, true); err != nil {
+		t.Fatalf("ShareSnapshotRestore() error = %v", err)
+	}
+
+	list, err := c.ShareSnapshotList(ctx, "docker")
+	if err != nil {
+		t.Fatalf("ShareSnapshotList() error = %v", err)
+	}
+	if len(list.Snapshots) != 2 || list.Snapshots[0].Time != name || list.Snapshots[0].Lock {
+		t.Errorf("ShareSnapshotList() = %+v", list.Snapshots)
+	}
+
+	if err := c.ShareSnapshotDelete(ctx, "docker", name); err != nil {
+		t.Fatalf("ShareSnapshotDelete() error = %v", err)
+	}
+}
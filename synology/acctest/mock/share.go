@@ -0,0 +1,379 @@
+package mock
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+)
+
+// Share is a shared folder known to the mock server.
+type Share struct {
+	Name    string `json:"name"`
+	VolPath string `json:"vol_path"`
+	Desc    string `json:"desc,omitempty"`
+	UUID    string `json:"uuid"`
+
+	// Settings holds any other share attribute sent by the client, so
+	// resources can round-trip options the mock does not interpret.
+	Settings map[string]any `json:"-"`
+
+	// Permissions holds the explicit permission entries, keyed by user group
+	// type and then user or group name.
+	Permissions map[string]map[string]map[string]any `json:"-"`
+
+	// Snapshots holds the snapshots of the share in creation order.
+	Snapshots []map[string]any `json:"-"`
+}
+
+func (sh Share) data() map[string]any {
+	res := map[string]any{}
+	for k, v := range sh.Settings {
+		res[k] = v
+	}
+	res["name"] = sh.Name
+	res["vol_path"] = sh.VolPath
+	res["desc"] = sh.Desc
+	res["uuid"] = sh.UUID
+	return res
+}
+
+func (s *Server) registerShare() {
+	s.Handle("SYNO.Core.Share", 1, "list", s.shareList)
+	s.Handle("SYNO.Core.Share", 1, "get", s.shareGet)
+	s.Handle("SYNO.Core.Share", 1, "create", s.shareCreate)
+	s.Handle("SYNO.Core.Share", 1, "set", s.shareSet)
+	s.Handle("SYNO.Core.Share", 1, "delete", s.shareDelete)
+	s.Handle("SYNO.Core.Share.Permission", 1, "list", s.sharePermissionList)
+	s.Handle("SYNO.Core.Share.Permission", 1, "set", s.sharePermissionSet)
+	s.Handle("SYNO.Core.Share.Snapshot", 2, "create", s.shareSnapshotCreate)
+	s.Handle("SYNO.Core.Share.Snapshot", 2, "list", s.shareSnapshotList)
+	s.Handle("SYNO.Core.Share.Snapshot", 2, "set", s.shareSnapshotSet)
+	s.Handle("SYNO.Core.Share.Snapshot", 2, "delete", s.shareSnapshotDelete)
+	s.Handle("SYNO.Core.Share.Snapshot", 2, "restore", s.shareSnapshotRestore)
+}
+
+func (s *Server) createShare(sh Share) *Share {
+	if sh.VolPath == "" {
+		sh.VolPath = defaultVolume
+	}
+	if sh.UUID == "" {
+		sh.UUID = "share-" + s.nextID()
+	}
+	s.shares[sh.Name] = &sh
+	s.mkdirAll("/" + sh.Name)
+	return &sh
+}
+
+func (s *Server) sortedShares() []*Share {
+	res := make([]*Share, 0, len(s.shares))
+	for _, sh := range s.shares {
+		res = append(res, sh)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+// Share returns a copy of the named shared folder and whether it exists.
+func (s *Server) Share(name string) (Share, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sh, ok := s.shares[name]
+	if !ok {
+		return Share{}, false
+	}
+	return *sh, true
+}
+
+func (s *Server) shareList(_ *Request) (any, error) {
+	shares := []map[string]any{}
+	for _, sh := range s.sortedShares() {
+		shares = append(shares, sh.data())
+	}
+	return map[string]any{"shares": shares, "total": len(shares)}, nil
+}
+
+func (s *Server) shareGet(r *Request) (any, error) {
+	sh, ok := s.shares[r.Get("name")]
+	if !ok {
+		return nil, Errorf(404)
+	}
+	return sh.data(), nil
+}
+
+func shareInfo(r *Request) (map[string]any, error) {
+	info := map[string]any{}
+	if v := r.Params.Get("shareinfo"); v != "" {
+		if err := json.Unmarshal([]byte(v), &info); err != nil {
+			return nil, Errorf(400)
+		}
+	}
+	return info, nil
+}
+
+func applyShareInfo(sh *Share, info map[string]any) {
+	if sh.Settings == nil {
+		sh.Settings = map[string]any{}
+	}
+	for k, v := range info {
+		switch k {
+		case "name", "name_org", "uuid":
+		case "vol_path":
+			if p, ok := v.(string); ok && p != "" {
+				sh.VolPath = p
+			}
+		case "desc":
+			sh.Desc, _ = v.(string)
+		default:
+			sh.Settings[k] = v
+		}
+	}
+}
+
+func (s *Server) shareCreate(r *Request) (any, error) {
+	name := r.Get("name")
+	if name == "" {
+		return nil, Errorf(400)
+	}
+	if _, ok := s.shares[name]; ok {
+		return nil, Errorf(3301)
+	}
+
+	info, err := shareInfo(r)
+	if err != nil {
+		return nil, err
+	}
+
+	sh := Share{Name: name}
+	applyShareInfo(&sh, info)
+	s.createShare(sh)
+
+	return nil, nil
+}
+
+func (s *Server) shareSet(r *Request) (any, error) {
+	info, err := shareInfo(r)
+	if err != nil {
+		return nil, err
+	}
+
+	name := r.Get("name")
+	org, _ := info["name_org"].(string)
+	if org == "" {
+		org = name
+	}
+
+	sh, ok := s.shares[org]
+	if !ok {
+		return nil, Errorf(404)
+	}
+
+	if name != org {
+		if _, ok := s.shares[name]; ok {
+			return nil, Errorf(3301)
+		}
+		delete(s.shares, org)
+		for f, n := range s.files {
+			if within(f, "/"+org) {
+				delete(s.files, f)
+				s.files["/"+name+f[len(org)+1:]] = n
+			}
+		}
+		sh.Name = name
+		s.shares[name] = sh
+	}
+
+	applyShareInfo(sh, info)
+
+	return nil, nil
+}
+
+func (s *Server) shareDelete(r *Request) (any, error) {
+	for _, name := range r.List("name") {
+		if _, ok := s.shares[name]; !ok {
+			return nil, Errorf(404)
+		}
+		delete(s.shares, name)
+		for f := range s.files {
+			if within(f, "/"+name) {
+				delete(s.files, f)
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) sharePermissionList(r *Request) (any, error) {
+	sh, ok := s.shares[r.Get("name")]
+	if !ok {
+		return nil, Errorf(404)
+	}
+
+	entries := sh.Permissions[r.Get("user_group_type")]
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := []map[string]any{}
+	for _, name := range names {
+		items = append(items, entries[name])
+	}
+
+	return map[string]any{"items": items, "total": len(items)}, nil
+}
+
+func (s *Server) sharePermissionSet(r *Request) (any, error) {
+	sh, ok := s.shares[r.Get("name")]
+	if !ok {
+		return nil, Errorf(404)
+	}
+
+	var permissions []map[string]any
+	if err := json.Unmarshal([]byte(r.Params.Get("permissions")), &permissions); err != nil {
+		return nil, Errorf(400)
+	}
+
+	if sh.Permissions == nil {
+		sh.Permissions = map[string]map[string]map[string]any{}
+	}
+	t := r.Get("user_group_type")
+	if sh.Permissions[t] == nil {
+		sh.Permissions[t] = map[string]map[string]any{}
+	}
+
+	for _, p := range permissions {
+		name, _ := p["name"].(string)
+		if p["is_readonly"] == true || p["is_writable"] == true || p["is_deny"] == true {
+			sh.Permissions[t][name] = p
+		} else {
+			delete(sh.Permissions[t], name)
+		}
+	}
+
+	return nil, nil
+}
+
+func snapshotInfo(r *Request) (map[string]any, error) {
+	info := map[string]any{}
+	if v := r.Params.Get("snapinfo"); v != "" {
+		if err := json.Unmarshal([]byte(v), &info); err != nil {
+			return nil, Errorf(400)
+		}
+	}
+	return info, nil
+}
+
+func (sh *Share) snapshot(name string) map[string]any {
+	for _, snap := range sh.Snapshots {
+		if snap["time"] == name {
+			return snap
+		}
+	}
+	return nil
+}
+
+func (s *Server) shareSnapshotCreate(r *Request) (any, error) {
+	sh, ok := s.shares[r.Get("name")]
+	if !ok {
+		return nil, Errorf(404)
+	}
+
+	info, err := snapshotInfo(r)
+	if err != nil {
+		return nil, err
+	}
+
+	name := "GMT+00-2024.01.01-00.00." + s.nextID()
+	sh.Snapshots = append(sh.Snapshots, map[string]any{
+		"time": name,
+		"desc": info["desc"],
+		"lock": info["lock"] == true,
+	})
+
+	return name, nil
+}
+
+func (s *Server) shareSnapshotList(r *Request) (any, error) {
+	sh, ok := s.shares[r.Get("name")]
+	if !ok {
+		return nil, Errorf(404)
+	}
+
+	snapshots := sh.Snapshots
+	if snapshots == nil {
+		snapshots = []map[string]any{}
+	}
+	return map[string]any{"snapshots": snapshots, "total": len(snapshots)}, nil
+}
+
+func (s *Server) shareSnapshotSet(r *Request) (any, error) {
+	sh, ok := s.shares[r.Get("name")]
+	if !ok {
+		return nil, Errorf(404)
+	}
+
+	snap := sh.snapshot(r.Get("snapshot"))
+	if snap == nil {
+		return nil, Errorf(404)
+	}
+
+	info, err := snapshotInfo(r)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range info {
+		snap[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) shareSnapshotDelete(r *Request) (any, error) {
+	sh, ok := s.shares[r.Get("name")]
+	if !ok {
+		return nil, Errorf(404)
+	}
+
+	for _, name := range r.List("snapshots") {
+		snap := sh.snapshot(name)
+		if snap == nil {
+			return nil, Errorf(404)
+		}
+		if snap["lock"] == true {
+			return nil, Errorf(3305)
+		}
+	}
+
+	names := r.List("snapshots")
+	kept := sh.Snapshots[:0]
+	for _, snap := range sh.Snapshots {
+		if !slices.Contains(names, snap["time"].(string)) {
+			kept = append(kept, snap)
+		}
+	}
+	sh.Snapshots = kept
+
+	return nil, nil
+}
+
+func (s *Server) shareSnapshotRestore(r *Request) (any, error) {
+	sh, ok := s.shares[r.Get("name")]
+	if !ok {
+		return nil, Errorf(404)
+	}
+	if sh.snapshot(r.Get("snapshot")) == nil {
+		return nil, Errorf(404)
+	}
+
+	if r.Bool("take_snapshot_before") {
+		sh.Snapshots = append(sh.Snapshots, map[string]any{
+			"time": "GMT+00-2024.01.01-00.00." + s.nextID(),
+			"desc": "",
+			"lock": false,
+		})
+	}
+
+	return nil, nil
+}
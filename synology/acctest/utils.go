@@ -1,23 +1,66 @@
 package acctest
 
 import (
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
 	"github.com/synology-community/terraform-provider-synology/synology/provider"
 )
 
+// MockEnvVar enables the in-memory DSM mock server instead of a real NAS.
+const MockEnvVar = "SYNOLOGY_ACC_MOCK"
+
+// MockShare is the shared folder created on the mock server.
+const MockShare = "docker"
+
 // ProtoV5ProviderFactories returns a muxed ProviderServer that uses the provider code from this repo (SDK and plugin-framework).
 // Used to set ProtoV5ProviderFactories in a resource.TestStep within an acceptance test.
+//
+// When SYNOLOGY_ACC_MOCK is set the provider is pointed at a mock DSM server
+// which lives for the duration of the test.
 func ProtoV6ProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	if os.Getenv(MockEnvVar) != "" {
+		NewMockServer(t)
+	}
+
 	return map[string]func() (tfprotov6.ProviderServer, error){
 		"synology": providerserver.NewProtocol6WithError(provider.New()()),
 	}
 }
 
+// NewMockServer starts a mock DSM server and configures the provider
+// environment variables to use it. The server is closed when the test ends.
+func NewMockServer(t *testing.T, opts ...mock.Option) *mock.Server {
+	t.Helper()
+
+	s := mock.NewServer(append([]mock.Option{mock.WithShare(MockShare)}, opts...)...)
+	t.Cleanup(s.Close)
+
+	t.Setenv(provider.SYNOLOGY_HOST_ENV_VAR, s.Host())
+	t.Setenv(provider.SYNOLOGY_USER_ENV_VAR, s.Username)
+	t.Setenv(provider.SYNOLOGY_PASSWORD_ENV_VAR, s.Password)
+	t.Setenv(provider.SYNOLOGY_SKIP_CERT_CHECK_ENV_VAR, "true")
+
+	return s
+}
+
+// TestAccPreCheck verifies the environment needed to reach a real NAS is set.
+// It is a no-op when running against the mock server.
 func TestAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	if os.Getenv(MockEnvVar) != "" {
+		return
+	}
+
+	for _, v := range []string{
+		provider.SYNOLOGY_HOST_ENV_VAR,
+		provider.SYNOLOGY_USER_ENV_VAR,
+		provider.SYNOLOGY_PASSWORD_ENV_VAR,
+	} {
+		if os.Getenv(v) == "" {
+			t.Fatalf("%s must be set for acceptance tests, or set %s to run against the mock server", v, MockEnvVar)
+		}
+	}
 }
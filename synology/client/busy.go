@@ -0,0 +1,151 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// busyPollInterval is the wait between two polls of the system status while
+// DSM is busy.
+const busyPollInterval = 10 * time.Second
+
+// storageBusyCodes are the DSM error codes the storage APIs answer with
+// while DSM is busy, e.g. checking a volume or building a pool.
+var storageBusyCodes = map[int]bool{
+	402:  true, // System is too busy
+	421:  true, // Device or resource busy
+	5203: true, // volume_busy_waiting
+}
+
+// storageAPIPrefixes are the prefixes of the APIs whose changes wait for DSM
+// to be no longer busy.
+var storageAPIPrefixes = []string{
+	"SYNO.Storage.",
+	"SYNO.Core.Storage.",
+	"SYNO.Core.Share",
+}
+
+// SystemBusy is an http.RoundTripper which resends storage changes rejected
+// because DSM is busy. Before every new attempt it polls SYNO.Core.System
+// until DSM answers again, so that changes resume on their own once a volume
+// check or a pool expansion is over instead of failing the apply. After
+// timeout the last response is returned. Reads and other APIs are sent
+// unchanged.
+type SystemBusy struct {
+	transport http.RoundTripper
+	timeout   time.Duration
+	interval  time.Duration
+}
+
+// NewSystemBusy returns a SystemBusy sending requests through transport,
+// which defaults to http.DefaultTransport, and waiting up to timeout for DSM.
+func NewSystemBusy(transport http.RoundTripper, timeout time.Duration) *SystemBusy {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &SystemBusy{transport: transport, timeout: timeout, interval: busyPollInterval}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (b *SystemBusy) RoundTrip(req *http.Request) (*http.Response, error) {
+	in, err := newInteraction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiName, method := requestMethod(req, in)
+	if b.timeout <= 0 || !storageAPI(apiName) || checkRead(apiName, method) == nil {
+		return b.transport.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	deadline := time.Now().Add(b.timeout)
+	for attempt := 0; ; attempt++ {
+		r := req.Clone(withAttempt(req.Context(), attempt))
+		if body != nil {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		res, err := b.transport.RoundTrip(r)
+		if err != nil || !busyWith(res, storageBusyCodes) {
+			return res, err
+		}
+
+		if err := b.waitReady(req, apiName, requestValues(req, body), deadline); err != nil {
+			return res, nil
+		}
+	}
+}
+
+// waitReady polls the system status of DSM with the session of req until it
+// answers, at least once, or deadline passes.
+func (b *SystemBusy) waitReady(req *http.Request, apiName string, values url.Values, deadline time.Time) error {
+	ctx, cancel := context.WithDeadline(req.Context(), deadline)
+	defer cancel()
+
+	for {
+		select {
+		case <-time.After(b.interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		res, err := b.transport.RoundTrip(statusRequest(ctx, req, apiName, values))
+		if err != nil {
+			continue
+		}
+		body, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err == nil && succeeded(body) {
+			return nil
+		}
+	}
+}
+
+// statusRequest returns a request for the system status of DSM sent with the
+// session of req.
+func statusRequest(ctx context.Context, req *http.Request, apiName string, values url.Values) *http.Request {
+	q := url.Values{
+		"api":     {"SYNO.Core.System"},
+		"version": {"1"},
+		"method":  {"info"},
+	}
+	for _, k := range []string{"_sid", "SynoToken"} {
+		if v := values.Get(k); v != "" {
+			q.Set(k, v)
+		}
+	}
+
+	u := *req.URL
+	u.Path = strings.TrimSuffix(u.Path, "/"+apiName)
+	u.RawQuery = q.Encode()
+
+	r, _ := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	for _, c := range req.Cookies() {
+		r.AddCookie(c)
+	}
+	return r
+}
+
+func storageAPI(apiName string) bool {
+	for _, p := range storageAPIPrefixes {
+		if strings.HasPrefix(apiName, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+)
+
+var volumeSet = api.Method{
+	API:            "SYNO.Storage.CGI.Volume",
+	Version:        1,
+	Method:         "set",
+	ErrorSummaries: api.GlobalErrors,
+}
+
+type volumeSetRequest struct {
+	ID string `url:"id"`
+}
+
+func TestSystemBusy(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		timeout   time.Duration
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "resumes", timeout: time.Minute, wantCalls: 3},
+		{name: "times out", timeout: 20 * time.Millisecond, wantCalls: 1, wantErr: true},
+		{name: "disabled", timeout: 0, wantCalls: 1, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			s := mock.NewServer()
+			defer s.Close()
+
+			calls, polls := 0, 0
+			s.Handle("SYNO.Storage.CGI.Volume", 1, "set", func(r *mock.Request) (any, error) {
+				calls++
+				if calls < 3 {
+					return nil, mock.Errorf(402)
+				}
+				return map[string]any{}, nil
+			})
+			s.Handle("SYNO.Core.System", 1, "info", func(r *mock.Request) (any, error) {
+				polls++
+				if polls%2 == 1 {
+					return nil, mock.Errorf(100)
+				}
+				return map[string]any{"model": "DS920+"}, nil
+			})
+
+			c, err := synology.New(api.Options{Host: s.Host()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := NewSystemBusy(c.Client().HTTPClient.Transport, tt.timeout)
+			b.interval = time.Millisecond
+			if tt.name == "times out" {
+				b.interval = time.Hour
+			}
+			c.Client().HTTPClient.Transport = b
+
+			if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+				t.Fatalf("Login() error = %v", err)
+			}
+
+			err = api.Void(c, ctx, &volumeSetRequest{ID: "volume_1"}, volumeSet)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Void() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("Void() sent %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// cacheable lists the API methods whose responses do not change unless the
+// NAS is modified through the same API family.
+var cacheable = map[string]map[string]bool{
+	"SYNO.API.Info":            {"query": true},
+	"SYNO.Core.Storage.Volume": {"list": true},
+	"SYNO.Core.Package":        {"get": true, "list": true},
+	"SYNO.Core.Package.Server": {"list": true},
+}
+
+// Cache is an http.RoundTripper which keeps successful responses of
+// immutable lookups, keyed by API, method and parameters, for the lifetime
+// of the provider process. A single apply therefore asks the NAS for the API
+// info, volume list or package list only once.
+//
+// Any other request to an API whose name starts with a cached API name, for
+// example SYNO.Core.Package.Installation, drops the cached responses of that
+// family. Compound requests drop the whole cache.
+type Cache struct {
+	transport http.RoundTripper
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	api         string
+	status      int
+	contentType string
+	body        []byte
+}
+
+// NewCache returns a Cache sending requests through transport, which
+// defaults to http.DefaultTransport.
+func NewCache(transport http.RoundTripper) *Cache {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Cache{
+		transport: transport,
+		entries:   map[string]cacheEntry{},
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *Cache) RoundTrip(req *http.Request) (*http.Response, error) {
+	in, err := newInteraction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiName, method := requestMethod(req, in)
+	key := in.key()
+
+	if !cacheable[apiName][method] {
+		c.invalidate(apiName)
+		return c.transport.RoundTrip(req)
+	}
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	c.mu.Unlock()
+
+	if ok {
+		header := http.Header{}
+		header.Set("Content-Type", e.contentType)
+
+		return &http.Response{
+			StatusCode:    e.status,
+			Status:        http.StatusText(e.status),
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(e.body)),
+			ContentLength: int64(len(e.body)),
+			Request:       req,
+		}, nil
+	}
+
+	res, err := c.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	if res.StatusCode == http.StatusOK && succeeded(body) {
+		c.mu.Lock()
+		c.entries[key] = cacheEntry{
+			api:         apiName,
+			status:      res.StatusCode,
+			contentType: res.Header.Get("Content-Type"),
+			body:        body,
+		}
+		c.mu.Unlock()
+	}
+
+	return res, nil
+}
+
+// Invalidate drops every cached response.
+func (c *Cache) Invalidate() {
+	c.invalidate("")
+}
+
+func (c *Cache) invalidate(apiName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	all := apiName == "" || apiName == "SYNO.Entry.Request"
+	for k, e := range c.entries {
+		if all || apiName == e.api || strings.HasPrefix(apiName, e.api+".") {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// requestMethod returns the DSM API and method of a request. Post requests
+// carry them in the form body and the API name in the path as well.
+func requestMethod(req *http.Request, in Interaction) (string, string) {
+	values := parseValues(in)
+
+	apiName := values.Get("api")
+	if apiName == "" {
+		if i := strings.LastIndex(req.URL.Path, "/"); i >= 0 {
+			apiName = req.URL.Path[i+1:]
+		}
+	}
+
+	return apiName, values.Get("method")
+}
+
+// parseValues returns the parameters of a request from its query and form
+// body.
+func parseValues(in Interaction) url.Values {
+	values, _ := url.ParseQuery(in.Query)
+	if in.Body != "" {
+		body, _ := url.ParseQuery(in.Body)
+		for k, v := range body {
+			values[k] = v
+		}
+	}
+	return values
+}
+
+func succeeded(body []byte) bool {
+	var res struct {
+		Success bool `json:"success"`
+	}
+	return json.Unmarshal(body, &res) == nil && res.Success
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+)
+
+type countingTransport struct {
+	transport http.RoundTripper
+
+	mu    sync.Mutex
+	count int
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.count++
+	c.mu.Unlock()
+	return c.transport.RoundTrip(req)
+}
+
+func TestCache(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer(mock.WithShare("docker"))
+	defer s.Close()
+
+	volumes := 0
+	s.Handle("SYNO.Core.Storage.Volume", 1, "list", func(*mock.Request) (any, error) {
+		volumes++
+		return map[string]any{"volumes": []any{}, "total": 0, "offset": 0}, nil
+	})
+	s.Handle("SYNO.Core.Storage.Volume.Config", 1, "set", func(*mock.Request) (any, error) {
+		return nil, nil
+	})
+
+	c, err := synology.New(api.Options{Host: s.Host()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	counter := &countingTransport{transport: c.Client().HTTPClient.Transport}
+	c.Client().HTTPClient.Transport = NewCache(counter)
+
+	if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+		t.Fatal(err)
+	}
+
+	for range 3 {
+		if _, err := c.CoreAPI().VolumeList(ctx); err != nil {
+			t.Fatalf("VolumeList() error = %v", err)
+		}
+		if _, err := c.FileStationAPI().List(ctx, "/docker"); err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+	}
+
+	if volumes != 1 {
+		t.Errorf("volume list requested %d times, want 1", volumes)
+	}
+	if counter.count != 5 {
+		t.Errorf("sent %d requests, want 5", counter.count)
+	}
+
+	if err := api.Void(c, ctx, &struct{}{}, api.Method{
+		API:     "SYNO.Core.Storage.Volume.Config",
+		Version: 1,
+		Method:  "set",
+	}); err != nil {
+		t.Fatalf("set error = %v", err)
+	}
+
+	if _, err := c.CoreAPI().VolumeList(ctx); err != nil {
+		t.Fatalf("VolumeList() error = %v", err)
+	}
+	if volumes != 2 {
+		t.Errorf("volume list requested %d times after a change, want 2", volumes)
+	}
+}
@@ -0,0 +1,269 @@
+package client
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api/docker"
+	"github.com/synology-community/go-synology/pkg/api/docker/methods"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"gopkg.in/yaml.v3"
+)
+
+// The kinds of dependents.
+const (
+	DependentContainerProject = "container project"
+	DependentNFSExport        = "NFS export"
+	DependentRemoteFolder     = "remote folder"
+	DependentWebStationVHost  = "Web Station virtual host"
+	DependentDownloadStation  = "Download Station"
+	DependentHyperBackupTask  = "Hyper Backup task"
+	DependentShareSyncTask    = "Shared Folder Sync task"
+	DependentReplicationPlan  = "Snapshot Replication plan"
+)
+
+// volumePrefix matches the volume of a path on the NAS, such as /volume1, in
+// front of the shared folder.
+var volumePrefix = regexp.MustCompile(`^/volume[^/]*`)
+
+// Dependent is an object on the NAS which uses a folder, such as a container
+// project bind mounting it or a backup task backing it up.
+type Dependent struct {
+	Kind string
+	Name string
+	// Path is the File Station path the dependent uses, the folder or a
+	// folder within it.
+	Path string
+	// Running is whether the dependent uses the folder right now and breaks
+	// when the folder is removed, such as a running container project or a
+	// mounted remote folder. Tasks only use the folder when they run.
+	Running bool
+
+	// id is the ID of a container project.
+	id string
+}
+
+func (d Dependent) String() string {
+	s := fmt.Sprintf("%s %q using %s", d.Kind, d.Name, d.Path)
+	if d.Running {
+		s += " (running)"
+	}
+	return s
+}
+
+// Dependents returns the objects using the folder dir, a File Station path
+// such as /docker/app, or a folder within it: container projects bind
+// mounting them, remote folders mounted on them, Web Station virtual hosts
+// and the Download Station destination within them, the backup and sync tasks
+// of their shared folder and, when dir is a shared folder, its NFS exports.
+// Objects which cannot be listed, e.g. of packages which are not installed or
+// without administrator privileges, are skipped.
+func Dependents(ctx context.Context, c synology.Api, dir string) ([]Dependent, error) {
+	dir = fsPath(dir)
+	client := dsm.New(c)
+
+	var res []Dependent
+
+	containers, err := client.Supports(ctx, methods.API_DockerProject)
+	if err != nil {
+		return nil, err
+	}
+	if containers {
+		projects, _ := c.DockerAPI().ProjectList(ctx, dockerProjectList)
+		for _, p := range projects {
+			for _, m := range bindMounts(p) {
+				if within(m, dir) {
+					res = append(res, Dependent{
+						Kind:    DependentContainerProject,
+						Name:    p.Name,
+						Path:    m,
+						Running: p.IsRunning(),
+						id:      p.ID,
+					})
+				}
+			}
+		}
+	}
+
+	for _, t := range []string{dsm.RemoteMountCIFS, dsm.RemoteMountNFS} {
+		var mounts []dsm.RemoteMount
+		if res, err := client.RemoteMountList(ctx, t); err == nil {
+			mounts = res.Items
+		}
+		for _, m := range mounts {
+			if p := fsPath(m.MountPoint); within(p, dir) {
+				res = append(res, Dependent{
+					Kind:    DependentRemoteFolder,
+					Name:    m.Server + ":" + m.RemoteFolder,
+					Path:    p,
+					Running: m.Status == "mounted",
+				})
+			}
+		}
+	}
+
+	if vhosts, err := client.WebStationVHostList(ctx); err == nil {
+		for _, v := range vhosts.VHosts {
+			if p := fsPath(v.Root); within(p, dir) {
+				res = append(res, Dependent{Kind: DependentWebStationVHost, Name: v.FQDN, Path: p, Running: true})
+			}
+		}
+	}
+
+	if l, err := client.DownloadLocationGet(ctx); err == nil && l.DefaultDestination != "" {
+		if p := fsPath(l.DefaultDestination); within(p, dir) {
+			res = append(res, Dependent{Kind: DependentDownloadStation, Name: "default destination", Path: p})
+		}
+	}
+
+	// Tasks back up and sync whole shared folders, or folders within them.
+	share := strings.SplitN(strings.TrimPrefix(dir, "/"), "/", 2)[0]
+
+	if tasks, err := client.BackupTaskList(ctx); err == nil {
+		for _, t := range tasks.Tasks {
+			for _, src := range t.Sources {
+				if p := fsPath(src); within(p, dir) || within(dir, p) {
+					res = append(res, Dependent{Kind: DependentHyperBackupTask, Name: t.Name, Path: p})
+				}
+			}
+		}
+	}
+
+	if tasks, err := client.ShareSyncTaskList(ctx); err == nil {
+		for _, t := range tasks.Tasks {
+			if slices.Contains(t.Shares, share) {
+				res = append(res, Dependent{Kind: DependentShareSyncTask, Name: t.Name, Path: "/" + share})
+			}
+		}
+	}
+
+	if plans, err := client.ReplicationPlanList(ctx); err == nil {
+		for _, p := range plans.Plans {
+			if p.Share == share {
+				res = append(res, Dependent{Kind: DependentReplicationPlan, Name: p.TargetHost, Path: "/" + share})
+			}
+		}
+	}
+
+	if share != "" && dir == "/"+share {
+		var rules []dsm.NFSRule
+		if res, err := client.NFSSharePrivilegeLoad(ctx, share); err == nil {
+			rules = res.Rules
+		}
+		for _, r := range rules {
+			res = append(res, Dependent{
+				Kind:    DependentNFSExport,
+				Name:    r.Client,
+				Path:    dir,
+				Running: true,
+			})
+		}
+	}
+
+	slices.SortStableFunc(res, func(a, b Dependent) int {
+		return cmp.Or(strings.Compare(a.Kind, b.Kind), strings.Compare(a.Name, b.Name))
+	})
+	return res, nil
+}
+
+// ReleaseDependents prepares removing the folder dir. Running container
+// projects using it are stopped when the provider is configured with
+// stop_dependents, any other running dependent is listed in the returned
+// error, so that removing the folder does not leave DSM broken. A nil Client,
+// e.g. in tests, has no dependents.
+func (c *Client) ReleaseDependents(ctx context.Context, dir string) error {
+	if c == nil {
+		return nil
+	}
+
+	deps, err := Dependents(ctx, c.Api, dir)
+	if err != nil {
+		return err
+	}
+
+	var blocking []string
+	stopped := map[string]bool{}
+	for _, d := range deps {
+		if !d.Running || stopped[d.id] {
+			continue
+		}
+		if c.StopDependents && d.Kind == DependentContainerProject {
+			if _, err := c.DockerAPI().ProjectStopStream(ctx, docker.ProjectStreamRequest{ID: d.id}); err != nil {
+				return fmt.Errorf("failed to stop %s: %w", d, err)
+			}
+			stopped[d.id] = true
+			continue
+		}
+		blocking = append(blocking, d.String())
+	}
+
+	if len(blocking) > 0 {
+		return fmt.Errorf(
+			"%s is in use by:\n- %s\nStop or remove them first, or set stop_dependents in the provider configuration to stop container projects",
+			dir, strings.Join(blocking, "\n- "),
+		)
+	}
+	return nil
+}
+
+// dockerProjectList lists all container projects.
+var dockerProjectList = docker.ProjectListRequest{Limit: -1}
+
+// bindMounts returns the File Station paths of the folders bind mounted by
+// the services of p. Relative sources are within the project folder.
+func bindMounts(p docker.Project) []string {
+	var compose struct {
+		Services map[string]struct {
+			Volumes []yaml.Node `yaml:"volumes"`
+		} `yaml:"services"`
+	}
+	if err := yaml.Unmarshal([]byte(p.Content), &compose); err != nil {
+		return nil
+	}
+
+	var res []string
+	for _, s := range compose.Services {
+		for _, v := range s.Volumes {
+			var source string
+			switch v.Kind {
+			case yaml.ScalarNode:
+				source, _, _ = strings.Cut(v.Value, ":")
+			case yaml.MappingNode:
+				var m struct {
+					Type   string `yaml:"type"`
+					Source string `yaml:"source"`
+				}
+				if v.Decode(&m) != nil || m.Type != "bind" {
+					continue
+				}
+				source = m.Source
+			}
+
+			switch {
+			case strings.HasPrefix(source, "/"):
+				res = append(res, fsPath(source))
+			case strings.HasPrefix(source, "."):
+				res = append(res, path.Join(p.SharePath, source))
+			}
+			// Any other source is a named volume.
+		}
+	}
+	return res
+}
+
+// fsPath returns the File Station path of p, a path on the NAS with or
+// without its volume, e.g. /volume1/docker/ for /docker.
+func fsPath(p string) string {
+	return path.Clean("/" + volumePrefix.ReplaceAllString(p, ""))
+}
+
+// within reports whether p is dir or a path within it.
+func within(p, dir string) bool {
+	return p == dir || strings.HasPrefix(p, strings.TrimSuffix(dir, "/")+"/")
+}
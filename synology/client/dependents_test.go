@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/go-synology/pkg/api/docker"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+)
+
+func TestBindMounts(t *testing.T) {
+	p := docker.Project{
+		Name:      "media",
+		SharePath: "/docker/media",
+		Content: `
+services:
+  jellyfin:
+    image: jellyfin/jellyfin
+    volumes:
+      - ./config:/config
+      - /volume1/video:/media:ro
+      - cache:/cache
+      - type: bind
+        source: /volume2/music/
+        target: /music
+      - type: volume
+        source: transcodes
+        target: /transcodes
+volumes:
+  cache:
+  transcodes:
+`,
+	}
+
+	got := bindMounts(p)
+	slices.Sort(got)
+	want := []string{"/docker/media/config", "/music", "/video"}
+	if !slices.Equal(got, want) {
+		t.Errorf("bindMounts() = %v, want %v", got, want)
+	}
+
+	if got := bindMounts(docker.Project{Content: "{"}); got != nil {
+		t.Errorf("bindMounts() of invalid content = %v, want nil", got)
+	}
+}
+
+func TestWithin(t *testing.T) {
+	for _, tt := range []struct {
+		p, dir string
+		want   bool
+	}{
+		{"/docker/app", "/docker/app", true},
+		{"/docker/app/data", "/docker/app", true},
+		{"/docker/application", "/docker/app", false},
+		{"/docker", "/docker/app", false},
+		{"/docker/app", "/", true},
+	} {
+		if got := within(tt.p, tt.dir); got != tt.want {
+			t.Errorf("within(%q, %q) = %v, want %v", tt.p, tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestReleaseDependents(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer(mock.WithShare("docker"))
+	defer s.Close()
+
+	c, err := synology.New(api.Options{Host: s.Host()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The mock server offers neither Container Manager nor NFS, so nothing
+	// depends on the folder.
+	if err := (&Client{Api: c}).ReleaseDependents(ctx, "/docker/app"); err != nil {
+		t.Errorf("ReleaseDependents() error = %v", err)
+	}
+
+	var nilClient *Client
+	if err := nilClient.ReleaseDependents(ctx, "/docker/app"); err != nil {
+		t.Errorf("ReleaseDependents() of nil Client error = %v", err)
+	}
+
+	d := Dependent{Kind: DependentContainerProject, Name: "media", Path: "/docker/app/config", Running: true}
+	if got := d.String(); !strings.Contains(got, `"media"`) || !strings.HasSuffix(got, "(running)") {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -0,0 +1,187 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// secretParamWords are parts of parameter names whose values are redacted
+// from diagnostics and fixtures, compared case insensitively. "key" covers
+// pre-shared, license and private keys.
+var secretParamWords = []string{"pass", "secret", "token", "otp", "private", "cookie", "key", "psk"}
+
+// secretParamNames are words of parameter names, separated by underscores,
+// whose values are redacted as well. They only match whole words as they are
+// part of harmless names such as description. Task scripts and their extra
+// settings may contain secrets of the environment.
+var secretParamNames = []string{"script", "extra"}
+
+// Diagnose is an http.RoundTripper which adds the request to the errors DSM
+// answers with. Every error diagnostic then names the API, version and method
+// called and the parameters sent, so that the call can be reproduced with
+// curl. Secrets are redacted and the session is left out. The request is
+// added to the error fields as
+//
+//	request: {"api":"SYNO.Core.Share","method":"create","params":{...},"version":"1"}
+//
+// Logins are left alone, their errors carry the fields of the login flow.
+type Diagnose struct {
+	transport http.RoundTripper
+}
+
+// NewDiagnose returns a Diagnose sending requests through transport, which
+// defaults to http.DefaultTransport.
+func NewDiagnose(transport http.RoundTripper) *Diagnose {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Diagnose{transport: transport}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (d *Diagnose) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody && formRequest(req) {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	res, err := d.transport.RoundTrip(req)
+	if err != nil || !strings.HasPrefix(res.Header.Get("Content-Type"), "application/json") {
+		return res, err
+	}
+
+	values := requestValues(req, body)
+	apiName := values.Get("api")
+	if apiName == "" {
+		if i := strings.LastIndex(req.URL.Path, "/"); i >= 0 {
+			apiName = req.URL.Path[i+1:]
+		}
+	}
+	if apiName == "SYNO.API.Auth" {
+		return res, nil
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+	if err != nil || succeeded(resBody) {
+		return res, nil
+	}
+
+	if b, ok := withRequest(resBody, apiName, values); ok {
+		res.Body = io.NopCloser(bytes.NewReader(b))
+		res.ContentLength = int64(len(b))
+		res.Header.Del("Content-Length")
+	}
+	return res, nil
+}
+
+// withRequest returns the DSM error response body with the request added to
+// its error fields. Responses whose error fields are not an object are not
+// changed.
+func withRequest(body []byte, apiName string, values url.Values) ([]byte, bool) {
+	var r map[string]any
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, false
+	}
+	e, ok := r["error"].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	fields, ok := e["errors"].(map[string]any)
+	if e["errors"] == nil {
+		fields, ok = map[string]any{}, true
+	}
+	if !ok {
+		return nil, false
+	}
+
+	params := map[string]any{}
+	for k, v := range values {
+		switch {
+		case k == "api" || k == "version" || k == "method" || k == "_sid" || k == "SynoToken":
+		case secretParam(k):
+			params[k] = redacted
+		case len(v) == 1:
+			params[k] = redactParam(v[0])
+		default:
+			params[k] = v
+		}
+	}
+	fields["request"] = map[string]any{
+		"api":     apiName,
+		"version": values.Get("version"),
+		"method":  values.Get("method"),
+		"params":  params,
+	}
+	e["errors"] = fields
+
+	b, err := json.Marshal(r)
+	return b, err == nil
+}
+
+// redactParam returns the value of a parameter, with the secrets of JSON
+// values redacted.
+func redactParam(v string) any {
+	if !strings.HasPrefix(v, "{") && !strings.HasPrefix(v, "[") {
+		return v
+	}
+
+	var j any
+	if err := json.Unmarshal([]byte(v), &j); err != nil {
+		return v
+	}
+	return redact(j)
+}
+
+// secretParam reports whether the value of the parameter or field k is
+// redacted from diagnostics and fixtures.
+func secretParam(k string) bool {
+	if sensitiveParams[k] || sensitiveFields[strings.ToLower(k)] {
+		return true
+	}
+
+	k = strings.ToLower(k)
+	for _, w := range secretParamWords {
+		if strings.Contains(k, w) {
+			return true
+		}
+	}
+	for _, w := range strings.Split(k, "_") {
+		if slices.Contains(secretParamNames, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestValues returns the parameters of req from its query and, for form
+// requests, from body. Unlike parseValues, secrets are kept.
+func requestValues(req *http.Request, body []byte) url.Values {
+	values := req.URL.Query()
+	if formRequest(req) {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for k, v := range form {
+				values[k] = v
+			}
+		}
+	}
+	return values
+}
+
+func formRequest(req *http.Request) bool {
+	return strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded")
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+var userSet = api.Method{
+	API:            "SYNO.Core.User",
+	Version:        1,
+	Method:         "set",
+	ErrorSummaries: api.GlobalErrors,
+}
+
+type userSetRequest struct {
+	Name     string `url:"name,json"`
+	Password string `url:"password"`
+	Groups   string `url:"groups"`
+}
+
+func TestDiagnose(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer()
+	defer s.Close()
+
+	s.Handle("SYNO.Core.User", 1, "set", func(r *mock.Request) (any, error) {
+		return nil, mock.Errorf(400)
+	})
+
+	c, err := synology.New(api.Options{Host: s.Host()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Client().HTTPClient.Transport = NewDiagnose(c.Client().HTTPClient.Transport)
+
+	if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	err = api.Void(c, ctx, &userSetRequest{
+		Name:     "alice",
+		Password: "hunter2",
+		Groups:   `[{"name":"staff","token":"abc"}]`,
+	}, userSet)
+	if err == nil {
+		t.Fatal("Void() error = nil, want an error")
+	}
+
+	var apiErr api.ApiError
+	if !errors.As(err, &apiErr) || apiErr.Code != 400 {
+		t.Errorf("Void() error = %v, want a DSM error with code 400", err)
+	}
+
+	msg := err.Error()
+	for _, want := range []string{
+		`"api":"SYNO.Core.User"`,
+		`"version":"1"`,
+		`"method":"set"`,
+		`"name":"\"alice\""`,
+		`"password":"REDACTED"`,
+		`"token":"REDACTED"`,
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Void() error = %s, want it to contain %s", msg, want)
+		}
+	}
+	for _, secret := range []string{"hunter2", "abc", "sid-"} {
+		if strings.Contains(msg, secret) {
+			t.Errorf("Void() error = %s, want %q redacted", msg, secret)
+		}
+	}
+}
+
+func TestDiagnoseL2TPProfile(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer()
+	defer s.Close()
+
+	s.Handle(dsm.Core_Network_VPN_L2TP, 1, "create", func(r *mock.Request) (any, error) {
+		return nil, mock.Errorf(400)
+	})
+
+	c, err := synology.New(api.Options{Host: s.Host()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Client().HTTPClient.Transport = NewDiagnose(c.Client().HTTPClient.Transport)
+
+	if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	_, err = dsm.New(c).L2TPProfileCreate(ctx, dsm.L2TPProfile{
+		Name:         "office",
+		Server:       "vpn.example.com",
+		User:         "alice",
+		Password:     "hunter2",
+		PresharedKey: "s3cr3t-psk",
+	})
+	if err == nil {
+		t.Fatal("L2TPProfileCreate() error = nil, want an error")
+	}
+
+	msg := err.Error()
+	for _, want := range []string{
+		`"api":"SYNO.Core.Network.VPN.L2TP"`,
+		`"server":"vpn.example.com"`,
+		`"pass":"REDACTED"`,
+		`"preshared_key":"REDACTED"`,
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("L2TPProfileCreate() error = %s, want it to contain %s", msg, want)
+		}
+	}
+	for _, secret := range []string{"hunter2", "s3cr3t-psk"} {
+		if strings.Contains(msg, secret) {
+			t.Errorf("L2TPProfileCreate() error = %s, want %q redacted", msg, secret)
+		}
+	}
+}
+
+func TestSecretParam(t *testing.T) {
+	for k, want := range map[string]bool{
+		"_sid":          true,
+		"SynoToken":     true,
+		"passwd":        true,
+		"new_password":  true,
+		"client_secret": true,
+		"otp_code":      true,
+		"preshared_key": true,
+		"license_key":   true,
+		"psk":           true,
+		"script":        true,
+		"extra":         true,
+		"task_script":   true,
+		"name":          false,
+		"path":          false,
+		"description":   false,
+		"extraction":    false,
+	} {
+		if got := secretParam(k); got != want {
+			t.Errorf("secretParam(%q) = %v, want %v", k, got, want)
+		}
+	}
+}
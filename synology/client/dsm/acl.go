@@ -0,0 +1,110 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_ACL = "SYNO.Core.ACL"
+
+// Owner types of an ACL entry.
+const (
+	ACLOwnerUser  = "user"
+	ACLOwnerGroup = "group"
+)
+
+// Permissions of an ACL entry, following the Windows access rights.
+var ACLPermissions = []string{
+	"read_data",
+	"write_data",
+	"exe_file",
+	"append_data",
+	"delete",
+	"delete_sub",
+	"read_attr",
+	"write_attr",
+	"read_ext_attr",
+	"write_ext_attr",
+	"read_perm",
+	"change_perm",
+	"take_ownership",
+}
+
+// Inheritance flags of an ACL entry.
+var ACLInheritance = []string{
+	"child_files",
+	"child_folders",
+	"this_folder",
+	"all_descendants",
+}
+
+var (
+	ACLGet = api.Method{
+		API:            Core_ACL,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ACLSet = api.Method{
+		API:            Core_ACL,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// ACE is an entry of the ACL of a file or folder. Permission and Inherit map
+// the names in ACLPermissions and ACLInheritance to whether they are set.
+type ACE struct {
+	OwnerType  string          `json:"owner_type"`
+	OwnerName  string          `json:"owner_name"`
+	IsAllow    bool            `json:"is_allow"`
+	Inherited  bool            `json:"inherited,omitempty"`
+	Permission map[string]bool `json:"permission"`
+	Inherit    map[string]bool `json:"inherit"`
+}
+
+// ACL is the access control list of a file or folder. Inherited reports
+// whether the entries of the parent folder apply as well.
+type ACL struct {
+	Entries   []ACE `json:"acls"`
+	Inherited bool  `json:"inherited"`
+}
+
+type ACLGetRequest struct {
+	FilePath string `url:"file_path"`
+	Type     string `url:"type"`
+}
+
+type ACLSetRequest struct {
+	FilePath  string `url:"file_path"`
+	Inherited bool   `url:"inherited"`
+	Entries   []ACE  `url:"acls,json"`
+}
+
+// ACLGet returns the ACL of a File Station path, including the entries
+// inherited from parent folders.
+func (c *Client) ACLGet(ctx context.Context, path string) (*ACL, error) {
+	return api.Get[ACL](c.client, ctx, &ACLGetRequest{
+		FilePath: path,
+		Type:     "all",
+	}, ACLGet)
+}
+
+// ACLSet replaces the entries of a File Station path which are not
+// inherited.
+func (c *Client) ACLSet(ctx context.Context, path string, acl ACL) error {
+	entries := []ACE{}
+	for _, e := range acl.Entries {
+		if !e.Inherited {
+			entries = append(entries, e)
+		}
+	}
+
+	return api.Void(c.client, ctx, &ACLSetRequest{
+		FilePath:  path,
+		Inherited: acl.Inherited,
+		Entries:   entries,
+	}, ACLSet)
+}
@@ -0,0 +1,39 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const ActiveBackup_Task = "SYNO.ActiveBackup.Task"
+
+var ActiveBackupTaskList = api.Method{
+	API:            ActiveBackup_Task,
+	Version:        1,
+	Method:         api.MethodList,
+	ErrorSummaries: api.GlobalErrors,
+}
+
+// ActiveBackupResultSuccess is the LastResult of a task whose last backup
+// succeeded.
+const ActiveBackupResultSuccess = "success"
+
+// ActiveBackupTask is a backup task of Active Backup for Business.
+// LastSuccessTime is a Unix time, zero before the first successful backup.
+type ActiveBackupTask struct {
+	TaskID          int64  `json:"task_id"`
+	Name            string `json:"task_name"`
+	LastResult      string `json:"last_result"`
+	LastSuccessTime int64  `json:"last_success_time"`
+	UsedSize        int64  `json:"used_size"`
+}
+
+type ActiveBackupTaskListResponse struct {
+	Tasks []ActiveBackupTask `json:"tasks"`
+}
+
+// ActiveBackupTaskList returns the Active Backup for Business tasks.
+func (c *Client) ActiveBackupTaskList(ctx context.Context) (*ActiveBackupTaskListResponse, error) {
+	return api.Get[ActiveBackupTaskListResponse](c.client, ctx, &struct{}{}, ActiveBackupTaskList)
+}
@@ -0,0 +1,114 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_AppPriv_Rule = "SYNO.Core.AppPriv.Rule"
+
+// Entity types accepted by SYNO.Core.AppPriv.Rule.
+const (
+	AppPrivilegeEntityUser     = "user"
+	AppPrivilegeEntityGroup    = "group"
+	AppPrivilegeEntityEveryone = "everyone"
+)
+
+// IDs of package applications seats are commonly managed for.
+const (
+	AppPrivilegeDrive  = "SYNO.SDS.Drive.Application"
+	AppPrivilegePhotos = "SYNO.Foto.AppInstance"
+)
+
+// AppPrivilegeAnyIP is the address range DSM uses for a rule that applies
+// from every source address.
+const AppPrivilegeAnyIP = "0.0.0.0"
+
+var (
+	AppPrivilegeRuleList = api.Method{
+		API:            Core_AppPriv_Rule,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	AppPrivilegeRuleSet = api.Method{
+		API:            Core_AppPriv_Rule,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	AppPrivilegeRuleDelete = api.Method{
+		API:            Core_AppPriv_Rule,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// AppPrivilegeRule allows or denies an application to a user or group. The
+// IP lists restrict the rule to source addresses, AppPrivilegeAnyIP matching
+// every address.
+type AppPrivilegeRule struct {
+	EntityType string   `json:"entity_type"`
+	EntityName string   `json:"entity_name"`
+	AppID      string   `json:"app_id"`
+	AllowIP    []string `json:"allow_ip"`
+	DenyIP     []string `json:"deny_ip"`
+}
+
+// Allowed reports whether the rule grants access from every address.
+func (r AppPrivilegeRule) Allowed() bool {
+	for _, ip := range r.AllowIP {
+		if ip == AppPrivilegeAnyIP {
+			return true
+		}
+	}
+	return false
+}
+
+// Denied reports whether the rule denies access from every address.
+func (r AppPrivilegeRule) Denied() bool {
+	for _, ip := range r.DenyIP {
+		if ip == AppPrivilegeAnyIP {
+			return true
+		}
+	}
+	return false
+}
+
+type AppPrivilegeRuleListRequest struct {
+	AppID  string `url:"app_id"`
+	Offset int    `url:"offset"`
+	Limit  int    `url:"limit"`
+}
+
+type AppPrivilegeRuleListResponse struct {
+	Rules []AppPrivilegeRule `json:"rules"`
+	Total int                `json:"total"`
+}
+
+type AppPrivilegeRuleSetRequest struct {
+	Rules []AppPrivilegeRule `url:"rules,json"`
+}
+
+// AppPrivilegeRuleList returns the rules of an application.
+func (c *Client) AppPrivilegeRuleList(ctx context.Context, appID string) (*AppPrivilegeRuleListResponse, error) {
+	return api.Get[AppPrivilegeRuleListResponse](c.client, ctx, &AppPrivilegeRuleListRequest{
+		AppID:  appID,
+		Offset: 0,
+		Limit:  -1,
+	}, AppPrivilegeRuleList)
+}
+
+// AppPrivilegeRuleSet creates or replaces the given rules, matched by app,
+// entity type and entity name.
+func (c *Client) AppPrivilegeRuleSet(ctx context.Context, rules []AppPrivilegeRule) error {
+	return api.Void(c.client, ctx, &AppPrivilegeRuleSetRequest{Rules: rules}, AppPrivilegeRuleSet)
+}
+
+// AppPrivilegeRuleDelete removes the given rules. Only the app and entity
+// fields of each rule are used.
+func (c *Client) AppPrivilegeRuleDelete(ctx context.Context, rules []AppPrivilegeRule) error {
+	return api.Void(c.client, ctx, &AppPrivilegeRuleSetRequest{Rules: rules}, AppPrivilegeRuleDelete)
+}
@@ -0,0 +1,189 @@
+package dsm
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Core_Certificate_CRT     = "SYNO.Core.Certificate.CRT"
+	Core_Certificate_Service = "SYNO.Core.Certificate.Service"
+
+	Core_Certificate_LetsEncrypt = "SYNO.Core.Certificate.LetsEncrypt"
+)
+
+var (
+	CertificateList = api.Method{
+		API:            Core_Certificate_CRT,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	CertificateDelete = api.Method{
+		API:            Core_Certificate_CRT,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	CertificateServiceSet = api.Method{
+		API:            Core_Certificate_Service,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	LetsEncryptCreate = api.Method{
+		API:            Core_Certificate_LetsEncrypt,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LetsEncryptRenew = api.Method{
+		API:            Core_Certificate_LetsEncrypt,
+		Version:        1,
+		Method:         "renew",
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// CertificateService is a DSM service or package virtual host a certificate
+// can be bound to.
+type CertificateService struct {
+	DisplayName string `json:"display_name"`
+	Service     string `json:"service"`
+	Subscriber  string `json:"subscriber"`
+	IsPkg       bool   `json:"isPkg"`
+}
+
+type CertificateName struct {
+	CommonName   string   `json:"common_name"`
+	Organization string   `json:"organization,omitempty"`
+	SubAltName   []string `json:"sub_alt_name,omitempty"`
+}
+
+// Certificate is a certificate installed on the NAS. ValidFrom and ValidTill
+// are formatted like "Jan  2 15:04:05 2006 GMT".
+type Certificate struct {
+	ID          string               `json:"id"`
+	Description string               `json:"desc"`
+	IsDefault   bool                 `json:"is_default"`
+	Issuer      CertificateName      `json:"issuer"`
+	Subject     CertificateName      `json:"subject"`
+	ValidFrom   string               `json:"valid_from"`
+	ValidTill   string               `json:"valid_till"`
+	Renewable   bool                 `json:"renewable"`
+	Services    []CertificateService `json:"services"`
+}
+
+// Bound reports whether the certificate is used by the service of
+// subscriber.
+func (c Certificate) Bound(subscriber, service string) bool {
+	for _, s := range c.Services {
+		if s.Subscriber == subscriber && s.Service == service {
+			return true
+		}
+	}
+	return false
+}
+
+// certificateTimeLayout is the layout of ValidFrom and ValidTill.
+const certificateTimeLayout = "Jan _2 15:04:05 2006 MST"
+
+// Starts returns the start of the validity period of the certificate.
+func (c Certificate) Starts() (time.Time, error) {
+	return time.Parse(certificateTimeLayout, c.ValidFrom)
+}
+
+// Expires returns the end of the validity period of the certificate.
+func (c Certificate) Expires() (time.Time, error) {
+	return time.Parse(certificateTimeLayout, c.ValidTill)
+}
+
+// Domains returns the common name of the certificate followed by its other
+// subject alternative names.
+func (c Certificate) Domains() []string {
+	domains := []string{c.Subject.CommonName}
+	for _, n := range c.Subject.SubAltName {
+		if n != c.Subject.CommonName {
+			domains = append(domains, n)
+		}
+	}
+	return domains
+}
+
+type CertificateListResponse struct {
+	Certificates []Certificate `json:"certificates"`
+}
+
+type CertificateServiceSetting struct {
+	Service CertificateService `json:"service"`
+	OldID   string             `json:"old_id"`
+	ID      string             `json:"id"`
+}
+
+type CertificateServiceSetRequest struct {
+	Settings []CertificateServiceSetting `url:"settings,json"`
+}
+
+type CertificateDeleteRequest struct {
+	IDs []string `url:"ids,json"`
+}
+
+type LetsEncryptCreateRequest struct {
+	Description string `url:"desc"`
+	AsDefault   bool   `url:"as_default"`
+	DomainName  string `url:"domain_name"`
+	Email       string `url:"email"`
+	AltNames    string `url:"alter_name"`
+}
+
+type LetsEncryptCreateResponse struct {
+	ID string `json:"id"`
+}
+
+type LetsEncryptRenewRequest struct {
+	ID string `url:"id"`
+}
+
+// CertificateList returns the certificates installed on the NAS.
+func (c *Client) CertificateList(ctx context.Context) (*CertificateListResponse, error) {
+	return api.Get[CertificateListResponse](c.client, ctx, &struct{}{}, CertificateList)
+}
+
+// CertificateServiceSet binds the certificate id to service, replacing the
+// certificate oldID.
+func (c *Client) CertificateServiceSet(ctx context.Context, service CertificateService, oldID, id string) error {
+	return api.Void(c.client, ctx, &CertificateServiceSetRequest{
+		Settings: []CertificateServiceSetting{{Service: service, OldID: oldID, ID: id}},
+	}, CertificateServiceSet)
+}
+
+// CertificateDelete deletes the certificate id. The certificate must not be
+// bound to any service.
+func (c *Client) CertificateDelete(ctx context.Context, id string) error {
+	return api.Void(c.client, ctx, &CertificateDeleteRequest{IDs: []string{id}}, CertificateDelete)
+}
+
+// LetsEncryptCreate requests a certificate for domains from Let's Encrypt.
+// The first domain is the common name, the others are added as subject
+// alternative names. The NAS must be reachable on port 80 of every domain.
+func (c *Client) LetsEncryptCreate(
+	ctx context.Context,
+	description, email string,
+	domains []string,
+) (*LetsEncryptCreateResponse, error) {
+	req := &LetsEncryptCreateRequest{Description: description, Email: email}
+	if len(domains) > 0 {
+		req.DomainName = domains[0]
+		req.AltNames = strings.Join(domains[1:], ";")
+	}
+	return api.Post[LetsEncryptCreateResponse](c.client, ctx, req, LetsEncryptCreate)
+}
+
+// LetsEncryptRenew renews the Let's Encrypt certificate id.
+func (c *Client) LetsEncryptRenew(ctx context.Context, id string) error {
+	return api.Void(c.client, ctx, &LetsEncryptRenewRequest{ID: id}, LetsEncryptRenew)
+}
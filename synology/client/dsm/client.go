@@ -0,0 +1,32 @@
+// Package dsm implements the DSM web APIs used by the provider which are not
+// covered by go-synology. It follows the go-synology layout: api.Method
+// definitions, request and response types and a Client wrapping api.Api.
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+// Client calls the DSM APIs through an authenticated go-synology client.
+type Client struct {
+	client api.Api
+}
+
+// New returns a Client using c for transport and credentials.
+func New(c api.Api) *Client {
+	return &Client{client: c}
+}
+
+// Supports reports whether the NAS offers the API name. Some APIs are only
+// offered by the models supporting their feature.
+func (c *Client) Supports(ctx context.Context, name string) (bool, error) {
+	info, err := c.client.GetApiInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := (*info)[name]
+	return ok, nil
+}
@@ -0,0 +1,96 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/go-synology/pkg/util/form"
+)
+
+const (
+	Backup_Config_Backup     = "SYNO.Backup.Config.Backup"
+	Backup_Config_AutoBackup = "SYNO.Backup.Config.AutoBackup"
+)
+
+var (
+	ConfigBackupStart = api.Method{
+		API:            Backup_Config_Backup,
+		Version:        1,
+		Method:         api.MethodStart,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ConfigBackupStatus = api.Method{
+		API:            Backup_Config_Backup,
+		Version:        1,
+		Method:         api.MethodStatus,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ConfigBackupDownload = api.Method{
+		API:            Backup_Config_Backup,
+		Version:        1,
+		Method:         "download",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ConfigAutoBackupGet = api.Method{
+		API:            Backup_Config_AutoBackup,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ConfigAutoBackupSet = api.Method{
+		API:            Backup_Config_AutoBackup,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+type ConfigBackupRequest struct {
+	TaskID string `url:"task_id"`
+}
+
+type ConfigBackupStartResponse struct {
+	TaskID string `json:"task_id"`
+}
+
+type ConfigBackupStatusResponse struct {
+	Finished bool   `json:"finished"`
+	Success  bool   `json:"success"`
+	FileName string `json:"file_name"`
+}
+
+// ConfigAutoBackup uploads the configuration to the Synology account of the
+// NAS whenever it changes.
+type ConfigAutoBackup struct {
+	Enabled bool `json:"enable" url:"enable"`
+}
+
+// ConfigBackupStart starts exporting the DSM configuration and returns the ID
+// of the export task.
+func (c *Client) ConfigBackupStart(ctx context.Context) (string, error) {
+	res, err := api.Post[ConfigBackupStartResponse](c.client, ctx, &struct{}{}, ConfigBackupStart)
+	if err != nil {
+		return "", err
+	}
+	return res.TaskID, nil
+}
+
+// ConfigBackupStatus returns the progress of an export task.
+func (c *Client) ConfigBackupStatus(ctx context.Context, taskID string) (*ConfigBackupStatusResponse, error) {
+	return api.Get[ConfigBackupStatusResponse](c.client, ctx, &ConfigBackupRequest{TaskID: taskID}, ConfigBackupStatus)
+}
+
+// ConfigBackupDownload returns the .dss file of a finished export task.
+func (c *Client) ConfigBackupDownload(ctx context.Context, taskID string) (*form.File, error) {
+	return api.Get[form.File](c.client, ctx, &ConfigBackupRequest{TaskID: taskID}, ConfigBackupDownload)
+}
+
+// ConfigAutoBackupGet returns the automatic configuration backup setting.
+func (c *Client) ConfigAutoBackupGet(ctx context.Context) (*ConfigAutoBackup, error) {
+	return api.Get[ConfigAutoBackup](c.client, ctx, &struct{}{}, ConfigAutoBackupGet)
+}
+
+// ConfigAutoBackupSet enables or disables the automatic configuration backup.
+func (c *Client) ConfigAutoBackupSet(ctx context.Context, settings ConfigAutoBackup) error {
+	return api.Void(c.client, ctx, &settings, ConfigAutoBackupSet)
+}
@@ -0,0 +1,83 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Core_DDNS_Record = "SYNO.Core.DDNS.Record"
+	Core_DDNS_ExtIP  = "SYNO.Core.DDNS.ExtIP"
+)
+
+var (
+	DDNSRecordList = api.Method{
+		API:            Core_DDNS_Record,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DDNSExternalIPList = api.Method{
+		API:            Core_DDNS_ExtIP,
+		Version:        2,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// DDNSRecord is a DDNS hostname registered by the NAS.
+type DDNSRecord struct {
+	ID       string `json:"id"`
+	Provider string `json:"provider"`
+	Hostname string `json:"hostname"`
+	Enable   bool   `json:"enable"`
+	Status   string `json:"status"`
+}
+
+type DDNSRecordListResponse struct {
+	Records []DDNSRecord `json:"records"`
+}
+
+// DDNSExternalIP is the public address of the NAS as detected by DSM. Type
+// names the interface the address was detected for, e.g. "WAN".
+type DDNSExternalIP struct {
+	IP   string `json:"ip"`
+	IPv6 string `json:"ipv6"`
+	Type string `json:"type"`
+}
+
+type DDNSExternalIPListRequest struct {
+	Retry bool `url:"retry"`
+}
+
+// DDNSRecordList returns the DDNS records of the NAS.
+func (c *Client) DDNSRecordList(ctx context.Context) (*DDNSRecordListResponse, error) {
+	return api.Get[DDNSRecordListResponse](c.client, ctx, &struct{}{}, DDNSRecordList)
+}
+
+// ServiceHost returns the hostname clients reach the NAS with: the first
+// enabled DDNS hostname, or the host the provider connects to.
+func (c *Client) ServiceHost(ctx context.Context) (string, error) {
+	list, err := c.DDNSRecordList(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, r := range list.Records {
+		if r.Enable && r.Hostname != "" {
+			return r.Hostname, nil
+		}
+	}
+
+	return c.client.BaseUrl().Hostname(), nil
+}
+
+// DDNSExternalIPList detects the public addresses of the NAS.
+func (c *Client) DDNSExternalIPList(ctx context.Context) ([]DDNSExternalIP, error) {
+	res, err := api.Get[[]DDNSExternalIP](c.client, ctx, &DDNSExternalIPListRequest{Retry: true}, DDNSExternalIPList)
+	if err != nil {
+		return nil, err
+	}
+	return *res, nil
+}
@@ -0,0 +1,45 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Storage_CGI_BtrfsDedupe = "SYNO.Storage.CGI.BtrfsDedupe"
+
+var (
+	DedupGet = api.Method{
+		API:            Storage_CGI_BtrfsDedupe,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DedupSet = api.Method{
+		API:            Storage_CGI_BtrfsDedupe,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// VolumeDedup holds the data deduplication setting of a Btrfs volume. DSM
+// only offers deduplication on some models with SSD volumes.
+type VolumeDedup struct {
+	VolumePath string `json:"volume_path" url:"volume_path"`
+	Enabled    bool   `json:"enable"      url:"enable"`
+}
+
+type DedupGetRequest struct {
+	VolumePath string `url:"volume_path"`
+}
+
+// DedupGet returns the deduplication setting of a volume such as /volume1.
+func (c *Client) DedupGet(ctx context.Context, volume string) (*VolumeDedup, error) {
+	return api.Get[VolumeDedup](c.client, ctx, &DedupGetRequest{VolumePath: volume}, DedupGet)
+}
+
+// DedupSet saves the deduplication setting of a volume.
+func (c *Client) DedupSet(ctx context.Context, d VolumeDedup) error {
+	return api.Void(c.client, ctx, &d, DedupSet)
+}
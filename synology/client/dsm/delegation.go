@@ -0,0 +1,71 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_Delegation = "SYNO.Core.Delegation"
+
+// Delegated administration roles of DSM 7.2.
+const (
+	DelegationUserManagement   = "user_management"
+	DelegationShareManagement  = "share_management"
+	DelegationSystemMonitoring = "system_monitoring"
+)
+
+var (
+	DelegationGet = api.Method{
+		API:            Core_Delegation,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DelegationSet = api.Method{
+		API:            Core_Delegation,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// Delegation are the local users and groups a delegated administration role
+// is granted to. Delegated administrators get the rights of the role without
+// being members of the administrators group.
+type Delegation struct {
+	Role   string   `json:"role"`
+	Users  []string `json:"users"`
+	Groups []string `json:"groups"`
+}
+
+type DelegationGetRequest struct {
+	Role string `url:"role"`
+}
+
+type DelegationSetRequest struct {
+	Role   string   `url:"role"`
+	Users  []string `url:"users,json"`
+	Groups []string `url:"groups,json"`
+}
+
+// DelegationGet returns the users and groups a role is granted to.
+func (c *Client) DelegationGet(ctx context.Context, role string) (*Delegation, error) {
+	return api.Get[Delegation](c.client, ctx, &DelegationGetRequest{Role: role}, DelegationGet)
+}
+
+// DelegationSet replaces the users and groups a role is granted to.
+func (c *Client) DelegationSet(ctx context.Context, d Delegation) error {
+	users, groups := d.Users, d.Groups
+	if users == nil {
+		users = []string{}
+	}
+	if groups == nil {
+		groups = []string{}
+	}
+	return api.Void(c.client, ctx, &DelegationSetRequest{
+		Role:   d.Role,
+		Users:  users,
+		Groups: groups,
+	}, DelegationSet)
+}
@@ -0,0 +1,108 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Network_DHCPServer             = "SYNO.Network.DHCPServer"
+	Network_DHCPServer_Reservation = "SYNO.Network.DHCPServer.Reservation"
+)
+
+var (
+	DHCPServerGet = api.Method{
+		API:            Network_DHCPServer,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DHCPServerSet = api.Method{
+		API:            Network_DHCPServer,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DHCPReservationGet = api.Method{
+		API:            Network_DHCPServer_Reservation,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DHCPReservationSet = api.Method{
+		API:            Network_DHCPServer_Reservation,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// DHCPRange is an address range handed out by the DHCP server.
+type DHCPRange struct {
+	StartIP   string `json:"start_ip"`
+	EndIP     string `json:"end_ip"`
+	Netmask   string `json:"netmask"`
+	Gateway   string `json:"gateway"`
+	LeaseTime int64  `json:"lease_time"`
+}
+
+// DHCPServer holds the DHCP server settings of a network interface.
+type DHCPServer struct {
+	Enable     bool        `json:"enable"      url:"enable"`
+	Ranges     []DHCPRange `json:"ranges"      url:"ranges,json"`
+	DNSServers []string    `json:"dns"         url:"dns,json"`
+	DomainName string      `json:"domain_name" url:"domain_name"`
+}
+
+type DHCPServerRequest struct {
+	IfName string `url:"ifname"`
+}
+
+type DHCPServerSetRequest struct {
+	IfName string `url:"ifname"`
+	DHCPServer
+}
+
+// DHCPReservation is a fixed address assigned to a MAC address.
+type DHCPReservation struct {
+	MAC      string `json:"mac"`
+	IP       string `json:"ip"`
+	Hostname string `json:"hostname"`
+}
+
+type DHCPReservationResponse struct {
+	Reservations []DHCPReservation `json:"reservations"`
+}
+
+type DHCPReservationSetRequest struct {
+	IfName       string            `url:"ifname"`
+	Reservations []DHCPReservation `url:"reservations,json"`
+}
+
+// DHCPServerGet returns the DHCP server settings of the interface ifname.
+func (c *Client) DHCPServerGet(ctx context.Context, ifname string) (*DHCPServer, error) {
+	return api.Get[DHCPServer](c.client, ctx, &DHCPServerRequest{IfName: ifname}, DHCPServerGet)
+}
+
+// DHCPServerSet replaces the DHCP server settings of the interface ifname.
+func (c *Client) DHCPServerSet(ctx context.Context, ifname string, server DHCPServer) error {
+	return api.Void(c.client, ctx, &DHCPServerSetRequest{IfName: ifname, DHCPServer: server}, DHCPServerSet)
+}
+
+// DHCPReservationList returns the reservations of the interface ifname.
+func (c *Client) DHCPReservationList(ctx context.Context, ifname string) ([]DHCPReservation, error) {
+	res, err := api.Get[DHCPReservationResponse](c.client, ctx, &DHCPServerRequest{IfName: ifname}, DHCPReservationGet)
+	if err != nil {
+		return nil, err
+	}
+	return res.Reservations, nil
+}
+
+// DHCPReservationSet replaces the reservations of the interface ifname.
+func (c *Client) DHCPReservationSet(ctx context.Context, ifname string, reservations []DHCPReservation) error {
+	return api.Void(c.client, ctx, &DHCPReservationSetRequest{
+		IfName:       ifname,
+		Reservations: reservations,
+	}, DHCPReservationSet)
+}
@@ -0,0 +1,338 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	ActiveDirectory_Domain         = "SYNO.ActiveDirectory.Domain"
+	ActiveDirectory_User           = "SYNO.ActiveDirectory.User"
+	ActiveDirectory_Group          = "SYNO.ActiveDirectory.Group"
+	ActiveDirectory_OU             = "SYNO.ActiveDirectory.OU"
+	ActiveDirectory_PasswordPolicy = "SYNO.ActiveDirectory.Policy.Password"
+)
+
+// Scopes of Directory Server groups.
+const (
+	DirectoryGroupDomainLocal = "domain_local"
+	DirectoryGroupGlobal      = "global"
+	DirectoryGroupUniversal   = "universal"
+)
+
+// Types of Directory Server groups.
+const (
+	DirectoryGroupSecurity     = "security"
+	DirectoryGroupDistribution = "distribution"
+)
+
+var (
+	DirectoryDomainGet = api.Method{
+		API:            ActiveDirectory_Domain,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryDomainCreate = api.Method{
+		API:            ActiveDirectory_Domain,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	DirectoryUserList = api.Method{
+		API:            ActiveDirectory_User,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryUserCreate = api.Method{
+		API:            ActiveDirectory_User,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryUserSet = api.Method{
+		API:            ActiveDirectory_User,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryUserDelete = api.Method{
+		API:            ActiveDirectory_User,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	DirectoryGroupList = api.Method{
+		API:            ActiveDirectory_Group,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryGroupCreate = api.Method{
+		API:            ActiveDirectory_Group,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryGroupSet = api.Method{
+		API:            ActiveDirectory_Group,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryGroupDelete = api.Method{
+		API:            ActiveDirectory_Group,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	DirectoryOUList = api.Method{
+		API:            ActiveDirectory_OU,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryOUCreate = api.Method{
+		API:            ActiveDirectory_OU,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryOUSet = api.Method{
+		API:            ActiveDirectory_OU,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryOUDelete = api.Method{
+		API:            ActiveDirectory_OU,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	DirectoryPasswordPolicyGet = api.Method{
+		API:            ActiveDirectory_PasswordPolicy,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DirectoryPasswordPolicySet = api.Method{
+		API:            ActiveDirectory_PasswordPolicy,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// DirectoryDomain is the Active Directory domain served by Directory Server.
+// Created is false until the domain is provisioned.
+type DirectoryDomain struct {
+	Created      bool   `json:"created"`
+	Name         string `json:"domain_name"`
+	NetBIOSName  string `json:"workgroup"`
+	ForestLevel  string `json:"forest_level"`
+	DNSForwarder string `json:"dns_forwarder"`
+	BaseDN       string `json:"base_dn"`
+}
+
+type DirectoryDomainCreateRequest struct {
+	Name          string `url:"domain_name"`
+	NetBIOSName   string `url:"workgroup"`
+	AdminPassword string `url:"admin_password"`
+	ForestLevel   string `url:"forest_level"`
+	DNSForwarder  string `url:"dns_forwarder"`
+}
+
+// DirectoryUser is a user of the Directory Server domain. Container is the DN
+// of the OU or container holding it, e.g. CN=Users,DC=corp,DC=example,DC=com.
+type DirectoryUser struct {
+	Name                 string `json:"name"`
+	DN                   string `json:"dn,omitempty"`
+	Container            string `json:"container"`
+	DisplayName          string `json:"display_name"`
+	Email                string `json:"email"`
+	Description          string `json:"description"`
+	Disabled             bool   `json:"disabled"`
+	PasswordNeverExpires bool   `json:"password_never_expire"`
+	MustChangePassword   bool   `json:"must_change_password"`
+}
+
+type DirectoryUserListResponse struct {
+	Users []DirectoryUser `json:"users"`
+}
+
+type DirectoryUserRequest struct {
+	User DirectoryUser `url:"user,json"`
+	// Password is only changed when it is set.
+	Password string `url:"password,omitempty"`
+}
+
+type DirectoryUserSetRequest struct {
+	Name     string        `url:"name"`
+	User     DirectoryUser `url:"user,json"`
+	Password string        `url:"password,omitempty"`
+}
+
+type DirectoryNameRequest struct {
+	Names []string `url:"names,json"`
+}
+
+// DirectoryGroup is a group of the Directory Server domain. Members are the
+// names of its users and groups.
+type DirectoryGroup struct {
+	Name        string   `json:"name"`
+	DN          string   `json:"dn,omitempty"`
+	Container   string   `json:"container"`
+	Description string   `json:"description"`
+	Scope       string   `json:"scope"`
+	Type        string   `json:"type"`
+	Members     []string `json:"members"`
+}
+
+type DirectoryGroupListResponse struct {
+	Groups []DirectoryGroup `json:"groups"`
+}
+
+type DirectoryGroupRequest struct {
+	Group DirectoryGroup `url:"group,json"`
+}
+
+type DirectoryGroupSetRequest struct {
+	Name  string         `url:"name"`
+	Group DirectoryGroup `url:"group,json"`
+}
+
+// DirectoryOU is an organizational unit of the Directory Server domain.
+type DirectoryOU struct {
+	Name        string `json:"name"`
+	DN          string `json:"dn,omitempty"`
+	Container   string `json:"container"`
+	Description string `json:"description"`
+}
+
+type DirectoryOUListResponse struct {
+	OUs []DirectoryOU `json:"ous"`
+}
+
+type DirectoryOURequest struct {
+	OU DirectoryOU `url:"ou,json"`
+}
+
+type DirectoryOUSetRequest struct {
+	DN string      `url:"dn"`
+	OU DirectoryOU `url:"ou,json"`
+}
+
+type DirectoryDNRequest struct {
+	DNs []string `url:"dns,json"`
+}
+
+// DirectoryPasswordPolicy are the password and lockout rules of the domain.
+// Ages are in days, LockoutDuration in minutes; 0 turns a rule off.
+type DirectoryPasswordPolicy struct {
+	Complexity       bool  `json:"complexity"`
+	MinLength        int64 `json:"min_length"`
+	History          int64 `json:"history_length"`
+	MinAge           int64 `json:"min_age"`
+	MaxAge           int64 `json:"max_age"`
+	LockoutThreshold int64 `json:"lockout_threshold"`
+	LockoutDuration  int64 `json:"lockout_duration"`
+}
+
+type DirectoryPasswordPolicySetRequest struct {
+	Policy DirectoryPasswordPolicy `url:"policy,json"`
+}
+
+// DirectoryDomainGet returns the domain of Directory Server.
+func (c *Client) DirectoryDomainGet(ctx context.Context) (*DirectoryDomain, error) {
+	return api.Get[DirectoryDomain](c.client, ctx, &struct{}{}, DirectoryDomainGet)
+}
+
+// DirectoryDomainCreate provisions the domain, making the NAS its domain
+// controller. The domain cannot be changed afterwards.
+func (c *Client) DirectoryDomainCreate(ctx context.Context, req DirectoryDomainCreateRequest) error {
+	return api.Void(c.client, ctx, &req, DirectoryDomainCreate)
+}
+
+// DirectoryUserList returns the users of the domain.
+func (c *Client) DirectoryUserList(ctx context.Context) (*DirectoryUserListResponse, error) {
+	return api.Get[DirectoryUserListResponse](c.client, ctx, &struct{}{}, DirectoryUserList)
+}
+
+// DirectoryUserCreate adds a user with the password.
+func (c *Client) DirectoryUserCreate(ctx context.Context, user DirectoryUser, password string) error {
+	user.DN = ""
+	return api.Void(c.client, ctx, &DirectoryUserRequest{User: user, Password: password}, DirectoryUserCreate)
+}
+
+// DirectoryUserSet updates the user with the name, moving it to the container
+// of user. The password is only changed when it is not empty.
+func (c *Client) DirectoryUserSet(ctx context.Context, name string, user DirectoryUser, password string) error {
+	user.DN = ""
+	return api.Void(c.client, ctx, &DirectoryUserSetRequest{Name: name, User: user, Password: password}, DirectoryUserSet)
+}
+
+// DirectoryUserDelete removes a user.
+func (c *Client) DirectoryUserDelete(ctx context.Context, name string) error {
+	return api.Void(c.client, ctx, &DirectoryNameRequest{Names: []string{name}}, DirectoryUserDelete)
+}
+
+// DirectoryGroupList returns the groups of the domain.
+func (c *Client) DirectoryGroupList(ctx context.Context) (*DirectoryGroupListResponse, error) {
+	return api.Get[DirectoryGroupListResponse](c.client, ctx, &struct{}{}, DirectoryGroupList)
+}
+
+// DirectoryGroupCreate adds a group.
+func (c *Client) DirectoryGroupCreate(ctx context.Context, group DirectoryGroup) error {
+	group.DN = ""
+	return api.Void(c.client, ctx, &DirectoryGroupRequest{Group: group}, DirectoryGroupCreate)
+}
+
+// DirectoryGroupSet updates the group with the name, replacing its members.
+func (c *Client) DirectoryGroupSet(ctx context.Context, name string, group DirectoryGroup) error {
+	group.DN = ""
+	return api.Void(c.client, ctx, &DirectoryGroupSetRequest{Name: name, Group: group}, DirectoryGroupSet)
+}
+
+// DirectoryGroupDelete removes a group. Its members are kept.
+func (c *Client) DirectoryGroupDelete(ctx context.Context, name string) error {
+	return api.Void(c.client, ctx, &DirectoryNameRequest{Names: []string{name}}, DirectoryGroupDelete)
+}
+
+// DirectoryOUList returns the organizational units of the domain.
+func (c *Client) DirectoryOUList(ctx context.Context) (*DirectoryOUListResponse, error) {
+	return api.Get[DirectoryOUListResponse](c.client, ctx, &struct{}{}, DirectoryOUList)
+}
+
+// DirectoryOUCreate adds an organizational unit.
+func (c *Client) DirectoryOUCreate(ctx context.Context, ou DirectoryOU) error {
+	ou.DN = ""
+	return api.Void(c.client, ctx, &DirectoryOURequest{OU: ou}, DirectoryOUCreate)
+}
+
+// DirectoryOUSet updates the description of the organizational unit dn.
+func (c *Client) DirectoryOUSet(ctx context.Context, dn string, ou DirectoryOU) error {
+	ou.DN = ""
+	return api.Void(c.client, ctx, &DirectoryOUSetRequest{DN: dn, OU: ou}, DirectoryOUSet)
+}
+
+// DirectoryOUDelete removes an organizational unit, which must be empty.
+func (c *Client) DirectoryOUDelete(ctx context.Context, dn string) error {
+	return api.Void(c.client, ctx, &DirectoryDNRequest{DNs: []string{dn}}, DirectoryOUDelete)
+}
+
+// DirectoryPasswordPolicyGet returns the password rules of the domain.
+func (c *Client) DirectoryPasswordPolicyGet(ctx context.Context) (*DirectoryPasswordPolicy, error) {
+	return api.Get[DirectoryPasswordPolicy](c.client, ctx, &struct{}{}, DirectoryPasswordPolicyGet)
+}
+
+// DirectoryPasswordPolicySet replaces the password rules of the domain.
+func (c *Client) DirectoryPasswordPolicySet(ctx context.Context, policy DirectoryPasswordPolicy) error {
+	return api.Void(c.client, ctx, &DirectoryPasswordPolicySetRequest{Policy: policy}, DirectoryPasswordPolicySet)
+}
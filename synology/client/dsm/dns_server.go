@@ -0,0 +1,186 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	DNSServer_Zone        = "SYNO.DNSServer.Zone"
+	DNSServer_ZoneConf    = "SYNO.DNSServer.ZoneConf"
+	DNSServer_Zone_Record = "SYNO.DNSServer.Zone.Record"
+)
+
+// DNS Server zone types.
+const (
+	DNSZoneMaster = "master"
+	DNSZoneSlave  = "slave"
+)
+
+// DNS Server zone domain types.
+const (
+	DNSDomainForward = "forward"
+	DNSDomainReverse = "reverse"
+)
+
+var (
+	DNSZoneList = api.Method{
+		API:            DNSServer_Zone,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DNSZoneCreate = api.Method{
+		API:            DNSServer_Zone,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DNSZoneDelete = api.Method{
+		API:            DNSServer_Zone,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	DNSZoneConfGet = api.Method{
+		API:            DNSServer_ZoneConf,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DNSZoneConfSet = api.Method{
+		API:            DNSServer_ZoneConf,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	DNSRecordList = api.Method{
+		API:            DNSServer_Zone_Record,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DNSRecordCreate = api.Method{
+		API:            DNSServer_Zone_Record,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DNSRecordDelete = api.Method{
+		API:            DNSServer_Zone_Record,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// DNSZone is a zone served by the DNS Server package.
+type DNSZone struct {
+	ZoneID     string `json:"zone_id"`
+	DomainName string `json:"domain_name"`
+	DomainType string `json:"domain_type"`
+	ZoneType   string `json:"zone_type"`
+	Enabled    bool   `json:"zone_enable"`
+	ReadOnly   bool   `json:"is_readonly"`
+}
+
+type DNSZoneListResponse struct {
+	Items []DNSZone `json:"items"`
+}
+
+type DNSZoneCreateRequest struct {
+	DomainName string   `url:"domain_name"`
+	DomainType string   `url:"domain_type"`
+	ZoneType   string   `url:"zone_type"`
+	MasterIPs  []string `url:"master_zone,json,omitempty"`
+}
+
+type DNSZoneDeleteRequest struct {
+	Items []string `url:"items,json"`
+}
+
+// DNSZoneConf holds the settings of a zone. MasterIPs is only used by slave
+// zones.
+type DNSZoneConf struct {
+	ZoneID           string   `json:"zone_id"              url:"zone_id"`
+	Enabled          bool     `json:"zone_enable"          url:"zone_enable"`
+	MasterIPs        []string `json:"master_zone"          url:"master_zone,json"`
+	EnableTransfer   bool     `json:"enable_zone_transfer" url:"enable_zone_transfer"`
+	TransferAllowIPs []string `json:"zone_transfer_list"   url:"zone_transfer_list,json"`
+}
+
+type DNSZoneConfGetRequest struct {
+	ZoneID string `url:"zone_id"`
+}
+
+// DNSRecord is a resource record of a zone. Owner is the fully qualified
+// name with a trailing dot and TTL is a number of seconds, both as sent by
+// DSM.
+type DNSRecord struct {
+	ZoneName   string `json:"zone_name"   url:"zone_name"`
+	DomainName string `json:"domain_name" url:"domain_name"`
+	Owner      string `json:"rr_owner"    url:"rr_owner"`
+	Type       string `json:"rr_type"     url:"rr_type"`
+	TTL        string `json:"rr_ttl"      url:"rr_ttl"`
+	Info       string `json:"rr_info"     url:"rr_info"`
+}
+
+type DNSRecordListRequest struct {
+	ZoneName   string `url:"zone_name"`
+	DomainName string `url:"domain_name"`
+}
+
+type DNSRecordListResponse struct {
+	Items []DNSRecord `json:"items"`
+}
+
+type DNSRecordDeleteRequest struct {
+	Items []DNSRecord `url:"items,json"`
+}
+
+// DNSZoneList returns the zones of the DNS Server package.
+func (c *Client) DNSZoneList(ctx context.Context) (*DNSZoneListResponse, error) {
+	return api.Get[DNSZoneListResponse](c.client, ctx, &struct{}{}, DNSZoneList)
+}
+
+// DNSZoneCreate creates a zone. DSM names the zone after its domain.
+func (c *Client) DNSZoneCreate(ctx context.Context, req DNSZoneCreateRequest) error {
+	return api.Void(c.client, ctx, &req, DNSZoneCreate)
+}
+
+// DNSZoneDelete deletes a zone with all its records.
+func (c *Client) DNSZoneDelete(ctx context.Context, zoneID string) error {
+	return api.Void(c.client, ctx, &DNSZoneDeleteRequest{Items: []string{zoneID}}, DNSZoneDelete)
+}
+
+// DNSZoneConfGet returns the settings of a zone.
+func (c *Client) DNSZoneConfGet(ctx context.Context, zoneID string) (*DNSZoneConf, error) {
+	return api.Get[DNSZoneConf](c.client, ctx, &DNSZoneConfGetRequest{ZoneID: zoneID}, DNSZoneConfGet)
+}
+
+// DNSZoneConfSet replaces the settings of a zone.
+func (c *Client) DNSZoneConfSet(ctx context.Context, conf DNSZoneConf) error {
+	return api.Void(c.client, ctx, &conf, DNSZoneConfSet)
+}
+
+// DNSRecordList returns the resource records of a zone.
+func (c *Client) DNSRecordList(ctx context.Context, zoneName, domainName string) (*DNSRecordListResponse, error) {
+	return api.Get[DNSRecordListResponse](c.client, ctx, &DNSRecordListRequest{
+		ZoneName:   zoneName,
+		DomainName: domainName,
+	}, DNSRecordList)
+}
+
+// DNSRecordCreate adds a resource record to a zone.
+func (c *Client) DNSRecordCreate(ctx context.Context, record DNSRecord) error {
+	return api.Void(c.client, ctx, &record, DNSRecordCreate)
+}
+
+// DNSRecordDelete removes resource records. Records are matched on all
+// their fields.
+func (c *Client) DNSRecordDelete(ctx context.Context, records ...DNSRecord) error {
+	return api.Void(c.client, ctx, &DNSRecordDeleteRequest{Items: records}, DNSRecordDelete)
+}
@@ -0,0 +1,234 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	DownloadStation2_Task               = "SYNO.DownloadStation2.Task"
+	DownloadStation2_Settings_Location  = "SYNO.DownloadStation2.Settings.Location"
+	DownloadStation2_Settings_BT        = "SYNO.DownloadStation2.Settings.BT"
+	DownloadStation2_Settings_FtpHttp   = "SYNO.DownloadStation2.Settings.FtpHttp"
+	DownloadStation2_Settings_Scheduler = "SYNO.DownloadStation2.Settings.Scheduler"
+)
+
+// DownloadTaskStatuses names the statuses of Download Station tasks.
+var DownloadTaskStatuses = map[int]string{
+	1:   "waiting",
+	2:   "downloading",
+	3:   "paused",
+	4:   "finishing",
+	5:   "finished",
+	6:   "hash_checking",
+	7:   "pre_seeding",
+	8:   "seeding",
+	9:   "filehosting_waiting",
+	10:  "extracting",
+	11:  "preprocessing",
+	12:  "preprocess_pass",
+	13:  "downloaded",
+	14:  "postprocessing",
+	15:  "captcha_needed",
+	101: "error",
+}
+
+var (
+	DownloadTaskList = api.Method{
+		API:            DownloadStation2_Task,
+		Version:        2,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DownloadTaskCreate = api.Method{
+		API:            DownloadStation2_Task,
+		Version:        2,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DownloadTaskDelete = api.Method{
+		API:            DownloadStation2_Task,
+		Version:        2,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	DownloadLocationGet = api.Method{
+		API:            DownloadStation2_Settings_Location,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DownloadLocationSet = api.Method{
+		API:            DownloadStation2_Settings_Location,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DownloadBTGet = api.Method{
+		API:            DownloadStation2_Settings_BT,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DownloadBTSet = api.Method{
+		API:            DownloadStation2_Settings_BT,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DownloadFtpHttpGet = api.Method{
+		API:            DownloadStation2_Settings_FtpHttp,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DownloadFtpHttpSet = api.Method{
+		API:            DownloadStation2_Settings_FtpHttp,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DownloadSchedulerGet = api.Method{
+		API:            DownloadStation2_Settings_Scheduler,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DownloadSchedulerSet = api.Method{
+		API:            DownloadStation2_Settings_Scheduler,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+type DownloadTaskDetail struct {
+	Destination string `json:"destination"`
+	URI         string `json:"uri"`
+}
+
+type DownloadTaskAdditional struct {
+	Detail DownloadTaskDetail `json:"detail"`
+}
+
+// DownloadTask is a Download Station task. Size is in bytes.
+type DownloadTask struct {
+	ID         string                 `json:"id"`
+	Title      string                 `json:"title"`
+	Type       string                 `json:"type"`
+	Size       int64                  `json:"size"`
+	Status     int                    `json:"status"`
+	Additional DownloadTaskAdditional `json:"additional"`
+}
+
+type DownloadTaskListRequest struct {
+	Additional []string `url:"additional,json"`
+}
+
+type DownloadTaskListResponse struct {
+	Tasks []DownloadTask `json:"task"`
+}
+
+type DownloadTaskCreateRequest struct {
+	Type        string   `url:"type"`
+	URL         []string `url:"url,json"`
+	Destination string   `url:"destination"`
+	CreateList  bool     `url:"create_list"`
+}
+
+type DownloadTaskCreateResponse struct {
+	TaskIDs []string `json:"task_id"`
+}
+
+type DownloadTaskDeleteRequest struct {
+	IDs           []string `url:"id,json"`
+	ForceComplete bool     `url:"force_complete"`
+}
+
+type DownloadLocation struct {
+	DefaultDestination string `json:"default_destination" url:"default_destination"`
+}
+
+// DownloadRate holds transfer rate limits in KB/s, 0 meaning unlimited.
+// MaxUploadRate only applies to BitTorrent.
+type DownloadRate struct {
+	MaxDownloadRate int64 `json:"max_download_rate"         url:"max_download_rate"`
+	MaxUploadRate   int64 `json:"max_upload_rate,omitempty" url:"max_upload_rate,omitempty"`
+}
+
+// DownloadScheduler enables the download schedule set up in Download Station.
+type DownloadScheduler struct {
+	Enable bool `json:"enable" url:"enable"`
+}
+
+// DownloadTaskList returns the Download Station tasks with their details.
+func (c *Client) DownloadTaskList(ctx context.Context) (*DownloadTaskListResponse, error) {
+	return api.Get[DownloadTaskListResponse](c.client, ctx, &DownloadTaskListRequest{
+		Additional: []string{"detail"},
+	}, DownloadTaskList)
+}
+
+// DownloadTaskCreate creates a task downloading uri to destination and returns
+// its ID. An empty destination downloads to the default destination.
+func (c *Client) DownloadTaskCreate(ctx context.Context, uri, destination string) (string, error) {
+	res, err := api.Post[DownloadTaskCreateResponse](c.client, ctx, &DownloadTaskCreateRequest{
+		Type:        "url",
+		URL:         []string{uri},
+		Destination: destination,
+	}, DownloadTaskCreate)
+	if err != nil {
+		return "", err
+	}
+	if len(res.TaskIDs) == 0 {
+		return "", nil
+	}
+	return res.TaskIDs[0], nil
+}
+
+// DownloadTaskDelete deletes a task. Files which were downloaded are kept.
+func (c *Client) DownloadTaskDelete(ctx context.Context, id string) error {
+	return api.Void(c.client, ctx, &DownloadTaskDeleteRequest{IDs: []string{id}}, DownloadTaskDelete)
+}
+
+// DownloadLocationGet returns the default destination of Download Station.
+func (c *Client) DownloadLocationGet(ctx context.Context) (*DownloadLocation, error) {
+	return api.Get[DownloadLocation](c.client, ctx, &struct{}{}, DownloadLocationGet)
+}
+
+// DownloadLocationSet sets the default destination of Download Station.
+func (c *Client) DownloadLocationSet(ctx context.Context, location DownloadLocation) error {
+	return api.Void(c.client, ctx, &location, DownloadLocationSet)
+}
+
+// DownloadBTGet returns the BitTorrent rate limits.
+func (c *Client) DownloadBTGet(ctx context.Context) (*DownloadRate, error) {
+	return api.Get[DownloadRate](c.client, ctx, &struct{}{}, DownloadBTGet)
+}
+
+// DownloadBTSet sets the BitTorrent rate limits.
+func (c *Client) DownloadBTSet(ctx context.Context, rate DownloadRate) error {
+	return api.Void(c.client, ctx, &rate, DownloadBTSet)
+}
+
+// DownloadFtpHttpGet returns the FTP and HTTP rate limit.
+func (c *Client) DownloadFtpHttpGet(ctx context.Context) (*DownloadRate, error) {
+	return api.Get[DownloadRate](c.client, ctx, &struct{}{}, DownloadFtpHttpGet)
+}
+
+// DownloadFtpHttpSet sets the FTP and HTTP rate limit.
+func (c *Client) DownloadFtpHttpSet(ctx context.Context, rate DownloadRate) error {
+	rate.MaxUploadRate = 0
+	return api.Void(c.client, ctx, &rate, DownloadFtpHttpSet)
+}
+
+// DownloadSchedulerGet returns whether the download schedule is enabled.
+func (c *Client) DownloadSchedulerGet(ctx context.Context) (*DownloadScheduler, error) {
+	return api.Get[DownloadScheduler](c.client, ctx, &struct{}{}, DownloadSchedulerGet)
+}
+
+// DownloadSchedulerSet enables or disables the download schedule.
+func (c *Client) DownloadSchedulerSet(ctx context.Context, scheduler DownloadScheduler) error {
+	return api.Void(c.client, ctx, &scheduler, DownloadSchedulerSet)
+}
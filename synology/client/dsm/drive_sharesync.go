@@ -0,0 +1,120 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const SynologyDriveShareSync_Connection = "SYNO.SynologyDriveShareSync.Connection"
+
+// Directions of Synology Drive ShareSync folder pairs.
+const (
+	DriveSyncBidirectional = "bidirectional"
+	DriveSyncDownloadOnly  = "download_only"
+	DriveSyncUploadOnly    = "upload_only"
+)
+
+var (
+	DriveShareSyncList = api.Method{
+		API:            SynologyDriveShareSync_Connection,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DriveShareSyncCreate = api.Method{
+		API:            SynologyDriveShareSync_Connection,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DriveShareSyncSet = api.Method{
+		API:            SynologyDriveShareSync_Connection,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	DriveShareSyncDelete = api.Method{
+		API:            SynologyDriveShareSync_Connection,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// DriveSyncPair syncs LocalPath, a File Station path of the NAS, with
+// RemotePath on the remote NAS.
+type DriveSyncPair struct {
+	LocalPath  string `json:"local_path"`
+	RemotePath string `json:"remote_path"`
+	Direction  string `json:"sync_direction"`
+}
+
+// DriveShareSyncSchedule limits syncing to a time window. A non zero
+// RepeatHour syncs every RepeatHour hours starting at Hour instead of
+// continuously.
+type DriveShareSyncSchedule struct {
+	Enabled    bool   `json:"enable"`
+	Hour       int64  `json:"hour"`
+	Minute     int64  `json:"minute"`
+	RepeatHour int64  `json:"repeat_hour"`
+	WeekDay    string `json:"week_day"`
+}
+
+// DriveShareSync is a Synology Drive ShareSync connection to the Drive Server
+// of a remote NAS. The password is write only.
+type DriveShareSync struct {
+	ID       int64                  `json:"conn_id,omitempty"`
+	Server   string                 `json:"server_address"`
+	Port     int64                  `json:"port"`
+	Username string                 `json:"username"`
+	Password string                 `json:"password,omitempty"`
+	SSL      bool                   `json:"use_ssl"`
+	Pairs    []DriveSyncPair        `json:"sessions"`
+	Schedule DriveShareSyncSchedule `json:"schedule"`
+	Status   string                 `json:"status,omitempty"`
+}
+
+type DriveShareSyncListResponse struct {
+	Connections []DriveShareSync `json:"connections"`
+}
+
+type DriveShareSyncRequest struct {
+	Connection DriveShareSync `url:"connection,json"`
+}
+
+type DriveShareSyncCreateResponse struct {
+	ID int64 `json:"conn_id"`
+}
+
+type DriveShareSyncDeleteRequest struct {
+	IDs []int64 `url:"conn_ids,json"`
+}
+
+// DriveShareSyncList returns the ShareSync connections of the NAS.
+func (c *Client) DriveShareSyncList(ctx context.Context) (*DriveShareSyncListResponse, error) {
+	return api.Get[DriveShareSyncListResponse](c.client, ctx, &struct{}{}, DriveShareSyncList)
+}
+
+// DriveShareSyncCreate connects to a remote Drive Server and returns the ID
+// of the connection.
+func (c *Client) DriveShareSyncCreate(ctx context.Context, conn DriveShareSync) (int64, error) {
+	conn.ID = 0
+	res, err := api.Post[DriveShareSyncCreateResponse](c.client, ctx, &DriveShareSyncRequest{Connection: conn}, DriveShareSyncCreate)
+	if err != nil {
+		return 0, err
+	}
+	return res.ID, nil
+}
+
+// DriveShareSyncSet updates the connection with the ID of conn, replacing its
+// folder pairs. The password is only changed when it is not empty.
+func (c *Client) DriveShareSyncSet(ctx context.Context, conn DriveShareSync) error {
+	return api.Void(c.client, ctx, &DriveShareSyncRequest{Connection: conn}, DriveShareSyncSet)
+}
+
+// DriveShareSyncDelete removes a connection. The synced files are kept on
+// both sides.
+func (c *Client) DriveShareSyncDelete(ctx context.Context, id int64) error {
+	return api.Void(c.client, ctx, &DriveShareSyncDeleteRequest{IDs: []int64{id}}, DriveShareSyncDelete)
+}
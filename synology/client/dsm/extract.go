@@ -0,0 +1,63 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const FileStation_Extract = "SYNO.FileStation.Extract"
+
+var (
+	ExtractStart = api.Method{
+		API:            FileStation_Extract,
+		Version:        2,
+		Method:         "start",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ExtractStatus = api.Method{
+		API:            FileStation_Extract,
+		Version:        2,
+		Method:         "status",
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// ExtractRequest starts the extraction of an archive into a folder.
+type ExtractRequest struct {
+	FilePath        string `url:"file_path"`
+	DestFolderPath  string `url:"dest_folder_path"`
+	Overwrite       bool   `url:"overwrite"`
+	KeepDir         bool   `url:"keep_dir"`
+	CreateSubfolder bool   `url:"create_subfolder"`
+	Password        string `url:"password,omitempty"`
+}
+
+type ExtractStartResponse struct {
+	TaskID string `json:"taskid"`
+}
+
+type ExtractStatusRequest struct {
+	TaskID string `url:"taskid"`
+}
+
+type ExtractStatusResponse struct {
+	Finished bool    `json:"finished"`
+	Progress float64 `json:"progress"`
+}
+
+// ExtractStart starts a background extraction and returns its task ID.
+func (c *Client) ExtractStart(ctx context.Context, req ExtractRequest) (string, error) {
+	resp, err := api.Get[ExtractStartResponse](c.client, ctx, &req, ExtractStart)
+	if err != nil {
+		return "", err
+	}
+	return resp.TaskID, nil
+}
+
+// ExtractStatus returns the progress of a background extraction.
+func (c *Client) ExtractStatus(ctx context.Context, taskID string) (*ExtractStatusResponse, error) {
+	return api.Get[ExtractStatusResponse](c.client, ctx, &ExtractStatusRequest{
+		TaskID: taskID,
+	}, ExtractStatus)
+}
@@ -0,0 +1,87 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const FileStation_Favorite = "SYNO.FileStation.Favorite"
+
+var (
+	FavoriteList = api.Method{
+		API:            FileStation_Favorite,
+		Version:        2,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	FavoriteAdd = api.Method{
+		API:            FileStation_Favorite,
+		Version:        2,
+		Method:         "add",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	FavoriteEdit = api.Method{
+		API:            FileStation_Favorite,
+		Version:        2,
+		Method:         "edit",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	FavoriteDelete = api.Method{
+		API:            FileStation_Favorite,
+		Version:        2,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// Favorite is a File Station favorite of the logged in user, a named
+// shortcut to a folder. Status is "valid", or "broken" when the folder no
+// longer exists.
+type Favorite struct {
+	Path   string `json:"path"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type FavoriteListRequest struct {
+	Offset int64 `url:"offset"`
+	Limit  int64 `url:"limit"`
+}
+
+type FavoriteListResponse struct {
+	Total     int64      `json:"total"`
+	Favorites []Favorite `json:"favorites"`
+}
+
+type FavoriteRequest struct {
+	Path string `url:"path,json"`
+	Name string `url:"name,json"`
+}
+
+type FavoriteDeleteRequest struct {
+	Path string `url:"path,json"`
+}
+
+// FavoriteList returns the favorites of the logged in user.
+func (c *Client) FavoriteList(ctx context.Context) (*FavoriteListResponse, error) {
+	return api.Get[FavoriteListResponse](c.client, ctx, &FavoriteListRequest{
+		Offset: 0,
+		Limit:  -1,
+	}, FavoriteList)
+}
+
+// FavoriteAdd adds a favorite to the end of the list of the logged in user.
+func (c *Client) FavoriteAdd(ctx context.Context, path, name string) error {
+	return api.Void(c.client, ctx, &FavoriteRequest{Path: path, Name: name}, FavoriteAdd)
+}
+
+// FavoriteEdit renames the favorite of path.
+func (c *Client) FavoriteEdit(ctx context.Context, path, name string) error {
+	return api.Void(c.client, ctx, &FavoriteRequest{Path: path, Name: name}, FavoriteEdit)
+}
+
+// FavoriteDelete removes the favorite of path. The folder is kept.
+func (c *Client) FavoriteDelete(ctx context.Context, path string) error {
+	return api.Void(c.client, ctx, &FavoriteDeleteRequest{Path: path}, FavoriteDelete)
+}
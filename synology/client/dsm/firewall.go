@@ -0,0 +1,81 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_Security_Firewall_Rules = "SYNO.Core.Security.Firewall.Rules"
+
+var (
+	FirewallRulesGet = api.Method{
+		API:            Core_Security_Firewall_Rules,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	FirewallRulesSet = api.Method{
+		API:            Core_Security_Firewall_Rules,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// FirewallRule is a rule of a firewall profile. DSM evaluates the rules of an
+// adapter in order and applies the first one matching a packet.
+type FirewallRule struct {
+	Name     string `json:"name"`
+	Enabled  bool   `json:"enabled"`
+	Policy   string `json:"policy"`
+	Protocol string `json:"protocol"`
+	Ports    string `json:"ports"`
+	SourceIP string `json:"source_ip"`
+}
+
+type FirewallRulesGetRequest struct {
+	ProfileName string `url:"profile_name"`
+	Adapter     string `url:"adapter"`
+}
+
+type FirewallRulesGetResponse struct {
+	Rules []FirewallRule `json:"rules"`
+}
+
+type FirewallRulesSetRequest struct {
+	ProfileName string         `url:"profile_name"`
+	Adapter     string         `url:"adapter"`
+	Rules       []FirewallRule `url:"rules,json"`
+}
+
+// FirewallRulesGet returns the ordered rules of an adapter in a firewall
+// profile.
+func (c *Client) FirewallRulesGet(
+	ctx context.Context,
+	profile string,
+	adapter string,
+) (*FirewallRulesGetResponse, error) {
+	return api.Get[FirewallRulesGetResponse](c.client, ctx, &FirewallRulesGetRequest{
+		ProfileName: profile,
+		Adapter:     adapter,
+	}, FirewallRulesGet)
+}
+
+// FirewallRulesSet replaces the rules of an adapter in a firewall profile.
+func (c *Client) FirewallRulesSet(
+	ctx context.Context,
+	profile string,
+	adapter string,
+	rules []FirewallRule,
+) error {
+	if rules == nil {
+		rules = []FirewallRule{}
+	}
+
+	return api.Void(c.client, ctx, &FirewallRulesSetRequest{
+		ProfileName: profile,
+		Adapter:     adapter,
+		Rules:       rules,
+	}, FirewallRulesSet)
+}
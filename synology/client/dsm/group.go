@@ -0,0 +1,82 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_Group_Member = "SYNO.Core.Group.Member"
+
+var (
+	GroupMemberList = api.Method{
+		API:            Core_Group_Member,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	GroupMemberAdd = api.Method{
+		API:            Core_Group_Member,
+		Version:        1,
+		Method:         "add",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	GroupMemberRemove = api.Method{
+		API:            Core_Group_Member,
+		Version:        1,
+		Method:         "remove",
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+type GroupMember struct {
+	Name string `json:"name"`
+}
+
+type GroupMemberListRequest struct {
+	Group   string `url:"group"`
+	InGroup bool   `url:"ingroup"`
+	Offset  int64  `url:"offset"`
+	Limit   int64  `url:"limit"`
+}
+
+type GroupMemberListResponse struct {
+	Total int64         `json:"total"`
+	Users []GroupMember `json:"users"`
+}
+
+type GroupMemberRequest struct {
+	Group string   `url:"group"`
+	Names []string `url:"name,json"`
+}
+
+// GroupMemberList returns the names of the local users in a local group.
+func (c *Client) GroupMemberList(ctx context.Context, group string) ([]string, error) {
+	res, err := api.Get[GroupMemberListResponse](c.client, ctx, &GroupMemberListRequest{
+		Group:   group,
+		InGroup: true,
+		Offset:  0,
+		Limit:   -1,
+	}, GroupMemberList)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(res.Users))
+	for i, u := range res.Users {
+		names[i] = u.Name
+	}
+	return names, nil
+}
+
+// GroupMemberAdd adds users to a local group. Users which are members
+// already are ignored.
+func (c *Client) GroupMemberAdd(ctx context.Context, group string, names ...string) error {
+	return api.Void(c.client, ctx, &GroupMemberRequest{Group: group, Names: names}, GroupMemberAdd)
+}
+
+// GroupMemberRemove removes users from a local group. Users which are not
+// members are ignored.
+func (c *Client) GroupMemberRemove(ctx context.Context, group string, names ...string) error {
+	return api.Void(c.client, ctx, &GroupMemberRequest{Group: group, Names: names}, GroupMemberRemove)
+}
@@ -0,0 +1,65 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Storage_CGI_Storage = "SYNO.Storage.CGI.Storage"
+
+	// Core_Hardware_VideoTranscoding is only offered by models with a GPU
+	// usable for hardware transcoding.
+	Core_Hardware_VideoTranscoding = "SYNO.Core.Hardware.VideoTranscoding"
+)
+
+var StorageLoadInfo = api.Method{
+	API:            Storage_CGI_Storage,
+	Version:        1,
+	Method:         "load_info",
+	ErrorSummaries: api.GlobalErrors,
+}
+
+// Disk container types.
+const (
+	DiskContainerInternal = "internal"
+	DiskContainerEbox     = "ebox"
+)
+
+// StorageDisk is a drive known to Storage Manager. DiskType is "SATA", "SAS"
+// or "NVMe"; Container.Str names the enclosure holding it, e.g. "DX517-1".
+// Status is "normal", "not_init" or "initialized" for working drives.
+type StorageDisk struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Model     string `json:"model"`
+	Vendor    string `json:"vendor"`
+	DiskType  string `json:"diskType"`
+	IsSSD     bool   `json:"isSsd"`
+	Status    string `json:"status"`
+	SizeTotal string `json:"size_total"`
+	Container struct {
+		Type string `json:"type"`
+		Str  string `json:"str"`
+	} `json:"container"`
+}
+
+// StorageVolume is a volume known to Storage Manager. Status is "normal",
+// "degrade", "crashed" or a transient state such as "repairing".
+type StorageVolume struct {
+	ID      string `json:"id"`
+	VolPath string `json:"vol_path"`
+	Status  string `json:"status"`
+}
+
+type StorageInfo struct {
+	Disks   []StorageDisk   `json:"disks"`
+	Volumes []StorageVolume `json:"volumes"`
+}
+
+// StorageLoadInfo returns the drives, storage pools and volumes shown by
+// Storage Manager.
+func (c *Client) StorageLoadInfo(ctx context.Context) (*StorageInfo, error) {
+	return api.Get[StorageInfo](c.client, ctx, &struct{}{}, StorageLoadInfo)
+}
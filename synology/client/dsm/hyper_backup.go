@@ -0,0 +1,327 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Backup_Repository = "SYNO.Backup.Repository"
+	Backup_Task       = "SYNO.Backup.Task"
+	Backup_Version    = "SYNO.Backup.Version"
+	Backup_Restore    = "SYNO.Backup.Restore"
+)
+
+var (
+	BackupRepositoryList = api.Method{
+		API:            Backup_Repository,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupTaskList = api.Method{
+		API:            Backup_Task,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupTaskGet = api.Method{
+		API:            Backup_Task,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupTaskCreate = api.Method{
+		API:            Backup_Task,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupTaskSet = api.Method{
+		API:            Backup_Task,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupTaskDelete = api.Method{
+		API:            Backup_Task,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupTaskStatus = api.Method{
+		API:            Backup_Task,
+		Version:        1,
+		Method:         "status",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupTaskIntegrityCheck = api.Method{
+		API:            Backup_Task,
+		Version:        1,
+		Method:         "check_integrity",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupTaskIntegrityScheduleSet = api.Method{
+		API:            Backup_Task,
+		Version:        1,
+		Method:         "set_integrity_schedule",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupVersionList = api.Method{
+		API:            Backup_Version,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupRestoreStart = api.Method{
+		API:            Backup_Restore,
+		Version:        1,
+		Method:         "start",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	BackupRestoreStatus = api.Method{
+		API:            Backup_Restore,
+		Version:        1,
+		Method:         "status",
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// Hyper Backup repository transfer types.
+const (
+	BackupTransferC2    = "synocloud"
+	BackupTransferVault = "image_remote"
+	BackupTransferLocal = "image_local"
+	BackupTransferRsync = "rsync"
+)
+
+// BackupRepository is a Hyper Backup destination.
+type BackupRepository struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	TargetType   string `json:"target_type"`
+	TransferType string `json:"transfer_type"`
+	Host         string `json:"host"`
+	Port         int    `json:"port"`
+	Bucket       string `json:"bucket"`
+	Region       string `json:"region"`
+	Share        string `json:"share"`
+}
+
+type BackupRepositoryListResponse struct {
+	Repositories []BackupRepository `json:"repo"`
+}
+
+// BackupRepositoryList returns the Hyper Backup destinations configured on the
+// NAS.
+func (c *Client) BackupRepositoryList(ctx context.Context) (*BackupRepositoryListResponse, error) {
+	return api.Get[BackupRepositoryListResponse](c.client, ctx, &struct{}{}, BackupRepositoryList)
+}
+
+// Hyper Backup task and restore states.
+const (
+	BackupStateNone      = "none"
+	BackupStateBackingUp = "backup"
+	BackupStateChecking  = "detect"
+	BackupStateRestoring = "restore"
+	BackupStateDone      = "done"
+	BackupStateFailed    = "failed"
+
+	BackupResultSuccess = "success"
+)
+
+// BackupSchedule is the backup schedule of a task. A non zero RepeatHour
+// runs the task every RepeatHour hours starting at Hour.
+type BackupSchedule struct {
+	Enabled    bool   `json:"enable"`
+	Hour       int64  `json:"hour"`
+	Minute     int64  `json:"minute"`
+	RepeatHour int64  `json:"repeat_hour"`
+	WeekDay    string `json:"week_day"`
+}
+
+// BackupRsyncTarget is the remote rsync server of an rsync task. Credentials
+// are write only.
+type BackupRsyncTarget struct {
+	Host      string `json:"host"`
+	Port      int64  `json:"port"`
+	Module    string `json:"module,omitempty"`
+	Path      string `json:"path"`
+	Username  string `json:"username"`
+	Password  string `json:"password,omitempty"`
+	SSHKey    string `json:"ssh_private_key,omitempty"`
+	EnableSSH bool   `json:"enable_ssh"`
+}
+
+// BackupTaskSettings are the configurable settings of a Hyper Backup task.
+type BackupTaskSettings struct {
+	TaskID         int64              `json:"task_id,omitempty"`
+	Name           string             `json:"name"`
+	TransferType   string             `json:"transfer_type"`
+	Sources        []string           `json:"backup_folders"`
+	Rsync          *BackupRsyncTarget `json:"rsync,omitempty"`
+	Schedule       BackupSchedule     `json:"schedule"`
+	BandwidthLimit int64              `json:"bandwidth_limit"`
+}
+
+// BackupTask is a Hyper Backup task.
+type BackupTask struct {
+	BackupTaskSettings
+
+	RepoID        int    `json:"repo_id"`
+	State         string `json:"state"`
+	Status        string `json:"status"`
+	LastResult    string `json:"last_bkp_result"`
+	LastTime      string `json:"last_bkp_time"`
+	NextTime      string `json:"next_bkp_time"`
+	IntegrityTime string `json:"last_detect_time"`
+	Integrity     string `json:"last_detect_result"`
+
+	// UsedSize is the size in bytes of the backup data at the destination.
+	UsedSize int64 `json:"used_size"`
+}
+
+type BackupTaskListResponse struct {
+	Tasks []BackupTask `json:"task_list"`
+}
+
+type BackupTaskRequest struct {
+	TaskID int64 `url:"task_id"`
+}
+
+type BackupTaskSettingsRequest struct {
+	Task BackupTaskSettings `url:"task,json"`
+}
+
+type BackupTaskCreateResponse struct {
+	TaskID int64 `json:"task_id"`
+}
+
+type BackupTaskDeleteRequest struct {
+	TaskIDs []int64 `url:"task_id_list,json"`
+}
+
+type BackupTaskStatusResponse struct {
+	State    string `json:"state"`
+	Progress int    `json:"progress"`
+	Result   string `json:"last_result"`
+}
+
+type BackupTaskIntegrityCheckRequest struct {
+	TaskID     int64 `url:"task_id"`
+	DetectData bool  `url:"detect_data"`
+}
+
+// BackupIntegritySchedule is the scheduled integrity check of a task.
+type BackupIntegritySchedule struct {
+	Enabled   bool   `json:"enable"`
+	Hour      int64  `json:"hour"`
+	Minute    int64  `json:"minute"`
+	WeekDay   string `json:"week_day"`
+	TimeLimit int64  `json:"time_limit"`
+}
+
+type BackupTaskIntegrityScheduleSetRequest struct {
+	TaskID   int64                   `url:"task_id"`
+	Schedule BackupIntegritySchedule `url:"schedule,json"`
+}
+
+// BackupVersion is a restorable version of a task.
+type BackupVersion struct {
+	VersionID int64  `json:"version_id"`
+	Time      int64  `json:"time"`
+	Locked    bool   `json:"locked"`
+	Status    string `json:"status"`
+}
+
+type BackupVersionListResponse struct {
+	Versions []BackupVersion `json:"version"`
+}
+
+type BackupRestoreStartRequest struct {
+	TaskID     int64    `url:"task_id"`
+	VersionID  int64    `url:"version_id"`
+	Paths      []string `url:"paths,json"`
+	TargetPath string   `url:"target_path"`
+	Overwrite  bool     `url:"overwrite"`
+}
+
+type BackupRestoreStatusResponse struct {
+	State    string `json:"state"`
+	Progress int    `json:"progress"`
+	Error    string `json:"error"`
+}
+
+// BackupTaskList returns the Hyper Backup tasks.
+func (c *Client) BackupTaskList(ctx context.Context) (*BackupTaskListResponse, error) {
+	return api.Get[BackupTaskListResponse](c.client, ctx, &struct{}{}, BackupTaskList)
+}
+
+// BackupTaskGet returns a Hyper Backup task.
+func (c *Client) BackupTaskGet(ctx context.Context, id int64) (*BackupTask, error) {
+	return api.Get[BackupTask](c.client, ctx, &BackupTaskRequest{TaskID: id}, BackupTaskGet)
+}
+
+// BackupTaskCreate creates a Hyper Backup task and returns its ID.
+func (c *Client) BackupTaskCreate(ctx context.Context, task BackupTaskSettings) (int64, error) {
+	task.TaskID = 0
+	res, err := api.Post[BackupTaskCreateResponse](c.client, ctx, &BackupTaskSettingsRequest{Task: task}, BackupTaskCreate)
+	if err != nil {
+		return 0, err
+	}
+	return res.TaskID, nil
+}
+
+// BackupTaskSet updates the settings of a Hyper Backup task.
+func (c *Client) BackupTaskSet(ctx context.Context, task BackupTaskSettings) error {
+	_, err := api.Post[struct{}](c.client, ctx, &BackupTaskSettingsRequest{Task: task}, BackupTaskSet)
+	return err
+}
+
+// BackupTaskDelete deletes a Hyper Backup task. The backup data on the
+// destination is kept.
+func (c *Client) BackupTaskDelete(ctx context.Context, id int64) error {
+	return api.Void(c.client, ctx, &BackupTaskDeleteRequest{TaskIDs: []int64{id}}, BackupTaskDelete)
+}
+
+// BackupTaskStatus returns the current activity of a task.
+func (c *Client) BackupTaskStatus(ctx context.Context, id int64) (*BackupTaskStatusResponse, error) {
+	return api.Get[BackupTaskStatusResponse](c.client, ctx, &BackupTaskRequest{TaskID: id}, BackupTaskStatus)
+}
+
+// BackupTaskIntegrityCheck starts an integrity check of the task's backup
+// data. Without detectData only the index is checked.
+func (c *Client) BackupTaskIntegrityCheck(ctx context.Context, id int64, detectData bool) error {
+	return api.Void(c.client, ctx, &BackupTaskIntegrityCheckRequest{
+		TaskID:     id,
+		DetectData: detectData,
+	}, BackupTaskIntegrityCheck)
+}
+
+// BackupTaskIntegrityScheduleSet sets the scheduled integrity check of a task.
+func (c *Client) BackupTaskIntegrityScheduleSet(
+	ctx context.Context,
+	id int64,
+	schedule BackupIntegritySchedule,
+) error {
+	return api.Void(c.client, ctx, &BackupTaskIntegrityScheduleSetRequest{
+		TaskID:   id,
+		Schedule: schedule,
+	}, BackupTaskIntegrityScheduleSet)
+}
+
+// BackupVersionList returns the versions of a task, newest first.
+func (c *Client) BackupVersionList(ctx context.Context, id int64) (*BackupVersionListResponse, error) {
+	return api.Get[BackupVersionListResponse](c.client, ctx, &BackupTaskRequest{TaskID: id}, BackupVersionList)
+}
+
+// BackupRestoreStart restores paths of a task version into targetPath.
+func (c *Client) BackupRestoreStart(ctx context.Context, req BackupRestoreStartRequest) error {
+	return api.Void(c.client, ctx, &req, BackupRestoreStart)
+}
+
+// BackupRestoreStatus returns the progress of the running restore of a task.
+func (c *Client) BackupRestoreStatus(ctx context.Context, id int64) (*BackupRestoreStatusResponse, error) {
+	return api.Get[BackupRestoreStatusResponse](c.client, ctx, &BackupTaskRequest{TaskID: id}, BackupRestoreStatus)
+}
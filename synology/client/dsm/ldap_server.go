@@ -0,0 +1,193 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	LDAPServer_Setting = "SYNO.LDAPServer.Setting"
+	LDAPServer_User    = "SYNO.LDAPServer.User"
+	LDAPServer_Group   = "SYNO.LDAPServer.Group"
+)
+
+var (
+	LDAPServerSettingGet = api.Method{
+		API:            LDAPServer_Setting,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LDAPServerSettingSet = api.Method{
+		API:            LDAPServer_Setting,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	LDAPServerUserList = api.Method{
+		API:            LDAPServer_User,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LDAPServerUserCreate = api.Method{
+		API:            LDAPServer_User,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LDAPServerUserSet = api.Method{
+		API:            LDAPServer_User,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LDAPServerUserDelete = api.Method{
+		API:            LDAPServer_User,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	LDAPServerGroupList = api.Method{
+		API:            LDAPServer_Group,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LDAPServerGroupCreate = api.Method{
+		API:            LDAPServer_Group,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LDAPServerGroupSet = api.Method{
+		API:            LDAPServer_Group,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LDAPServerGroupDelete = api.Method{
+		API:            LDAPServer_Group,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// LDAPServerSetting is the directory served by the LDAP Server package. The
+// base DN is derived from FQDN, e.g. dc=example,dc=com for example.com.
+type LDAPServerSetting struct {
+	Enabled bool   `json:"enable"`
+	FQDN    string `json:"fqdn"`
+	BaseDN  string `json:"base_dn"`
+	BindDN  string `json:"bind_dn"`
+}
+
+type LDAPServerSettingSetRequest struct {
+	Enabled bool   `url:"enable"`
+	FQDN    string `url:"fqdn"`
+	// Password of the bind DN is only changed when it is set.
+	Password string `url:"password,omitempty"`
+}
+
+// LDAPServerUser is a user of the LDAP Server directory.
+type LDAPServerUser struct {
+	Name        string `json:"name"`
+	UID         int64  `json:"uid,omitempty"`
+	FullName    string `json:"fullname"`
+	Email       string `json:"email"`
+	Description string `json:"description"`
+	Expired     bool   `json:"expired"`
+}
+
+type LDAPServerUserListResponse struct {
+	Users []LDAPServerUser `json:"users"`
+}
+
+type LDAPServerUserRequest struct {
+	User     LDAPServerUser `url:"user,json"`
+	Password string         `url:"password,omitempty"`
+}
+
+type LDAPServerNameRequest struct {
+	Names []string `url:"names,json"`
+}
+
+// LDAPServerGroup is a group of the LDAP Server directory. Members are the
+// names of its users.
+type LDAPServerGroup struct {
+	Name        string   `json:"name"`
+	GID         int64    `json:"gid,omitempty"`
+	Description string   `json:"description"`
+	Members     []string `json:"members"`
+}
+
+type LDAPServerGroupListResponse struct {
+	Groups []LDAPServerGroup `json:"groups"`
+}
+
+type LDAPServerGroupRequest struct {
+	Group LDAPServerGroup `url:"group,json"`
+}
+
+// LDAPServerSettingGet returns the settings of LDAP Server.
+func (c *Client) LDAPServerSettingGet(ctx context.Context) (*LDAPServerSetting, error) {
+	return api.Get[LDAPServerSetting](c.client, ctx, &struct{}{}, LDAPServerSettingGet)
+}
+
+// LDAPServerSettingSet replaces the settings of LDAP Server. The password of
+// the bind DN is only changed when password is not empty.
+func (c *Client) LDAPServerSettingSet(ctx context.Context, setting LDAPServerSetting, password string) error {
+	return api.Void(c.client, ctx, &LDAPServerSettingSetRequest{
+		Enabled:  setting.Enabled,
+		FQDN:     setting.FQDN,
+		Password: password,
+	}, LDAPServerSettingSet)
+}
+
+// LDAPServerUserList returns the users of the directory.
+func (c *Client) LDAPServerUserList(ctx context.Context) (*LDAPServerUserListResponse, error) {
+	return api.Get[LDAPServerUserListResponse](c.client, ctx, &struct{}{}, LDAPServerUserList)
+}
+
+// LDAPServerUserCreate adds a user with the password.
+func (c *Client) LDAPServerUserCreate(ctx context.Context, user LDAPServerUser, password string) error {
+	user.UID = 0
+	return api.Void(c.client, ctx, &LDAPServerUserRequest{User: user, Password: password}, LDAPServerUserCreate)
+}
+
+// LDAPServerUserSet updates the user with the name of user. The password is
+// only changed when it is not empty.
+func (c *Client) LDAPServerUserSet(ctx context.Context, user LDAPServerUser, password string) error {
+	return api.Void(c.client, ctx, &LDAPServerUserRequest{User: user, Password: password}, LDAPServerUserSet)
+}
+
+// LDAPServerUserDelete removes a user.
+func (c *Client) LDAPServerUserDelete(ctx context.Context, name string) error {
+	return api.Void(c.client, ctx, &LDAPServerNameRequest{Names: []string{name}}, LDAPServerUserDelete)
+}
+
+// LDAPServerGroupList returns the groups of the directory.
+func (c *Client) LDAPServerGroupList(ctx context.Context) (*LDAPServerGroupListResponse, error) {
+	return api.Get[LDAPServerGroupListResponse](c.client, ctx, &struct{}{}, LDAPServerGroupList)
+}
+
+// LDAPServerGroupCreate adds a group.
+func (c *Client) LDAPServerGroupCreate(ctx context.Context, group LDAPServerGroup) error {
+	group.GID = 0
+	return api.Void(c.client, ctx, &LDAPServerGroupRequest{Group: group}, LDAPServerGroupCreate)
+}
+
+// LDAPServerGroupSet updates the group with the name of group, replacing its
+// members.
+func (c *Client) LDAPServerGroupSet(ctx context.Context, group LDAPServerGroup) error {
+	return api.Void(c.client, ctx, &LDAPServerGroupRequest{Group: group}, LDAPServerGroupSet)
+}
+
+// LDAPServerGroupDelete removes a group. Its members are kept.
+func (c *Client) LDAPServerGroupDelete(ctx context.Context, name string) error {
+	return api.Void(c.client, ctx, &LDAPServerNameRequest{Names: []string{name}}, LDAPServerGroupDelete)
+}
@@ -0,0 +1,116 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	LogCenter_Client          = "SYNO.LogCenter.Client"
+	LogCenter_Setting_Archive = "SYNO.LogCenter.Setting.Archive"
+
+	Core_SyslogClient_Setting_Notify = "SYNO.Core.SyslogClient.Setting.Notify"
+)
+
+var (
+	LogCenterClientGet = api.Method{
+		API:            LogCenter_Client,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LogCenterClientSet = api.Method{
+		API:            LogCenter_Client,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	LogCenterArchiveGet = api.Method{
+		API:            LogCenter_Setting_Archive,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LogCenterArchiveSet = api.Method{
+		API:            LogCenter_Setting_Archive,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	LogNotifyGet = api.Method{
+		API:            Core_SyslogClient_Setting_Notify,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LogNotifySet = api.Method{
+		API:            Core_SyslogClient_Setting_Notify,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// LogCenterClient holds the log forwarding settings of Log Center. Protocol
+// is "udp" or "tcp", Format "bsd" (RFC 3164) or "ietf" (RFC 5424). Secure
+// enables TLS and requires TCP.
+type LogCenterClient struct {
+	Enable   bool   `json:"enable"        url:"enable"`
+	Server   string `json:"server"        url:"server"`
+	Port     int64  `json:"port"          url:"port"`
+	Protocol string `json:"proto"         url:"proto"`
+	Format   string `json:"format"        url:"format"`
+	Secure   bool   `json:"enable_secure" url:"enable_secure"`
+}
+
+// LogCenterArchive holds the archiving settings of Log Center. Logs are moved
+// to an archive under Path once there are more than Threshold entries; Keep
+// archives are kept, 0 keeps all of them.
+type LogCenterArchive struct {
+	Enable    bool   `json:"enable"    url:"enable"`
+	Path      string `json:"path"      url:"path"`
+	Threshold int64  `json:"threshold" url:"threshold"`
+	Keep      int64  `json:"keep"      url:"keep"`
+}
+
+// LogNotify holds the log notification settings. A notification is sent when
+// Count logs of at least Level are written within Period minutes.
+type LogNotify struct {
+	Enable bool   `json:"enable" url:"enable"`
+	Level  string `json:"level"  url:"level"`
+	Count  int64  `json:"count"  url:"count"`
+	Period int64  `json:"period" url:"period"`
+}
+
+// LogCenterClientGet returns the log forwarding settings.
+func (c *Client) LogCenterClientGet(ctx context.Context) (*LogCenterClient, error) {
+	return api.Get[LogCenterClient](c.client, ctx, &struct{}{}, LogCenterClientGet)
+}
+
+// LogCenterClientSet replaces the log forwarding settings.
+func (c *Client) LogCenterClientSet(ctx context.Context, settings LogCenterClient) error {
+	return api.Void(c.client, ctx, &settings, LogCenterClientSet)
+}
+
+// LogCenterArchiveGet returns the log archiving settings.
+func (c *Client) LogCenterArchiveGet(ctx context.Context) (*LogCenterArchive, error) {
+	return api.Get[LogCenterArchive](c.client, ctx, &struct{}{}, LogCenterArchiveGet)
+}
+
+// LogCenterArchiveSet replaces the log archiving settings.
+func (c *Client) LogCenterArchiveSet(ctx context.Context, settings LogCenterArchive) error {
+	return api.Void(c.client, ctx, &settings, LogCenterArchiveSet)
+}
+
+// LogNotifyGet returns the log notification settings.
+func (c *Client) LogNotifyGet(ctx context.Context) (*LogNotify, error) {
+	return api.Get[LogNotify](c.client, ctx, &struct{}{}, LogNotifyGet)
+}
+
+// LogNotifySet replaces the log notification settings.
+func (c *Client) LogNotifySet(ctx context.Context, settings LogNotify) error {
+	return api.Void(c.client, ctx, &settings, LogNotifySet)
+}
@@ -0,0 +1,45 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_Theme_Login = "SYNO.Core.Theme.Login"
+
+var (
+	LoginThemeGet = api.Method{
+		API:            Core_Theme_Login,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LoginThemeSet = api.Method{
+		API:            Core_Theme_Login,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// LoginTheme is the look of the DSM login page. Images are referenced by
+// their absolute path on the NAS, e.g. /volume1/web/login.jpg.
+type LoginTheme struct {
+	Title             string `json:"login_title"             url:"login_title"`
+	WelcomeMessage    string `json:"login_welcome_msg"       url:"login_welcome_msg"`
+	BackgroundEnabled bool   `json:"login_background_enable" url:"login_background_enable"`
+	BackgroundPath    string `json:"login_background_path"   url:"login_background_path"`
+	LogoEnabled       bool   `json:"login_logo_enable"       url:"login_logo_enable"`
+	LogoPath          string `json:"login_logo_path"         url:"login_logo_path"`
+}
+
+// LoginThemeGet returns the login page settings.
+func (c *Client) LoginThemeGet(ctx context.Context) (*LoginTheme, error) {
+	return api.Get[LoginTheme](c.client, ctx, &struct{}{}, LoginThemeGet)
+}
+
+// LoginThemeSet replaces the login page settings.
+func (c *Client) LoginThemeSet(ctx context.Context, theme LoginTheme) error {
+	return api.Void(c.client, ctx, &theme, LoginThemeSet)
+}
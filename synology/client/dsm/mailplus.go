@@ -0,0 +1,200 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	MailPlusServer_Domain  = "SYNO.MailPlusServer.Domain"
+	MailPlusServer_Alias   = "SYNO.MailPlusServer.Alias"
+	MailPlusServer_Account = "SYNO.MailPlusServer.Account"
+)
+
+// MailPlus alias member types.
+const (
+	MailPlusMemberUser     = "user"
+	MailPlusMemberGroup    = "group"
+	MailPlusMemberExternal = "external"
+)
+
+var (
+	MailPlusDomainList = api.Method{
+		API:            MailPlusServer_Domain,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	MailPlusDomainCreate = api.Method{
+		API:            MailPlusServer_Domain,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	MailPlusDomainSet = api.Method{
+		API:            MailPlusServer_Domain,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	MailPlusDomainDelete = api.Method{
+		API:            MailPlusServer_Domain,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	MailPlusAliasList = api.Method{
+		API:            MailPlusServer_Alias,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	MailPlusAliasCreate = api.Method{
+		API:            MailPlusServer_Alias,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	MailPlusAliasSet = api.Method{
+		API:            MailPlusServer_Alias,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	MailPlusAliasDelete = api.Method{
+		API:            MailPlusServer_Alias,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	MailPlusAccountList = api.Method{
+		API:            MailPlusServer_Account,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	MailPlusAccountSet = api.Method{
+		API:            MailPlusServer_Account,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// MailPlusDomain is a mail domain served by MailPlus Server.
+type MailPlusDomain struct {
+	ID          int64  `json:"domain_id,omitempty"`
+	Name        string `json:"domain_name"`
+	Description string `json:"description"`
+}
+
+type MailPlusDomainListResponse struct {
+	Domains []MailPlusDomain `json:"domains"`
+}
+
+type MailPlusDomainRequest struct {
+	Domain MailPlusDomain `url:"domain,json"`
+}
+
+type MailPlusDomainDeleteRequest struct {
+	IDs []int64 `url:"domain_ids,json"`
+}
+
+type MailPlusAliasMember struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// MailPlusAlias forwards the mail of an address of a domain to its members.
+type MailPlusAlias struct {
+	Name     string                `json:"alias_name"`
+	DomainID int64                 `json:"domain_id"`
+	Members  []MailPlusAliasMember `json:"members"`
+}
+
+type MailPlusAliasListRequest struct {
+	DomainID int64 `url:"domain_id"`
+}
+
+type MailPlusAliasListResponse struct {
+	Aliases []MailPlusAlias `json:"aliases"`
+}
+
+type MailPlusAliasRequest struct {
+	Alias MailPlusAlias `url:"alias,json"`
+}
+
+type MailPlusAliasDeleteRequest struct {
+	DomainID int64    `url:"domain_id"`
+	Names    []string `url:"alias_names,json"`
+}
+
+// MailPlusAccount is a DSM user which may use MailPlus. Every enabled account
+// takes a MailPlus license.
+type MailPlusAccount struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enable"`
+}
+
+type MailPlusAccountListResponse struct {
+	Accounts []MailPlusAccount `json:"accounts"`
+}
+
+type MailPlusAccountSetRequest struct {
+	Accounts []MailPlusAccount `url:"accounts,json"`
+}
+
+// MailPlusDomainList returns the mail domains.
+func (c *Client) MailPlusDomainList(ctx context.Context) (*MailPlusDomainListResponse, error) {
+	return api.Get[MailPlusDomainListResponse](c.client, ctx, &struct{}{}, MailPlusDomainList)
+}
+
+// MailPlusDomainCreate adds a mail domain and returns it with its ID.
+func (c *Client) MailPlusDomainCreate(ctx context.Context, domain MailPlusDomain) (*MailPlusDomain, error) {
+	domain.ID = 0
+	return api.Post[MailPlusDomain](c.client, ctx, &MailPlusDomainRequest{Domain: domain}, MailPlusDomainCreate)
+}
+
+// MailPlusDomainSet updates the mail domain with the ID of domain.
+func (c *Client) MailPlusDomainSet(ctx context.Context, domain MailPlusDomain) error {
+	return api.Void(c.client, ctx, &MailPlusDomainRequest{Domain: domain}, MailPlusDomainSet)
+}
+
+// MailPlusDomainDelete removes a mail domain with its aliases.
+func (c *Client) MailPlusDomainDelete(ctx context.Context, id int64) error {
+	return api.Void(c.client, ctx, &MailPlusDomainDeleteRequest{IDs: []int64{id}}, MailPlusDomainDelete)
+}
+
+// MailPlusAliasList returns the aliases of a mail domain.
+func (c *Client) MailPlusAliasList(ctx context.Context, domainID int64) (*MailPlusAliasListResponse, error) {
+	return api.Get[MailPlusAliasListResponse](c.client, ctx, &MailPlusAliasListRequest{DomainID: domainID}, MailPlusAliasList)
+}
+
+// MailPlusAliasCreate adds an alias.
+func (c *Client) MailPlusAliasCreate(ctx context.Context, alias MailPlusAlias) error {
+	return api.Void(c.client, ctx, &MailPlusAliasRequest{Alias: alias}, MailPlusAliasCreate)
+}
+
+// MailPlusAliasSet replaces the members of an alias.
+func (c *Client) MailPlusAliasSet(ctx context.Context, alias MailPlusAlias) error {
+	return api.Void(c.client, ctx, &MailPlusAliasRequest{Alias: alias}, MailPlusAliasSet)
+}
+
+// MailPlusAliasDelete removes an alias.
+func (c *Client) MailPlusAliasDelete(ctx context.Context, domainID int64, name string) error {
+	return api.Void(c.client, ctx, &MailPlusAliasDeleteRequest{DomainID: domainID, Names: []string{name}}, MailPlusAliasDelete)
+}
+
+// MailPlusAccountList returns the DSM users known to MailPlus.
+func (c *Client) MailPlusAccountList(ctx context.Context) (*MailPlusAccountListResponse, error) {
+	return api.Get[MailPlusAccountListResponse](c.client, ctx, &struct{}{}, MailPlusAccountList)
+}
+
+// MailPlusAccountSet enables or disables MailPlus for the accounts. Other
+// accounts are left unchanged.
+func (c *Client) MailPlusAccountSet(ctx context.Context, accounts ...MailPlusAccount) error {
+	return api.Void(c.client, ctx, &MailPlusAccountSetRequest{Accounts: accounts}, MailPlusAccountSet)
+}
@@ -0,0 +1,74 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_FileServ_NFS_SharePrivilege = "SYNO.Core.FileServ.NFS.SharePrivilege"
+
+var (
+	NFSSharePrivilegeLoad = api.Method{
+		API:            Core_FileServ_NFS_SharePrivilege,
+		Version:        1,
+		Method:         "load",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	NFSSharePrivilegeSave = api.Method{
+		API:            Core_FileServ_NFS_SharePrivilege,
+		Version:        1,
+		Method:         "save",
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+type NFSSecurityFlavor struct {
+	Sys               bool `json:"sys"`
+	Kerberos          bool `json:"kerberos"`
+	KerberosIntegrity bool `json:"kerberos_integrity"`
+	KerberosPrivacy   bool `json:"kerberos_privacy"`
+}
+
+// NFSRule is an NFS client rule of a shared folder.
+type NFSRule struct {
+	Client         string            `json:"client"`
+	Privilege      string            `json:"privilege"`
+	RootSquash     string            `json:"root_squash"`
+	Async          bool              `json:"async"`
+	Insecure       bool              `json:"insecure"`
+	Crossmnt       bool              `json:"crossmnt"`
+	SecurityFlavor NFSSecurityFlavor `json:"security_flavor"`
+}
+
+type NFSSharePrivilegeLoadRequest struct {
+	ShareName string `url:"share_name"`
+}
+
+type NFSSharePrivilegeLoadResponse struct {
+	Rules []NFSRule `json:"rule"`
+}
+
+type NFSSharePrivilegeSaveRequest struct {
+	ShareName string    `url:"share_name"`
+	Rules     []NFSRule `url:"rule,json"`
+}
+
+// NFSSharePrivilegeLoad returns the NFS rules of a shared folder.
+func (c *Client) NFSSharePrivilegeLoad(ctx context.Context, share string) (*NFSSharePrivilegeLoadResponse, error) {
+	return api.Get[NFSSharePrivilegeLoadResponse](c.client, ctx, &NFSSharePrivilegeLoadRequest{
+		ShareName: share,
+	}, NFSSharePrivilegeLoad)
+}
+
+// NFSSharePrivilegeSave replaces the NFS rules of a shared folder.
+func (c *Client) NFSSharePrivilegeSave(ctx context.Context, share string, rules []NFSRule) error {
+	if rules == nil {
+		rules = []NFSRule{}
+	}
+
+	return api.Void(c.client, ctx, &NFSSharePrivilegeSaveRequest{
+		ShareName: share,
+		Rules:     rules,
+	}, NFSSharePrivilegeSave)
+}
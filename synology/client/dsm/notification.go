@@ -0,0 +1,129 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Core_Notification_Push_Webhook_Provider  = "SYNO.Core.Notification.Push.Webhook.Provider"
+	Core_Notification_Advance_FilterSettings = "SYNO.Core.Notification.Advance.FilterSettings"
+)
+
+var (
+	NotificationWebhookList = api.Method{
+		API:            Core_Notification_Push_Webhook_Provider,
+		Version:        2,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	NotificationWebhookCreate = api.Method{
+		API:            Core_Notification_Push_Webhook_Provider,
+		Version:        2,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	NotificationWebhookSet = api.Method{
+		API:            Core_Notification_Push_Webhook_Provider,
+		Version:        2,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	NotificationWebhookDelete = api.Method{
+		API:            Core_Notification_Push_Webhook_Provider,
+		Version:        2,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	NotificationFilterList = api.Method{
+		API:            Core_Notification_Advance_FilterSettings,
+		Version:        2,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	NotificationFilterSet = api.Method{
+		API:            Core_Notification_Advance_FilterSettings,
+		Version:        2,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// NotificationWebhook is a webhook DSM pushes notifications to. Template is
+// the request body, in which DSM replaces @@TEXT@@ with the notification.
+type NotificationWebhook struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	URL         string            `json:"url"`
+	Method      string            `json:"req_method"`
+	ContentType string            `json:"content_type"`
+	Headers     map[string]string `json:"req_header"`
+	Template    string            `json:"template"`
+}
+
+type NotificationWebhookListResponse struct {
+	Webhooks []NotificationWebhook `json:"list"`
+}
+
+type NotificationWebhookRequest struct {
+	Webhook NotificationWebhook `url:"webhook,json"`
+}
+
+type NotificationWebhookDeleteRequest struct {
+	ID string `url:"id"`
+}
+
+// NotificationEvent is a DSM event and the channels it is pushed to. The
+// channels are shared by every target of a kind, e.g. every webhook.
+type NotificationEvent struct {
+	Tag     string `json:"tag"`
+	Group   string `json:"group"`
+	Mail    bool   `json:"mail"`
+	SMS     bool   `json:"sms"`
+	Mobile  bool   `json:"mobile"`
+	Webhook bool   `json:"webhook"`
+}
+
+type NotificationFilterListResponse struct {
+	Events []NotificationEvent `json:"events"`
+}
+
+type NotificationFilterSetRequest struct {
+	Events []NotificationEvent `url:"events,json"`
+}
+
+// NotificationWebhookList returns the notification webhooks.
+func (c *Client) NotificationWebhookList(ctx context.Context) (*NotificationWebhookListResponse, error) {
+	return api.Get[NotificationWebhookListResponse](c.client, ctx, &struct{}{}, NotificationWebhookList)
+}
+
+// NotificationWebhookCreate creates a notification webhook. DSM assigns the
+// ID.
+func (c *Client) NotificationWebhookCreate(ctx context.Context, webhook NotificationWebhook) error {
+	webhook.ID = ""
+	return api.Void(c.client, ctx, &NotificationWebhookRequest{Webhook: webhook}, NotificationWebhookCreate)
+}
+
+// NotificationWebhookSet updates the notification webhook with the ID of
+// webhook.
+func (c *Client) NotificationWebhookSet(ctx context.Context, webhook NotificationWebhook) error {
+	return api.Void(c.client, ctx, &NotificationWebhookRequest{Webhook: webhook}, NotificationWebhookSet)
+}
+
+// NotificationWebhookDelete deletes a notification webhook.
+func (c *Client) NotificationWebhookDelete(ctx context.Context, id string) error {
+	return api.Void(c.client, ctx, &NotificationWebhookDeleteRequest{ID: id}, NotificationWebhookDelete)
+}
+
+// NotificationFilterList returns the events DSM sends notifications for.
+func (c *Client) NotificationFilterList(ctx context.Context) (*NotificationFilterListResponse, error) {
+	return api.Get[NotificationFilterListResponse](c.client, ctx, &struct{}{}, NotificationFilterList)
+}
+
+// NotificationFilterSet updates the channels of the given events. Events
+// which are not listed are left unchanged.
+func (c *Client) NotificationFilterSet(ctx context.Context, events []NotificationEvent) error {
+	return api.Void(c.client, ctx, &NotificationFilterSetRequest{Events: events}, NotificationFilterSet)
+}
@@ -0,0 +1,49 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_Package_Setting = "SYNO.Core.Package.Setting"
+
+// Trust levels of Package Center, deciding whose signatures are accepted on
+// installed packages.
+const (
+	PackageTrustSynology  = 0
+	PackageTrustPublisher = 1
+	PackageTrustAny       = 2
+)
+
+var (
+	PackageSettingGet = api.Method{
+		API:            Core_Package_Setting,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	PackageSettingSet = api.Method{
+		API:            Core_Package_Setting,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// PackageSettings holds the Package Center settings managed by the provider.
+// DefaultVol is the volume new packages are installed to, e.g. "/volume1".
+type PackageSettings struct {
+	TrustLevel int    `json:"trust_level" url:"trust_level"`
+	DefaultVol string `json:"default_vol" url:"default_vol,omitempty"`
+}
+
+// PackageSettingGet returns the Package Center settings.
+func (c *Client) PackageSettingGet(ctx context.Context) (*PackageSettings, error) {
+	return api.Get[PackageSettings](c.client, ctx, &struct{}{}, PackageSettingGet)
+}
+
+// PackageSettingSet saves the Package Center settings.
+func (c *Client) PackageSettingSet(ctx context.Context, s PackageSettings) error {
+	return api.Void(c.client, ctx, &s, PackageSettingSet)
+}
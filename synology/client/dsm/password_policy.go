@@ -0,0 +1,163 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Core_User_PasswordPolicy = "SYNO.Core.User.PasswordPolicy"
+	Core_User_PasswordExpiry = "SYNO.Core.User.PasswordExpiry"
+	Core_OTP_EnforcePolicy   = "SYNO.Core.OTP.EnforcePolicy"
+)
+
+// Groups of users 2-factor authentication can be enforced for.
+const (
+	OTPEnforceNone   = "none"
+	OTPEnforceAdmin  = "admin"
+	OTPEnforceAll    = "user"
+	OTPEnforceCustom = "custom"
+)
+
+var (
+	PasswordPolicyGet = api.Method{
+		API:            Core_User_PasswordPolicy,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	PasswordPolicySet = api.Method{
+		API:            Core_User_PasswordPolicy,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	PasswordExpiryGet = api.Method{
+		API:            Core_User_PasswordExpiry,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	PasswordExpirySet = api.Method{
+		API:            Core_User_PasswordExpiry,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	OTPEnforcePolicyGet = api.Method{
+		API:            Core_OTP_EnforcePolicy,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	OTPEnforcePolicySet = api.Method{
+		API:            Core_OTP_EnforcePolicy,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// PasswordStrength are the rules new passwords of local users must follow.
+type PasswordStrength struct {
+	MinLengthEnabled      bool  `json:"min_length_enable"`
+	MinLength             int64 `json:"min_length"`
+	MixedCase             bool  `json:"mixed_case"`
+	IncludeNumeric        bool  `json:"included_numeric_char"`
+	IncludeSpecial        bool  `json:"included_special_char"`
+	ExcludeUsername       bool  `json:"exclude_username"`
+	ExcludeCommonPassword bool  `json:"exclude_common_password"`
+	HistoryEnabled        bool  `json:"exclude_history"`
+	HistoryCount          int64 `json:"history_num"`
+}
+
+type PasswordPolicy struct {
+	EnableResetPasswordByEmail bool             `json:"enable_reset_passwd_by_email"`
+	Strength                   PasswordStrength `json:"strong_password"`
+}
+
+// PasswordExpiry makes passwords of local users expire after MaxAge days.
+type PasswordExpiry struct {
+	Enabled           bool  `json:"password_expire_enable"`
+	MaxAge            int64 `json:"max_age"`
+	MinAgeEnabled     bool  `json:"min_age_enable"`
+	MinAge            int64 `json:"min_age"`
+	ReminderEnabled   bool  `json:"enable_mail_notification"`
+	ReminderDays      int64 `json:"mail_notification_days"`
+	AllowExpiredLogin bool  `json:"enable_login_prompt"`
+}
+
+// OTPEnforcePolicy is the group of users who must set up 2-factor
+// authentication. Users and Groups are only used with OTPEnforceCustom.
+type OTPEnforcePolicy struct {
+	Option string   `json:"otp_enforce_option"`
+	Users  []string `json:"otp_enforce_users"`
+	Groups []string `json:"otp_enforce_groups"`
+}
+
+type PasswordPolicySetRequest struct {
+	EnableResetPasswordByEmail bool             `url:"enable_reset_passwd_by_email"`
+	Strength                   PasswordStrength `url:"strong_password,json"`
+}
+
+type PasswordExpirySetRequest struct {
+	Enabled           bool  `url:"password_expire_enable"`
+	MaxAge            int64 `url:"max_age"`
+	MinAgeEnabled     bool  `url:"min_age_enable"`
+	MinAge            int64 `url:"min_age"`
+	ReminderEnabled   bool  `url:"enable_mail_notification"`
+	ReminderDays      int64 `url:"mail_notification_days"`
+	AllowExpiredLogin bool  `url:"enable_login_prompt"`
+}
+
+type OTPEnforcePolicySetRequest struct {
+	Option string   `url:"otp_enforce_option"`
+	Users  []string `url:"otp_enforce_users,json"`
+	Groups []string `url:"otp_enforce_groups,json"`
+}
+
+// PasswordPolicyGet returns the password strength rules.
+func (c *Client) PasswordPolicyGet(ctx context.Context) (*PasswordPolicy, error) {
+	return api.Get[PasswordPolicy](c.client, ctx, &struct{}{}, PasswordPolicyGet)
+}
+
+// PasswordPolicySet replaces the password strength rules.
+func (c *Client) PasswordPolicySet(ctx context.Context, policy PasswordPolicy) error {
+	return api.Void(c.client, ctx, &PasswordPolicySetRequest{
+		EnableResetPasswordByEmail: policy.EnableResetPasswordByEmail,
+		Strength:                   policy.Strength,
+	}, PasswordPolicySet)
+}
+
+// PasswordExpiryGet returns the password expiration settings.
+func (c *Client) PasswordExpiryGet(ctx context.Context) (*PasswordExpiry, error) {
+	return api.Get[PasswordExpiry](c.client, ctx, &struct{}{}, PasswordExpiryGet)
+}
+
+// PasswordExpirySet replaces the password expiration settings.
+func (c *Client) PasswordExpirySet(ctx context.Context, expiry PasswordExpiry) error {
+	return api.Void(c.client, ctx, &PasswordExpirySetRequest{
+		Enabled:           expiry.Enabled,
+		MaxAge:            expiry.MaxAge,
+		MinAgeEnabled:     expiry.MinAgeEnabled,
+		MinAge:            expiry.MinAge,
+		ReminderEnabled:   expiry.ReminderEnabled,
+		ReminderDays:      expiry.ReminderDays,
+		AllowExpiredLogin: expiry.AllowExpiredLogin,
+	}, PasswordExpirySet)
+}
+
+// OTPEnforcePolicyGet returns who must use 2-factor authentication.
+func (c *Client) OTPEnforcePolicyGet(ctx context.Context) (*OTPEnforcePolicy, error) {
+	return api.Get[OTPEnforcePolicy](c.client, ctx, &struct{}{}, OTPEnforcePolicyGet)
+}
+
+// OTPEnforcePolicySet replaces who must use 2-factor authentication.
+func (c *Client) OTPEnforcePolicySet(ctx context.Context, policy OTPEnforcePolicy) error {
+	return api.Void(c.client, ctx, &OTPEnforcePolicySetRequest{
+		Option: policy.Option,
+		Users:  policy.Users,
+		Groups: policy.Groups,
+	}, OTPEnforcePolicySet)
+}
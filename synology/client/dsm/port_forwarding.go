@@ -0,0 +1,65 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Core_PortForwarding_RouterInfo = "SYNO.Core.PortForwarding.RouterInfo"
+	Core_PortForwarding_Rules      = "SYNO.Core.PortForwarding.Rules"
+)
+
+var (
+	PortForwardingRouterInfoGet = api.Method{
+		API:            Core_PortForwarding_RouterInfo,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	PortForwardingRuleList = api.Method{
+		API:            Core_PortForwarding_Rules,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// RouterInfo describes the router set up under Control Panel > External
+// Access > Router Configuration. The fields are empty when no router is set
+// up.
+type RouterInfo struct {
+	Brand         string `json:"router_brand"`
+	Model         string `json:"router_model"`
+	Version       string `json:"router_version"`
+	UPnPSupported bool   `json:"support_upnp"`
+	UPnPEnabled   bool   `json:"upnp_enable"`
+}
+
+// PortForwardingRule forwards RouterPort on the router to LocalPort on the
+// NAS. Ports are comma separated lists or ranges. Status holds the result of
+// the last connection test, "ok" when the service was reachable.
+type PortForwardingRule struct {
+	ID         int64  `json:"id"`
+	Service    string `json:"service_name"`
+	Protocol   string `json:"protocol"`
+	LocalPort  string `json:"ds_port"`
+	RouterPort string `json:"router_port"`
+	Enable     bool   `json:"enable"`
+	Status     string `json:"status"`
+}
+
+type PortForwardingRuleListResponse struct {
+	Rules []PortForwardingRule `json:"rules"`
+}
+
+// PortForwardingRouterInfo returns the router the NAS forwards ports on.
+func (c *Client) PortForwardingRouterInfo(ctx context.Context) (*RouterInfo, error) {
+	return api.Get[RouterInfo](c.client, ctx, &struct{}{}, PortForwardingRouterInfoGet)
+}
+
+// PortForwardingRuleList returns the port forwarding rules of the router.
+func (c *Client) PortForwardingRuleList(ctx context.Context) (*PortForwardingRuleListResponse, error) {
+	return api.Get[PortForwardingRuleListResponse](c.client, ctx, &struct{}{}, PortForwardingRuleList)
+}
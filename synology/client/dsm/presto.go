@@ -0,0 +1,101 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Presto_Setting = "SYNO.Presto.Setting"
+	Presto_License = "SYNO.Presto.License"
+)
+
+var (
+	PrestoSettingGet = api.Method{
+		API:            Presto_Setting,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	PrestoSettingSet = api.Method{
+		API:            Presto_Setting,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	PrestoLicenseGet = api.Method{
+		API:            Presto_License,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	PrestoLicenseActivate = api.Method{
+		API:            Presto_License,
+		Version:        1,
+		Method:         "activate",
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// PrestoSetting are the settings of Presto File Server. Transfers are
+// negotiated over TCP Port and sent over UDP DataPort. Shares are the shared
+// folders Presto clients can access; all shared folders are exposed when
+// AllShares is set.
+type PrestoSetting struct {
+	Enabled   bool     `json:"enable"`
+	Port      int64    `json:"port"`
+	DataPort  int64    `json:"data_port"`
+	AllShares bool     `json:"all_shares"`
+	Shares    []string `json:"shares"`
+}
+
+type PrestoSettingSetRequest struct {
+	Enabled   bool     `url:"enable"`
+	Port      int64    `url:"port"`
+	DataPort  int64    `url:"data_port"`
+	AllShares bool     `url:"all_shares"`
+	Shares    []string `url:"shares,json"`
+}
+
+// PrestoLicense is the license of Presto File Server. Connections is the
+// number of concurrent client connections it allows.
+type PrestoLicense struct {
+	Status      string `json:"status"`
+	Connections int64  `json:"connections"`
+}
+
+type PrestoLicenseActivateRequest struct {
+	Key string `url:"license_key"`
+}
+
+// PrestoSettingGet returns the settings of Presto File Server.
+func (c *Client) PrestoSettingGet(ctx context.Context) (*PrestoSetting, error) {
+	return api.Get[PrestoSetting](c.client, ctx, &struct{}{}, PrestoSettingGet)
+}
+
+// PrestoSettingSet replaces the settings of Presto File Server.
+func (c *Client) PrestoSettingSet(ctx context.Context, setting PrestoSetting) error {
+	shares := setting.Shares
+	if shares == nil {
+		shares = []string{}
+	}
+	return api.Void(c.client, ctx, &PrestoSettingSetRequest{
+		Enabled:   setting.Enabled,
+		Port:      setting.Port,
+		DataPort:  setting.DataPort,
+		AllShares: setting.AllShares,
+		Shares:    shares,
+	}, PrestoSettingSet)
+}
+
+// PrestoLicenseGet returns the license of Presto File Server.
+func (c *Client) PrestoLicenseGet(ctx context.Context) (*PrestoLicense, error) {
+	return api.Get[PrestoLicense](c.client, ctx, &struct{}{}, PrestoLicenseGet)
+}
+
+// PrestoLicenseActivate activates a license key, which requires the NAS to
+// reach the Synology license server.
+func (c *Client) PrestoLicenseActivate(ctx context.Context, key string) error {
+	return api.Void(c.client, ctx, &PrestoLicenseActivateRequest{Key: key}, PrestoLicenseActivate)
+}
@@ -0,0 +1,51 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const ProxyServer_Setting = "SYNO.ProxyServer.Setting"
+
+var (
+	ProxyServerGet = api.Method{
+		API:            ProxyServer_Setting,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ProxyServerSet = api.Method{
+		API:            ProxyServer_Setting,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// ProxyServerSettings holds the settings of the Proxy Server package. The
+// cache size is in MB. The parent proxy password is never returned.
+type ProxyServerSettings struct {
+	Port           int64    `json:"port"                  url:"port"`
+	CacheEnable    bool     `json:"cache_enable"          url:"cache_enable"`
+	CacheSize      int64    `json:"cache_size"            url:"cache_size"`
+	AccessControl  bool     `json:"access_control_enable" url:"access_control_enable"`
+	AllowedClients []string `json:"allow_list"            url:"allow_list,json"`
+
+	ParentEnable   bool   `json:"parent_proxy_enable"             url:"parent_proxy_enable"`
+	ParentHost     string `json:"parent_proxy_host"               url:"parent_proxy_host"`
+	ParentPort     int64  `json:"parent_proxy_port"               url:"parent_proxy_port"`
+	ParentUser     string `json:"parent_proxy_user"               url:"parent_proxy_user"`
+	ParentPassword string `json:"parent_proxy_password,omitempty" url:"parent_proxy_password,omitempty"`
+}
+
+// ProxyServerGet returns the settings of the Proxy Server package.
+func (c *Client) ProxyServerGet(ctx context.Context) (*ProxyServerSettings, error) {
+	return api.Get[ProxyServerSettings](c.client, ctx, &struct{}{}, ProxyServerGet)
+}
+
+// ProxyServerSet replaces the settings of the Proxy Server package and
+// restarts the proxy. An empty parent proxy password keeps the current one.
+func (c *Client) ProxyServerSet(ctx context.Context, settings ProxyServerSettings) error {
+	return api.Void(c.client, ctx, &settings, ProxyServerSet)
+}
@@ -0,0 +1,138 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	RADIUSServer_Setting = "SYNO.RADIUSServer.Setting"
+	RADIUSServer_Client  = "SYNO.RADIUSServer.Client"
+)
+
+var (
+	RADIUSSettingGet = api.Method{
+		API:            RADIUSServer_Setting,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	RADIUSSettingSet = api.Method{
+		API:            RADIUSServer_Setting,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	RADIUSClientList = api.Method{
+		API:            RADIUSServer_Client,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	RADIUSClientCreate = api.Method{
+		API:            RADIUSServer_Client,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	RADIUSClientSet = api.Method{
+		API:            RADIUSServer_Client,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	RADIUSClientDelete = api.Method{
+		API:            RADIUSServer_Client,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// RADIUSSetting are the settings of the RADIUS Server package. The user
+// sources decide whose credentials are accepted.
+type RADIUSSetting struct {
+	Enabled        bool  `json:"enable"`
+	AuthPort       int64 `json:"auth_port"`
+	AccountingPort int64 `json:"acct_port"`
+	LocalUsers     bool  `json:"enable_local_user"`
+	DomainUsers    bool  `json:"enable_domain_user"`
+	LDAPUsers      bool  `json:"enable_ldap_user"`
+}
+
+type RADIUSSettingSetRequest struct {
+	Enabled        bool  `url:"enable"`
+	AuthPort       int64 `url:"auth_port"`
+	AccountingPort int64 `url:"acct_port"`
+	LocalUsers     bool  `url:"enable_local_user"`
+	DomainUsers    bool  `url:"enable_domain_user"`
+	LDAPUsers      bool  `url:"enable_ldap_user"`
+}
+
+// RADIUSClient is a network access server, such as a Wi-Fi access point,
+// allowed to authenticate users against the NAS. Address is an IP address or
+// a subnet in CIDR notation.
+type RADIUSClient struct {
+	Name    string `json:"name"`
+	Address string `json:"ip"`
+	Secret  string `json:"secret,omitempty"`
+	Enabled bool   `json:"enable"`
+}
+
+type RADIUSClientListResponse struct {
+	Clients []RADIUSClient `json:"clients"`
+}
+
+type RADIUSClientRequest struct {
+	Client RADIUSClient `url:"client,json"`
+}
+
+type RADIUSClientSetRequest struct {
+	Name   string       `url:"name"`
+	Client RADIUSClient `url:"client,json"`
+}
+
+type RADIUSClientDeleteRequest struct {
+	Names []string `url:"names,json"`
+}
+
+// RADIUSSettingGet returns the settings of RADIUS Server.
+func (c *Client) RADIUSSettingGet(ctx context.Context) (*RADIUSSetting, error) {
+	return api.Get[RADIUSSetting](c.client, ctx, &struct{}{}, RADIUSSettingGet)
+}
+
+// RADIUSSettingSet replaces the settings of RADIUS Server.
+func (c *Client) RADIUSSettingSet(ctx context.Context, setting RADIUSSetting) error {
+	return api.Void(c.client, ctx, &RADIUSSettingSetRequest{
+		Enabled:        setting.Enabled,
+		AuthPort:       setting.AuthPort,
+		AccountingPort: setting.AccountingPort,
+		LocalUsers:     setting.LocalUsers,
+		DomainUsers:    setting.DomainUsers,
+		LDAPUsers:      setting.LDAPUsers,
+	}, RADIUSSettingSet)
+}
+
+// RADIUSClientList returns the RADIUS clients. Their secrets are not
+// returned.
+func (c *Client) RADIUSClientList(ctx context.Context) (*RADIUSClientListResponse, error) {
+	return api.Get[RADIUSClientListResponse](c.client, ctx, &struct{}{}, RADIUSClientList)
+}
+
+// RADIUSClientCreate adds a RADIUS client.
+func (c *Client) RADIUSClientCreate(ctx context.Context, client RADIUSClient) error {
+	return api.Void(c.client, ctx, &RADIUSClientRequest{Client: client}, RADIUSClientCreate)
+}
+
+// RADIUSClientSet updates the RADIUS client with the name. The secret is
+// only changed when it is not empty.
+func (c *Client) RADIUSClientSet(ctx context.Context, name string, client RADIUSClient) error {
+	return api.Void(c.client, ctx, &RADIUSClientSetRequest{Name: name, Client: client}, RADIUSClientSet)
+}
+
+// RADIUSClientDelete removes a RADIUS client.
+func (c *Client) RADIUSClientDelete(ctx context.Context, name string) error {
+	return api.Void(c.client, ctx, &RADIUSClientDeleteRequest{Names: []string{name}}, RADIUSClientDelete)
+}
@@ -0,0 +1,85 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	FileStation_Mount      = "SYNO.FileStation.Mount"
+	FileStation_Mount_List = "SYNO.FileStation.Mount.List"
+)
+
+// Protocols of remote folders.
+const (
+	RemoteMountCIFS = "cifs"
+	RemoteMountNFS  = "nfs"
+)
+
+var (
+	RemoteMountCreate = api.Method{
+		API:            FileStation_Mount,
+		Version:        1,
+		Method:         "mount_remote",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	RemoteMountDelete = api.Method{
+		API:            FileStation_Mount,
+		Version:        1,
+		Method:         "unmount",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	RemoteMountList = api.Method{
+		API:            FileStation_Mount_List,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// RemoteMount is a remote CIFS or NFS folder mounted on an empty File Station
+// folder.
+type RemoteMount struct {
+	MountType    string `json:"mount_type"    url:"mount_type"`
+	Server       string `json:"server_ip"     url:"server_ip"`
+	RemoteFolder string `json:"remote_folder" url:"remote_folder"`
+	MountPoint   string `json:"mount_point"   url:"mount_point"`
+	User         string `json:"user"          url:"user,omitempty"`
+	Password     string `json:"-"             url:"pass,omitempty"`
+	AutoMount    bool   `json:"auto_mount"    url:"auto_mount"`
+	Status       string `json:"status"        url:"-"`
+}
+
+type RemoteMountDeleteRequest struct {
+	MountType  string `url:"mount_type"`
+	MountPoint string `url:"mount_point"`
+}
+
+type RemoteMountListRequest struct {
+	MountType string `url:"mount_type"`
+}
+
+type RemoteMountListResponse struct {
+	Items []RemoteMount `json:"items"`
+}
+
+// RemoteMountCreate mounts a remote folder.
+func (c *Client) RemoteMountCreate(ctx context.Context, m RemoteMount) error {
+	return api.Void(c.client, ctx, &m, RemoteMountCreate)
+}
+
+// RemoteMountDelete unmounts the remote folder at mountPoint.
+func (c *Client) RemoteMountDelete(ctx context.Context, mountType, mountPoint string) error {
+	return api.Void(c.client, ctx, &RemoteMountDeleteRequest{
+		MountType:  mountType,
+		MountPoint: mountPoint,
+	}, RemoteMountDelete)
+}
+
+// RemoteMountList returns the remote folders mounted with a protocol.
+func (c *Client) RemoteMountList(ctx context.Context, mountType string) (*RemoteMountListResponse, error) {
+	return api.Get[RemoteMountListResponse](c.client, ctx, &RemoteMountListRequest{
+		MountType: mountType,
+	}, RemoteMountList)
+}
@@ -0,0 +1,165 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const DR_Plan = "SYNO.DR.Plan"
+
+var (
+	ReplicationPlanList = api.Method{
+		API:            DR_Plan,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ReplicationPlanGet = api.Method{
+		API:            DR_Plan,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ReplicationPlanCreate = api.Method{
+		API:            DR_Plan,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ReplicationPlanSet = api.Method{
+		API:            DR_Plan,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ReplicationPlanDelete = api.Method{
+		API:            DR_Plan,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// ReplicationSchedule is the sync schedule of a replication plan. A non zero
+// RepeatHour syncs every RepeatHour hours starting at Hour.
+type ReplicationSchedule struct {
+	Enabled    bool   `json:"enable"`
+	Hour       int64  `json:"hour"`
+	Minute     int64  `json:"minute"`
+	RepeatHour int64  `json:"repeat_hour"`
+	WeekDay    string `json:"week_day"`
+}
+
+// ReplicationRetention is the snapshot retention on the replication target.
+// Zero values keep snapshots forever.
+type ReplicationRetention struct {
+	KeepLatest int64 `json:"keep_latest"`
+	KeepDays   int64 `json:"keep_days"`
+}
+
+// ReplicationPlan replicates a shared folder to a remote NAS.
+type ReplicationPlan struct {
+	PlanID       string               `json:"plan_id,omitempty"`
+	Share        string               `json:"share_name"`
+	TargetHost   string               `json:"target_host"`
+	TargetPort   int64                `json:"target_port"`
+	TargetVolume string               `json:"target_volume"`
+	Encrypt      bool                 `json:"encrypt_transfer"`
+	Schedule     ReplicationSchedule  `json:"schedule"`
+	Retention    ReplicationRetention `json:"target_retention"`
+
+	// The status of the last sync, returned by DSM and omitted on writes.
+	// LastSyncTime is a Unix time.
+	LastSyncTime   int64  `json:"last_sync_time,omitempty"`
+	LastSyncResult string `json:"last_sync_result,omitempty"`
+	LastSyncSize   int64  `json:"last_sync_size,omitempty"`
+}
+
+// ReplicationCredentials authenticate against the replication target.
+type ReplicationCredentials struct {
+	Username string
+	Password string
+}
+
+type ReplicationPlanListResponse struct {
+	Plans []ReplicationPlan `json:"plans"`
+}
+
+type ReplicationPlanRequest struct {
+	PlanID string `url:"plan_id"`
+}
+
+type ReplicationPlanCreateRequest struct {
+	Plan     ReplicationPlan `url:"plan,json"`
+	Username string          `url:"target_user"`
+	Password string          `url:"target_passwd"`
+}
+
+type ReplicationPlanCreateResponse struct {
+	PlanID string `json:"plan_id"`
+}
+
+type ReplicationPlanSetRequest struct {
+	PlanID   string          `url:"plan_id"`
+	Plan     ReplicationPlan `url:"plan,json"`
+	Username string          `url:"target_user,omitempty"`
+	Password string          `url:"target_passwd,omitempty"`
+}
+
+type ReplicationPlanDeleteRequest struct {
+	PlanIDs        []string `url:"plan_ids,json"`
+	RemoveSnapshot bool     `url:"remove_target_snapshot"`
+}
+
+// ReplicationPlanList returns the replication plans of the NAS.
+func (c *Client) ReplicationPlanList(ctx context.Context) (*ReplicationPlanListResponse, error) {
+	return api.Get[ReplicationPlanListResponse](c.client, ctx, &struct{}{}, ReplicationPlanList)
+}
+
+// ReplicationPlanGet returns a replication plan.
+func (c *Client) ReplicationPlanGet(ctx context.Context, id string) (*ReplicationPlan, error) {
+	return api.Get[ReplicationPlan](c.client, ctx, &ReplicationPlanRequest{PlanID: id}, ReplicationPlanGet)
+}
+
+// ReplicationPlanCreate creates a replication plan and returns its ID.
+func (c *Client) ReplicationPlanCreate(
+	ctx context.Context,
+	plan ReplicationPlan,
+	credentials ReplicationCredentials,
+) (string, error) {
+	plan.PlanID = ""
+	res, err := api.Post[ReplicationPlanCreateResponse](c.client, ctx, &ReplicationPlanCreateRequest{
+		Plan:     plan,
+		Username: credentials.Username,
+		Password: credentials.Password,
+	}, ReplicationPlanCreate)
+	if err != nil {
+		return "", err
+	}
+	return res.PlanID, nil
+}
+
+// ReplicationPlanSet updates a replication plan.
+func (c *Client) ReplicationPlanSet(
+	ctx context.Context,
+	plan ReplicationPlan,
+	credentials ReplicationCredentials,
+) error {
+	_, err := api.Post[struct{}](c.client, ctx, &ReplicationPlanSetRequest{
+		PlanID:   plan.PlanID,
+		Plan:     plan,
+		Username: credentials.Username,
+		Password: credentials.Password,
+	}, ReplicationPlanSet)
+	return err
+}
+
+// ReplicationPlanDelete deletes a replication plan, optionally removing the
+// replicated share from the target.
+func (c *Client) ReplicationPlanDelete(ctx context.Context, id string, removeTarget bool) error {
+	return api.Void(c.client, ctx, &ReplicationPlanDeleteRequest{
+		PlanIDs:        []string{id},
+		RemoveSnapshot: removeTarget,
+	}, ReplicationPlanDelete)
+}
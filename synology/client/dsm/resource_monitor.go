@@ -0,0 +1,99 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const ResourceMonitor_EventRule = "SYNO.ResourceMonitor.EventRule"
+
+// Metrics watched by Resource Monitor alarm rules.
+const (
+	ResourceMonitorMetricCPU    = "cpu"
+	ResourceMonitorMetricMemory = "memory"
+	ResourceMonitorMetricVolume = "volume"
+)
+
+var (
+	ResourceMonitorRuleList = api.Method{
+		API:            ResourceMonitor_EventRule,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ResourceMonitorRuleCreate = api.Method{
+		API:            ResourceMonitor_EventRule,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ResourceMonitorRuleSet = api.Method{
+		API:            ResourceMonitor_EventRule,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ResourceMonitorRuleDelete = api.Method{
+		API:            ResourceMonitor_EventRule,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// ResourceMonitorRule raises an alarm when the utilization of Metric stays
+// above Threshold percent for Duration minutes. Target is the volume of volume
+// rules, e.g. "volume1". Notify lists the notification channels, "mail",
+// "sms", "mobile" or "webhook".
+type ResourceMonitorRule struct {
+	ID        int64    `json:"id,omitempty"`
+	Name      string   `json:"name"`
+	Enable    bool     `json:"enable"`
+	Metric    string   `json:"type"`
+	Target    string   `json:"target,omitempty"`
+	Threshold int64    `json:"threshold"`
+	Duration  int64    `json:"duration"`
+	Notify    []string `json:"notify"`
+}
+
+type ResourceMonitorRuleListResponse struct {
+	Rules []ResourceMonitorRule `json:"rules"`
+}
+
+type ResourceMonitorRuleRequest struct {
+	Rule ResourceMonitorRule `url:"rule,json"`
+}
+
+type ResourceMonitorRuleCreateResponse struct {
+	ID int64 `json:"id"`
+}
+
+type ResourceMonitorRuleDeleteRequest struct {
+	IDs []int64 `url:"ids,json"`
+}
+
+// ResourceMonitorRuleList returns the alarm rules of Resource Monitor.
+func (c *Client) ResourceMonitorRuleList(ctx context.Context) (*ResourceMonitorRuleListResponse, error) {
+	return api.Get[ResourceMonitorRuleListResponse](c.client, ctx, &struct{}{}, ResourceMonitorRuleList)
+}
+
+// ResourceMonitorRuleCreate creates an alarm rule and returns its ID.
+func (c *Client) ResourceMonitorRuleCreate(ctx context.Context, rule ResourceMonitorRule) (int64, error) {
+	rule.ID = 0
+	res, err := api.Post[ResourceMonitorRuleCreateResponse](c.client, ctx, &ResourceMonitorRuleRequest{Rule: rule}, ResourceMonitorRuleCreate)
+	if err != nil {
+		return 0, err
+	}
+	return res.ID, nil
+}
+
+// ResourceMonitorRuleSet updates the alarm rule with the ID of rule.
+func (c *Client) ResourceMonitorRuleSet(ctx context.Context, rule ResourceMonitorRule) error {
+	return api.Void(c.client, ctx, &ResourceMonitorRuleRequest{Rule: rule}, ResourceMonitorRuleSet)
+}
+
+// ResourceMonitorRuleDelete deletes an alarm rule.
+func (c *Client) ResourceMonitorRuleDelete(ctx context.Context, id int64) error {
+	return api.Void(c.client, ctx, &ResourceMonitorRuleDeleteRequest{IDs: []int64{id}}, ResourceMonitorRuleDelete)
+}
@@ -0,0 +1,101 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_AppPortal_ReverseProxy = "SYNO.Core.AppPortal.ReverseProxy"
+
+var (
+	ReverseProxyList = api.Method{
+		API:            Core_AppPortal_ReverseProxy,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ReverseProxyCreate = api.Method{
+		API:            Core_AppPortal_ReverseProxy,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ReverseProxyUpdate = api.Method{
+		API:            Core_AppPortal_ReverseProxy,
+		Version:        1,
+		Method:         api.MethodUpdate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ReverseProxyDelete = api.Method{
+		API:            Core_AppPortal_ReverseProxy,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// Reverse proxy endpoint protocols.
+const (
+	ReverseProxyHTTP  = 0
+	ReverseProxyHTTPS = 1
+)
+
+type ReverseProxyHTTPSOptions struct {
+	HSTS bool `json:"hsts"`
+}
+
+type ReverseProxyEndpoint struct {
+	Protocol int                       `json:"protocol"`
+	Fqdn     string                    `json:"fqdn"`
+	Port     int                       `json:"port"`
+	HTTPS    *ReverseProxyHTTPSOptions `json:"https,omitempty"`
+}
+
+type ReverseProxyHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ReverseProxyEntry is a reverse proxy rule of the DSM application portal.
+type ReverseProxyEntry struct {
+	UUID             string               `json:"UUID,omitempty"`
+	Description      string               `json:"description"`
+	Frontend         ReverseProxyEndpoint `json:"frontend"`
+	Backend          ReverseProxyEndpoint `json:"backend"`
+	CustomizeHeaders []ReverseProxyHeader `json:"customize_headers"`
+}
+
+type ReverseProxyListResponse struct {
+	Entries []ReverseProxyEntry `json:"entries"`
+}
+
+type ReverseProxyEntryRequest struct {
+	Entry ReverseProxyEntry `url:"entry,json"`
+}
+
+type ReverseProxyDeleteRequest struct {
+	UUIDs []string `url:"uuids,json"`
+}
+
+// ReverseProxyList returns the reverse proxy rules in the order DSM matches
+// them.
+func (c *Client) ReverseProxyList(ctx context.Context) (*ReverseProxyListResponse, error) {
+	return api.Get[ReverseProxyListResponse](c.client, ctx, &struct{}{}, ReverseProxyList)
+}
+
+// ReverseProxyCreate creates a reverse proxy rule. DSM assigns the UUID.
+func (c *Client) ReverseProxyCreate(ctx context.Context, entry ReverseProxyEntry) error {
+	entry.UUID = ""
+	return api.Void(c.client, ctx, &ReverseProxyEntryRequest{Entry: entry}, ReverseProxyCreate)
+}
+
+// ReverseProxyUpdate updates the reverse proxy rule with the UUID of entry.
+func (c *Client) ReverseProxyUpdate(ctx context.Context, entry ReverseProxyEntry) error {
+	return api.Void(c.client, ctx, &ReverseProxyEntryRequest{Entry: entry}, ReverseProxyUpdate)
+}
+
+// ReverseProxyDelete deletes reverse proxy rules.
+func (c *Client) ReverseProxyDelete(ctx context.Context, uuids ...string) error {
+	return api.Void(c.client, ctx, &ReverseProxyDeleteRequest{UUIDs: uuids}, ReverseProxyDelete)
+}
@@ -0,0 +1,107 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Core_Web_DSM                 = "SYNO.Core.Web.DSM"
+	Core_Security_DSM            = "SYNO.Core.Security.DSM"
+	Core_Web_Security_TLSProfile = "SYNO.Core.Web.Security.TLSProfile"
+)
+
+// TLS profiles of the DSM web server, following the Mozilla server side TLS
+// guidelines.
+const (
+	TLSProfileModern       = "modern"
+	TLSProfileIntermediate = "intermediate"
+	TLSProfileOld          = "old"
+)
+
+var (
+	WebDSMGet = api.Method{
+		API:            Core_Web_DSM,
+		Version:        2,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	WebDSMSet = api.Method{
+		API:            Core_Web_DSM,
+		Version:        2,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SecurityDSMGet = api.Method{
+		API:            Core_Security_DSM,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SecurityDSMSet = api.Method{
+		API:            Core_Security_DSM,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	TLSProfileGet = api.Method{
+		API:            Core_Web_Security_TLSProfile,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	TLSProfileSet = api.Method{
+		API:            Core_Web_Security_TLSProfile,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// WebDSM holds the HTTP settings of the DSM web server. Only the fields
+// managed by the provider are listed, set leaves the others unchanged.
+type WebDSM struct {
+	EnableHSTS bool `json:"enable_hsts" url:"enable_hsts"`
+}
+
+// SecurityDSM holds the browser protection settings of the DSM login.
+type SecurityDSM struct {
+	CSRFProtection         bool `json:"csrf_protect"           url:"csrf_protect"`
+	ClickjackingProtection bool `json:"enable_x_frame_options" url:"enable_x_frame_options"`
+	SkipIPChecking         bool `json:"skip_ip_checking"       url:"skip_ip_checking"`
+}
+
+type TLSProfile struct {
+	DefaultLevel string `json:"default-level" url:"default-level"`
+}
+
+// WebDSMGet returns the HTTP settings of the DSM web server.
+func (c *Client) WebDSMGet(ctx context.Context) (*WebDSM, error) {
+	return api.Get[WebDSM](c.client, ctx, &struct{}{}, WebDSMGet)
+}
+
+// WebDSMSet updates the HTTP settings of the DSM web server.
+func (c *Client) WebDSMSet(ctx context.Context, settings WebDSM) error {
+	return api.Void(c.client, ctx, &settings, WebDSMSet)
+}
+
+// SecurityDSMGet returns the browser protection settings.
+func (c *Client) SecurityDSMGet(ctx context.Context) (*SecurityDSM, error) {
+	return api.Get[SecurityDSM](c.client, ctx, &struct{}{}, SecurityDSMGet)
+}
+
+// SecurityDSMSet updates the browser protection settings.
+func (c *Client) SecurityDSMSet(ctx context.Context, settings SecurityDSM) error {
+	return api.Void(c.client, ctx, &settings, SecurityDSMSet)
+}
+
+// TLSProfileGet returns the default TLS profile of the DSM services.
+func (c *Client) TLSProfileGet(ctx context.Context) (*TLSProfile, error) {
+	return api.Get[TLSProfile](c.client, ctx, &struct{}{}, TLSProfileGet)
+}
+
+// TLSProfileSet sets the default TLS profile of the DSM services.
+func (c *Client) TLSProfileSet(ctx context.Context, profile TLSProfile) error {
+	return api.Void(c.client, ctx, &profile, TLSProfileSet)
+}
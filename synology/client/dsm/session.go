@@ -0,0 +1,57 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	DSM_Info              = "SYNO.DSM.Info"
+	Core_Desktop_Initdata = "SYNO.Core.Desktop.Initdata"
+)
+
+var (
+	DSMInfoGet = api.Method{
+		API:            DSM_Info,
+		Version:        2,
+		Method:         "getinfo",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SessionGet = api.Method{
+		API:            Core_Desktop_Initdata,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// DSMInfo describes the NAS and its DSM version, e.g. "DSM 7.2.2-72806".
+type DSMInfo struct {
+	Model   string `json:"model"`
+	Version string `json:"version_string"`
+}
+
+// Session is the account the client is signed in with.
+type Session struct {
+	User    string `json:"user"`
+	IsAdmin bool   `json:"is_admin"`
+}
+
+type SessionResponse struct {
+	Session Session `json:"session"`
+}
+
+// DSMInfo returns the model and DSM version of the NAS.
+func (c *Client) DSMInfo(ctx context.Context) (*DSMInfo, error) {
+	return api.Get[DSMInfo](c.client, ctx, &struct{}{}, DSMInfoGet)
+}
+
+// Session returns the account the client is signed in with.
+func (c *Client) Session(ctx context.Context) (*Session, error) {
+	res, err := api.Get[SessionResponse](c.client, ctx, &struct{}{}, SessionGet)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Session, nil
+}
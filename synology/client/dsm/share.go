@@ -0,0 +1,75 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_Share = "SYNO.Core.Share"
+
+var (
+	ShareGet = api.Method{
+		API:            Core_Share,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ShareSet = api.Method{
+		API:            Core_Share,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// ShareSettings holds the options of a shared folder managed by the
+// provider. Name and VolPath identify the share and are sent back unchanged,
+// as is EnableShareCow, which can only be set when the share is created.
+type ShareSettings struct {
+	Name                   string `json:"name"`
+	NameOrg                string `json:"name_org,omitempty"`
+	VolPath                string `json:"vol_path"`
+	Hidden                 bool   `json:"hidden"`
+	HideUnreadable         bool   `json:"hide_unreadable"`
+	EnableRecycleBin       bool   `json:"enable_recycle_bin"`
+	RecycleBinAdminOnly    bool   `json:"recycle_bin_admin_only"`
+	EnableSnapshotBrowsing bool   `json:"enable_snapshot_browsing"`
+	EnableShareCow         bool   `json:"enable_share_cow"`
+	EnableShareCompress    bool   `json:"enable_share_compress"`
+}
+
+type ShareGetRequest struct {
+	Name       string   `url:"name"`
+	Additional []string `url:"additional,json"`
+}
+
+type ShareSetRequest struct {
+	Name      string        `url:"name"`
+	ShareInfo ShareSettings `url:"shareinfo,json"`
+}
+
+// ShareSettingsGet returns the options of a shared folder.
+func (c *Client) ShareSettingsGet(ctx context.Context, name string) (*ShareSettings, error) {
+	return api.Get[ShareSettings](c.client, ctx, &ShareGetRequest{
+		Name: name,
+		Additional: []string{
+			"hidden",
+			"hide_unreadable",
+			"recyclebin",
+			"enable_snapshot_browsing",
+			"enable_share_cow",
+			"enable_share_compress",
+		},
+	}, ShareGet)
+}
+
+// ShareSettingsSet saves the options of a shared folder.
+func (c *Client) ShareSettingsSet(ctx context.Context, s ShareSettings) error {
+	s.NameOrg = s.Name
+
+	return api.Void(c.client, ctx, &ShareSetRequest{
+		Name:      s.Name,
+		ShareInfo: s,
+	}, ShareSet)
+}
@@ -0,0 +1,95 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_Share_Permission = "SYNO.Core.Share.Permission"
+
+// User group types accepted by SYNO.Core.Share.Permission.
+const (
+	UserGroupTypeLocalUser  = "local_user"
+	UserGroupTypeLocalGroup = "local_group"
+)
+
+var (
+	SharePermissionList = api.Method{
+		API:            Core_Share_Permission,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SharePermissionSet = api.Method{
+		API:            Core_Share_Permission,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// SharePermission is the access of a single user or group to a share. An
+// entry with every flag unset removes the explicit permission.
+type SharePermission struct {
+	Name       string `json:"name"`
+	IsReadonly bool   `json:"is_readonly"`
+	IsWritable bool   `json:"is_writable"`
+	IsDeny     bool   `json:"is_deny"`
+	IsCustom   bool   `json:"is_custom"`
+	IsAdmin    bool   `json:"is_admin,omitempty"`
+	Inherit    string `json:"inherit,omitempty"`
+}
+
+// Explicit reports whether the entry grants or denies access.
+func (p SharePermission) Explicit() bool {
+	return p.IsReadonly || p.IsWritable || p.IsDeny
+}
+
+type SharePermissionListRequest struct {
+	Name          string `url:"name"`
+	UserGroupType string `url:"user_group_type"`
+	Offset        int    `url:"offset"`
+	Limit         int    `url:"limit"`
+}
+
+type SharePermissionListResponse struct {
+	Items []SharePermission `json:"items"`
+	Total int               `json:"total"`
+}
+
+type SharePermissionSetRequest struct {
+	Name          string            `url:"name"`
+	UserGroupType string            `url:"user_group_type"`
+	Permissions   []SharePermission `url:"permissions,json"`
+}
+
+// SharePermissionList returns the permissions of every user or group of the
+// given type on a share.
+func (c *Client) SharePermissionList(
+	ctx context.Context,
+	share string,
+	userGroupType string,
+) (*SharePermissionListResponse, error) {
+	return api.Get[SharePermissionListResponse](c.client, ctx, &SharePermissionListRequest{
+		Name:          share,
+		UserGroupType: userGroupType,
+		Offset:        0,
+		Limit:         -1,
+	}, SharePermissionList)
+}
+
+// SharePermissionSet applies the given permissions to a share in a single
+// call. Users or groups which are not listed are left unchanged.
+func (c *Client) SharePermissionSet(
+	ctx context.Context,
+	share string,
+	userGroupType string,
+	permissions []SharePermission,
+) error {
+	return api.Void(c.client, ctx, &SharePermissionSetRequest{
+		Name:          share,
+		UserGroupType: userGroupType,
+		Permissions:   permissions,
+	}, SharePermissionSet)
+}
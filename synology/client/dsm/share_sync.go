@@ -0,0 +1,105 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_ShareSync_Task = "SYNO.Core.ShareSync.Task"
+
+var (
+	ShareSyncTaskList = api.Method{
+		API:            Core_ShareSync_Task,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ShareSyncTaskCreate = api.Method{
+		API:            Core_ShareSync_Task,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ShareSyncTaskSet = api.Method{
+		API:            Core_ShareSync_Task,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ShareSyncTaskDelete = api.Method{
+		API:            Core_ShareSync_Task,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// ShareSyncSchedule is the schedule of a Shared Folder Sync task. A non zero
+// RepeatHour runs the task every RepeatHour hours starting at Hour.
+type ShareSyncSchedule struct {
+	Enabled    bool   `json:"enable"`
+	Hour       int64  `json:"hour"`
+	Minute     int64  `json:"minute"`
+	RepeatHour int64  `json:"repeat_hour"`
+	WeekDay    string `json:"week_day"`
+}
+
+// ShareSyncTask mirrors Shares one way to the Shared Folder Sync server of
+// another NAS. SyncOnChange starts the task whenever a file of the shares is
+// modified. The password is write only.
+type ShareSyncTask struct {
+	ID           int64             `json:"task_id,omitempty"`
+	Name         string            `json:"task_name"`
+	Shares       []string          `json:"shares"`
+	Server       string            `json:"server_ip"`
+	Port         int64             `json:"port"`
+	Username     string            `json:"account"`
+	Password     string            `json:"passwd,omitempty"`
+	Encrypt      bool              `json:"enable_encrypt"`
+	SyncOnChange bool              `json:"sync_on_change"`
+	Schedule     ShareSyncSchedule `json:"schedule"`
+	Status       string            `json:"status,omitempty"`
+}
+
+type ShareSyncTaskListResponse struct {
+	Tasks []ShareSyncTask `json:"tasks"`
+}
+
+type ShareSyncTaskRequest struct {
+	Task ShareSyncTask `url:"task,json"`
+}
+
+type ShareSyncTaskCreateResponse struct {
+	ID int64 `json:"task_id"`
+}
+
+type ShareSyncTaskDeleteRequest struct {
+	IDs []int64 `url:"task_ids,json"`
+}
+
+// ShareSyncTaskList returns the Shared Folder Sync tasks of the NAS.
+func (c *Client) ShareSyncTaskList(ctx context.Context) (*ShareSyncTaskListResponse, error) {
+	return api.Get[ShareSyncTaskListResponse](c.client, ctx, &struct{}{}, ShareSyncTaskList)
+}
+
+// ShareSyncTaskCreate creates a Shared Folder Sync task and returns its ID.
+func (c *Client) ShareSyncTaskCreate(ctx context.Context, task ShareSyncTask) (int64, error) {
+	task.ID = 0
+	res, err := api.Post[ShareSyncTaskCreateResponse](c.client, ctx, &ShareSyncTaskRequest{Task: task}, ShareSyncTaskCreate)
+	if err != nil {
+		return 0, err
+	}
+	return res.ID, nil
+}
+
+// ShareSyncTaskSet updates the Shared Folder Sync task with the ID of task.
+func (c *Client) ShareSyncTaskSet(ctx context.Context, task ShareSyncTask) error {
+	return api.Void(c.client, ctx, &ShareSyncTaskRequest{Task: task}, ShareSyncTaskSet)
+}
+
+// ShareSyncTaskDelete deletes a Shared Folder Sync task. The synced folders on
+// the server are kept.
+func (c *Client) ShareSyncTaskDelete(ctx context.Context, id int64) error {
+	return api.Void(c.client, ctx, &ShareSyncTaskDeleteRequest{IDs: []int64{id}}, ShareSyncTaskDelete)
+}
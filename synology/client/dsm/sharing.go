@@ -0,0 +1,128 @@
+package dsm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const FileStation_Sharing = "SYNO.FileStation.Sharing"
+
+var (
+	SharingList = api.Method{
+		API:            FileStation_Sharing,
+		Version:        3,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SharingCreate = api.Method{
+		API:            FileStation_Sharing,
+		Version:        3,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SharingEdit = api.Method{
+		API:            FileStation_Sharing,
+		Version:        3,
+		Method:         "edit",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SharingDelete = api.Method{
+		API:            FileStation_Sharing,
+		Version:        3,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// SharingLink is a public link to a file or folder. DateExpired is empty for
+// links which never expire and "YYYY-MM-DD" otherwise; DSM appends the time
+// of day when it returns a link. The password is write only.
+//
+// A link with FileRequest set is a file request, a link to a folder which
+// visitors can only upload to. RequestName is its title and RequestInfo a
+// message shown to uploaders.
+type SharingLink struct {
+	ID           string `json:"id"`
+	URL          string `json:"url"`
+	Path         string `json:"path"`
+	IsFolder     bool   `json:"isFolder"`
+	HasPassword  bool   `json:"has_password"`
+	DateExpired  string `json:"date_expired"`
+	EnableUpload bool   `json:"enable_upload"`
+	FileRequest  bool   `json:"file_request"`
+	RequestName  string `json:"request_name"`
+	RequestInfo  string `json:"request_info"`
+	Status       string `json:"status"`
+}
+
+type SharingListRequest struct {
+	Offset int64 `url:"offset"`
+	Limit  int64 `url:"limit"`
+}
+
+type SharingListResponse struct {
+	Total int64         `json:"total"`
+	Links []SharingLink `json:"links"`
+}
+
+type SharingCreateRequest struct {
+	Path         string `url:"path"`
+	Password     string `url:"password,omitempty"`
+	DateExpired  string `url:"date_expired,omitempty"`
+	EnableUpload bool   `url:"enable_upload"`
+	FileRequest  bool   `url:"file_request,omitempty"`
+	RequestName  string `url:"request_name,omitempty"`
+	RequestInfo  string `url:"request_info,omitempty"`
+}
+
+type SharingCreateResponse struct {
+	Links []SharingLink `json:"links"`
+}
+
+// SharingEditRequest replaces the settings of a link. An empty password
+// removes it and an empty expiry makes the link permanent. RequestName and
+// RequestInfo are only sent for file requests.
+type SharingEditRequest struct {
+	ID           string  `url:"id"`
+	Password     string  `url:"password"`
+	DateExpired  string  `url:"date_expired"`
+	EnableUpload bool    `url:"enable_upload"`
+	RequestName  *string `url:"request_name,omitempty"`
+	RequestInfo  *string `url:"request_info,omitempty"`
+}
+
+type SharingDeleteRequest struct {
+	ID string `url:"id"`
+}
+
+// SharingList returns the links of the logged in user.
+func (c *Client) SharingList(ctx context.Context) (*SharingListResponse, error) {
+	return api.Get[SharingListResponse](c.client, ctx, &SharingListRequest{
+		Offset: 0,
+		Limit:  -1,
+	}, SharingList)
+}
+
+// SharingCreate creates a link to path and returns it.
+func (c *Client) SharingCreate(ctx context.Context, req SharingCreateRequest) (*SharingLink, error) {
+	res, err := api.Post[SharingCreateResponse](c.client, ctx, &req, SharingCreate)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Links) == 0 {
+		return nil, fmt.Errorf("no link to %s was created", req.Path)
+	}
+	return &res.Links[0], nil
+}
+
+// SharingEdit updates a link. The URL of the link is kept.
+func (c *Client) SharingEdit(ctx context.Context, req SharingEditRequest) error {
+	return api.Void(c.client, ctx, &req, SharingEdit)
+}
+
+// SharingDelete removes a link. The shared file or folder is kept.
+func (c *Client) SharingDelete(ctx context.Context, id string) error {
+	return api.Void(c.client, ctx, &SharingDeleteRequest{ID: id}, SharingDelete)
+}
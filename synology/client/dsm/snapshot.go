@@ -0,0 +1,238 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Core_Share_Snapshot = "SYNO.Core.Share.Snapshot"
+	Core_ISCSI_LUN      = "SYNO.Core.ISCSI.LUN"
+)
+
+var (
+	ShareSnapshotCreate = api.Method{
+		API:            Core_Share_Snapshot,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ShareSnapshotList = api.Method{
+		API:            Core_Share_Snapshot,
+		Version:        2,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ShareSnapshotSet = api.Method{
+		API:            Core_Share_Snapshot,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ShareSnapshotDelete = api.Method{
+		API:            Core_Share_Snapshot,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	ShareSnapshotRestore = api.Method{
+		API:            Core_Share_Snapshot,
+		Version:        1,
+		Method:         "restore",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LUNSnapshotTake = api.Method{
+		API:            Core_ISCSI_LUN,
+		Version:        1,
+		Method:         "take_snapshot",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LUNSnapshotList = api.Method{
+		API:            Core_ISCSI_LUN,
+		Version:        1,
+		Method:         "list_snapshot",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LUNSnapshotSet = api.Method{
+		API:            Core_ISCSI_LUN,
+		Version:        1,
+		Method:         "set_snapshot",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LUNSnapshotDelete = api.Method{
+		API:            Core_ISCSI_LUN,
+		Version:        1,
+		Method:         "delete_snapshot",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	LUNSnapshotRestore = api.Method{
+		API:            Core_ISCSI_LUN,
+		Version:        1,
+		Method:         "restore_snapshot",
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+type ShareSnapshotInfo struct {
+	Description string `json:"desc"`
+	Lock        bool   `json:"lock"`
+}
+
+// ShareSnapshot is a snapshot of a shared folder, named after the GMT time it
+// was taken at.
+type ShareSnapshot struct {
+	Time        string `json:"time"`
+	Description string `json:"desc"`
+	Lock        bool   `json:"lock"`
+}
+
+type ShareSnapshotCreateRequest struct {
+	Name string            `url:"name"`
+	Info ShareSnapshotInfo `url:"snapinfo,json"`
+}
+
+type ShareSnapshotListRequest struct {
+	Name       string   `url:"name"`
+	Additional []string `url:"additional,json"`
+}
+
+type ShareSnapshotListResponse struct {
+	Snapshots []ShareSnapshot `json:"snapshots"`
+}
+
+type ShareSnapshotSetRequest struct {
+	Name     string            `url:"name"`
+	Snapshot string            `url:"snapshot"`
+	Info     ShareSnapshotInfo `url:"snapinfo,json"`
+}
+
+type ShareSnapshotDeleteRequest struct {
+	Name      string   `url:"name"`
+	Snapshots []string `url:"snapshots,json"`
+}
+
+type ShareSnapshotRestoreRequest struct {
+	Name           string `url:"name"`
+	Snapshot       string `url:"snapshot"`
+	SnapshotBefore bool   `url:"take_snapshot_before"`
+}
+
+// LUNSnapshot is a snapshot of an iSCSI LUN.
+type LUNSnapshot struct {
+	UUID        string `json:"uuid"`
+	Description string `json:"description"`
+	Locked      bool   `json:"is_locked"`
+	TakenTime   int64  `json:"time"`
+}
+
+type LUNSnapshotTakeRequest struct {
+	LUN         string `url:"src_lun_uuid,json"`
+	Description string `url:"description,json"`
+	Locked      bool   `url:"is_locked"`
+	AppAware    bool   `url:"is_app_consistent"`
+	TakenBy     string `url:"taken_by,json"`
+}
+
+type LUNSnapshotTakeResponse struct {
+	UUID string `json:"snapshot_uuid"`
+}
+
+type LUNSnapshotListRequest struct {
+	LUN string `url:"src_lun_uuid,json"`
+}
+
+type LUNSnapshotListResponse struct {
+	Snapshots []LUNSnapshot `json:"snapshots"`
+}
+
+type LUNSnapshotSetRequest struct {
+	UUID   string `url:"snapshot_uuid,json"`
+	Locked bool   `url:"new_is_locked"`
+}
+
+type LUNSnapshotRequest struct {
+	UUID string `url:"snapshot_uuid,json"`
+}
+
+// ShareSnapshotCreate takes a snapshot of a shared folder and returns its
+// name.
+func (c *Client) ShareSnapshotCreate(ctx context.Context, share string, info ShareSnapshotInfo) (string, error) {
+	res, err := api.Get[string](c.client, ctx, &ShareSnapshotCreateRequest{
+		Name: share,
+		Info: info,
+	}, ShareSnapshotCreate)
+	if err != nil {
+		return "", err
+	}
+	return *res, nil
+}
+
+// ShareSnapshotList returns the snapshots of a shared folder.
+func (c *Client) ShareSnapshotList(ctx context.Context, share string) (*ShareSnapshotListResponse, error) {
+	return api.Get[ShareSnapshotListResponse](c.client, ctx, &ShareSnapshotListRequest{
+		Name:       share,
+		Additional: []string{"desc", "lock"},
+	}, ShareSnapshotList)
+}
+
+// ShareSnapshotSet updates the description and lock of a snapshot.
+func (c *Client) ShareSnapshotSet(ctx context.Context, share, snapshot string, info ShareSnapshotInfo) error {
+	return api.Void(c.client, ctx, &ShareSnapshotSetRequest{
+		Name:     share,
+		Snapshot: snapshot,
+		Info:     info,
+	}, ShareSnapshotSet)
+}
+
+// ShareSnapshotDelete deletes snapshots of a shared folder.
+func (c *Client) ShareSnapshotDelete(ctx context.Context, share string, snapshots ...string) error {
+	return api.Void(c.client, ctx, &ShareSnapshotDeleteRequest{
+		Name:      share,
+		Snapshots: snapshots,
+	}, ShareSnapshotDelete)
+}
+
+// ShareSnapshotRestore reverts a shared folder to a snapshot, optionally
+// taking a snapshot of the current state first.
+func (c *Client) ShareSnapshotRestore(ctx context.Context, share, snapshot string, snapshotBefore bool) error {
+	return api.Void(c.client, ctx, &ShareSnapshotRestoreRequest{
+		Name:           share,
+		Snapshot:       snapshot,
+		SnapshotBefore: snapshotBefore,
+	}, ShareSnapshotRestore)
+}
+
+// LUNSnapshotTake takes a snapshot of a LUN and returns its UUID.
+func (c *Client) LUNSnapshotTake(ctx context.Context, lun, description string, locked bool) (string, error) {
+	res, err := api.Get[LUNSnapshotTakeResponse](c.client, ctx, &LUNSnapshotTakeRequest{
+		LUN:         lun,
+		Description: description,
+		Locked:      locked,
+		TakenBy:     "terraform",
+	}, LUNSnapshotTake)
+	if err != nil {
+		return "", err
+	}
+	return res.UUID, nil
+}
+
+// LUNSnapshotList returns the snapshots of a LUN.
+func (c *Client) LUNSnapshotList(ctx context.Context, lun string) (*LUNSnapshotListResponse, error) {
+	return api.Get[LUNSnapshotListResponse](c.client, ctx, &LUNSnapshotListRequest{LUN: lun}, LUNSnapshotList)
+}
+
+// LUNSnapshotSet locks or unlocks a LUN snapshot.
+func (c *Client) LUNSnapshotSet(ctx context.Context, uuid string, locked bool) error {
+	return api.Void(c.client, ctx, &LUNSnapshotSetRequest{UUID: uuid, Locked: locked}, LUNSnapshotSet)
+}
+
+// LUNSnapshotDelete deletes a LUN snapshot.
+func (c *Client) LUNSnapshotDelete(ctx context.Context, uuid string) error {
+	return api.Void(c.client, ctx, &LUNSnapshotRequest{UUID: uuid}, LUNSnapshotDelete)
+}
+
+// LUNSnapshotRestore reverts a LUN to a snapshot.
+func (c *Client) LUNSnapshotRestore(ctx context.Context, uuid string) error {
+	return api.Void(c.client, ctx, &LUNSnapshotRequest{UUID: uuid}, LUNSnapshotRestore)
+}
@@ -0,0 +1,139 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Core_Directory_SSO    = "SYNO.Core.Directory.SSO"
+	SSOServer_OIDC_Client = "SYNO.SSOServer.OIDC.Client"
+)
+
+// Protocols DSM can use to sign in with an external identity provider.
+const (
+	SSOProtocolOIDC = "oidc"
+	SSOProtocolSAML = "saml"
+)
+
+var (
+	SSOClientGet = api.Method{
+		API:            Core_Directory_SSO,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SSOClientSet = api.Method{
+		API:            Core_Directory_SSO,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	SSOServerClientList = api.Method{
+		API:            SSOServer_OIDC_Client,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SSOServerClientCreate = api.Method{
+		API:            SSOServer_OIDC_Client,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SSOServerClientSet = api.Method{
+		API:            SSOServer_OIDC_Client,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SSOServerClientDelete = api.Method{
+		API:            SSOServer_OIDC_Client,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// SSOClient is the external identity provider DSM users can sign in with.
+// Only the fields of Protocol are used by DSM.
+type SSOClient struct {
+	Enabled      bool   `json:"sso_enable"`
+	Protocol     string `json:"sso_protocol"`
+	Name         string `json:"sso_name"`
+	DefaultLogin bool   `json:"sso_default_login"`
+
+	OIDCWellKnownURL  string `json:"oidc_well_known_url"`
+	OIDCClientID      string `json:"oidc_client_id"`
+	OIDCClientSecret  string `json:"oidc_client_secret,omitempty"`
+	OIDCScope         string `json:"oidc_scope"`
+	OIDCUsernameClaim string `json:"oidc_username_claim"`
+	OIDCRedirectURI   string `json:"oidc_redirect_uri"`
+
+	SAMLMetadata string `json:"saml_idp_metadata"`
+}
+
+// SSOServerClient is an OIDC application registered with the SSO Server
+// package.
+type SSOServerClient struct {
+	ClientID     string   `json:"client_id,omitempty"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	Name         string   `json:"app_name"`
+	RedirectURIs []string `json:"redirect_uris"`
+}
+
+type SSOClientSetRequest struct {
+	Settings SSOClient `url:"settings,json"`
+}
+
+type SSOServerClientListResponse struct {
+	Clients []SSOServerClient `json:"clients"`
+}
+
+type SSOServerClientRequest struct {
+	Client SSOServerClient `url:"client,json"`
+}
+
+type SSOServerClientDeleteRequest struct {
+	ClientIDs []string `url:"client_ids,json"`
+}
+
+// SSOClientGet returns the SSO client settings. The client secret is never
+// returned.
+func (c *Client) SSOClientGet(ctx context.Context) (*SSOClient, error) {
+	return api.Get[SSOClient](c.client, ctx, &struct{}{}, SSOClientGet)
+}
+
+// SSOClientSet replaces the SSO client settings. An empty client secret keeps
+// the current one.
+func (c *Client) SSOClientSet(ctx context.Context, settings SSOClient) error {
+	_, err := api.Post[struct{}](c.client, ctx, &SSOClientSetRequest{Settings: settings}, SSOClientSet)
+	return err
+}
+
+// SSOServerClientList returns the applications registered with SSO Server.
+func (c *Client) SSOServerClientList(ctx context.Context) (*SSOServerClientListResponse, error) {
+	return api.Get[SSOServerClientListResponse](c.client, ctx, &struct{}{}, SSOServerClientList)
+}
+
+// SSOServerClientCreate registers an application with SSO Server and returns
+// it with the generated client ID and secret.
+func (c *Client) SSOServerClientCreate(ctx context.Context, client SSOServerClient) (*SSOServerClient, error) {
+	client.ClientID = ""
+	client.ClientSecret = ""
+	return api.Post[SSOServerClient](c.client, ctx, &SSOServerClientRequest{Client: client}, SSOServerClientCreate)
+}
+
+// SSOServerClientSet updates an application registered with SSO Server.
+func (c *Client) SSOServerClientSet(ctx context.Context, client SSOServerClient) error {
+	client.ClientSecret = ""
+	_, err := api.Post[struct{}](c.client, ctx, &SSOServerClientRequest{Client: client}, SSOServerClientSet)
+	return err
+}
+
+// SSOServerClientDelete removes an application from SSO Server.
+func (c *Client) SSOServerClientDelete(ctx context.Context, clientID string) error {
+	return api.Void(c.client, ctx, &SSOServerClientDeleteRequest{ClientIDs: []string{clientID}}, SSOServerClientDelete)
+}
@@ -0,0 +1,99 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_Report = "SYNO.Core.Report"
+
+var (
+	StorageReportList = api.Method{
+		API:            Core_Report,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	StorageReportCreate = api.Method{
+		API:            Core_Report,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	StorageReportSet = api.Method{
+		API:            Core_Report,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	StorageReportDelete = api.Method{
+		API:            Core_Report,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+type StorageReportSchedule struct {
+	Enabled    bool   `json:"enable"`
+	Hour       int64  `json:"hour"`
+	Minute     int64  `json:"minute"`
+	RepeatHour int64  `json:"repeat_hour"`
+	WeekDay    string `json:"week_day"`
+}
+
+// StorageReport is a Storage Analyzer report task. The report lists the TopN
+// largest files of Shares and is written below ReportPath. Recipients are
+// mailed the report when MailEnable is set.
+type StorageReport struct {
+	ID         int64                 `json:"id,omitempty"`
+	Name       string                `json:"profile_name"`
+	Shares     []string              `json:"target"`
+	TopN       int64                 `json:"max_display"`
+	ReportPath string                `json:"report_path"`
+	MailEnable bool                  `json:"email_enable"`
+	Recipients []string              `json:"email_list"`
+	Schedule   StorageReportSchedule `json:"schedule"`
+}
+
+type StorageReportListResponse struct {
+	Reports []StorageReport `json:"profiles"`
+}
+
+type StorageReportRequest struct {
+	Report StorageReport `url:"profile,json"`
+}
+
+type StorageReportCreateResponse struct {
+	ID int64 `json:"id"`
+}
+
+type StorageReportDeleteRequest struct {
+	IDs []int64 `url:"ids,json"`
+}
+
+// StorageReportList returns the Storage Analyzer report tasks.
+func (c *Client) StorageReportList(ctx context.Context) (*StorageReportListResponse, error) {
+	return api.Get[StorageReportListResponse](c.client, ctx, &struct{}{}, StorageReportList)
+}
+
+// StorageReportCreate creates a report task and returns its ID.
+func (c *Client) StorageReportCreate(ctx context.Context, report StorageReport) (int64, error) {
+	report.ID = 0
+	res, err := api.Post[StorageReportCreateResponse](c.client, ctx, &StorageReportRequest{Report: report}, StorageReportCreate)
+	if err != nil {
+		return 0, err
+	}
+	return res.ID, nil
+}
+
+// StorageReportSet updates the report task with the ID of report.
+func (c *Client) StorageReportSet(ctx context.Context, report StorageReport) error {
+	return api.Void(c.client, ctx, &StorageReportRequest{Report: report}, StorageReportSet)
+}
+
+// StorageReportDelete deletes a report task. Generated reports are kept.
+func (c *Client) StorageReportDelete(ctx context.Context, id int64) error {
+	return api.Void(c.client, ctx, &StorageReportDeleteRequest{IDs: []int64{id}}, StorageReportDelete)
+}
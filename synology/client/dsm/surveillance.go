@@ -0,0 +1,205 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	SurveillanceStation_PrivilegeProfile = "SYNO.SurveillanceStation.PrivilegeProfile"
+	SurveillanceStation_Camera           = "SYNO.SurveillanceStation.Camera"
+	SurveillanceStation_Emap             = "SYNO.SurveillanceStation.Emap"
+)
+
+// SurveillanceEmapItemCamera is the type of the e-map items placing a
+// camera.
+const SurveillanceEmapItemCamera = "camera"
+
+var (
+	SurveillanceProfileList = api.Method{
+		API:            SurveillanceStation_PrivilegeProfile,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SurveillanceProfileCreate = api.Method{
+		API:            SurveillanceStation_PrivilegeProfile,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SurveillanceProfileSet = api.Method{
+		API:            SurveillanceStation_PrivilegeProfile,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SurveillanceProfileDelete = api.Method{
+		API:            SurveillanceStation_PrivilegeProfile,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	SurveillanceCameraGroupList = api.Method{
+		API:            SurveillanceStation_Camera,
+		Version:        1,
+		Method:         "ListGroup",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SurveillanceCameraGroupSave = api.Method{
+		API:            SurveillanceStation_Camera,
+		Version:        1,
+		Method:         "SaveGroup",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SurveillanceCameraGroupDelete = api.Method{
+		API:            SurveillanceStation_Camera,
+		Version:        1,
+		Method:         "DeleteGroup",
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	SurveillanceEmapList = api.Method{
+		API:            SurveillanceStation_Emap,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SurveillanceEmapSet = api.Method{
+		API:            SurveillanceStation_Emap,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// SurveillanceCameraPrivilege is what the users of a privilege profile may
+// do with a camera. Cameras not listed in a profile are hidden from its
+// users.
+type SurveillanceCameraPrivilege struct {
+	CameraID int64 `json:"camera_id"`
+	LiveView bool  `json:"liveview"`
+	Playback bool  `json:"playback"`
+	PTZ      bool  `json:"ptz"`
+	Audio    bool  `json:"audio"`
+}
+
+// SurveillancePrivilegeProfile is a role of Surveillance Station. Every DSM
+// user using Surveillance Station belongs to exactly one profile.
+type SurveillancePrivilegeProfile struct {
+	ID          int64                         `json:"id,omitempty"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description"`
+	Users       []string                      `json:"users"`
+	Cameras     []SurveillanceCameraPrivilege `json:"cameras"`
+}
+
+type SurveillanceProfileListResponse struct {
+	Profiles []SurveillancePrivilegeProfile `json:"profiles"`
+}
+
+type SurveillanceProfileRequest struct {
+	Profile SurveillancePrivilegeProfile `url:"profile,json"`
+}
+
+type SurveillanceProfileDeleteRequest struct {
+	IDs []int64 `url:"ids,json"`
+}
+
+// SurveillanceCameraGroup groups cameras for the live view layout and for
+// bulk actions.
+type SurveillanceCameraGroup struct {
+	ID          int64   `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	CameraIDs   []int64 `json:"camera_ids"`
+}
+
+type SurveillanceCameraGroupListResponse struct {
+	Groups []SurveillanceCameraGroup `json:"groups"`
+}
+
+type SurveillanceCameraGroupRequest struct {
+	Group SurveillanceCameraGroup `url:"group,json"`
+}
+
+type SurveillanceCameraGroupDeleteRequest struct {
+	IDs []int64 `url:"ids,json"`
+}
+
+// SurveillanceEmapItem places an item on an e-map. X and Y are in pixels of
+// the map image from its top left corner.
+type SurveillanceEmapItem struct {
+	Type   string `json:"type"`
+	ItemID int64  `json:"item_id"`
+	X      int64  `json:"x"`
+	Y      int64  `json:"y"`
+}
+
+// SurveillanceEmap is a floor plan with the cameras placed on it.
+type SurveillanceEmap struct {
+	ID    int64                  `json:"id"`
+	Name  string                 `json:"name"`
+	Items []SurveillanceEmapItem `json:"items"`
+}
+
+type SurveillanceEmapListResponse struct {
+	Emaps []SurveillanceEmap `json:"emaps"`
+}
+
+type SurveillanceEmapSetRequest struct {
+	ID    int64                  `url:"id"`
+	Items []SurveillanceEmapItem `url:"items,json"`
+}
+
+// SurveillanceProfileList returns the privilege profiles.
+func (c *Client) SurveillanceProfileList(ctx context.Context) (*SurveillanceProfileListResponse, error) {
+	return api.Get[SurveillanceProfileListResponse](c.client, ctx, &struct{}{}, SurveillanceProfileList)
+}
+
+// SurveillanceProfileCreate adds a privilege profile and returns it with its
+// ID.
+func (c *Client) SurveillanceProfileCreate(ctx context.Context, profile SurveillancePrivilegeProfile) (*SurveillancePrivilegeProfile, error) {
+	profile.ID = 0
+	return api.Post[SurveillancePrivilegeProfile](c.client, ctx, &SurveillanceProfileRequest{Profile: profile}, SurveillanceProfileCreate)
+}
+
+// SurveillanceProfileSet replaces the privilege profile with the ID of
+// profile. A user listed in profile is moved out of its former profile.
+func (c *Client) SurveillanceProfileSet(ctx context.Context, profile SurveillancePrivilegeProfile) error {
+	return api.Void(c.client, ctx, &SurveillanceProfileRequest{Profile: profile}, SurveillanceProfileSet)
+}
+
+// SurveillanceProfileDelete removes a privilege profile. Its users lose
+// access to Surveillance Station.
+func (c *Client) SurveillanceProfileDelete(ctx context.Context, id int64) error {
+	return api.Void(c.client, ctx, &SurveillanceProfileDeleteRequest{IDs: []int64{id}}, SurveillanceProfileDelete)
+}
+
+// SurveillanceCameraGroupList returns the camera groups.
+func (c *Client) SurveillanceCameraGroupList(ctx context.Context) (*SurveillanceCameraGroupListResponse, error) {
+	return api.Get[SurveillanceCameraGroupListResponse](c.client, ctx, &struct{}{}, SurveillanceCameraGroupList)
+}
+
+// SurveillanceCameraGroupSave adds the camera group, or replaces it when its
+// ID is set, and returns it with its ID.
+func (c *Client) SurveillanceCameraGroupSave(ctx context.Context, group SurveillanceCameraGroup) (*SurveillanceCameraGroup, error) {
+	return api.Post[SurveillanceCameraGroup](c.client, ctx, &SurveillanceCameraGroupRequest{Group: group}, SurveillanceCameraGroupSave)
+}
+
+// SurveillanceCameraGroupDelete removes a camera group. Its cameras are kept.
+func (c *Client) SurveillanceCameraGroupDelete(ctx context.Context, id int64) error {
+	return api.Void(c.client, ctx, &SurveillanceCameraGroupDeleteRequest{IDs: []int64{id}}, SurveillanceCameraGroupDelete)
+}
+
+// SurveillanceEmapList returns the e-maps with their items.
+func (c *Client) SurveillanceEmapList(ctx context.Context) (*SurveillanceEmapListResponse, error) {
+	return api.Get[SurveillanceEmapListResponse](c.client, ctx, &struct{}{}, SurveillanceEmapList)
+}
+
+// SurveillanceEmapSet replaces the items placed on the e-map id.
+func (c *Client) SurveillanceEmapSet(ctx context.Context, id int64, items []SurveillanceEmapItem) error {
+	return api.Void(c.client, ctx, &SurveillanceEmapSetRequest{ID: id, Items: items}, SurveillanceEmapSet)
+}
@@ -0,0 +1,58 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_MyDSMCenter = "SYNO.Core.MyDSMCenter"
+
+var (
+	SynologyAccountGet = api.Method{
+		API:            Core_MyDSMCenter,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SynologyAccountLogin = api.Method{
+		API:            Core_MyDSMCenter,
+		Version:        1,
+		Method:         "login",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SynologyAccountLogout = api.Method{
+		API:            Core_MyDSMCenter,
+		Version:        1,
+		Method:         "logout",
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// SynologyAccount is the Synology Account the NAS is bound to. QuickConnect
+// and the C2 services require a bound account.
+type SynologyAccount struct {
+	Account  string `json:"account"`
+	LoggedIn bool   `json:"is_logined"`
+}
+
+type SynologyAccountLoginRequest struct {
+	Account string `url:"account"`
+	Token   string `url:"token"`
+}
+
+// SynologyAccountGet returns the Synology Account binding.
+func (c *Client) SynologyAccountGet(ctx context.Context) (*SynologyAccount, error) {
+	return api.Get[SynologyAccount](c.client, ctx, &struct{}{}, SynologyAccountGet)
+}
+
+// SynologyAccountLogin binds the NAS to account, authenticating with an
+// access token of the account.
+func (c *Client) SynologyAccountLogin(ctx context.Context, account, token string) error {
+	return api.Void(c.client, ctx, &SynologyAccountLoginRequest{Account: account, Token: token}, SynologyAccountLogin)
+}
+
+// SynologyAccountLogout unbinds the NAS from its Synology Account.
+func (c *Client) SynologyAccountLogout(ctx context.Context) error {
+	return api.Void(c.client, ctx, &struct{}{}, SynologyAccountLogout)
+}
@@ -0,0 +1,107 @@
+package dsm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Core_System              = "SYNO.Core.System"
+	Core_System_SystemHealth = "SYNO.Core.System.SystemHealth"
+	Core_Hardware_NeedReboot = "SYNO.Core.Hardware.NeedReboot"
+	Core_Upgrade_Server      = "SYNO.Core.Upgrade.Server"
+)
+
+var (
+	SystemReboot = api.Method{
+		API:            Core_System,
+		Version:        1,
+		Method:         "reboot",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	NeedRebootGet = api.Method{
+		API:            Core_Hardware_NeedReboot,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	SystemHealthGet = api.Method{
+		API:            Core_System_SystemHealth,
+		Version:        1,
+		Method:         api.MethodGet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	UpgradeCheck = api.Method{
+		API:            Core_Upgrade_Server,
+		Version:        1,
+		Method:         "check",
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+type SystemRebootRequest struct {
+	Force bool `url:"force"`
+}
+
+type NeedRebootResponse struct {
+	NeedReboot bool `json:"need_reboot"`
+}
+
+// SystemHealth is the overall status shown by the DSM health widget. Rule.Type
+// is "normal", "warning" or "danger".
+type SystemHealth struct {
+	Hostname string `json:"hostname"`
+	Rule     struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"rule"`
+}
+
+type UpgradeCheckResponse struct {
+	Update struct {
+		Available bool   `json:"available"`
+		Version   string `json:"version"`
+	} `json:"update"`
+}
+
+// SystemReboot restarts the NAS. The call returns before the NAS goes down.
+func (c *Client) SystemReboot(ctx context.Context) error {
+	return api.Void(c.client, ctx, &SystemRebootRequest{}, SystemReboot)
+}
+
+// NeedReboot reports whether settings changed since the last boot only take
+// effect after a reboot.
+func (c *Client) NeedReboot(ctx context.Context) (bool, error) {
+	res, err := api.Get[NeedRebootResponse](c.client, ctx, &struct{}{}, NeedRebootGet)
+	if err != nil {
+		return false, err
+	}
+	return res.NeedReboot, nil
+}
+
+// SystemHealth returns the overall status of the NAS.
+func (c *Client) SystemHealth(ctx context.Context) (*SystemHealth, error) {
+	return api.Get[SystemHealth](c.client, ctx, &struct{}{}, SystemHealthGet)
+}
+
+// UpgradeCheck asks the Synology update server for a newer DSM version.
+func (c *Client) UpgradeCheck(ctx context.Context) (*UpgradeCheckResponse, error) {
+	return api.Get[UpgradeCheckResponse](c.client, ctx, &struct{}{}, UpgradeCheck)
+}
+
+// Ping reports whether the DSM web API answers. Any DSM error response, such
+// as an expired session after a reboot, counts as an answer. SYNO.API.Info is
+// not used as its response is cached by the provider.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.NeedReboot(ctx)
+
+	var apiErr api.ApiError
+	var notFound api.NotFoundError
+	var denied api.PermissionDeniedError
+	if errors.As(err, &apiErr) || errors.As(err, &notFound) || errors.As(err, &denied) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,136 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/go-synology/pkg/api/core"
+	"github.com/synology-community/go-synology/pkg/api/core/methods"
+)
+
+var TaskHistoryList = api.Method{
+	API:            methods.Core_TaskScheduler,
+	Version:        3,
+	Method:         "get_history_status_list",
+	ErrorSummaries: api.GlobalErrors,
+}
+
+// TaskDetail is a task as DSM returns it, with the settings of its type, its
+// schedule and its notifications, which core.TaskResult leaves out.
+type TaskDetail map[string]any
+
+// TaskGet returns the complete task id.
+func (c *Client) TaskGet(ctx context.Context, id int64) (TaskDetail, error) {
+	res, err := api.Get[TaskDetail](c.client, ctx, &core.TaskGetRequest{ID: id}, methods.TaskGet)
+	if err != nil {
+		return nil, err
+	}
+	return *res, nil
+}
+
+// The task types of the DSM built-in tasks. go-synology only covers the
+// script type, whose extra settings differ from these.
+const (
+	TaskTypeBeep       = "beep"
+	TaskTypeService    = "service"
+	TaskTypeRecycleBin = "recycle"
+)
+
+// BeepTaskExtra are the settings of a beep control task.
+type BeepTaskExtra struct {
+	Duration int64 `json:"beep_duration"`
+}
+
+// ServiceTaskExtra are the settings of a task starting or stopping packages
+// and system services.
+type ServiceTaskExtra struct {
+	Action   string        `json:"action"`
+	Services []TaskService `json:"services"`
+}
+
+type TaskService struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// RecycleBinTaskExtra are the settings of a task emptying the recycle bins of
+// shared folders. With CleanAllShares unset only Shares are emptied.
+type RecycleBinTaskExtra struct {
+	CleanAllShares bool     `json:"clean_all_shares"`
+	Shares         []string `json:"clean_share_list"`
+	Days           int64    `json:"clean_days,omitempty"`
+}
+
+// BuiltinTaskRequest creates or updates a built-in task. Extra is one of
+// BeepTaskExtra, ServiceTaskExtra and RecycleBinTaskExtra, matching Type.
+type BuiltinTaskRequest struct {
+	ID                 *int64            `url:"id,omitempty"`
+	Name               string            `url:"name"`
+	RealOwner          string            `url:"real_owner"`
+	Owner              string            `url:"owner"`
+	Type               string            `url:"type"`
+	Enable             bool              `url:"enable"`
+	Schedule           core.TaskSchedule `url:"schedule,json"`
+	Extra              any               `url:"extra,json"`
+	SynoConfirmPWToken string            `url:"SynoConfirmPWToken,omitempty"`
+}
+
+// BuiltinTaskCreate creates a built-in task. Built-in tasks run as root, which
+// requires confirming the password of the provider user.
+func (c *Client) BuiltinTaskCreate(ctx context.Context, req BuiltinTaskRequest) (*core.TaskResult, error) {
+	if err := c.confirmPassword(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	return api.Post[core.TaskResult](c.client, ctx, &req, methods.RootTaskCreate)
+}
+
+// BuiltinTaskUpdate updates the built-in task req.ID.
+func (c *Client) BuiltinTaskUpdate(ctx context.Context, req BuiltinTaskRequest) (*core.TaskResult, error) {
+	if err := c.confirmPassword(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	return api.Post[core.TaskResult](c.client, ctx, &req, methods.RootTaskUpdate)
+}
+
+func (c *Client) confirmPassword(ctx context.Context, req *BuiltinTaskRequest) error {
+	if req.SynoConfirmPWToken != "" {
+		return nil
+	}
+
+	res, err := api.Post[core.PasswordConfirmResponse](c.client, ctx, &core.PasswordConfirmRequest{
+		Password: c.client.Password(),
+	}, methods.PasswordConfirm)
+	if err != nil {
+		return err
+	}
+
+	req.SynoConfirmPWToken = res.SynoConfirmPWToken
+	return nil
+}
+
+type TaskHistoryListRequest struct {
+	ID    int64 `url:"id"`
+	Limit int64 `url:"limit,omitempty"`
+}
+
+// TaskHistory is a run of a task. Times are Unix seconds; StopTime is zero
+// while the task runs. OutputPath is only set when the task saves its output.
+type TaskHistory struct {
+	StartTime  int64  `json:"start_time"`
+	StopTime   int64  `json:"stop_time"`
+	ExitCode   int64  `json:"exit_code"`
+	ExitInfo   string `json:"exit_info"`
+	OutputPath string `json:"result_path"`
+}
+
+type TaskHistoryListResponse struct {
+	History []TaskHistory `json:"history"`
+	Total   int64         `json:"total"`
+}
+
+// TaskHistoryList returns the recent runs of the task id, newest first.
+func (c *Client) TaskHistoryList(ctx context.Context, id, limit int64) (*TaskHistoryListResponse, error) {
+	return api.Get[TaskHistoryListResponse](c.client, ctx, &TaskHistoryListRequest{ID: id, Limit: limit}, TaskHistoryList)
+}
@@ -0,0 +1,104 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const Core_User = "SYNO.Core.User"
+
+var (
+	UserList = api.Method{
+		API:            Core_User,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	UserCreate = api.Method{
+		API:            Core_User,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	UserSet = api.Method{
+		API:            Core_User,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	UserDelete = api.Method{
+		API:            Core_User,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// userAdditional are the additional fields requested when listing users.
+var userAdditional = []string{"uid", "email", "description", "expired", "passwd_never_expire", "password_last_change"}
+
+// User is a local DSM user. Expired is "normal" for enabled accounts.
+// PasswordLastChange is the Unix time the password was last changed, 0 if
+// it is unknown. The password is write only.
+type User struct {
+	Name                 string `json:"name"`
+	UID                  int64  `json:"uid,omitempty"`
+	Description          string `json:"description"`
+	Email                string `json:"email"`
+	Expired              string `json:"expired,omitempty"`
+	PasswordNeverExpires bool   `json:"passwd_never_expire"`
+	PasswordLastChange   int64  `json:"password_last_change,omitempty"`
+}
+
+type UserListRequest struct {
+	Offset     int64    `url:"offset"`
+	Limit      int64    `url:"limit"`
+	Additional []string `url:"additional,json"`
+}
+
+type UserListResponse struct {
+	Total int64  `json:"total"`
+	Users []User `json:"users"`
+}
+
+// UserRequest creates or updates a user. The password is only changed when
+// it is not empty; ForcePasswordChange makes the user choose a new password
+// at the next login.
+type UserRequest struct {
+	Name                 string `url:"name"`
+	Password             string `url:"password,omitempty"`
+	Description          string `url:"description"`
+	Email                string `url:"email"`
+	PasswordNeverExpires bool   `url:"passwd_never_expire"`
+	ForcePasswordChange  bool   `url:"force_change_passwd"`
+}
+
+type UserDeleteRequest struct {
+	Names []string `url:"name,json"`
+}
+
+// UserList returns the local users.
+func (c *Client) UserList(ctx context.Context) (*UserListResponse, error) {
+	return api.Get[UserListResponse](c.client, ctx, &UserListRequest{
+		Offset:     0,
+		Limit:      -1,
+		Additional: userAdditional,
+	}, UserList)
+}
+
+// UserCreate creates a local user.
+func (c *Client) UserCreate(ctx context.Context, req UserRequest) error {
+	return api.Void(c.client, ctx, &req, UserCreate)
+}
+
+// UserSet updates the local user with the name of req.
+func (c *Client) UserSet(ctx context.Context, req UserRequest) error {
+	return api.Void(c.client, ctx, &req, UserSet)
+}
+
+// UserDelete removes a local user. The home folder of the user is removed
+// along with it.
+func (c *Client) UserDelete(ctx context.Context, name string) error {
+	return api.Void(c.client, ctx, &UserDeleteRequest{Names: []string{name}}, UserDelete)
+}
@@ -0,0 +1,98 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	Virtualization_API_Host         = "SYNO.Virtualization.API.Host"
+	Virtualization_API_Guest_Action = "SYNO.Virtualization.API.Guest.Action"
+	Virtualization_Guest_Action     = "SYNO.Virtualization.Guest.Action"
+)
+
+var (
+	VirtualizationHostList = api.Method{
+		API:            Virtualization_API_Host,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	GuestPowerOnHost = api.Method{
+		API:            Virtualization_API_Guest_Action,
+		Version:        1,
+		Method:         "poweron",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	GuestShutdown = api.Method{
+		API:            Virtualization_API_Guest_Action,
+		Version:        1,
+		Method:         "shutdown",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	GuestMigrate = api.Method{
+		API:            Virtualization_Guest_Action,
+		Version:        1,
+		Method:         "migrate",
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// VirtualizationHost is a host of the Virtual Machine Manager cluster. Memory
+// sizes are in MB.
+type VirtualizationHost struct {
+	ID           string `json:"host_id"`
+	Name         string `json:"host_name"`
+	Status       string `json:"status"`
+	TotalCPUCore int64  `json:"total_cpu_core"`
+	FreeCPUCore  int64  `json:"free_cpu_core"`
+	TotalRAMSize int64  `json:"total_ram_size"`
+	FreeRAMSize  int64  `json:"free_ram_size"`
+}
+
+type VirtualizationHostListResponse struct {
+	Hosts []VirtualizationHost `json:"hosts"`
+}
+
+type GuestPowerOnHostRequest struct {
+	Name     string `url:"guest_name"`
+	HostName string `url:"host_name"`
+}
+
+type GuestShutdownRequest struct {
+	Name string `url:"guest_name"`
+}
+
+type GuestMigrateRequest struct {
+	Name     string `url:"guest_name"`
+	HostName string `url:"dest_host_name"`
+	Live     bool   `url:"is_live"`
+}
+
+// VirtualizationHostList returns the hosts of the cluster.
+func (c *Client) VirtualizationHostList(ctx context.Context) ([]VirtualizationHost, error) {
+	res, err := api.Get[VirtualizationHostListResponse](c.client, ctx, &struct{}{}, VirtualizationHostList)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Hosts, nil
+}
+
+// GuestPowerOnHost powers on the guest name on the cluster host hostName.
+func (c *Client) GuestPowerOnHost(ctx context.Context, name, hostName string) error {
+	return api.Void(c.client, ctx, &GuestPowerOnHostRequest{Name: name, HostName: hostName}, GuestPowerOnHost)
+}
+
+// GuestShutdown asks the guest name to shut down through ACPI. The call
+// returns before the guest is stopped.
+func (c *Client) GuestShutdown(ctx context.Context, name string) error {
+	return api.Void(c.client, ctx, &GuestShutdownRequest{Name: name}, GuestShutdown)
+}
+
+// GuestMigrate moves the running guest name to the cluster host hostName
+// without shutting it down.
+func (c *Client) GuestMigrate(ctx context.Context, name, hostName string) error {
+	return api.Void(c.client, ctx, &GuestMigrateRequest{Name: name, HostName: hostName, Live: true}, GuestMigrate)
+}
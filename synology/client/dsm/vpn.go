@@ -0,0 +1,196 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/go-synology/pkg/util/form"
+)
+
+const (
+	Core_Network_VPN                 = "SYNO.Core.Network.VPN"
+	Core_Network_VPN_L2TP            = "SYNO.Core.Network.VPN.L2TP"
+	Core_Network_VPN_OpenVPNWithConf = "SYNO.Core.Network.VPN.OpenVPNWithConf"
+)
+
+// Protocols of VPN client profiles.
+const (
+	VPNProtocolL2TP    = "l2tp"
+	VPNProtocolOpenVPN = "openvpn"
+)
+
+// Connection states of VPN client profiles.
+const (
+	VPNStatusConnected    = "connected"
+	VPNStatusConnecting   = "connecting"
+	VPNStatusDisconnected = "disconnected"
+)
+
+var (
+	VPNProfileList = api.Method{
+		API:            Core_Network_VPN,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	VPNProfileConnect = api.Method{
+		API:            Core_Network_VPN,
+		Version:        1,
+		Method:         "connect",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	VPNProfileDisconnect = api.Method{
+		API:            Core_Network_VPN,
+		Version:        1,
+		Method:         "disconnect",
+		ErrorSummaries: api.GlobalErrors,
+	}
+	L2TPProfileCreate = api.Method{
+		API:            Core_Network_VPN_L2TP,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	L2TPProfileSet = api.Method{
+		API:            Core_Network_VPN_L2TP,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	L2TPProfileDelete = api.Method{
+		API:            Core_Network_VPN_L2TP,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	OpenVPNProfileCreate = api.Method{
+		API:            Core_Network_VPN_OpenVPNWithConf,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	OpenVPNProfileSet = api.Method{
+		API:            Core_Network_VPN_OpenVPNWithConf,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	OpenVPNProfileDelete = api.Method{
+		API:            Core_Network_VPN_OpenVPNWithConf,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+// VPNProfile is a VPN client profile as listed by DSM. Credentials and
+// OpenVPN configurations are not returned.
+type VPNProfile struct {
+	ID        string `json:"id"`
+	Name      string `json:"conf_name"`
+	Protocol  string `json:"prot"`
+	Server    string `json:"server"`
+	User      string `json:"user"`
+	Reconnect bool   `json:"reconnect"`
+	Status    string `json:"status"`
+}
+
+type VPNProfileListResponse struct {
+	Profiles []VPNProfile `json:"profiles"`
+}
+
+// L2TPProfile is an L2TP/IPsec client profile authenticated with a
+// pre-shared key.
+type L2TPProfile struct {
+	ID           string `url:"id,omitempty"`
+	Name         string `url:"conf_name"`
+	Server       string `url:"server"`
+	User         string `url:"user"`
+	Password     string `url:"pass"`
+	PresharedKey string `url:"preshared_key"`
+	Reconnect    bool   `url:"reconnect"`
+}
+
+// OpenVPNProfile is an OpenVPN client profile created from an uploaded .ovpn
+// configuration. The fields are sent in the multipart form so credentials
+// stay out of the query string.
+type OpenVPNProfile struct {
+	ID        string    `url:"-" form:"id"`
+	Name      string    `url:"-" form:"conf_name"`
+	User      string    `url:"-" form:"user"`
+	Password  string    `url:"-" form:"pass"`
+	Reconnect bool      `url:"-" form:"reconnect"`
+	Config    form.File `url:"-" form:"uploadConf" kind:"file"`
+}
+
+type VPNProfileCreateResponse struct {
+	ID string `json:"id"`
+}
+
+type VPNProfileRequest struct {
+	ID       string `url:"id"`
+	Protocol string `url:"prot"`
+}
+
+type VPNProfileDeleteRequest struct {
+	IDs []string `url:"id,json"`
+}
+
+// VPNProfileList returns the VPN client profiles of the NAS.
+func (c *Client) VPNProfileList(ctx context.Context) (*VPNProfileListResponse, error) {
+	return api.Get[VPNProfileListResponse](c.client, ctx, &struct{}{}, VPNProfileList)
+}
+
+// VPNProfileConnect connects the NAS with a VPN client profile. DSM keeps a
+// single VPN connection, connecting drops any other one.
+func (c *Client) VPNProfileConnect(ctx context.Context, id, protocol string) error {
+	return api.Void(c.client, ctx, &VPNProfileRequest{ID: id, Protocol: protocol}, VPNProfileConnect)
+}
+
+// VPNProfileDisconnect disconnects a VPN client profile.
+func (c *Client) VPNProfileDisconnect(ctx context.Context, id, protocol string) error {
+	return api.Void(c.client, ctx, &VPNProfileRequest{ID: id, Protocol: protocol}, VPNProfileDisconnect)
+}
+
+// L2TPProfileCreate creates an L2TP client profile and returns its ID.
+func (c *Client) L2TPProfileCreate(ctx context.Context, profile L2TPProfile) (string, error) {
+	profile.ID = ""
+	res, err := api.Post[VPNProfileCreateResponse](c.client, ctx, &profile, L2TPProfileCreate)
+	if err != nil {
+		return "", err
+	}
+	return res.ID, nil
+}
+
+// L2TPProfileSet updates the L2TP client profile with the ID of profile.
+func (c *Client) L2TPProfileSet(ctx context.Context, profile L2TPProfile) error {
+	return api.Void(c.client, ctx, &profile, L2TPProfileSet)
+}
+
+// L2TPProfileDelete deletes an L2TP client profile.
+func (c *Client) L2TPProfileDelete(ctx context.Context, id string) error {
+	return api.Void(c.client, ctx, &VPNProfileDeleteRequest{IDs: []string{id}}, L2TPProfileDelete)
+}
+
+// OpenVPNProfileCreate uploads an OpenVPN configuration as a new client
+// profile and returns its ID.
+func (c *Client) OpenVPNProfileCreate(ctx context.Context, profile OpenVPNProfile) (string, error) {
+	profile.ID = ""
+	res, err := api.PostFileWithQuery[VPNProfileCreateResponse](c.client, ctx, &profile, OpenVPNProfileCreate)
+	if err != nil {
+		return "", err
+	}
+	return res.ID, nil
+}
+
+// OpenVPNProfileSet updates the OpenVPN client profile with the ID of profile,
+// uploading its configuration again.
+func (c *Client) OpenVPNProfileSet(ctx context.Context, profile OpenVPNProfile) error {
+	_, err := api.PostFileWithQuery[api.Response](c.client, ctx, &profile, OpenVPNProfileSet)
+	return err
+}
+
+// OpenVPNProfileDelete deletes an OpenVPN client profile.
+func (c *Client) OpenVPNProfileDelete(ctx context.Context, id string) error {
+	return api.Void(c.client, ctx, &VPNProfileDeleteRequest{IDs: []string{id}}, OpenVPNProfileDelete)
+}
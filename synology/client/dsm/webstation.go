@@ -0,0 +1,173 @@
+package dsm
+
+import (
+	"context"
+
+	"github.com/synology-community/go-synology/pkg/api"
+)
+
+const (
+	WebStation_HTTP_VHost  = "SYNO.WebStation.HTTP.VHost"
+	WebStation_PHP_Profile = "SYNO.WebStation.PHP.Profile"
+)
+
+// WebStationSubscriber is the certificate subscriber of Web Station virtual
+// hosts.
+const WebStationSubscriber = "WebStation"
+
+// Web Station virtual host backends.
+const (
+	WebStationBackendNginx    = 0
+	WebStationBackendApache24 = 2
+)
+
+var (
+	WebStationVHostList = api.Method{
+		API:            WebStation_HTTP_VHost,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	WebStationVHostCreate = api.Method{
+		API:            WebStation_HTTP_VHost,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	WebStationVHostSet = api.Method{
+		API:            WebStation_HTTP_VHost,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	WebStationVHostDelete = api.Method{
+		API:            WebStation_HTTP_VHost,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+
+	WebStationPHPProfileList = api.Method{
+		API:            WebStation_PHP_Profile,
+		Version:        1,
+		Method:         api.MethodList,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	WebStationPHPProfileCreate = api.Method{
+		API:            WebStation_PHP_Profile,
+		Version:        1,
+		Method:         api.MethodCreate,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	WebStationPHPProfileSet = api.Method{
+		API:            WebStation_PHP_Profile,
+		Version:        1,
+		Method:         api.MethodSet,
+		ErrorSummaries: api.GlobalErrors,
+	}
+	WebStationPHPProfileDelete = api.Method{
+		API:            WebStation_PHP_Profile,
+		Version:        1,
+		Method:         api.MethodDelete,
+		ErrorSummaries: api.GlobalErrors,
+	}
+)
+
+type WebStationVHostPorts struct {
+	HTTP  []int64 `json:"http"`
+	HTTPS []int64 `json:"https"`
+}
+
+type WebStationVHostHTTPS struct {
+	HSTS bool `json:"hsts"`
+}
+
+// WebStationVHost is a Web Station virtual host. PHP and Python are the UUIDs
+// of the script language profiles serving it, if any.
+type WebStationVHost struct {
+	UUID    string               `json:"UUID,omitempty"`
+	FQDN    string               `json:"fqdn"`
+	Root    string               `json:"root"`
+	Backend int                  `json:"backend"`
+	PHP     string               `json:"php,omitempty"`
+	Python  string               `json:"python,omitempty"`
+	Port    WebStationVHostPorts `json:"port"`
+	HTTPS   WebStationVHostHTTPS `json:"https"`
+}
+
+type WebStationVHostListResponse struct {
+	VHosts []WebStationVHost `json:"vhosts"`
+}
+
+type WebStationVHostRequest struct {
+	VHost WebStationVHost `url:"vhost,json"`
+}
+
+type WebStationVHostDeleteRequest struct {
+	UUIDs []string `url:"uuids,json"`
+}
+
+// WebStationPHPProfile is a PHP profile of Web Station. Backend is the PHP
+// package serving it, e.g. "php82".
+type WebStationPHPProfile struct {
+	UUID        string            `json:"uuid,omitempty"`
+	Name        string            `json:"profile_name"`
+	Description string            `json:"profile_desc"`
+	Backend     string            `json:"backend"`
+	Extensions  []string          `json:"extensions"`
+	Settings    map[string]string `json:"php_settings"`
+}
+
+type WebStationPHPProfileListResponse struct {
+	Profiles []WebStationPHPProfile `json:"profiles"`
+}
+
+type WebStationPHPProfileRequest struct {
+	Profile WebStationPHPProfile `url:"profile,json"`
+}
+
+type WebStationPHPProfileDeleteRequest struct {
+	UUIDs []string `url:"uuids,json"`
+}
+
+// WebStationVHostList returns the Web Station virtual hosts.
+func (c *Client) WebStationVHostList(ctx context.Context) (*WebStationVHostListResponse, error) {
+	return api.Get[WebStationVHostListResponse](c.client, ctx, &struct{}{}, WebStationVHostList)
+}
+
+// WebStationVHostCreate creates a virtual host. DSM assigns the UUID.
+func (c *Client) WebStationVHostCreate(ctx context.Context, vhost WebStationVHost) error {
+	vhost.UUID = ""
+	return api.Void(c.client, ctx, &WebStationVHostRequest{VHost: vhost}, WebStationVHostCreate)
+}
+
+// WebStationVHostSet updates the virtual host with the UUID of vhost.
+func (c *Client) WebStationVHostSet(ctx context.Context, vhost WebStationVHost) error {
+	return api.Void(c.client, ctx, &WebStationVHostRequest{VHost: vhost}, WebStationVHostSet)
+}
+
+// WebStationVHostDelete deletes a virtual host. The document root is kept.
+func (c *Client) WebStationVHostDelete(ctx context.Context, uuid string) error {
+	return api.Void(c.client, ctx, &WebStationVHostDeleteRequest{UUIDs: []string{uuid}}, WebStationVHostDelete)
+}
+
+// WebStationPHPProfileList returns the PHP profiles of Web Station.
+func (c *Client) WebStationPHPProfileList(ctx context.Context) (*WebStationPHPProfileListResponse, error) {
+	return api.Get[WebStationPHPProfileListResponse](c.client, ctx, &struct{}{}, WebStationPHPProfileList)
+}
+
+// WebStationPHPProfileCreate creates a PHP profile. DSM assigns the UUID.
+func (c *Client) WebStationPHPProfileCreate(ctx context.Context, profile WebStationPHPProfile) error {
+	profile.UUID = ""
+	return api.Void(c.client, ctx, &WebStationPHPProfileRequest{Profile: profile}, WebStationPHPProfileCreate)
+}
+
+// WebStationPHPProfileSet updates the PHP profile with the UUID of profile.
+func (c *Client) WebStationPHPProfileSet(ctx context.Context, profile WebStationPHPProfile) error {
+	return api.Void(c.client, ctx, &WebStationPHPProfileRequest{Profile: profile}, WebStationPHPProfileSet)
+}
+
+// WebStationPHPProfileDelete deletes a PHP profile.
+func (c *Client) WebStationPHPProfileDelete(ctx context.Context, uuid string) error {
+	return api.Void(c.client, ctx, &WebStationPHPProfileDeleteRequest{UUIDs: []string{uuid}}, WebStationPHPProfileDelete)
+}
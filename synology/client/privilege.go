@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// errNoPermission is the DSM error code of requests the signed in account
+// is not allowed to make.
+const errNoPermission = 105
+
+// Privilege is a right of the provider account which resources need to
+// manage their objects.
+type Privilege int
+
+const (
+	// PrivilegeAdmin is membership of the DSM administrators group, needed by
+	// the Control Panel and most package settings.
+	PrivilegeAdmin Privilege = iota + 1
+	// PrivilegeSurveillanceManager is the Surveillance Station manager
+	// privilege, held by DSM administrators and by users with a manager
+	// privilege profile.
+	PrivilegeSurveillanceManager
+)
+
+func (p Privilege) String() string {
+	switch p {
+	case PrivilegeAdmin:
+		return "DSM administrator"
+	case PrivilegeSurveillanceManager:
+		return "Surveillance Station manager"
+	}
+	return fmt.Sprintf("Privilege(%d)", int(p))
+}
+
+// privileges caches the privileges of the provider account for the lifetime
+// of the provider process. session is nil when it cannot be looked up.
+type privileges struct {
+	mu      sync.Mutex
+	checked bool
+	session *dsm.Session
+	held    map[Privilege]bool
+}
+
+// Require returns an error naming the missing privilege when the provider
+// account lacks p, so that resources fail at plan time with an actionable
+// message instead of a permission error from the API at apply. Privileges
+// which cannot be looked up, e.g. on DSM versions without the session API,
+// are not checked.
+func (c *Client) Require(ctx context.Context, p Privilege) error {
+	c.privileges.mu.Lock()
+	defer c.privileges.mu.Unlock()
+
+	client := dsm.New(c.Api)
+	if !c.privileges.checked {
+		c.privileges.checked = true
+		if session, err := client.Session(ctx); err == nil {
+			c.privileges.session = session
+			c.privileges.held = map[Privilege]bool{PrivilegeAdmin: session.IsAdmin}
+		}
+	}
+	session := c.privileges.session
+	if session == nil || session.IsAdmin {
+		return nil
+	}
+
+	held, ok := c.privileges.held[p]
+	if !ok && p == PrivilegeSurveillanceManager {
+		// Only managers may list the privilege profiles. Any other error
+		// than a denied request leaves the privilege unchecked.
+		_, err := client.SurveillanceProfileList(ctx)
+		var apiErr api.ApiError
+		held = !errors.As(err, &apiErr) || apiErr.Code != errNoPermission
+		c.privileges.held[p] = held
+	}
+	if held {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"managing this resource needs a %s, but the provider account %q is not one: sign in as a %s or grant %q the privilege",
+		p, session.User, p, session.User,
+	)
+}
+
+// RequireOf checks p with Require on the provider data passed to Configure.
+// Nothing is checked when the provider is not configured.
+func RequireOf(ctx context.Context, providerData any, p Privilege) error {
+	if c, ok := providerData.(*Client); ok {
+		return c.Require(ctx, p)
+	}
+	return nil
+}
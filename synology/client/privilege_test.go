@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+)
+
+func TestRequire(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name  string
+		opts  []mock.Option
+		admin bool
+	}{
+		{"administrator", nil, true},
+		{"user", []mock.Option{mock.WithoutAdmin()}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := mock.NewServer(tt.opts...)
+			defer s.Close()
+
+			c, err := synology.New(api.Options{Host: s.Host()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+				t.Fatal(err)
+			}
+
+			pc := &Client{Api: c}
+			err = RequireOf(ctx, pc, PrivilegeAdmin)
+			if tt.admin && err != nil {
+				t.Errorf("Require() error = %v", err)
+			}
+			if !tt.admin && (err == nil || !strings.Contains(err.Error(), "DSM administrator")) {
+				t.Errorf("Require() error = %v, want a missing DSM administrator", err)
+			}
+
+			// Without the Surveillance Station API the privilege is not checked.
+			if err := pc.Require(ctx, PrivilegeSurveillanceManager); err != nil {
+				t.Errorf("Require() error = %v", err)
+			}
+		})
+	}
+
+	if err := RequireOf(ctx, nil, PrivilegeAdmin); err != nil {
+		t.Errorf("RequireOf() without provider data = %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// fileQueueRetries is how often a File Station change rejected as busy
+	// is sent again.
+	fileQueueRetries = 5
+	// fileQueueBackoff is the wait before the first retry, doubled for every
+	// further retry.
+	fileQueueBackoff = time.Second
+)
+
+// fileBusyCodes are the File Station error codes documented for operations
+// rejected because the NAS is still busy with earlier ones. Other errors,
+// such as the generic 100, are returned at once.
+var fileBusyCodes = map[int]bool{
+	402: true, // System is too busy
+	421: true, // Device or resource busy
+}
+
+// FileQueue is an http.RoundTripper which sends at most a number of File
+// Station changes at a time and retries changes rejected as busy with an
+// exponential backoff. Low-end models reject bursts of File Station
+// operations instead of queueing them. Reads and other APIs are sent
+// unchanged.
+type FileQueue struct {
+	transport http.RoundTripper
+	slots     chan struct{}
+	backoff   time.Duration
+}
+
+// NewFileQueue returns a FileQueue sending up to concurrency File Station
+// changes at a time through transport, which defaults to
+// http.DefaultTransport.
+func NewFileQueue(transport http.RoundTripper, concurrency int) *FileQueue {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &FileQueue{
+		transport: transport,
+		slots:     make(chan struct{}, max(concurrency, 1)),
+		backoff:   fileQueueBackoff,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (q *FileQueue) RoundTrip(req *http.Request) (*http.Response, error) {
+	in, err := newInteraction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiName, method := requestMethod(req, in)
+	if !strings.HasPrefix(apiName, "SYNO.FileStation.") || checkRead(apiName, method) == nil {
+		return q.transport.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	select {
+	case q.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-q.slots }()
+
+	wait := q.backoff
+	for attempt := 0; ; attempt++ {
+		r := req.Clone(withAttempt(req.Context(), attempt))
+		if body != nil {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		res, err := q.transport.RoundTrip(r)
+		if err != nil || attempt == fileQueueRetries || !busyWith(res, fileBusyCodes) {
+			return res, err
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		wait *= 2
+	}
+}
+
+// busyWith reports whether DSM rejected a request as busy, either with an
+// HTTP status or with one of codes. The body of res is restored for the
+// caller.
+func busyWith(res *http.Response, codes map[int]bool) bool {
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable {
+		return true
+	}
+	if err != nil {
+		return false
+	}
+
+	var r struct {
+		Success bool `json:"success"`
+		Error   struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	return json.Unmarshal(body, &r) == nil && !r.Success && codes[r.Error.Code]
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+)
+
+func TestFileQueueRetry(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		code      int
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "too busy", code: 402, wantCalls: 3},
+		{name: "resource busy", code: 421, wantCalls: 3},
+		{name: "unknown error", code: 100, wantCalls: 1, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			s := mock.NewServer(mock.WithShare("docker"))
+			defer s.Close()
+
+			calls := 0
+			s.Handle("SYNO.FileStation.CreateFolder", 2, "create", func(r *mock.Request) (any, error) {
+				calls++
+				if calls < 3 {
+					return nil, mock.Errorf(tt.code)
+				}
+				return map[string]any{"folders": []any{}}, nil
+			})
+
+			c, err := synology.New(api.Options{Host: s.Host()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			q := NewFileQueue(c.Client().HTTPClient.Transport, 1)
+			q.backoff = time.Millisecond
+			c.Client().HTTPClient.Transport = q
+
+			if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+				t.Fatalf("Login() error = %v", err)
+			}
+
+			_, err = c.FileStationAPI().CreateFolder(ctx, []string{"/docker"}, []string{"foo"}, true)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateFolder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("CreateFolder() sent %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// slowTransport answers every request after a delay and records the
+// highest number of requests in flight.
+type slowTransport struct {
+	inFlight, peak atomic.Int32
+}
+
+func (t *slowTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	n := t.inFlight.Add(1)
+	defer t.inFlight.Add(-1)
+	for {
+		p := t.peak.Load()
+		if n <= p || t.peak.CompareAndSwap(p, n) {
+			break
+		}
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"success":true}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestFileQueueConcurrency(t *testing.T) {
+	for _, tt := range []struct {
+		api, method string
+		want        int32
+	}{
+		{"SYNO.FileStation.Delete", "start", 2},
+		{"SYNO.FileStation.List", "list", 6},
+		{"SYNO.Core.Share", "set", 6},
+	} {
+		t.Run(tt.api, func(t *testing.T) {
+			transport := &slowTransport{}
+			q := NewFileQueue(transport, 2)
+
+			var wg sync.WaitGroup
+			for range 6 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					req, _ := http.NewRequest(http.MethodGet, "http://nas/webapi/entry.cgi?api="+tt.api+"&method="+tt.method, nil)
+					res, err := q.RoundTrip(req)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					_ = res.Body.Close()
+				}()
+			}
+			wg.Wait()
+
+			if got := transport.peak.Load(); got != tt.want {
+				t.Errorf("peak in flight = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// ErrReadOnly is returned for requests which would change the NAS while the
+// provider is read-only.
+var ErrReadOnly = errors.New("the provider is read-only")
+
+// readMethodPrefixes are the prefixes of the DSM methods which only read,
+// compared case insensitively.
+var readMethodPrefixes = []string{"get", "list", "load", "query", "is_"}
+
+// readMethods are the other DSM methods which only read.
+var readMethods = map[string]bool{
+	"info":        true,
+	"status":      true,
+	"check":       true,
+	"pull_status": true,
+	"login":       true,
+	"logout":      true,
+}
+
+// readAPIs are the APIs of which every method only reads, such as the
+// background File Station tasks computing checksums.
+var readAPIs = map[string]bool{
+	"SYNO.API.Info":             true,
+	"SYNO.API.Auth":             true,
+	"SYNO.FileStation.Download": true,
+	"SYNO.FileStation.MD5":      true,
+	"SYNO.FileStation.Search":   true,
+	"SYNO.FileStation.DirSize":  true,
+}
+
+// ReadOnly is an http.RoundTripper which refuses every request that could
+// change the NAS with ErrReadOnly, so that data sources and refresh work but
+// any create, update or delete fails before it reaches the NAS. Compound
+// requests are only sent when all of their requests read.
+type ReadOnly struct {
+	transport http.RoundTripper
+}
+
+// NewReadOnly returns a ReadOnly sending requests through transport, which
+// defaults to http.DefaultTransport.
+func NewReadOnly(transport http.RoundTripper) *ReadOnly {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &ReadOnly{transport: transport}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *ReadOnly) RoundTrip(req *http.Request) (*http.Response, error) {
+	in, err := newInteraction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiName, method := requestMethod(req, in)
+	if apiName == "SYNO.Entry.Request" {
+		err = checkCompound(in)
+	} else {
+		err = checkRead(apiName, method)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.transport.RoundTrip(req)
+}
+
+// RetryPolicy wraps the retry policy of the go-synology client so that
+// refused requests fail at once instead of being retried.
+func RetryPolicy(next retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if errors.Is(err, ErrReadOnly) {
+			return false, err
+		}
+		return next(ctx, resp, err)
+	}
+}
+
+func checkRead(apiName, method string) error {
+	if readAPIs[apiName] || readMethods[strings.ToLower(method)] {
+		return nil
+	}
+	for _, p := range readMethodPrefixes {
+		if strings.HasPrefix(strings.ToLower(method), p) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: refusing %s %s", ErrReadOnly, apiName, method)
+}
+
+// checkCompound checks every request of a compound request. The requests
+// are the JSON array in the compound parameter.
+func checkCompound(in Interaction) error {
+	values := parseValues(in)
+
+	var requests []struct {
+		API    string `json:"api"`
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal([]byte(values.Get("compound")), &requests); err != nil {
+		return fmt.Errorf("%w: refusing a compound request which cannot be read: %s", ErrReadOnly, err)
+	}
+
+	for _, r := range requests {
+		if err := checkRead(r.API, r.Method); err != nil {
+			return err
+		}
+	}
+	return nil
+}
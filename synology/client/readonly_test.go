@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+)
+
+func TestReadOnly(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer(mock.WithShare("docker"))
+	defer s.Close()
+
+	c, err := synology.New(api.Options{Host: s.Host()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Client().HTTPClient.Transport = NewReadOnly(c.Client().HTTPClient.Transport)
+	c.Client().CheckRetry = RetryPolicy(c.Client().CheckRetry)
+
+	if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	if _, err := c.FileStationAPI().List(ctx, "/docker"); err != nil {
+		t.Errorf("List() error = %v", err)
+	}
+
+	_, err = c.FileStationAPI().CreateFolder(ctx, []string{"/docker"}, []string{"foo"}, true)
+	if !errors.Is(err, ErrReadOnly) {
+		t.Errorf("CreateFolder() error = %v, want %v", err, ErrReadOnly)
+	}
+	if s.Exists("/docker/foo") {
+		t.Error("CreateFolder() reached the server")
+	}
+}
+
+func TestCheckRead(t *testing.T) {
+	for _, tt := range []struct {
+		api, method string
+		read        bool
+	}{
+		{"SYNO.Core.Share", "list", true},
+		{"SYNO.Core.TaskScheduler", "get_history_status_list", true},
+		{"SYNO.SurveillanceStation.Camera", "ListGroup", true},
+		{"SYNO.FileStation.MD5", "start", true},
+		{"SYNO.FileStation.Delete", "start", false},
+		{"SYNO.Core.Share", "set", false},
+		{"SYNO.Backup.Task", "check_integrity", false},
+	} {
+		if err := checkRead(tt.api, tt.method); (err == nil) != tt.read {
+			t.Errorf("checkRead(%q, %q) = %v, want read %v", tt.api, tt.method, err, tt.read)
+		}
+	}
+}
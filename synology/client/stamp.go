@@ -0,0 +1,88 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	synology "github.com/synology-community/go-synology"
+)
+
+// Client is the Synology client handed to resources and data sources. It
+// embeds the API client, so resources which only need the API keep asserting
+// synology.Api, and carries the provider settings affecting how objects are
+// written.
+type Client struct {
+	synology.Api
+
+	Stamp Stamp
+
+	// User is the account the provider is logged in as.
+	User string
+
+	// StopDependents is whether container projects using a folder are
+	// stopped before the folder is removed, see ReleaseDependents.
+	StopDependents bool
+
+	privileges privileges
+}
+
+// UserOf returns the account the provider data passed to Configure is logged
+// in as, empty when it is not known.
+func UserOf(providerData any) string {
+	if c, ok := providerData.(*Client); ok {
+		return c.User
+	}
+	return ""
+}
+
+// Stamp marks objects created by the provider by appending Suffix to their
+// description. With Required set, objects whose description lacks the suffix
+// are not modified.
+type Stamp struct {
+	Suffix   string
+	Required bool
+}
+
+// StampOf returns the stamp of the provider data passed to Configure, the zero
+// Stamp when the provider is not configured with one.
+func StampOf(providerData any) Stamp {
+	if c, ok := providerData.(*Client); ok {
+		return c.Stamp
+	}
+	return Stamp{}
+}
+
+// Apply appends the suffix to description, separated by a space.
+func (s Stamp) Apply(description string) string {
+	if s.Suffix == "" || s.stamped(description) {
+		return description
+	}
+	if description == "" {
+		return s.Suffix
+	}
+	return description + " " + s.Suffix
+}
+
+// Strip removes the suffix appended by Apply.
+func (s Stamp) Strip(description string) string {
+	if s.Suffix == "" || !s.stamped(description) {
+		return description
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(description, s.Suffix), " ")
+}
+
+// Check returns an error when the suffix is required and description, as
+// stored on the NAS, lacks it.
+func (s Stamp) Check(kind, description string) error {
+	if !s.Required || s.Suffix == "" || s.stamped(description) {
+		return nil
+	}
+	return fmt.Errorf(
+		"the %s %q was not created by Terraform: its description does not end with %q",
+		kind, description, s.Suffix,
+	)
+}
+
+func (s Stamp) stamped(description string) bool {
+	return strings.HasSuffix(description, s.Suffix)
+}
@@ -0,0 +1,39 @@
+package client
+
+import "testing"
+
+func TestStamp(t *testing.T) {
+	s := Stamp{Suffix: "[terraform]", Required: true}
+
+	for _, tt := range []struct {
+		description string
+		stamped     string
+	}{
+		{"", "[terraform]"},
+		{"web", "web [terraform]"},
+		{"web [terraform]", "web [terraform]"},
+	} {
+		if got := s.Apply(tt.description); got != tt.stamped {
+			t.Errorf("Apply(%q) = %q, want %q", tt.description, got, tt.stamped)
+		}
+		if err := s.Check("rule", s.Apply(tt.description)); err != nil {
+			t.Errorf("Check(%q) = %v", s.Apply(tt.description), err)
+		}
+	}
+
+	if got := s.Strip("web [terraform]"); got != "web" {
+		t.Errorf("Strip() = %q, want %q", got, "web")
+	}
+	if got := s.Strip("web"); got != "web" {
+		t.Errorf("Strip() = %q, want %q", got, "web")
+	}
+	if err := s.Check("rule", "web"); err == nil {
+		t.Error("Check() of an unstamped description succeeded")
+	}
+	if err := (Stamp{Suffix: "[terraform]"}).Check("rule", "web"); err != nil {
+		t.Errorf("Check() without Required = %v", err)
+	}
+	if got := (Stamp{}).Apply("web"); got != "web" {
+		t.Errorf("Apply() without suffix = %q", got)
+	}
+}
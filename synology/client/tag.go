@@ -0,0 +1,40 @@
+package client
+
+import "net/http"
+
+// Tag is an http.RoundTripper which marks every request with a User-Agent
+// and a launchApp parameter, so that the access logs of the NAS attribute the
+// changes to a pipeline. Empty values leave requests unchanged.
+type Tag struct {
+	transport http.RoundTripper
+	userAgent string
+	launchApp string
+}
+
+// NewTag returns a Tag sending requests through transport, which defaults to
+// http.DefaultTransport.
+func NewTag(transport http.RoundTripper, userAgent, launchApp string) *Tag {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Tag{transport: transport, userAgent: userAgent, launchApp: launchApp}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Tag) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.userAgent == "" && t.launchApp == "" {
+		return t.transport.RoundTrip(req)
+	}
+
+	r := req.Clone(req.Context())
+	if t.userAgent != "" {
+		r.Header.Set("User-Agent", t.userAgent)
+	}
+	if t.launchApp != "" {
+		q := r.URL.Query()
+		q.Set("launchApp", t.launchApp)
+		r.URL.RawQuery = q.Encode()
+	}
+	return t.transport.RoundTrip(r)
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+)
+
+func TestTag(t *testing.T) {
+	for _, tt := range []struct {
+		name                 string
+		userAgent, launchApp string
+		wantUserAgent        string
+	}{
+		{name: "tagged", userAgent: "ci/pipeline-42", launchApp: "terraform-ci", wantUserAgent: "ci/pipeline-42"},
+		{name: "launch app only", launchApp: "terraform-ci", wantUserAgent: "Go-http-client/1.1"},
+		{name: "untagged", wantUserAgent: "Go-http-client/1.1"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			s := mock.NewServer()
+			defer s.Close()
+
+			var userAgent, launchApp string
+			s.Handle("SYNO.Core.User", 1, "set", func(r *mock.Request) (any, error) {
+				userAgent = r.HTTP.UserAgent()
+				launchApp = r.Get("launchApp")
+				return map[string]any{}, nil
+			})
+
+			c, err := synology.New(api.Options{Host: s.Host()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.Client().HTTPClient.Transport = NewTag(c.Client().HTTPClient.Transport, tt.userAgent, tt.launchApp)
+
+			if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+				t.Fatalf("Login() error = %v", err)
+			}
+			if err := api.Void(c, ctx, &userSetRequest{Name: "alice"}, userSet); err != nil {
+				t.Fatalf("Void() error = %v", err)
+			}
+
+			if userAgent != tt.wantUserAgent {
+				t.Errorf("User-Agent = %q, want %q", userAgent, tt.wantUserAgent)
+			}
+			if launchApp != tt.launchApp {
+				t.Errorf("launchApp = %q, want %q", launchApp, tt.launchApp)
+			}
+		})
+	}
+}
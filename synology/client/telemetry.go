@@ -0,0 +1,231 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// telemetryName is the instrumentation scope and default service name of the
+// provider's telemetry.
+const telemetryName = "terraform-provider-synology"
+
+type attemptKey struct{}
+
+// withAttempt returns a context marking a request sent again by the
+// provider, attempt counting from 0 for the first request.
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// TelemetryEnabled reports whether the standard OpenTelemetry environment
+// variables ask for traces or metrics to be exported over OTLP.
+func TelemetryEnabled() bool {
+	if v, _ := strconv.ParseBool(os.Getenv("OTEL_SDK_DISABLED")); v {
+		return false
+	}
+	return tracesEnabled() || metricsEnabled()
+}
+
+func tracesEnabled() bool {
+	return signalEnabled("OTEL_TRACES_EXPORTER", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+}
+
+func metricsEnabled() bool {
+	return signalEnabled("OTEL_METRICS_EXPORTER", "OTEL_EXPORTER_OTLP_METRICS_ENDPOINT")
+}
+
+// signalEnabled reports whether a signal is exported: its exporter is otlp,
+// or unset while an OTLP endpoint is.
+func signalEnabled(exporterVar, endpointVar string) bool {
+	switch os.Getenv(exporterVar) {
+	case "otlp":
+		return true
+	case "":
+		return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv(endpointVar) != ""
+	default:
+		return false
+	}
+}
+
+// StartTelemetry installs the global OpenTelemetry tracer and meter
+// providers exporting over OTLP/HTTP as configured by the standard
+// environment variables, such as OTEL_EXPORTER_OTLP_ENDPOINT and
+// OTEL_SERVICE_NAME. Without them nothing is installed. The returned function
+// flushes and stops the exporters.
+func StartTelemetry(ctx context.Context) (func(context.Context) error, error) {
+	shutdown := func(context.Context) error { return nil }
+	if !TelemetryEnabled() {
+		return shutdown, nil
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", telemetryName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return shutdown, err
+	}
+
+	var shutdowns []func(context.Context) error
+	if tracesEnabled() {
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return shutdown, err
+		}
+		tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+		otel.SetTracerProvider(tp)
+		shutdowns = append(shutdowns, tp.Shutdown)
+	}
+	if metricsEnabled() {
+		exporter, err := otlpmetrichttp.New(ctx)
+		if err != nil {
+			return shutdown, err
+		}
+		mp := sdkmetric.NewMeterProvider(
+			sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)),
+			sdkmetric.WithResource(res),
+		)
+		otel.SetMeterProvider(mp)
+		shutdowns = append(shutdowns, mp.Shutdown)
+	}
+
+	return func(ctx context.Context) error {
+		var errs []error
+		for _, s := range shutdowns {
+			errs = append(errs, s(ctx))
+		}
+		return errors.Join(errs...)
+	}, nil
+}
+
+// Telemetry is an http.RoundTripper which traces every DSM API call as a
+// span and counts the calls, the calls DSM answers with an error and the
+// calls sent again.
+type Telemetry struct {
+	transport http.RoundTripper
+	tracer    trace.Tracer
+
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+	retries  metric.Int64Counter
+}
+
+// NewTelemetry returns a Telemetry sending requests through transport, which
+// defaults to http.DefaultTransport, and reporting to tp and mp.
+func NewTelemetry(transport http.RoundTripper, tp trace.TracerProvider, mp metric.MeterProvider) *Telemetry {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	meter := mp.Meter(telemetryName)
+	t := &Telemetry{transport: transport, tracer: tp.Tracer(telemetryName)}
+	// Creating instruments only fails for invalid names, the noop counters
+	// returned then are fine.
+	t.requests, _ = meter.Int64Counter("synology.api.requests",
+		metric.WithDescription("The DSM API calls sent."))
+	t.errors, _ = meter.Int64Counter("synology.api.errors",
+		metric.WithDescription("The DSM API calls which failed or which DSM answered with an error."))
+	t.retries, _ = meter.Int64Counter("synology.api.retries",
+		metric.WithDescription("The DSM API calls sent again, e.g. because DSM was busy."))
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Telemetry) RoundTrip(req *http.Request) (*http.Response, error) {
+	in, err := newInteraction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiName, method := requestMethod(req, in)
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "synology"),
+		attribute.String("rpc.service", apiName),
+		attribute.String("rpc.method", method),
+	}
+	metricAttrs := metric.WithAttributes(attrs[1:]...)
+
+	ctx, span := t.tracer.Start(req.Context(), apiName+"/"+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+		trace.WithAttributes(attribute.String("synology.api.version", parseValues(in).Get("version"))),
+	)
+	defer span.End()
+
+	t.requests.Add(ctx, 1, metricAttrs)
+	if attempt, _ := req.Context().Value(attemptKey{}).(int); attempt > 0 {
+		t.retries.Add(ctx, 1, metricAttrs)
+		span.SetAttributes(attribute.Int("synology.attempt", attempt))
+	}
+
+	res, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		t.errors.Add(ctx, 1, metricAttrs)
+		return res, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
+	if code, ok := errorCode(res); ok {
+		span.SetAttributes(attribute.Int("synology.error.code", code))
+		span.SetStatus(codes.Error, "DSM error "+strconv.Itoa(code))
+		t.errors.Add(ctx, 1, metric.WithAttributes(append(attrs[1:], attribute.Int("synology.error.code", code))...))
+	}
+
+	return res, nil
+}
+
+// RequestLogHook counts the calls the HTTP client sends again after
+// connection errors, to be set as the retryablehttp.Client RequestLogHook.
+func (t *Telemetry) RequestLogHook(_ retryablehttp.Logger, req *http.Request, attempt int) {
+	if attempt > 0 {
+		t.retries.Add(req.Context(), 1)
+	}
+}
+
+// errorCode returns the DSM error code of a JSON response which is not a
+// success. The body of res is restored for the caller.
+func errorCode(res *http.Response) (int, bool) {
+	if !strings.HasPrefix(res.Header.Get("Content-Type"), "application/json") {
+		return 0, false
+	}
+
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return 0, false
+	}
+
+	var r struct {
+		Success bool `json:"success"`
+		Error   struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &r) != nil || r.Success {
+		return 0, false
+	}
+	return r.Error.Code, true
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTelemetry(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer(mock.WithShare("docker"))
+	defer s.Close()
+
+	calls := 0
+	s.Handle("SYNO.FileStation.CreateFolder", 2, "create", func(r *mock.Request) (any, error) {
+		calls++
+		if calls < 2 {
+			return nil, mock.Errorf(402)
+		}
+		return map[string]any{"folders": []any{}}, nil
+	})
+
+	spans := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	c, err := synology.New(api.Options{Host: s.Host()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Client().HTTPClient.Transport = NewTelemetry(c.Client().HTTPClient.Transport, tp, mp)
+	q := NewFileQueue(c.Client().HTTPClient.Transport, 1)
+	q.backoff = time.Millisecond
+	c.Client().HTTPClient.Transport = q
+
+	if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if _, err := c.FileStationAPI().CreateFolder(ctx, []string{"/docker"}, []string{"foo"}, true); err != nil {
+		t.Fatalf("CreateFolder() error = %v", err)
+	}
+
+	var created []sdktrace.ReadOnlySpan
+	for _, span := range spans.GetSpans().Snapshots() {
+		if span.Name() == "SYNO.FileStation.CreateFolder/create" {
+			created = append(created, span)
+		}
+	}
+	if len(created) != 2 {
+		t.Fatalf("got %d CreateFolder spans, want 2", len(created))
+	}
+	if got := created[0].Status().Code; got != codes.Error {
+		t.Errorf("first span status = %v, want %v", got, codes.Error)
+	}
+	if got := created[1].Status().Code; got != codes.Unset {
+		t.Errorf("second span status = %v, want %v", got, codes.Unset)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatal(err)
+	}
+	counts := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if !ok {
+				continue
+			}
+			for _, dp := range sum.DataPoints {
+				if v, _ := dp.Attributes.Value(attribute.Key("rpc.service")); v.AsString() == "SYNO.FileStation.CreateFolder" {
+					counts[m.Name] += dp.Value
+				}
+			}
+		}
+	}
+	want := map[string]int64{
+		"synology.api.requests": 2,
+		"synology.api.errors":   1,
+		"synology.api.retries":  1,
+	}
+	for name, v := range want {
+		if counts[name] != v {
+			t.Errorf("%s = %d, want %d", name, counts[name], v)
+		}
+	}
+}
+
+func TestTelemetryEnabled(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{name: "unset", want: false},
+		{name: "endpoint", env: map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"}, want: true},
+		{name: "traces endpoint", env: map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://localhost:4318/v1/traces"}, want: true},
+		{name: "exporter", env: map[string]string{"OTEL_METRICS_EXPORTER": "otlp"}, want: true},
+		{name: "disabled", env: map[string]string{
+			"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318",
+			"OTEL_SDK_DISABLED":           "true",
+		}, want: false},
+		{name: "no exporters", env: map[string]string{
+			"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318",
+			"OTEL_TRACES_EXPORTER":        "none",
+			"OTEL_METRICS_EXPORTER":       "none",
+		}, want: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{
+				"OTEL_SDK_DISABLED",
+				"OTEL_EXPORTER_OTLP_ENDPOINT",
+				"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT",
+				"OTEL_EXPORTER_OTLP_METRICS_ENDPOINT",
+				"OTEL_TRACES_EXPORTER",
+				"OTEL_METRICS_EXPORTER",
+			} {
+				t.Setenv(k, tt.env[k])
+			}
+
+			if got := TelemetryEnabled(); got != tt.want {
+				t.Errorf("TelemetryEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
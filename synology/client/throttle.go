@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// throttleSlices is how many pieces the bytes of one second are read in, so
+// that uploads are sent evenly instead of in bursts of a second.
+const throttleSlices = 10
+
+type uploadLimitKey struct{}
+
+// WithUploadLimit returns a context under which uploads are sent at most at
+// limit KB/s, instead of the limit of the Throttle. A limit of 0 removes the
+// limit.
+func WithUploadLimit(ctx context.Context, limit int64) context.Context {
+	return context.WithValue(ctx, uploadLimitKey{}, limit)
+}
+
+// Throttle is an http.RoundTripper which limits the speed at which uploads
+// are sent, so that large files sent over slow links do not use up all of
+// their bandwidth. Uploads are multipart requests; all uploads in flight
+// share the limit. Other requests are sent unchanged.
+type Throttle struct {
+	transport http.RoundTripper
+	limiter   *limiter
+}
+
+// NewThrottle returns a Throttle sending uploads through transport, which
+// defaults to http.DefaultTransport, at most at limit KB/s. A limit of 0
+// sends uploads at full speed unless a request sets a limit of its own with
+// WithUploadLimit.
+func NewThrottle(transport http.RoundTripper, limit int64) *Throttle {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Throttle{transport: transport, limiter: newLimiter(limit)}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Throttle) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.Body == http.NoBody ||
+		!strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data") {
+		return t.transport.RoundTrip(req)
+	}
+
+	l := t.limiter
+	if limit, ok := req.Context().Value(uploadLimitKey{}).(int64); ok {
+		l = newLimiter(limit)
+	}
+	if l == nil {
+		return t.transport.RoundTrip(req)
+	}
+
+	r := req.Clone(req.Context())
+	r.Body = &throttledBody{ReadCloser: req.Body, ctx: req.Context(), limiter: l}
+	return t.transport.RoundTrip(r)
+}
+
+// limiter spaces out reads so that they do not exceed a number of bytes per
+// second.
+type limiter struct {
+	rate int64
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newLimiter(limit int64) *limiter {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &limiter{rate: limit * 1024}
+}
+
+// chunk returns how many bytes are read at a time.
+func (l *limiter) chunk() int {
+	return int(max(l.rate/throttleSlices, 1))
+}
+
+// wait blocks until n more bytes may be sent.
+func (l *limiter) wait(ctx context.Context, n int) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	l.next = l.next.Add(time.Duration(int64(n) * int64(time.Second) / l.rate))
+	until := l.next
+	l.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(until))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// throttledBody is a request body read no faster than its limiter allows.
+type throttledBody struct {
+	io.ReadCloser
+	ctx     context.Context
+	limiter *limiter
+}
+
+func (b *throttledBody) Read(p []byte) (int, error) {
+	if len(p) > b.limiter.chunk() {
+		p = p[:b.limiter.chunk()]
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		if werr := b.limiter.wait(b.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+
+	return n, err
+}
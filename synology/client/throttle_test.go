@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// drainTransport reads the whole request body like a transport sending it.
+type drainTransport struct{}
+
+func (drainTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		if _, err := io.Copy(io.Discard, req.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"success":true}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestThrottle(t *testing.T) {
+	body := strings.Repeat("x", 2048)
+
+	for _, tt := range []struct {
+		name        string
+		contentType string
+		limit       int64
+		ctx         context.Context
+		min, max    time.Duration
+	}{
+		{"upload", "multipart/form-data; boundary=x", 4, context.Background(), 400 * time.Millisecond, 2 * time.Second},
+		{"form", "application/x-www-form-urlencoded", 4, context.Background(), 0, 100 * time.Millisecond},
+		{"no limit", "multipart/form-data; boundary=x", 0, context.Background(), 0, 100 * time.Millisecond},
+		{"request limit", "multipart/form-data; boundary=x", 0, WithUploadLimit(context.Background(), 4), 400 * time.Millisecond, 2 * time.Second},
+		{"request without limit", "multipart/form-data; boundary=x", 4, WithUploadLimit(context.Background(), 0), 0, 100 * time.Millisecond},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(tt.ctx, http.MethodPost, "http://nas/webapi/entry.cgi", strings.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", tt.contentType)
+
+			start := time.Now()
+			res, err := NewThrottle(drainTransport{}, tt.limit).RoundTrip(req)
+			if err != nil {
+				t.Fatalf("RoundTrip() error = %v", err)
+			}
+			_ = res.Body.Close()
+
+			if d := time.Since(start); d < tt.min || d > tt.max {
+				t.Errorf("RoundTrip() took %v, want between %v and %v", d, tt.min, tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,349 @@
+// Package client contains the HTTP plumbing the provider wraps around the
+// go-synology client.
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// VCRModeEnvVar selects the fixture mode, either "record" or "replay".
+	VCRModeEnvVar = "SYNOLOGY_VCR_MODE"
+	// VCRCassetteEnvVar is the path of the fixture file.
+	VCRCassetteEnvVar = "SYNOLOGY_VCR_CASSETTE"
+)
+
+// VCRMode is the mode of a Recorder.
+type VCRMode string
+
+const (
+	// VCRRecord forwards requests to the NAS and saves the responses.
+	VCRRecord VCRMode = "record"
+	// VCRReplay answers requests from a fixture file without any network
+	// access.
+	VCRReplay VCRMode = "replay"
+)
+
+const redacted = "REDACTED"
+
+// sensitiveParams are request parameters which are removed from fixtures and
+// ignored when matching requests.
+var sensitiveParams = map[string]bool{
+	"_sid":      true,
+	"SynoToken": true,
+	"account":   true,
+	"passwd":    true,
+	"otp_code":  true,
+	"device_id": true,
+}
+
+// sensitiveFields are response fields which are redacted in fixtures, besides
+// the fields named like secrets, see secretParam.
+var sensitiveFields = map[string]bool{
+	"sid":       true,
+	"synotoken": true,
+	"did":       true,
+	"device_id": true,
+}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+	Query       string `json:"query,omitempty"`
+	Body        string `json:"body,omitempty"`
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type,omitempty"`
+	Response    string `json:"response"`
+}
+
+func (i Interaction) key() string {
+	return i.Method + " " + i.Path + "?" + i.Query + "\n" + i.Body
+}
+
+// Cassette is the content of a fixture file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper which records DSM responses to a fixture
+// file or replays them from it.
+//
+// Session identifiers, tokens and credentials are stripped before anything
+// is written, so fixtures can be committed. Requests are matched on method,
+// path and their remaining parameters. Identical requests are answered in
+// the order they were recorded, the last answer being repeated once they are
+// exhausted.
+type Recorder struct {
+	mode      VCRMode
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	replayed map[string]int
+}
+
+// NewRecorder returns a Recorder for the fixture file at path. In record
+// mode requests are sent through transport, which defaults to
+// http.DefaultTransport.
+func NewRecorder(mode VCRMode, path string, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	r := &Recorder{
+		mode:      mode,
+		path:      path,
+		transport: transport,
+		replayed:  map[string]int{},
+	}
+
+	switch mode {
+	case VCRRecord:
+	case VCRReplay:
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read fixture: %w", err)
+		}
+		if err := json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("unable to decode fixture %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown fixture mode %q", mode)
+	}
+
+	return r, nil
+}
+
+// NewRecorderFromEnv returns a Recorder configured from SYNOLOGY_VCR_MODE and
+// SYNOLOGY_VCR_CASSETTE, or nil when fixtures are not enabled.
+func NewRecorderFromEnv(transport http.RoundTripper) (*Recorder, error) {
+	mode := os.Getenv(VCRModeEnvVar)
+	if mode == "" {
+		return nil, nil
+	}
+
+	path := os.Getenv(VCRCassetteEnvVar)
+	if path == "" {
+		return nil, fmt.Errorf("%s must be set when %s is set", VCRCassetteEnvVar, VCRModeEnvVar)
+	}
+
+	return NewRecorder(VCRMode(mode), path, transport)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	in, err := newInteraction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == VCRReplay {
+		return r.replay(req, in)
+	}
+
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	in.Status = res.StatusCode
+	in.ContentType = res.Header.Get("Content-Type")
+	in.Response = sanitizeResponse(in.ContentType, body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, in)
+	if err := r.save(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request, in Interaction) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := in.key()
+
+	var matches []Interaction
+	for _, i := range r.cassette.Interactions {
+		if i.key() == key {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no fixture recorded in %s for %s %s?%s", r.path, in.Method, in.Path, in.Query)
+	}
+
+	n := r.replayed[key]
+	r.replayed[key]++
+	if n >= len(matches) {
+		n = len(matches) - 1
+	}
+	m := matches[n]
+
+	header := http.Header{}
+	if m.ContentType != "" {
+		header.Set("Content-Type", m.ContentType)
+	}
+
+	return &http.Response{
+		StatusCode:    m.Status,
+		Status:        fmt.Sprintf("%d %s", m.Status, http.StatusText(m.Status)),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(m.Response)),
+		ContentLength: int64(len(m.Response)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) save() error {
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(b, '\n'), 0o644)
+}
+
+func newInteraction(req *http.Request) (Interaction, error) {
+	in := Interaction{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  sanitizeValues(req.URL.Query()),
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return in, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return in, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	// Multipart uploads are matched on the query only, their content is not
+	// stored in fixtures.
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return in, err
+		}
+		in.Body = sanitizeValues(values)
+	}
+
+	return in, nil
+}
+
+// sanitizeValues encodes values in a stable order without the sensitive
+// parameters and with the secrets of the other parameters redacted, so that
+// fixtures hold no passwords or keys of the objects written.
+func sanitizeValues(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		if !sensitiveParams[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	clean := url.Values{}
+	for _, k := range keys {
+		for _, v := range values[k] {
+			if secretParam(k) {
+				v = redacted
+			} else {
+				v = redactValue(v)
+			}
+			clean.Add(k, v)
+		}
+	}
+
+	return clean.Encode()
+}
+
+// redactValue returns v with the secrets of JSON values redacted.
+func redactValue(v string) string {
+	r := redactParam(v)
+	if s, ok := r.(string); ok {
+		return s
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		return v
+	}
+	return string(b)
+}
+
+func sanitizeResponse(contentType string, body []byte) string {
+	if !strings.HasPrefix(contentType, "application/json") {
+		return string(body)
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+
+	b, err := json.Marshal(redact(v))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(b)
+}
+
+// redact replaces the values of the fields of v named like secrets, see
+// secretParam. Flags and numbers, such as password_never_expires, are kept.
+func redact(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, e := range t {
+			switch e.(type) {
+			case bool, float64, nil:
+				continue
+			}
+			if secretParam(k) {
+				t[k] = redacted
+			} else {
+				t[k] = redact(e)
+			}
+		}
+	case []any:
+		for i, e := range t {
+			t[i] = redact(e)
+		}
+	}
+	return v
+}
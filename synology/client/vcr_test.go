@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	synology "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+func newTestClient(t *testing.T, host string, rec func(c synology.Api) *Recorder) synology.Api {
+	t.Helper()
+
+	c, err := synology.New(api.Options{Host: host})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Client().HTTPClient.Transport = rec(c)
+
+	if _, err := c.Login(context.Background(), api.LoginOptions{
+		Username: "admin",
+		Password: "password",
+	}); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	return c
+}
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	cassette := filepath.Join(t.TempDir(), "fixtures", "folder.json")
+
+	s := mock.NewServer(mock.WithShare("docker"))
+
+	c := newTestClient(t, s.Host(), func(c synology.Api) *Recorder {
+		r, err := NewRecorder(VCRRecord, cassette, c.Client().HTTPClient.Transport)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+
+	if _, err := c.FileStationAPI().CreateFolder(ctx, []string{"/docker"}, []string{"foo"}, true); err != nil {
+		t.Fatalf("CreateFolder() error = %v", err)
+	}
+	if _, err := c.FileStationAPI().Get(ctx, "/docker/foo"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	s.Close()
+
+	b, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"sid-", "token-", "password"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("fixture contains %q", secret)
+		}
+	}
+
+	c = newTestClient(t, "https://fixture.invalid", func(synology.Api) *Recorder {
+		r, err := NewRecorder(VCRReplay, cassette, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+
+	if _, err := c.FileStationAPI().CreateFolder(ctx, []string{"/docker"}, []string{"foo"}, true); err != nil {
+		t.Fatalf("replayed CreateFolder() error = %v", err)
+	}
+	folder, err := c.FileStationAPI().Get(ctx, "/docker/foo")
+	if err != nil {
+		t.Fatalf("replayed Get() error = %v", err)
+	}
+	if folder.Path != "/docker/foo" {
+		t.Errorf("replayed Get() path = %s", folder.Path)
+	}
+
+	if _, err := c.FileStationAPI().Get(ctx, "/docker/bar"); err == nil {
+		t.Error("Get() of a request which was never recorded succeeded")
+	}
+}
+
+func TestRecorderRedactsSecrets(t *testing.T) {
+	ctx := context.Background()
+	cassette := filepath.Join(t.TempDir(), "fixtures", "user.json")
+
+	s := mock.NewServer()
+	s.Handle(dsm.Core_User, 1, "create", func(r *mock.Request) (any, error) {
+		return map[string]any{"name": r.Get("name"), "token": "t0k3n", "passwd_never_expire": true}, nil
+	})
+
+	c := newTestClient(t, s.Host(), func(c synology.Api) *Recorder {
+		r, err := NewRecorder(VCRRecord, cassette, c.Client().HTTPClient.Transport)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+
+	user := dsm.UserRequest{Name: "alice", Password: "hunter2"}
+	if err := dsm.New(c).UserCreate(ctx, user); err != nil {
+		t.Fatalf("UserCreate() error = %v", err)
+	}
+
+	s.Close()
+
+	b, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"hunter2", "t0k3n"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("fixture contains %q", secret)
+		}
+	}
+	for _, want := range []string{"password=REDACTED", "passwd_never_expire\\\":true"} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("fixture does not contain %q:\n%s", want, b)
+		}
+	}
+
+	c = newTestClient(t, "https://fixture.invalid", func(synology.Api) *Recorder {
+		r, err := NewRecorder(VCRReplay, cassette, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+
+	if err := dsm.New(c).UserCreate(ctx, user); err != nil {
+		t.Fatalf("replayed UserCreate() error = %v", err)
+	}
+}
+
+func TestNewRecorderFromEnv(t *testing.T) {
+	t.Setenv(VCRModeEnvVar, "")
+	if r, err := NewRecorderFromEnv(nil); r != nil || err != nil {
+		t.Errorf("NewRecorderFromEnv() = %v, %v, want nil, nil", r, err)
+	}
+
+	t.Setenv(VCRModeEnvVar, string(VCRRecord))
+	t.Setenv(VCRCassetteEnvVar, "")
+	if _, err := NewRecorderFromEnv(nil); err == nil {
+		t.Error("NewRecorderFromEnv() without a cassette succeeded")
+	}
+
+	t.Setenv(VCRModeEnvVar, "rewind")
+	t.Setenv(VCRCassetteEnvVar, "fixture.json")
+	if _, err := NewRecorderFromEnv(nil); err == nil {
+		t.Error("NewRecorderFromEnv() with an unknown mode succeeded")
+	}
+}
@@ -27,7 +27,9 @@ type ApiResourceModel struct {
 	Result     types.Dynamic `tfsdk:"result"`
 }
 
-var _ resource.Resource = &ApiResource{}
+var (
+	_ resource.Resource = &ApiResource{}
+)
 
 func NewApiResource() resource.Resource {
 	return &ApiResource{}
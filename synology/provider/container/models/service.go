@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 	"unsafe"
@@ -17,6 +18,33 @@ import (
 	"github.com/synology-community/terraform-provider-synology/synology/models/composetypes"
 )
 
+// gpuDevice is the device node of the integrated GPU used for hardware
+// transcoding on Synology models.
+const gpuDevice = "/dev/dri"
+
+// parseDeviceMapping parses a device mapping of the short syntax,
+// SOURCE[:TARGET[:PERMISSIONS]].
+func parseDeviceMapping(v string) composetypes.DeviceMapping {
+	parts := strings.SplitN(v, ":", 3)
+	dm := composetypes.DeviceMapping{Source: parts[0], Target: parts[0]}
+	if len(parts) > 1 {
+		dm.Target = parts[1]
+	}
+	if len(parts) > 2 {
+		dm.Permissions = parts[2]
+	}
+	return dm
+}
+
+// formatDeviceMapping returns the short syntax of dm.
+func formatDeviceMapping(dm composetypes.DeviceMapping) string {
+	v := dm.Source + ":" + dm.Target
+	if dm.Permissions != "" {
+		v += ":" + dm.Permissions
+	}
+	return v
+}
+
 type Capabilities struct {
 	Add  types.List `tfsdk:"add"`
 	Drop types.List `tfsdk:"drop"`
@@ -253,10 +281,12 @@ type Service struct {
 	Configs       types.List   `tfsdk:"configs"`
 	ContainerName types.String `tfsdk:"container_name"`
 	Dependencies  types.Map    `tfsdk:"depends_on"`
+	Devices       types.List   `tfsdk:"devices"`
 	DNS           types.List   `tfsdk:"dns"`
 	Entrypoint    types.List   `tfsdk:"entrypoint"`
 	Environment   types.Map    `tfsdk:"environment"`
 	ExtraHosts    types.Map    `tfsdk:"extra_hosts"`
+	GPU           types.Bool   `tfsdk:"gpu"`
 	HealthCheck   types.Object `tfsdk:"healthcheck"`
 	HostName      types.String `tfsdk:"hostname"`
 	DomainName    types.String `tfsdk:"domainname"`
@@ -371,6 +401,8 @@ func (m Service) AttrType() map[string]attr.Type {
 		"cap_drop":     types.ListType{ElemType: types.StringType},
 		"sysctls":      types.MapType{ElemType: types.StringType},
 		"extra_hosts":  types.MapType{ElemType: types.StringType},
+		"devices":      types.ListType{ElemType: types.StringType},
+		"gpu":          types.BoolType,
 	}
 }
 
@@ -394,6 +426,7 @@ func (m Service) Value() attr.Value {
 	var capabilities basetypes.ObjectValue
 	var sysctls basetypes.MapValue
 	var extraHosts basetypes.MapValue
+	var devices basetypes.ListValue
 	// var extensions basetypes.MapValue
 
 	// if e, diag := m.Extensions.ToMapValue(context.Background()); !diag.HasError() {
@@ -472,16 +505,22 @@ func (m Service) Value() attr.Value {
 		extraHosts = e
 	}
 
+	if dv, diag := m.Devices.ToListValue(context.Background()); !diag.HasError() {
+		devices = dv
+	}
+
 	return types.ObjectValueMust(m.AttrType(), map[string]attr.Value{
 		"capabilities":   capabilities,
 		"command":        commands,
 		"configs":        configs,
 		"container_name": types.StringValue(m.ContainerName.ValueString()),
 		"depends_on":     dependencies,
+		"devices":        devices,
 		"dns":            dns,
 		"entrypoint":     entrypoints,
 		"environment":    environment,
 		"extra_hosts":    extraHosts,
+		"gpu":            types.BoolValue(m.GPU.ValueBool()),
 		"healthcheck":    healthcheck,
 		"hostname":       types.StringValue(m.HostName.ValueString()),
 		"domainname":     types.StringValue(m.DomainName.ValueString()),
@@ -812,6 +851,26 @@ func (m Service) AsComposeConfig(
 		}
 	}
 
+	if !m.Devices.IsNull() && !m.Devices.IsUnknown() {
+		devices := []string{}
+		if diag := m.Devices.ElementsAs(ctx, &devices, true); !diag.HasError() {
+			for _, v := range devices {
+				service.Devices = append(service.Devices, parseDeviceMapping(v))
+			}
+		} else {
+			d = append(d, diag...)
+		}
+	}
+
+	if m.GPU.ValueBool() && !slices.ContainsFunc(service.Devices, func(dm composetypes.DeviceMapping) bool {
+		return dm.Source == gpuDevice
+	}) {
+		service.Devices = append(service.Devices, composetypes.DeviceMapping{
+			Source: gpuDevice,
+			Target: gpuDevice,
+		})
+	}
+
 	if !m.Ulimits.IsNull() && !m.Ulimits.IsUnknown() {
 		ulimits := map[string]Ulimit{}
 		if diag := m.Ulimits.ElementsAs(ctx, &ulimits, true); !diag.HasError() {
@@ -1338,6 +1397,19 @@ func (m *Service) FromComposeConfig(
 
 	m.Privileged = types.BoolValue(service.Privileged)
 
+	if len(service.Devices) > 0 {
+		devices := []string{}
+		for _, dm := range service.Devices {
+			devices = append(devices, formatDeviceMapping(dm))
+		}
+		devicesValue, diags := types.ListValueFrom(ctx, types.StringType, devices)
+		if diags.HasError() {
+			d = append(d, diags...)
+		} else {
+			m.Devices = devicesValue
+		}
+	}
+
 	if len(service.Tmpfs) > 0 {
 		tmpfsValue, diags := types.ListValueFrom(ctx, types.StringType, service.Tmpfs)
 		if diags.HasError() {
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -29,11 +32,17 @@ import (
 	"github.com/synology-community/go-synology/pkg/api/docker"
 	"github.com/synology-community/go-synology/pkg/api/filestation"
 	"github.com/synology-community/go-synology/pkg/util/form"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
 	"github.com/synology-community/terraform-provider-synology/synology/provider/container/models"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &ProjectResource{}
+var (
+	_ resource.Resource             = &ProjectResource{}
+	_ resource.ResourceWithIdentity = &ProjectResource{}
+)
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -43,6 +52,7 @@ type ProjectResource struct {
 	client     docker.Api
 	fsClient   filestation.Api
 	coreClient core.Api
+	dsmClient  *dsm.Client
 }
 
 // ProjectResourceModel describes the resource data model.
@@ -59,12 +69,15 @@ type ProjectResourceModel struct {
 	Run           types.Bool   `tfsdk:"run"`
 	Status        types.String `tfsdk:"status"`
 	ServicePortal types.Object `tfsdk:"service_portal"`
+	URL           types.String `tfsdk:"url"`
 	Content       types.String `tfsdk:"content"`
 	Metadata      types.Map    `tfsdk:"metadata"`
 	// ComposeFiles types.ListType `tfsdk:"compose_files"`
-	// Environment  types.MapType  `tfsdk:"environment"`
 	CreatedAt timetypes.RFC3339 `tfsdk:"created_at"`
 	UpdatedAt timetypes.RFC3339 `tfsdk:"updated_at"`
+
+	Environment          types.Map `tfsdk:"environment"`
+	SensitiveEnvironment types.Map `tfsdk:"sensitive_environment"`
 }
 
 func (p ProjectResourceModel) IsRunning() bool {
@@ -75,10 +88,22 @@ func (p ProjectResourceModel) ShouldRun() bool {
 	return !p.IsRunning() && p.Run.ValueBool()
 }
 
+// envFileTimeout is how long the .env file of a project may take to be
+// restricted to root.
+const envFileTimeout = time.Minute
+
+// envNameValidator accepts the names of environment variables.
+var envNameValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`),
+	"must be a valid environment variable name",
+)
+
 const projectDescription = `A Docker Compose project for the Container Manager Synology API.
 
 > **Note:** Synology creates a shared folder for each project. The shared folder is created in the ` + "`/projects`" + ` directory by default. The shared folder is named after the project name. The shared folder is used to store the project files and data. The shared folder is mounted to the ` + "`/volume1/projects`" + ` directory on the Synology NAS.
 
+> **Note:** When services are only added or changed, a running project is updated in place and Docker Compose only recreates the changed services. Removing a service or changing networks, volumes, configs or secrets stops the whole project first.
+
 `
 
 func projectExists(err error) bool {
@@ -172,6 +197,88 @@ func (f *ProjectResource) handleSecrets(
 	return
 }
 
+// handleEnvFile writes the environment variables of the project to the .env
+// file next to the compose file, readable by root only. Docker Compose reads
+// it when the project is built, so the content can refer to the variables
+// instead of containing their values.
+func (f *ProjectResource) handleEnvFile(
+	ctx context.Context,
+	data ProjectResourceModel,
+) (diags diag.Diagnostics) {
+	env := map[string]string{}
+	secrets := map[string]string{}
+	if !data.Environment.IsNull() && !data.Environment.IsUnknown() {
+		diags.Append(data.Environment.ElementsAs(ctx, &env, true)...)
+	}
+	if !data.SensitiveEnvironment.IsNull() && !data.SensitiveEnvironment.IsUnknown() {
+		diags.Append(data.SensitiveEnvironment.ElementsAs(ctx, &secrets, true)...)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	for k, v := range secrets {
+		if _, ok := env[k]; ok {
+			diags.AddError(
+				"Duplicate environment variable",
+				fmt.Sprintf("%s is set in both environment and sensitive_environment.", k),
+			)
+			return
+		}
+		env[k] = v
+	}
+
+	sharePath := data.SharePath.ValueString()
+	_, err := f.fsClient.Upload(
+		ctx,
+		sharePath,
+		form.File{
+			Name:    ".env",
+			Content: util.DotEnv(env),
+		}, false,
+		true)
+	if err != nil {
+		diags.AddError(
+			"Failed to upload file",
+			fmt.Sprintf("Unable to upload .env file, got error: %s", err),
+		)
+		return
+	}
+
+	file, err := f.fsClient.Get(ctx, sharePath+"/.env")
+	if err != nil {
+		diags.AddError("Failed to find .env file", err.Error())
+		return
+	}
+
+	// The script only changes the mode, so that the values never reach the
+	// task scheduler.
+	script := "chmod 600 " + util.ShellQuote(file.Additional.RealPath)
+	diags.Append(util.RunRootScript(ctx, f.coreClient, "terraform project env "+data.Name.ValueString(), script)...)
+	if diags.HasError() {
+		return
+	}
+
+	// The root task runs in the background, the project is only built once
+	// the file is in place and restricted.
+	waitCtx, cancel := context.WithTimeout(ctx, envFileTimeout)
+	defer cancel()
+	err = util.Poll(waitCtx, time.Second, func() (bool, error) {
+		file, err := f.fsClient.Get(waitCtx, sharePath+"/.env")
+		if err != nil {
+			return false, nil
+		}
+		return file.Additional.Perm.Posix == 600, nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to restrict .env file",
+			fmt.Sprintf("The .env file of %s did not become readable by root only: %s", sharePath, err),
+		)
+	}
+	return
+}
+
 func (f *ProjectResource) ensureProjectShare(ctx context.Context, sharePath string) error {
 	folderParts := strings.Split(sharePath, "/")
 	plen := len(folderParts)
@@ -271,6 +378,13 @@ func (f *ProjectResource) Create(
 		}
 	}
 
+	if !data.Environment.IsNull() || !data.SensitiveEnvironment.IsNull() {
+		resp.Diagnostics.Append(f.handleEnvFile(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	servicePortal := models.ServicePortal{}
 
 	if !data.ServicePortal.IsNull() && !data.ServicePortal.IsUnknown() {
@@ -383,8 +497,11 @@ func (f *ProjectResource) Create(
 
 	// data.Content = types.StringValue(proj.Content)
 
+	resp.Diagnostics.Append(f.setURL(ctx, &data)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
 }
 
 // Delete implements resource.Resource.
@@ -479,7 +596,10 @@ func (f *ProjectResource) Read(
 		state.Content = types.StringNull()
 	}
 
+	resp.Diagnostics.Append(f.setURL(ctx, &state)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", state.Name.ValueString())...)
 }
 
 // Update implements resource.Resource.
@@ -506,7 +626,7 @@ func (f *ProjectResource) Update(
 		return
 	}
 
-	var servicesChanged, configChanged, secretChanged bool
+	var servicesChanged, configChanged, secretChanged, envChanged bool
 
 	if !reflect.DeepEqual(plan.Services, state.Services) {
 		servicesChanged = true
@@ -520,7 +640,12 @@ func (f *ProjectResource) Update(
 		secretChanged = true
 	}
 
-	if !servicesChanged && !configChanged {
+	if !plan.Environment.Equal(state.Environment) ||
+		!plan.SensitiveEnvironment.Equal(state.SensitiveEnvironment) {
+		envChanged = true
+	}
+
+	if !servicesChanged && !configChanged && !envChanged {
 		tflog.Info(ctx, "No changes detected in services or configs, skipping update")
 		return
 	}
@@ -543,6 +668,13 @@ func (f *ProjectResource) Update(
 		f.handleSecrets(ctx, plan)
 	}
 
+	if envChanged {
+		resp.Diagnostics.Append(f.handleEnvFile(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var content string
 	if !plan.Content.IsNull() && !plan.Content.IsUnknown() {
 		content = plan.Content.ValueString()
@@ -589,7 +721,15 @@ func (f *ProjectResource) Update(
 			return
 		}
 
-		if proj.IsRunning() {
+		// Building a running project only recreates the services whose
+		// definition changed, so the project is only stopped when shared
+		// definitions change or services are removed.
+		changed, partial := changedServices(plan, state)
+		if proj.IsRunning() && partial && plan.Run.ValueBool() {
+			tflog.Info(ctx, "Redeploying changed services only", map[string]any{
+				"services": changed,
+			})
+		} else if proj.IsRunning() {
 			_, err = f.client.ProjectStopStream(ctx, docker.ProjectStreamRequest{
 				ID: plan.ID.ValueString(),
 			})
@@ -650,8 +790,11 @@ func (f *ProjectResource) Update(
 
 	plan.Metadata = types.MapValueMust(types.StringType, map[string]attr.Value{})
 
+	resp.Diagnostics.Append(f.setURL(ctx, &plan)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", plan.Name.ValueString())...)
 }
 
 // Metadata implements resource.Resource.
@@ -661,6 +804,7 @@ func (f *ProjectResource) Metadata(
 	resp *resource.MetadataResponse,
 ) {
 	resp.TypeName = buildName(req.ProviderTypeName, "project")
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Schema implements resource.Resource.
@@ -702,6 +846,23 @@ func (f *ProjectResource) Schema(
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"environment": schema.MapAttribute{
+				MarkdownDescription: "Variables written to the `.env` file of the project, which Docker Compose substitutes for `${NAME}` in the content. The file is only readable by root.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(envNameValidator),
+				},
+			},
+			"sensitive_environment": schema.MapAttribute{
+				MarkdownDescription: "Like `environment`, for secrets which are hidden in the plan output. Referring to them as `${NAME}` keeps them out of the content.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(envNameValidator),
+				},
+			},
 			"share_path": schema.StringAttribute{
 				MarkdownDescription: "The share path of the project.",
 				Optional:            true,
@@ -737,6 +898,10 @@ func (f *ProjectResource) Schema(
 				Computed:            true,
 				CustomType:          timetypes.RFC3339Type{},
 			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the service portal, built from the first enabled DDNS hostname of the NAS, or the provider host if there is none. Null unless the service portal is enabled.",
+				Computed:            true,
+			},
 			"service_portal": schema.SingleNestedAttribute{
 				MarkdownDescription: "Synology Web Station configuration for the docker compose project.",
 				Optional:            true,
@@ -846,6 +1011,11 @@ func (f *ProjectResource) Schema(
 								},
 							},
 						},
+						"devices": schema.ListAttribute{
+							MarkdownDescription: "The devices passed to the service, as `SOURCE[:TARGET[:PERMISSIONS]]`, e.g. `/dev/ttyUSB0:/dev/ttyACM0:rwm`.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
 						"dns": schema.ListAttribute{
 							MarkdownDescription: "The DNS of the service.",
 							Optional:            true,
@@ -866,6 +1036,10 @@ func (f *ProjectResource) Schema(
 							Optional:            true,
 							ElementType:         types.StringType,
 						},
+						"gpu": schema.BoolAttribute{
+							MarkdownDescription: "Whether the integrated GPU of the NAS, `/dev/dri`, is passed to the service for hardware transcoding, e.g. for Plex or Frigate. See `hardware_transcoding` of the `synology_core_hardware` data source.",
+							Optional:            true,
+						},
 						"healthcheck": schema.SingleNestedAttribute{
 							MarkdownDescription: "Health check configuration.",
 							Optional:            true,
@@ -1387,9 +1561,15 @@ func (f *ProjectResource) Configure(
 		return
 	}
 
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
 	f.client = client.DockerAPI()
 	f.fsClient = client.FileStationAPI()
 	f.coreClient = client.CoreAPI()
+	f.dsmClient = dsm.New(client)
 }
 
 func (f *ProjectResource) ImportState(
@@ -1397,7 +1577,13 @@ func (f *ProjectResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	res, err := f.client.ProjectGetByName(ctx, req.ID)
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := f.client.ProjectGetByName(ctx, name)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to list package feeds", err.Error())
 		return
@@ -1584,7 +1770,77 @@ func (f *ProjectResource) ImportState(
 		Run:           types.BoolValue(false),
 		Metadata:      types.MapNull(types.StringType),
 		ServicePortal: servicePortalValues,
+
+		Environment:          types.MapNull(types.StringType),
+		SensitiveEnvironment: types.MapNull(types.StringType),
 	}
 
+	resp.Diagnostics.Append(f.setURL(ctx, &project)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, project)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", res.Name)...)
+}
+
+// changedServices returns the names of the services which are added or changed
+// by plan, and whether the update can be limited to them: no service is
+// removed and the networks, volumes, configs and secrets are unchanged.
+func changedServices(plan, state ProjectResourceModel) ([]string, bool) {
+	partial := plan.Networks.Equal(state.Networks) &&
+		plan.Volumes.Equal(state.Volumes) &&
+		plan.Configs.Equal(state.Configs) &&
+		plan.Secrets.Equal(state.Secrets) &&
+		!plan.Services.IsUnknown() && !state.Services.IsNull()
+
+	planned := plan.Services.Elements()
+	current := state.Services.Elements()
+
+	for name := range current {
+		if _, ok := planned[name]; !ok {
+			partial = false
+		}
+	}
+
+	changed := []string{}
+	for name, v := range planned {
+		if cur, ok := current[name]; !ok || !cur.Equal(v) {
+			changed = append(changed, name)
+		}
+	}
+	slices.Sort(changed)
+
+	return changed, partial
+}
+
+// setURL sets the URL of the service portal in data.
+func (f *ProjectResource) setURL(ctx context.Context, data *ProjectResourceModel) (diags diag.Diagnostics) {
+	data.URL = types.StringNull()
+
+	if data.ServicePortal.IsNull() || data.ServicePortal.IsUnknown() {
+		return
+	}
+
+	servicePortal := models.ServicePortal{}
+	diags = data.ServicePortal.As(ctx, &servicePortal, basetypes.ObjectAsOptions{})
+	if diags.HasError() || !servicePortal.Enable.ValueBool() {
+		return
+	}
+
+	host, err := f.dsmClient.ServiceHost(ctx)
+	if err != nil {
+		diags.AddError("Failed to list DDNS records", err.Error())
+		return
+	}
+
+	data.URL = types.StringValue(
+		util.ServiceURL(servicePortal.Protocol.ValueString(), host, servicePortal.Port.ValueInt64()),
+	)
+	return
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *ProjectResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the project.")
 }
@@ -139,6 +139,45 @@ import {
 	id = "traefik"
 }`
 
+	postgresProject = `
+resource "synology_container_project" "default" {
+	name = "postgres"
+
+	environment = {
+		POSTGRES_USER = "app"
+	}
+
+	sensitive_environment = {
+		POSTGRES_PASSWORD = "T3stP@ssw0rd"
+	}
+
+	services = {
+		postgres = {
+			image = "postgres:16"
+
+			environment = {
+				POSTGRES_USER     = "$${POSTGRES_USER}"
+				POSTGRES_PASSWORD = "$${POSTGRES_PASSWORD}"
+			}
+		}
+	}
+}`
+
+	plexProject = `
+resource "synology_container_project" "default" {
+	name = "plex"
+
+	services = {
+		plex = {
+			image        = "plexinc/pms-docker"
+			network_mode = "host"
+			gpu          = true
+			devices      = ["/dev/ttyUSB0"]
+			cap_add      = ["SYS_ADMIN"]
+		}
+	}
+}`
+
 	k3sProject = `
 	resource "synology_container_project" "foo" {
 		name = "k3s"
@@ -226,6 +265,14 @@ func TestAccProjectResource_basic(t *testing.T) {
 			"k3s",
 			k3sProject,
 		},
+		{
+			"postgres",
+			postgresProject,
+		},
+		{
+			"plex",
+			plexProject,
+		},
 		// {
 		// 	"homebridge project",
 		// 	homebridgeProject,
@@ -326,3 +373,41 @@ func TestAccProjectResource_basic(t *testing.T) {
 		})
 	}
 }
+
+func TestAccProjectResource_partialUpdate(t *testing.T) {
+	project := func(tag string) string {
+		return fmt.Sprintf(`
+resource "synology_container_project" "default" {
+	name = "partial"
+	run  = true
+
+	services = {
+		web = {
+			image = "nginx:%s"
+		}
+		cache = {
+			image = "redis:7"
+		}
+	}
+}`, tag)
+	}
+
+	r.UnitTest(t, r.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []r.TestStep{
+			{
+				Config: project("1.26"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("synology_container_project.default", "status", "RUNNING"),
+				),
+			},
+			{
+				Config: project("1.27"),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("synology_container_project.default", "services.web.image", "nginx:1.27"),
+					r.TestCheckResourceAttr("synology_container_project.default", "status", "RUNNING"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,254 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api/core"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// dockerBin is the Docker CLI of Container Manager.
+const dockerBin = "/usr/local/bin/docker"
+
+type VolumeResourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Driver     types.String `tfsdk:"driver"`
+	DriverOpts types.Map    `tfsdk:"driver_opts"`
+	Labels     types.Map    `tfsdk:"labels"`
+}
+
+// createScript returns the shell script creating the volume unless a volume
+// of the same name exists.
+func (m VolumeResourceModel) createScript(ctx context.Context) (string, diag.Diagnostics) {
+	opts := map[string]string{}
+	labels := map[string]string{}
+	diags := m.DriverOpts.ElementsAs(ctx, &opts, true)
+	diags.Append(m.Labels.ElementsAs(ctx, &labels, true)...)
+
+	name := util.ShellQuote(m.Name.ValueString())
+	args := []string{dockerBin, "volume", "create", "--driver", util.ShellQuote(m.Driver.ValueString())}
+	args = append(args, flags("--opt", opts)...)
+	args = append(args, flags("--label", labels)...)
+	args = append(args, name)
+
+	script := fmt.Sprintf("%s volume inspect %s >/dev/null 2>&1 || %s", dockerBin, name, strings.Join(args, " "))
+	return script, diags
+}
+
+// flags returns flag KEY=VALUE for each entry of values, sorted by key.
+func flags(flag string, values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	args := []string{}
+	for _, k := range keys {
+		args = append(args, flag, util.ShellQuote(k+"="+values[k]))
+	}
+	return args
+}
+
+var (
+	_ resource.Resource             = &VolumeResource{}
+	_ resource.ResourceWithIdentity = &VolumeResource{}
+)
+
+func NewVolumeResource() resource.Resource {
+	return &VolumeResource{}
+}
+
+type VolumeResource struct {
+	client core.Api
+}
+
+// Create implements resource.Resource.
+func (f *VolumeResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data VolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	script, diags := data.createScript(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(util.RunRootScript(ctx, f.client, "terraform docker volume "+data.Name.ValueString(), script)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource. Every attribute forces a replacement.
+func (f *VolumeResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data VolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (f *VolumeResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data VolumeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	script := fmt.Sprintf("%s volume rm %s", dockerBin, util.ShellQuote(data.Name.ValueString()))
+	resp.Diagnostics.Append(util.RunRootScript(ctx, f.client, "terraform docker volume "+data.Name.ValueString(), script)...)
+}
+
+// Metadata implements resource.Resource.
+func (f *VolumeResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "volume")
+}
+
+// Read implements resource.Resource. Container Manager has no API listing
+// volumes, so the state is kept as it is.
+func (f *VolumeResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data VolumeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (f *VolumeResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A Docker named volume, which compose projects can use as an `external` volume. Container Manager has no API for volumes, so they are managed with the Docker CLI through a temporary root task; volumes changed or removed outside Terraform are not detected. An existing volume of the same name is adopted as it is. Destroying the resource fails on the NAS while a container uses the volume.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the volume.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"driver": schema.StringAttribute{
+				MarkdownDescription: "The volume driver.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("local"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"driver_opts": schema.MapAttribute{
+				MarkdownDescription: "The driver options, e.g. `type`, `o` and `device` to mount an NFS export with the `local` driver.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "The labels of the volume.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (f *VolumeResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	f.client = client.CoreAPI()
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *VolumeResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the volume.")
+}
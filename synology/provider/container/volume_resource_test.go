@@ -0,0 +1,57 @@
+package container_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type VolumeResource struct{}
+
+func TestAccVolumeResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"local volume",
+			`
+			resource "synology_container_volume" "foo" {
+				name = "foo"
+
+				labels = {
+					owner = "terraform"
+				}
+			}`,
+		},
+		{
+			"nfs volume",
+			`
+			resource "synology_container_volume" "foo" {
+				name = "foo-nfs"
+
+				driver_opts = {
+					type   = "nfs"
+					o      = "addr=10.0.0.2,rw"
+					device = ":/export/foo"
+				}
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_container_volume.foo", "driver", "local"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type AdminRoleResourceModel struct {
+	Role   types.String `tfsdk:"role"`
+	Users  types.Set    `tfsdk:"users"`
+	Groups types.Set    `tfsdk:"groups"`
+}
+
+func (m AdminRoleResourceModel) delegation(ctx context.Context) (dsm.Delegation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	d := dsm.Delegation{Role: m.Role.ValueString()}
+	if !m.Users.IsNull() {
+		diags.Append(m.Users.ElementsAs(ctx, &d.Users, false)...)
+	}
+	if !m.Groups.IsNull() {
+		diags.Append(m.Groups.ElementsAs(ctx, &d.Groups, false)...)
+	}
+
+	return d, diags
+}
+
+// set updates m from d. Empty lists are kept null so that unset attributes
+// do not show a diff.
+func (m *AdminRoleResourceModel) set(ctx context.Context, d dsm.Delegation) (diags diag.Diagnostics) {
+	var ds diag.Diagnostics
+
+	m.Users = types.SetNull(types.StringType)
+	if len(d.Users) > 0 {
+		m.Users, ds = types.SetValueFrom(ctx, types.StringType, d.Users)
+		diags.Append(ds...)
+	}
+	m.Groups = types.SetNull(types.StringType)
+	if len(d.Groups) > 0 {
+		m.Groups, ds = types.SetValueFrom(ctx, types.StringType, d.Groups)
+		diags.Append(ds...)
+	}
+	return
+}
+
+var (
+	_ resource.Resource             = &AdminRoleResource{}
+	_ resource.ResourceWithIdentity = &AdminRoleResource{}
+)
+
+func NewAdminRoleResource() resource.Resource {
+	return &AdminRoleResource{}
+}
+
+type AdminRoleResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *AdminRoleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data AdminRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "role", data.Role.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *AdminRoleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data AdminRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "role", data.Role.ValueString())...)
+}
+
+// Delete implements resource.Resource. The role is revoked from every user
+// and group.
+func (p *AdminRoleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data AdminRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DelegationSet(ctx, dsm.Delegation{Role: data.Role.ValueString()}); err != nil {
+		resp.Diagnostics.AddError("Failed to revoke administration role", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *AdminRoleResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "admin_role")
+}
+
+// Read implements resource.Resource.
+func (p *AdminRoleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data AdminRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d, err := p.client.DelegationGet(ctx, data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get administration role", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *d)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "role", data.Role.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *AdminRoleResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages who a delegated administration role of DSM 7.2 or later is granted to, as in Control Panel > User & Group > Delegation. Delegated administrators get the rights of the role without joining the administrators group. Users and groups which are not listed lose the role.",
+
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role. One of `user_management` to manage users and groups, `share_management` to manage shared folders, or `system_monitoring` to view system status, logs and connections.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						dsm.DelegationUserManagement,
+						dsm.DelegationShareManagement,
+						dsm.DelegationSystemMonitoring,
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "The local users the role is granted to.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "The local groups whose members the role is granted to.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (p *AdminRoleResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *AdminRoleResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	role, diags := util.ImportID(ctx, req, "role")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d, err := p.client.DelegationGet(ctx, role)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get administration role", err.Error())
+		return
+	}
+
+	data := AdminRoleResourceModel{Role: types.StringValue(role)}
+	resp.Diagnostics.Append(data.set(ctx, *d)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "role", role)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *AdminRoleResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("role", "The delegated administration role.")
+}
+
+func (p *AdminRoleResource) apply(ctx context.Context, data AdminRoleResourceModel) diag.Diagnostics {
+	d, diags := data.delegation(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := p.client.DelegationSet(ctx, d); err != nil {
+		diags.AddError("Failed to set administration role", err.Error())
+	}
+	return diags
+}
@@ -0,0 +1,41 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type AdminRoleResource struct{}
+
+func TestAccAdminRoleResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"role is granted to a user",
+			`
+			resource "synology_core_admin_role" "foo" {
+				role  = "system_monitoring"
+				users = ["terraform"]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_core_admin_role.foo", "users.#", "1"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,431 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// Access of a user or group to an application.
+const (
+	privilegeAllow = "allow"
+	privilegeDeny  = "deny"
+)
+
+type AppPrivilegeResourceModel struct {
+	App      types.String `tfsdk:"app"`
+	Users    types.Map    `tfsdk:"users"`
+	Groups   types.Map    `tfsdk:"groups"`
+	Everyone types.String `tfsdk:"everyone"`
+}
+
+var (
+	_ resource.Resource             = &AppPrivilegeResource{}
+	_ resource.ResourceWithIdentity = &AppPrivilegeResource{}
+)
+
+func NewAppPrivilegeResource() resource.Resource {
+	return &AppPrivilegeResource{}
+}
+
+type AppPrivilegeResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *AppPrivilegeResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data AppPrivilegeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "app", data.App.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *AppPrivilegeResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data AppPrivilegeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "app", data.App.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *AppPrivilegeResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data AppPrivilegeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Users = types.MapNull(types.StringType)
+	data.Groups = types.MapNull(types.StringType)
+	data.Everyone = types.StringNull()
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *AppPrivilegeResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "app_privilege")
+}
+
+// Read implements resource.Resource.
+func (p *AppPrivilegeResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data AppPrivilegeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "app", data.App.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *AppPrivilegeResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	privilegeValidators := []validator.Map{
+		mapvalidator.ValueStringsAre(stringvalidator.OneOf(privilegeAllow, privilegeDeny)),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete list of application privileges of a DSM application, as in Control Panel > Application Privileges. Users and groups which are not listed lose their explicit rule for the application. Rules apply from every source address.",
+
+		Attributes: map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				MarkdownDescription: "The DSM application ID, e.g. `SYNO.SDS.App.FileStation3.Instance` for File Station or `SYNO.SDS.Drive.Application` for Synology Drive.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.MapAttribute{
+				MarkdownDescription: "Access of local users, keyed by user name. Either `allow` or `deny`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          privilegeValidators,
+			},
+			"groups": schema.MapAttribute{
+				MarkdownDescription: "Access of local groups, keyed by group name. Either `allow` or `deny`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          privilegeValidators,
+			},
+			"everyone": schema.StringAttribute{
+				MarkdownDescription: "The default access of every user without a more specific rule. Either `allow` or `deny`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(privilegeAllow, privilegeDeny),
+				},
+			},
+		},
+	}
+}
+
+func (p *AppPrivilegeResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *AppPrivilegeResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	app, diags := util.ImportID(ctx, req, "app")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := AppPrivilegeResourceModel{
+		App:      types.StringValue(app),
+		Users:    types.MapNull(types.StringType),
+		Groups:   types.MapNull(types.StringType),
+		Everyone: types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "app", app)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *AppPrivilegeResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("app", "The DSM application ID.")
+}
+
+// apply makes the rules of the application match data. Changed and new rules
+// are sent in a single set call and unlisted rules in a single delete call.
+func (p *AppPrivilegeResource) apply(ctx context.Context, data AppPrivilegeResourceModel) (diags diag.Diagnostics) {
+	app := data.App.ValueString()
+
+	want := map[string]map[string]string{
+		dsm.AppPrivilegeEntityUser:     {},
+		dsm.AppPrivilegeEntityGroup:    {},
+		dsm.AppPrivilegeEntityEveryone: {},
+	}
+	for entityType, m := range map[string]types.Map{
+		dsm.AppPrivilegeEntityUser:  data.Users,
+		dsm.AppPrivilegeEntityGroup: data.Groups,
+	} {
+		if !m.IsNull() && !m.IsUnknown() {
+			access := map[string]string{}
+			diags.Append(m.ElementsAs(ctx, &access, false)...)
+			want[entityType] = access
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+	if !data.Everyone.IsNull() && !data.Everyone.IsUnknown() {
+		want[dsm.AppPrivilegeEntityEveryone][""] = data.Everyone.ValueString()
+	}
+
+	current, err := p.client.AppPrivilegeRuleList(ctx, app)
+	if err != nil {
+		diags.AddError("Failed to list application privileges", err.Error())
+		return diags
+	}
+
+	set, stale := diffAppPrivileges(app, current.Rules, want)
+
+	if len(stale) > 0 {
+		if err := p.client.AppPrivilegeRuleDelete(ctx, stale); err != nil {
+			diags.AddError("Failed to delete application privileges", err.Error())
+			return diags
+		}
+	}
+
+	if len(set) > 0 {
+		if err := p.client.AppPrivilegeRuleSet(ctx, set); err != nil {
+			diags.AddError("Failed to set application privileges", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (p *AppPrivilegeResource) read(ctx context.Context, data *AppPrivilegeResourceModel) (diags diag.Diagnostics) {
+	current, err := p.client.AppPrivilegeRuleList(ctx, data.App.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list application privileges", err.Error())
+		return diags
+	}
+
+	users := map[string]string{}
+	groups := map[string]string{}
+	data.Everyone = types.StringNull()
+	for _, r := range current.Rules {
+		access, ok := appPrivilegeAccess(r)
+		if !ok {
+			continue
+		}
+		switch r.EntityType {
+		case dsm.AppPrivilegeEntityUser:
+			users[r.EntityName] = access
+		case dsm.AppPrivilegeEntityGroup:
+			groups[r.EntityName] = access
+		case dsm.AppPrivilegeEntityEveryone:
+			data.Everyone = types.StringValue(access)
+		}
+	}
+
+	for _, e := range []struct {
+		attr   *types.Map
+		access map[string]string
+	}{
+		{&data.Users, users},
+		{&data.Groups, groups},
+	} {
+		// Keep an unset attribute null rather than an empty map.
+		if len(e.access) == 0 && e.attr.IsNull() {
+			continue
+		}
+
+		v, d := types.MapValueFrom(ctx, types.StringType, e.access)
+		diags.Append(d...)
+		*e.attr = v
+	}
+
+	return diags
+}
+
+// appPrivilegeAccess returns the access a rule grants from every address. ok
+// is false for rules restricted to some addresses, which are not managed.
+func appPrivilegeAccess(r dsm.AppPrivilegeRule) (access string, ok bool) {
+	switch {
+	case r.Denied():
+		return privilegeDeny, true
+	case r.Allowed():
+		return privilegeAllow, true
+	default:
+		return "", false
+	}
+}
+
+// diffAppPrivileges returns the rules which must be set and deleted to turn
+// current into want, keyed by entity type and name.
+func diffAppPrivileges(
+	app string,
+	current []dsm.AppPrivilegeRule,
+	want map[string]map[string]string,
+) (set, stale []dsm.AppPrivilegeRule) {
+	seen := map[string]map[string]bool{}
+	for _, r := range current {
+		if seen[r.EntityType] == nil {
+			seen[r.EntityType] = map[string]bool{}
+		}
+		seen[r.EntityType][r.EntityName] = true
+
+		access, ok := want[r.EntityType][r.EntityName]
+		cur, managed := appPrivilegeAccess(r)
+		switch {
+		case !ok:
+			stale = append(stale, dsm.AppPrivilegeRule{
+				EntityType: r.EntityType,
+				EntityName: r.EntityName,
+				AppID:      app,
+			})
+		case !managed || cur != access:
+			set = append(set, newAppPrivilegeRule(app, r.EntityType, r.EntityName, access))
+		}
+	}
+
+	for _, entityType := range []string{
+		dsm.AppPrivilegeEntityUser,
+		dsm.AppPrivilegeEntityGroup,
+		dsm.AppPrivilegeEntityEveryone,
+	} {
+		var added []string
+		for name := range want[entityType] {
+			if !seen[entityType][name] {
+				added = append(added, name)
+			}
+		}
+		sort.Strings(added)
+
+		for _, name := range added {
+			set = append(set, newAppPrivilegeRule(app, entityType, name, want[entityType][name]))
+		}
+	}
+
+	return set, stale
+}
+
+func newAppPrivilegeRule(app, entityType, name, access string) dsm.AppPrivilegeRule {
+	r := dsm.AppPrivilegeRule{
+		EntityType: entityType,
+		EntityName: name,
+		AppID:      app,
+		AllowIP:    []string{},
+		DenyIP:     []string{},
+	}
+	if access == privilegeDeny {
+		r.DenyIP = []string{dsm.AppPrivilegeAnyIP}
+	} else {
+		r.AllowIP = []string{dsm.AppPrivilegeAnyIP}
+	}
+	return r
+}
@@ -0,0 +1,56 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type AppPrivilegeResource struct{}
+
+func TestAccAppPrivilegeResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"users and groups are set",
+			`
+			resource "synology_core_app_privilege" "foo" {
+				app = "SYNO.SDS.App.FileStation3.Instance"
+				users = {
+					admin = "allow"
+					guest = "deny"
+				}
+				groups = {
+					users = "allow"
+				}
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_core_app_privilege.foo",
+								"users.guest",
+								"deny",
+							),
+							r.TestCheckResourceAttr(
+								"synology_core_app_privilege.foo",
+								"groups.users",
+								"allow",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CertificatesDataSource{}
+
+func NewCertificatesDataSource() datasource.DataSource {
+	return &CertificatesDataSource{}
+}
+
+type CertificatesDataSource struct {
+	client *dsm.Client
+}
+
+type CertificateModel struct {
+	ID              types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	CommonName      types.String `tfsdk:"common_name"`
+	SubjectAltNames types.List   `tfsdk:"subject_alt_names"`
+	Issuer          types.String `tfsdk:"issuer"`
+	IsDefault       types.Bool   `tfsdk:"is_default"`
+	ValidFrom       types.String `tfsdk:"valid_from"`
+	Expires         types.String `tfsdk:"expires"`
+	DaysUntilExpiry types.Int64  `tfsdk:"days_until_expiry"`
+}
+
+func (m CertificateModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m CertificateModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.StringType,
+		"description":       types.StringType,
+		"common_name":       types.StringType,
+		"subject_alt_names": types.ListType{ElemType: types.StringType},
+		"issuer":            types.StringType,
+		"is_default":        types.BoolType,
+		"valid_from":        types.StringType,
+		"expires":           types.StringType,
+		"days_until_expiry": types.Int64Type,
+	}
+}
+
+// newCertificateModel converts c. days_until_expiry is negative for expired
+// certificates.
+func newCertificateModel(ctx context.Context, c dsm.Certificate, now time.Time) (CertificateModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	m := CertificateModel{
+		ID:          types.StringValue(c.ID),
+		Description: types.StringValue(c.Description),
+		CommonName:  types.StringValue(c.Subject.CommonName),
+		Issuer:      types.StringValue(c.Issuer.CommonName),
+		IsDefault:   types.BoolValue(c.IsDefault),
+		ValidFrom:   types.StringNull(),
+		Expires:     types.StringNull(),
+	}
+
+	names := c.Subject.SubAltName
+	if names == nil {
+		names = []string{}
+	}
+	v, d := types.ListValueFrom(ctx, types.StringType, names)
+	diags.Append(d...)
+	m.SubjectAltNames = v
+
+	if from, err := c.Starts(); err == nil {
+		m.ValidFrom = types.StringValue(from.UTC().Format(time.RFC3339))
+	}
+
+	expires, err := c.Expires()
+	if err != nil {
+		diags.AddError("Failed to parse certificate expiry", fmt.Sprintf("%s: %s", c.ID, err))
+		return m, diags
+	}
+	m.Expires = types.StringValue(expires.UTC().Format(time.RFC3339))
+	m.DaysUntilExpiry = types.Int64Value(int64(math.Floor(expires.Sub(now).Hours() / 24)))
+
+	return m, diags
+}
+
+type CertificatesDataSourceModel struct {
+	Certificates types.List `tfsdk:"certificates"`
+}
+
+func (d *CertificatesDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "certificates")
+}
+
+func (d *CertificatesDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the certificates installed on the NAS with their expiry.",
+
+		Attributes: map[string]schema.Attribute{
+			"certificates": schema.ListNestedAttribute{
+				MarkdownDescription: "The certificates.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the certificate.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the certificate.",
+							Computed:            true,
+						},
+						"common_name": schema.StringAttribute{
+							MarkdownDescription: "The common name of the subject.",
+							Computed:            true,
+						},
+						"subject_alt_names": schema.ListAttribute{
+							MarkdownDescription: "The subject alternative names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"issuer": schema.StringAttribute{
+							MarkdownDescription: "The common name of the issuer.",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Whether the certificate is the default certificate of the NAS.",
+							Computed:            true,
+						},
+						"valid_from": schema.StringAttribute{
+							MarkdownDescription: "The start of the validity period in RFC 3339 format.",
+							Computed:            true,
+						},
+						"expires": schema.StringAttribute{
+							MarkdownDescription: "The end of the validity period in RFC 3339 format.",
+							Computed:            true,
+						},
+						"days_until_expiry": schema.Int64Attribute{
+							MarkdownDescription: "The number of whole days until the certificate expires, negative once it has expired.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CertificatesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data CertificatesDataSourceModel
+
+	list, err := d.client.CertificateList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to list certificates, got error: %s", err),
+		)
+		return
+	}
+
+	now := time.Now()
+	certificates := []CertificateModel{}
+	for _, c := range list.Certificates {
+		m, diags := newCertificateModel(ctx, c, now)
+		resp.Diagnostics.Append(diags...)
+		certificates = append(certificates, m)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v, diags := types.ListValueFrom(ctx, CertificateModel{}.ModelType(), certificates)
+	resp.Diagnostics.Append(diags...)
+	data.Certificates = v
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *CertificatesDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = dsm.New(client)
+}
@@ -0,0 +1,288 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api/filestation"
+	"github.com/synology-community/go-synology/pkg/util/form"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type ConfigBackupResourceModel struct {
+	NASPath      types.String `tfsdk:"nas_path"`
+	LocalPath    types.String `tfsdk:"local_path"`
+	C2AutoBackup types.Bool   `tfsdk:"c2_auto_backup"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+	FileName     types.String `tfsdk:"file_name"`
+}
+
+var (
+	_ resource.Resource = &ConfigBackupResource{}
+)
+
+func NewConfigBackupResource() resource.Resource {
+	return &ConfigBackupResource{}
+}
+
+type ConfigBackupResource struct {
+	client *dsm.Client
+	files  filestation.Api
+}
+
+// Create implements resource.Resource.
+func (p *ConfigBackupResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ConfigBackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.setAutoBackup(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.FileName = types.StringNull()
+	if !data.NASPath.IsNull() || !data.LocalPath.IsNull() {
+		file, diags := p.export(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.FileName = types.StringValue(file.Name)
+
+		if !data.NASPath.IsNull() {
+			if _, err := p.files.Upload(ctx, data.NASPath.ValueString(), *file, true, true); err != nil {
+				resp.Diagnostics.AddError("Failed to upload configuration backup", err.Error())
+				return
+			}
+		}
+
+		if !data.LocalPath.IsNull() {
+			local := data.LocalPath.ValueString()
+			if err := os.MkdirAll(filepath.Dir(local), 0o700); err != nil {
+				resp.Diagnostics.AddError("Failed to write configuration backup", err.Error())
+				return
+			}
+			if err := os.WriteFile(local, []byte(file.Content), 0o600); err != nil {
+				resp.Diagnostics.AddError("Failed to write configuration backup", err.Error())
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource. Only c2_auto_backup changes in place.
+func (p *ConfigBackupResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ConfigBackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.setAutoBackup(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. Exported files and the automatic
+// backup setting are left in place.
+func (p *ConfigBackupResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+}
+
+// Metadata implements resource.Resource.
+func (p *ConfigBackupResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "config_backup")
+}
+
+// Read implements resource.Resource.
+func (p *ConfigBackupResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ConfigBackupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.C2AutoBackup.IsNull() {
+		settings, err := p.client.ConfigAutoBackupGet(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get automatic configuration backup", err.Error())
+			return
+		}
+		data.C2AutoBackup = types.BoolValue(settings.Enabled)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *ConfigBackupResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	replace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports the DSM configuration as a `.dss` file when created, storing it on the NAS and/or next to Terraform. Change `triggers`, for instance with a `time_rotating` resource, to export again on a schedule. Destroying the resource leaves the exported files in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"nas_path": schema.StringAttribute{
+				MarkdownDescription: "The File Station folder the `.dss` file is stored in, e.g. `/backup/dsm`. It is created if missing.",
+				Optional:            true,
+				PlanModifiers:       replace,
+			},
+			"local_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the `.dss` file written on the machine running Terraform.",
+				Optional:            true,
+				PlanModifiers:       replace,
+			},
+			"c2_auto_backup": schema.BoolAttribute{
+				MarkdownDescription: "Whether DSM backs up its configuration to the Synology account of the NAS whenever it changes. Left unchanged when unset.",
+				Optional:            true,
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which export the configuration again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the exported `.dss` file.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (p *ConfigBackupResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.files = client.FileStationAPI()
+}
+
+func (p *ConfigBackupResource) setAutoBackup(ctx context.Context, data ConfigBackupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.C2AutoBackup.IsNull() {
+		return diags
+	}
+
+	if err := p.client.ConfigAutoBackupSet(ctx, dsm.ConfigAutoBackup{Enabled: data.C2AutoBackup.ValueBool()}); err != nil {
+		diags.AddError("Failed to set automatic configuration backup", err.Error())
+	}
+
+	return diags
+}
+
+// export runs a configuration export and downloads the resulting file.
+func (p *ConfigBackupResource) export(ctx context.Context) (*form.File, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	id, err := p.client.ConfigBackupStart(ctx)
+	if err != nil {
+		diags.AddError("Failed to start configuration backup", err.Error())
+		return nil, diags
+	}
+
+	var status *dsm.ConfigBackupStatusResponse
+	err = util.Poll(ctx, 2*time.Second, func() (bool, error) {
+		s, err := p.client.ConfigBackupStatus(ctx, id)
+		status = s
+		return err == nil && s.Finished, err
+	})
+	if err != nil {
+		diags.AddError("Failed to wait for configuration backup", err.Error())
+		return nil, diags
+	}
+	if !status.Success {
+		diags.AddError("Configuration backup failed", "DSM could not export its configuration.")
+		return nil, diags
+	}
+
+	file, err := p.client.ConfigBackupDownload(ctx, id)
+	if err != nil {
+		diags.AddError("Failed to download configuration backup", err.Error())
+		return nil, diags
+	}
+	file.Name = path.Base(status.FileName)
+	if status.FileName == "" {
+		file.Name = fmt.Sprintf("config_%s.dss", time.Now().Format("20060102_150405"))
+	}
+
+	return file, diags
+}
@@ -0,0 +1,40 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ConfigBackupResource struct{}
+
+func TestAccConfigBackupResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"configuration is stored on the NAS",
+			`
+			resource "synology_core_config_backup" "foo" {
+				nas_path = "/docker/config-backup"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_core_config_backup.foo", "file_name"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
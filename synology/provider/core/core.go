@@ -15,11 +15,47 @@ func Resources() []func() resource.Resource {
 		NewPackageFeedResource,
 		NewTaskResource,
 		NewEventResource,
+		NewSharePermissionsResource,
+		NewFirewallRuleResource,
+		NewFirewallRulesetResource,
+		NewReverseProxyRuleResource,
+		NewReverseProxyRulesetResource,
+		NewNFSRuleResource,
+		NewNFSRulesetResource,
+		NewAppPrivilegeResource,
+		NewLoginStyleResource,
+		NewSSOClientResource,
+		NewPasswordPolicyResource,
+		NewSecuritySettingsResource,
+		NewConfigBackupResource,
+		NewRebootResource,
+		NewLetsEncryptCertificateResource,
+		NewNotificationWebhookResource,
+		NewPerformanceAlarmResource,
+		NewVPNClientProfileResource,
+		NewSharedFolderSyncTaskResource,
+		NewShareSettingsResource,
+		NewVolumeDeduplicationResource,
+		NewPackageCenterSettingsResource,
+		NewSynologyAccountResource,
+		NewUserResource,
+		NewGroupMembershipResource,
+		NewGroupMembersResource,
+		NewAdminRoleResource,
+		NewServiceAccessResource,
 	}
 }
 
 func DataSources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		// NewPackagesDataSource,
+		NewCertificatesDataSource,
+		NewExternalAccessDataSource,
+		NewHardwareDataSource,
+		NewHealthDataSource,
+		NewTaskResultsDataSource,
+		NewPingDataSource,
+		NewShareConsumersDataSource,
+		NewUserPasswordAgesDataSource,
 	}
 }
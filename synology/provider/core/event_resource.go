@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/synology-community/go-synology"
 	"github.com/synology-community/go-synology/pkg/api/core"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
 )
 
 type EventResourceModel struct {
@@ -28,7 +30,10 @@ type EventResourceModel struct {
 	When types.String `tfsdk:"when"`
 }
 
-var _ resource.Resource = &EventResource{}
+var (
+	_ resource.Resource             = &EventResource{}
+	_ resource.ResourceWithIdentity = &EventResource{}
+)
 
 func NewEventResource() resource.Resource {
 	return &EventResource{}
@@ -55,7 +60,11 @@ func (p *EventResource) Create(
 		return
 	}
 
-	eventReq := getEventRequest(data)
+	eventReq, err := p.getEventRequest(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find user", err.Error())
+		return
+	}
 
 	var eventCreate func(ctx context.Context, req core.EventRequest) (*core.EventResult, error)
 
@@ -73,7 +82,7 @@ func (p *EventResource) Create(
 		eventCreate = p.client.EventCreate
 	}
 
-	_, err := eventCreate(ctx, eventReq)
+	_, err = eventCreate(ctx, eventReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Event install failed", err.Error())
 		return
@@ -87,6 +96,7 @@ func (p *EventResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -115,7 +125,11 @@ func (p *EventResource) Update(
 		return
 	}
 
-	eventReq := getEventRequest(plan)
+	eventReq, err := p.getEventRequest(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find user", err.Error())
+		return
+	}
 
 	var eventUpdate func(ctx context.Context, req core.EventRequest) (*core.EventResult, error)
 
@@ -133,7 +147,7 @@ func (p *EventResource) Update(
 		eventUpdate = p.client.EventUpdate
 	}
 
-	_, err := eventUpdate(ctx, eventReq)
+	_, err = eventUpdate(ctx, eventReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Event install failed", err.Error())
 		return
@@ -149,6 +163,7 @@ func (p *EventResource) Update(
 
 	if plan.Name.ValueString() != state.Name.ValueString() {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), plan.Name)...)
+		resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", plan.Name.ValueString())...)
 	}
 
 	if plan.Run.ValueBool() && plan.When.ValueString() == "upgrade" {
@@ -203,6 +218,7 @@ func (p *EventResource) Metadata(
 	resp *resource.MetadataResponse,
 ) {
 	resp.TypeName = buildName(req.ProviderTypeName, "event")
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Read implements resource.Resource.
@@ -220,15 +236,17 @@ func (p *EventResource) Read(
 	event, err := p.client.EventGet(ctx, data.Name.ValueString())
 	if err != nil {
 		resp.State.RemoveResource(ctx)
+		return
 	}
 
 	data.Name = types.StringValue(event.Name)
 	data.Script = types.StringValue(event.Operation)
-	data.User = types.StringValue(event.Owner["0"])
+	data.User = types.StringValue(eventOwner(event.Owner))
 	data.Event = types.StringValue(event.Event)
 	data.When = types.StringValue("apply")
 
 	// resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", event.Name)...)
 }
 
 // Schema implements resource.Resource.
@@ -250,13 +268,13 @@ func (p *EventResource) Schema(
 				Required:            true,
 			},
 			"user": schema.StringAttribute{
-				MarkdownDescription: "The user that will execute the event.",
+				MarkdownDescription: "The user that will execute the event. Scripts of other users than `root` run without root privileges.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("root"),
 			},
 			"event": schema.StringAttribute{
-				MarkdownDescription: "Event trigger to run script. One of `bootup` or `shutdown`",
+				MarkdownDescription: "Event trigger to run script. One of `bootup` or `shutdown`.",
 				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("bootup", "shutdown"),
@@ -307,6 +325,11 @@ func (f *EventResource) Configure(
 		return
 	}
 
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
 	f.client = client.CoreAPI()
 }
 
@@ -315,7 +338,11 @@ func (p *EventResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	id := req.ID
+	id, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	event, err := p.client.EventGet(ctx, id)
 	if err != nil {
@@ -326,27 +353,57 @@ func (p *EventResource) ImportState(
 	result := EventResourceModel{
 		Name:   types.StringValue(event.Name),
 		Script: types.StringValue(event.Operation),
-		User:   types.StringValue(event.Owner["0"]),
+		User:   types.StringValue(eventOwner(event.Owner)),
 		Event:  types.StringValue(event.Event),
 		Run:    types.BoolValue(false),
 		When:   types.StringValue("apply"),
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", event.Name)...)
 }
 
-func getEventRequest(data EventResourceModel) core.EventRequest {
-	event := "bootup"
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *EventResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the event.")
+}
+
+// getEventRequest returns the request for the event configured in data. DSM
+// keys the owner of an event by the UID of the user.
+func (p *EventResource) getEventRequest(ctx context.Context, data EventResourceModel) (core.EventRequest, error) {
+	user := data.User.ValueString()
+
+	uid := "0"
+	if user != "root" {
+		users, err := p.client.UserList(ctx)
+		if err != nil {
+			return core.EventRequest{}, err
+		}
 
-	if !data.Script.IsNull() && !data.Script.IsUnknown() && data.Script.ValueString() != "" {
-		event = "bootup"
+		uid = ""
+		for _, u := range users.Users {
+			if u.Name == user {
+				uid = u.ID
+				break
+			}
+		}
+		if uid == "" {
+			return core.EventRequest{}, fmt.Errorf("user %q not found", user)
+		}
 	}
 
-	user := data.User.ValueString()
+	event := "bootup"
+	if !data.Event.IsNull() && !data.Event.IsUnknown() && data.Event.ValueString() != "" {
+		event = data.Event.ValueString()
+	}
 
 	return core.EventRequest{
 		Name:               data.Name.ValueString(),
-		Owner:              map[string]string{"0": user},
+		Owner:              map[string]string{uid: user},
 		Operation:          data.Script.ValueString(),
 		OperationType:      "script",
 		Event:              event,
@@ -355,5 +412,13 @@ func getEventRequest(data EventResourceModel) core.EventRequest {
 		NotifyIfError:      false,
 		NotifyMail:         "",
 		SynoConfirmPWToken: "",
+	}, nil
+}
+
+// eventOwner returns the name of the user owning an event.
+func eventOwner(owner core.EventOwner) string {
+	for _, user := range owner {
+		return user
 	}
+	return ""
 }
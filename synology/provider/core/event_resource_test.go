@@ -28,6 +28,17 @@ func TestAccEventResource_basic(t *testing.T) {
 				when = "apply"
 			}`,
 		},
+		{
+			"shutdown as user",
+			`
+			resource "synology_core_event" "test" {
+				name = "Test Shutdown"
+
+				script = "echo 'Goodbye, World!'"
+				user   = "terraform"
+				event  = "shutdown"
+			}`,
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.Name, func(t *testing.T) {
@@ -0,0 +1,228 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ExternalAccessDataSource{}
+
+func NewExternalAccessDataSource() datasource.DataSource {
+	return &ExternalAccessDataSource{}
+}
+
+type ExternalAccessDataSource struct {
+	client *dsm.Client
+}
+
+type ExternalServiceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Protocol    types.String `tfsdk:"protocol"`
+	NASPorts    types.String `tfsdk:"nas_ports"`
+	RouterPorts types.String `tfsdk:"router_ports"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Reachable   types.Bool   `tfsdk:"reachable"`
+}
+
+func (m ExternalServiceModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m ExternalServiceModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":         types.StringType,
+		"protocol":     types.StringType,
+		"nas_ports":    types.StringType,
+		"router_ports": types.StringType,
+		"enabled":      types.BoolType,
+		"reachable":    types.BoolType,
+	}
+}
+
+type ExternalAccessDataSourceModel struct {
+	ExternalIP   types.String `tfsdk:"external_ip"`
+	ExternalIPv6 types.String `tfsdk:"external_ipv6"`
+	RouterBrand  types.String `tfsdk:"router_brand"`
+	RouterModel  types.String `tfsdk:"router_model"`
+	UPnPEnabled  types.Bool   `tfsdk:"upnp_enabled"`
+	Services     types.List   `tfsdk:"services"`
+}
+
+func (d *ExternalAccessDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "external_access")
+}
+
+func (d *ExternalAccessDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the public address of the NAS, the router set up under **Control Panel** > **External Access** > **Router Configuration** and the DSM services forwarded on it.",
+
+		Attributes: map[string]schema.Attribute{
+			"external_ip": schema.StringAttribute{
+				MarkdownDescription: "The public IPv4 address of the NAS, null when it cannot be detected.",
+				Computed:            true,
+			},
+			"external_ipv6": schema.StringAttribute{
+				MarkdownDescription: "The public IPv6 address of the NAS, null when it cannot be detected.",
+				Computed:            true,
+			},
+			"router_brand": schema.StringAttribute{
+				MarkdownDescription: "The brand of the router, null when no router is set up.",
+				Computed:            true,
+			},
+			"router_model": schema.StringAttribute{
+				MarkdownDescription: "The model of the router, null when no router is set up.",
+				Computed:            true,
+			},
+			"upnp_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the NAS forwards ports on the router with UPnP.",
+				Computed:            true,
+			},
+			"services": schema.ListNestedAttribute{
+				MarkdownDescription: "The services forwarded on the router.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the service.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "The forwarded protocol, e.g. `tcp`.",
+							Computed:            true,
+						},
+						"nas_ports": schema.StringAttribute{
+							MarkdownDescription: "The ports of the service on the NAS, e.g. `5000,5001`.",
+							Computed:            true,
+						},
+						"router_ports": schema.StringAttribute{
+							MarkdownDescription: "The ports opened on the router.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the port forwarding rule is enabled.",
+							Computed:            true,
+						},
+						"reachable": schema.BoolAttribute{
+							MarkdownDescription: "Whether the last connection test of DSM reached the service from outside.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ExternalAccessDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	data := ExternalAccessDataSourceModel{
+		ExternalIP:   types.StringNull(),
+		ExternalIPv6: types.StringNull(),
+		RouterBrand:  types.StringNull(),
+		RouterModel:  types.StringNull(),
+	}
+
+	ips, err := d.client.DDNSExternalIPList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to detect the external address, got error: %s", err),
+		)
+		return
+	}
+	for _, ip := range ips {
+		if data.ExternalIP.IsNull() && ip.IP != "" && ip.IP != "0.0.0.0" {
+			data.ExternalIP = types.StringValue(ip.IP)
+		}
+		if data.ExternalIPv6.IsNull() && ip.IPv6 != "" && ip.IPv6 != "::" {
+			data.ExternalIPv6 = types.StringValue(ip.IPv6)
+		}
+	}
+
+	router, err := d.client.PortForwardingRouterInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to get the router configuration, got error: %s", err),
+		)
+		return
+	}
+	if router.Model != "" {
+		data.RouterBrand = types.StringValue(router.Brand)
+		data.RouterModel = types.StringValue(router.Model)
+	}
+	data.UPnPEnabled = types.BoolValue(router.UPnPSupported && router.UPnPEnabled)
+
+	rules, err := d.client.PortForwardingRuleList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to list port forwarding rules, got error: %s", err),
+		)
+		return
+	}
+
+	services := []ExternalServiceModel{}
+	for _, r := range rules.Rules {
+		services = append(services, ExternalServiceModel{
+			Name:        types.StringValue(r.Service),
+			Protocol:    types.StringValue(r.Protocol),
+			NASPorts:    types.StringValue(r.LocalPort),
+			RouterPorts: types.StringValue(r.RouterPort),
+			Enabled:     types.BoolValue(r.Enable),
+			Reachable:   types.BoolValue(r.Enable && r.Status == "ok"),
+		})
+	}
+
+	v, diags := types.ListValueFrom(ctx, ExternalServiceModel{}.ModelType(), services)
+	resp.Diagnostics.Append(diags...)
+	data.Services = v
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ExternalAccessDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = dsm.New(client)
+}
@@ -0,0 +1,557 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type FirewallRuleModel struct {
+	Name     types.String `tfsdk:"name"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Policy   types.String `tfsdk:"policy"`
+	Protocol types.String `tfsdk:"protocol"`
+	Ports    types.String `tfsdk:"ports"`
+	SourceIP types.String `tfsdk:"source_ip"`
+}
+
+func (m FirewallRuleModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m FirewallRuleModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":      types.StringType,
+		"enabled":   types.BoolType,
+		"policy":    types.StringType,
+		"protocol":  types.StringType,
+		"ports":     types.StringType,
+		"source_ip": types.StringType,
+	}
+}
+
+func (m FirewallRuleModel) rule() dsm.FirewallRule {
+	return dsm.FirewallRule{
+		Name:     m.Name.ValueString(),
+		Enabled:  m.Enabled.ValueBool(),
+		Policy:   m.Policy.ValueString(),
+		Protocol: m.Protocol.ValueString(),
+		Ports:    m.Ports.ValueString(),
+		SourceIP: m.SourceIP.ValueString(),
+	}
+}
+
+func newFirewallRuleModel(r dsm.FirewallRule) FirewallRuleModel {
+	return FirewallRuleModel{
+		Name:     types.StringValue(r.Name),
+		Enabled:  types.BoolValue(r.Enabled),
+		Policy:   types.StringValue(r.Policy),
+		Protocol: types.StringValue(r.Protocol),
+		Ports:    types.StringValue(r.Ports),
+		SourceIP: types.StringValue(r.SourceIP),
+	}
+}
+
+// firewallRuleAttributes returns the attributes of a single firewall rule,
+// shared by the rule and ruleset resources.
+func firewallRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the rule, unique within the adapter.",
+			Required:            true,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the rule is enabled.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"policy": schema.StringAttribute{
+			MarkdownDescription: "Action for matching traffic. One of `allow` or `deny`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("allow"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("allow", "deny"),
+			},
+		},
+		"protocol": schema.StringAttribute{
+			MarkdownDescription: "Protocol of matching traffic. One of `all`, `tcp` or `udp`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("all"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("all", "tcp", "udp"),
+			},
+		},
+		"ports": schema.StringAttribute{
+			MarkdownDescription: "Comma separated destination ports or port ranges, or `all`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("all"),
+		},
+		"source_ip": schema.StringAttribute{
+			MarkdownDescription: "Source IP address, subnet or range, or `all`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("all"),
+		},
+	}
+}
+
+// firewallSelfCheck warns when rules block the provider itself: when the
+// first enabled rule matching the address and port the provider reaches DSM
+// with denies the traffic.
+func firewallSelfCheck(ctx context.Context, u *url.URL, rules []dsm.FirewallRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if u == nil {
+		return diags
+	}
+
+	ip, port, err := util.SourceAddr(ctx, u)
+	if err != nil {
+		return diags
+	}
+
+	for _, r := range rules {
+		if !r.Enabled || r.Protocol == "udp" ||
+			!util.FirewallMatchesIP(r.SourceIP, ip) || !util.FirewallMatchesPort(r.Ports, port) {
+			continue
+		}
+		if r.Policy == "deny" {
+			diags.AddWarning(
+				"Firewall rule blocks the provider",
+				fmt.Sprintf(
+					"The rule %s denies %s, the address the provider connects from, on port %d. Later runs may be unable to reach the Synology station.",
+					r.Name, ip, port,
+				),
+			)
+		}
+		break
+	}
+
+	return diags
+}
+
+// firewallScopeAttributes returns the attributes selecting the rule list of a
+// firewall profile adapter.
+func firewallScopeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"profile": schema.StringAttribute{
+			MarkdownDescription: "The firewall profile. Defaults to `default`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("default"),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"adapter": schema.StringAttribute{
+			MarkdownDescription: "The network adapter the rules apply to. Defaults to `global`, which applies to all adapters.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("global"),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// parseScopedID splits an import ID of the form `a/b/c` into n parts.
+func parseScopedID(id string, n int, format string) ([]string, error) {
+	parts := strings.SplitN(id, "/", n)
+	if len(parts) != n || slices.Contains(parts, "") {
+		return nil, fmt.Errorf("expected an ID of the form %s, got %q", format, id)
+	}
+	return parts, nil
+}
+
+type FirewallRuleResourceModel struct {
+	Profile  types.String `tfsdk:"profile"`
+	Adapter  types.String `tfsdk:"adapter"`
+	Name     types.String `tfsdk:"name"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Policy   types.String `tfsdk:"policy"`
+	Protocol types.String `tfsdk:"protocol"`
+	Ports    types.String `tfsdk:"ports"`
+	SourceIP types.String `tfsdk:"source_ip"`
+	Position types.Int64  `tfsdk:"position"`
+}
+
+func (m FirewallRuleResourceModel) id() string {
+	return m.Profile.ValueString() + "/" + m.Adapter.ValueString() + "/" + m.Name.ValueString()
+}
+
+func (m FirewallRuleResourceModel) rule() dsm.FirewallRule {
+	return FirewallRuleModel{
+		Name:     m.Name,
+		Enabled:  m.Enabled,
+		Policy:   m.Policy,
+		Protocol: m.Protocol,
+		Ports:    m.Ports,
+		SourceIP: m.SourceIP,
+	}.rule()
+}
+
+func (m *FirewallRuleResourceModel) set(r dsm.FirewallRule) {
+	v := newFirewallRuleModel(r)
+	m.Name = v.Name
+	m.Enabled = v.Enabled
+	m.Policy = v.Policy
+	m.Protocol = v.Protocol
+	m.Ports = v.Ports
+	m.SourceIP = v.SourceIP
+}
+
+// placeFirewallRule puts rule at position in rules, replacing the rule named
+// old if there is one, and returns the new list and the index of the rule.
+// Without a position an existing rule keeps its index and a new rule is
+// appended. Positions past the end append the rule.
+func placeFirewallRule(
+	rules []dsm.FirewallRule,
+	rule dsm.FirewallRule,
+	old string,
+	position types.Int64,
+) ([]dsm.FirewallRule, int) {
+	i := slices.IndexFunc(rules, func(r dsm.FirewallRule) bool {
+		return r.Name == old
+	})
+	if i != -1 {
+		rules = slices.Delete(rules, i, i+1)
+	} else {
+		i = len(rules)
+	}
+
+	if !position.IsNull() && !position.IsUnknown() {
+		i = int(min(position.ValueInt64(), int64(len(rules))))
+	}
+
+	return slices.Insert(rules, i, rule), i
+}
+
+// firewallRulePosition returns the position to record for a rule found at
+// index out of n rules. A requested position past the end of the list is
+// kept as long as the rule is last, so it does not cause a permanent diff.
+func firewallRulePosition(requested types.Int64, index int, n int) types.Int64 {
+	if !requested.IsNull() && !requested.IsUnknown() &&
+		requested.ValueInt64() > int64(index) && index == n-1 {
+		return requested
+	}
+	return types.Int64Value(int64(index))
+}
+
+var (
+	_ resource.Resource               = &FirewallRuleResource{}
+	_ resource.ResourceWithIdentity   = &FirewallRuleResource{}
+	_ resource.ResourceWithModifyPlan = &FirewallRuleResource{}
+)
+
+func NewFirewallRuleResource() resource.Resource {
+	return &FirewallRuleResource{}
+}
+
+type FirewallRuleResource struct {
+	client *dsm.Client
+	url    *url.URL
+}
+
+// Create implements resource.Resource.
+func (p *FirewallRuleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data FirewallRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, adapter := data.Profile.ValueString(), data.Adapter.ValueString()
+
+	current, err := p.client.FirewallRulesGet(ctx, profile, adapter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get firewall rules", err.Error())
+		return
+	}
+
+	if slices.ContainsFunc(current.Rules, func(r dsm.FirewallRule) bool {
+		return r.Name == data.Name.ValueString()
+	}) {
+		resp.Diagnostics.AddError(
+			"Firewall rule already exists",
+			fmt.Sprintf("A rule named %s already exists, import it instead.", data.id()),
+		)
+		return
+	}
+
+	rules, i := placeFirewallRule(current.Rules, data.rule(), data.Name.ValueString(), data.Position)
+	if err := p.client.FirewallRulesSet(ctx, profile, adapter, rules); err != nil {
+		resp.Diagnostics.AddError("Failed to set firewall rules", err.Error())
+		return
+	}
+
+	data.Position = firewallRulePosition(data.Position, i, len(rules))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Update implements resource.Resource.
+func (p *FirewallRuleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state FirewallRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, adapter := plan.Profile.ValueString(), plan.Adapter.ValueString()
+
+	current, err := p.client.FirewallRulesGet(ctx, profile, adapter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get firewall rules", err.Error())
+		return
+	}
+
+	rules, i := placeFirewallRule(current.Rules, plan.rule(), state.Name.ValueString(), plan.Position)
+	if err := p.client.FirewallRulesSet(ctx, profile, adapter, rules); err != nil {
+		resp.Diagnostics.AddError("Failed to set firewall rules", err.Error())
+		return
+	}
+
+	plan.Position = firewallRulePosition(plan.Position, i, len(rules))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", plan.id())...)
+}
+
+// Delete implements resource.Resource.
+func (p *FirewallRuleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data FirewallRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, adapter := data.Profile.ValueString(), data.Adapter.ValueString()
+
+	current, err := p.client.FirewallRulesGet(ctx, profile, adapter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get firewall rules", err.Error())
+		return
+	}
+
+	rules := slices.DeleteFunc(current.Rules, func(r dsm.FirewallRule) bool {
+		return r.Name == data.Name.ValueString()
+	})
+
+	if err := p.client.FirewallRulesSet(ctx, profile, adapter, rules); err != nil {
+		resp.Diagnostics.AddError("Failed to set firewall rules", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *FirewallRuleResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "firewall_rule")
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// Read implements resource.Resource.
+func (p *FirewallRuleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data FirewallRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := p.client.FirewallRulesGet(ctx, data.Profile.ValueString(), data.Adapter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get firewall rules", err.Error())
+		return
+	}
+
+	i := slices.IndexFunc(current.Rules, func(r dsm.FirewallRule) bool {
+		return r.Name == data.Name.ValueString()
+	})
+	if i == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(current.Rules[i])
+	data.Position = firewallRulePosition(data.Position, i, len(current.Rules))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Schema implements resource.Resource.
+func (p *FirewallRuleResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	attributes := firewallScopeAttributes()
+	for k, v := range firewallRuleAttributes() {
+		attributes[k] = v
+	}
+	attributes["position"] = schema.Int64Attribute{
+		MarkdownDescription: "Zero based position of the rule in the adapter. DSM applies the first matching rule, so the rule is moved back to this position whenever the order on the NAS differs. Without it new rules are appended and existing rules keep their place.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single firewall rule. Other rules of the profile adapter are left untouched. Do not combine with `synology_core_firewall_ruleset` on the same adapter. Planning warns when the rules deny the address the provider connects from.",
+
+		Attributes: attributes,
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It warns when the
+// rule denies the provider's own connection.
+func (p *FirewallRuleResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FirewallRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(firewallSelfCheck(ctx, p.url, []dsm.FirewallRule{plan.rule()})...)
+}
+
+func (p *FirewallRuleResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.url = client.BaseUrl()
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *FirewallRuleResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := parseScopedID(id, 3, "profile/adapter/name")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	current, err := p.client.FirewallRulesGet(ctx, parts[0], parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get firewall rules", err.Error())
+		return
+	}
+
+	i := slices.IndexFunc(current.Rules, func(r dsm.FirewallRule) bool {
+		return r.Name == parts[2]
+	})
+	if i == -1 {
+		resp.Diagnostics.AddError("Firewall rule not found", fmt.Sprintf("Firewall rule %s not found", id))
+		return
+	}
+
+	data := FirewallRuleResourceModel{
+		Profile:  types.StringValue(parts[0]),
+		Adapter:  types.StringValue(parts[1]),
+		Position: types.Int64Value(int64(i)),
+	}
+	data.set(current.Rules[i])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *FirewallRuleResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The rule in the form `profile/adapter/name`.")
+}
@@ -0,0 +1,63 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type FirewallRuleResource struct{}
+
+func TestAccFirewallRuleResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"rule is appended",
+			`
+			resource "synology_core_firewall_rule" "ssh" {
+				name      = "ssh"
+				protocol  = "tcp"
+				ports     = "22"
+				source_ip = "192.168.1.0/24"
+			}`,
+		},
+		{
+			"rule is placed first",
+			`
+			resource "synology_core_firewall_rule" "ssh" {
+				name      = "ssh"
+				protocol  = "tcp"
+				ports     = "22"
+				source_ip = "192.168.1.0/24"
+				position  = 0
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_core_firewall_rule.ssh",
+								"policy",
+								"allow",
+							),
+							r.TestCheckResourceAttr(
+								"synology_core_firewall_rule.ssh",
+								"adapter",
+								"global",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
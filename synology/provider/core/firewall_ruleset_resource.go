@@ -0,0 +1,353 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type FirewallRulesetResourceModel struct {
+	Profile types.String `tfsdk:"profile"`
+	Adapter types.String `tfsdk:"adapter"`
+	Rules   types.List   `tfsdk:"rules"`
+}
+
+func (m FirewallRulesetResourceModel) id() string {
+	return m.Profile.ValueString() + "/" + m.Adapter.ValueString()
+}
+
+func (m FirewallRulesetResourceModel) rules(ctx context.Context) ([]dsm.FirewallRule, diag.Diagnostics) {
+	var elements []FirewallRuleModel
+	diags := m.Rules.ElementsAs(ctx, &elements, true)
+
+	rules := make([]dsm.FirewallRule, 0, len(elements))
+	for _, e := range elements {
+		rules = append(rules, e.rule())
+	}
+
+	return rules, diags
+}
+
+func (m *FirewallRulesetResourceModel) set(ctx context.Context, rules []dsm.FirewallRule) diag.Diagnostics {
+	elements := make([]FirewallRuleModel, 0, len(rules))
+	for _, r := range rules {
+		elements = append(elements, newFirewallRuleModel(r))
+	}
+
+	v, diags := types.ListValueFrom(ctx, FirewallRuleModel{}.ModelType(), elements)
+	m.Rules = v
+
+	return diags
+}
+
+var (
+	_ resource.Resource               = &FirewallRulesetResource{}
+	_ resource.ResourceWithIdentity   = &FirewallRulesetResource{}
+	_ resource.ResourceWithModifyPlan = &FirewallRulesetResource{}
+)
+
+func NewFirewallRulesetResource() resource.Resource {
+	return &FirewallRulesetResource{}
+}
+
+type FirewallRulesetResource struct {
+	client *dsm.Client
+	url    *url.URL
+}
+
+// Create implements resource.Resource.
+func (p *FirewallRulesetResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data FirewallRulesetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Update implements resource.Resource.
+func (p *FirewallRulesetResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data FirewallRulesetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Delete implements resource.Resource.
+func (p *FirewallRulesetResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data FirewallRulesetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := p.client.FirewallRulesSet(ctx, data.Profile.ValueString(), data.Adapter.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to set firewall rules", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *FirewallRulesetResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "firewall_ruleset")
+}
+
+// Read implements resource.Resource.
+func (p *FirewallRulesetResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data FirewallRulesetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := p.client.FirewallRulesGet(ctx, data.Profile.ValueString(), data.Adapter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get firewall rules", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, current.Rules)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Schema implements resource.Resource.
+func (p *FirewallRulesetResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	attributes := firewallScopeAttributes()
+	attributes["rules"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The rules of the adapter, in the order they are evaluated.",
+		Required:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: firewallRuleAttributes(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete, ordered list of firewall rules of a profile adapter. Rules which are not listed are removed from the NAS. Planning warns when the rules deny the address the provider connects from and lists the rule changes against the NAS.",
+
+		Attributes: attributes,
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It warns when the
+// rules deny the provider's own connection and lists the rule changes
+// against the rules currently on the NAS.
+func (p *FirewallRulesetResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FirewallRulesetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Rules.IsUnknown() {
+		return
+	}
+
+	rules, diags := plan.rules(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(firewallSelfCheck(ctx, p.url, rules)...)
+
+	if p.client == nil || plan.Profile.IsUnknown() || plan.Adapter.IsUnknown() {
+		return
+	}
+
+	current, err := p.client.FirewallRulesGet(ctx, plan.Profile.ValueString(), plan.Adapter.ValueString())
+	if err != nil {
+		return
+	}
+
+	var planned []FirewallRuleModel
+	resp.Diagnostics.Append(plan.Rules.ElementsAs(ctx, &planned, true)...)
+	resp.Diagnostics.Append(util.PlanPreview(firewallRulesDiff(current.Rules, planned))...)
+}
+
+func (p *FirewallRulesetResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.url = client.BaseUrl()
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *FirewallRulesetResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := parseScopedID(id, 2, "profile/adapter")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	current, err := p.client.FirewallRulesGet(ctx, parts[0], parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get firewall rules", err.Error())
+		return
+	}
+
+	data := FirewallRulesetResourceModel{
+		Profile: types.StringValue(parts[0]),
+		Adapter: types.StringValue(parts[1]),
+	}
+	resp.Diagnostics.Append(data.set(ctx, current.Rules)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *FirewallRulesetResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The adapter in the form `profile/adapter`.")
+}
+
+func (p *FirewallRulesetResource) apply(ctx context.Context, data FirewallRulesetResourceModel) diag.Diagnostics {
+	rules, diags := data.rules(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := p.client.FirewallRulesSet(ctx, data.Profile.ValueString(), data.Adapter.ValueString(), rules); err != nil {
+		diags.AddError("Failed to set firewall rules", err.Error())
+	}
+
+	return diags
+}
+
+// firewallRulesDiff returns the changes from the current rules to the
+// planned ones, matched by name.
+func firewallRulesDiff(current []dsm.FirewallRule, planned []FirewallRuleModel) []string {
+	var lines []string
+
+	existing := map[string]FirewallRuleModel{}
+	for _, r := range current {
+		existing[r.Name] = newFirewallRuleModel(r)
+	}
+
+	wanted := map[string]bool{}
+	var after []string
+	for _, r := range planned {
+		name := r.Name.ValueString()
+		wanted[name] = true
+
+		cur, ok := existing[name]
+		if !ok {
+			lines = append(lines, fmt.Sprintf("rules[%q]: added", name))
+			continue
+		}
+		after = append(after, name)
+		lines = append(lines, util.ModelDiff(fmt.Sprintf("rules[%q].", name), cur, r)...)
+	}
+
+	var before []string
+	for _, r := range current {
+		if !wanted[r.Name] {
+			lines = append(lines, fmt.Sprintf("rules[%q]: removed", r.Name))
+			continue
+		}
+		before = append(before, r.Name)
+	}
+
+	if !slices.Equal(before, after) {
+		lines = append(lines, fmt.Sprintf("order: %s -> %s", strings.Join(before, ", "), strings.Join(after, ", ")))
+	}
+
+	return lines
+}
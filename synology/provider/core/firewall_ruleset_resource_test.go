@@ -0,0 +1,58 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type FirewallRulesetResource struct{}
+
+func TestAccFirewallRulesetResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"rules are set in order",
+			`
+			resource "synology_core_firewall_ruleset" "global" {
+				rules = [
+					{
+						name      = "lan"
+						source_ip = "192.168.1.0/24"
+					},
+					{
+						name   = "deny-all"
+						policy = "deny"
+					},
+				]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_core_firewall_ruleset.global",
+								"rules.#",
+								"2",
+							),
+							r.TestCheckResourceAttr(
+								"synology_core_firewall_ruleset.global",
+								"rules.1.policy",
+								"deny",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
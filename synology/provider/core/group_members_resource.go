@@ -0,0 +1,313 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type GroupMembersResourceModel struct {
+	Group   types.String `tfsdk:"group"`
+	Members types.Set    `tfsdk:"members"`
+}
+
+var (
+	_ resource.Resource             = &GroupMembersResource{}
+	_ resource.ResourceWithIdentity = &GroupMembersResource{}
+)
+
+func NewGroupMembersResource() resource.Resource {
+	return &GroupMembersResource{}
+}
+
+type GroupMembersResource struct {
+	client *dsm.Client
+	// self is the account the provider is logged in as.
+	self string
+}
+
+// Create implements resource.Resource.
+func (p *GroupMembersResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data GroupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "group", data.Group.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *GroupMembersResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data GroupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "group", data.Group.ValueString())...)
+}
+
+// Delete implements resource.Resource. The members listed in the state are
+// removed from the group, the group and the users are kept.
+func (p *GroupMembersResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data GroupMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members []string
+	resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkSelf(data.Group.ValueString(), members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(members) > 0 {
+		if err := p.client.GroupMemberRemove(ctx, data.Group.ValueString(), members...); err != nil {
+			resp.Diagnostics.AddError("Failed to remove group members", err.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *GroupMembersResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "group_members")
+}
+
+// Read implements resource.Resource.
+func (p *GroupMembersResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data GroupMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "group", data.Group.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *GroupMembersResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete list of members of a local group: users which are not listed are removed from it. Use `synology_core_group_membership` instead to add single users to a group which is managed elsewhere.",
+
+		Attributes: map[string]schema.Attribute{
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "The names of the users in the group. An empty set removes every member.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (p *GroupMembersResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.self = synoclient.UserOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *GroupMembersResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	group, diags := util.ImportID(ctx, req, "group")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := GroupMembersResourceModel{Group: types.StringValue(group)}
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "group", group)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *GroupMembersResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("group", "The name of the group.")
+}
+
+// apply adds the missing members of data to the group and removes the others.
+func (p *GroupMembersResource) apply(ctx context.Context, data GroupMembersResourceModel) (diags diag.Diagnostics) {
+	var want []string
+	diags.Append(data.Members.ElementsAs(ctx, &want, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	group := data.Group.ValueString()
+	current, err := p.client.GroupMemberList(ctx, group)
+	if err != nil {
+		diags.AddError("Failed to list group members", err.Error())
+		return
+	}
+
+	var add, remove []string
+	for _, m := range want {
+		if !slices.Contains(current, m) {
+			add = append(add, m)
+		}
+	}
+	for _, m := range current {
+		if !slices.Contains(want, m) {
+			remove = append(remove, m)
+		}
+	}
+
+	diags.Append(p.checkSelf(group, remove)...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(add) > 0 {
+		if err := p.client.GroupMemberAdd(ctx, group, add...); err != nil {
+			diags.AddError("Failed to add group members", err.Error())
+			return
+		}
+	}
+	if len(remove) > 0 {
+		if err := p.client.GroupMemberRemove(ctx, group, remove...); err != nil {
+			diags.AddError("Failed to remove group members", err.Error())
+		}
+	}
+	return
+}
+
+// refresh reads the members of the group of data.
+func (p *GroupMembersResource) refresh(ctx context.Context, data *GroupMembersResourceModel) (diags diag.Diagnostics) {
+	members, err := p.client.GroupMemberList(ctx, data.Group.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list group members", err.Error())
+		return
+	}
+
+	if members == nil {
+		members = []string{}
+	}
+	data.Members, diags = types.SetValueFrom(ctx, types.StringType, members)
+	return
+}
+
+// checkSelf refuses to remove the account the provider is logged in as from
+// the group, which could lock the provider out of DSM in the middle of an
+// apply.
+func (p *GroupMembersResource) checkSelf(group string, remove []string) (diags diag.Diagnostics) {
+	if p.self == "" {
+		return
+	}
+
+	if slices.ContainsFunc(remove, func(m string) bool { return strings.EqualFold(m, p.self) }) {
+		diags.AddError(
+			"Refusing to remove group member",
+			fmt.Sprintf("The user %s is the account the provider is logged in as and cannot be removed from the group %s. Add it to members, or connect as another administrator to remove it.", p.self, group),
+		)
+	}
+	return
+}
@@ -0,0 +1,78 @@
+package core_test
+
+import (
+	"regexp"
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+)
+
+type GroupMembersResource struct{}
+
+func TestAccGroupMembersResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"members are replaced",
+			`
+			resource "synology_core_user" "foo" {
+				name     = "tf-members"
+				password = "Sup3r-Secret!"
+			}
+
+			resource "synology_core_group_members" "foo" {
+				group   = "http"
+				members = [synology_core_user.foo.name]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_core_group_members.foo", "members.#", "1"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccGroupMembersResource_self(t *testing.T) {
+	// The provider account is only removed from a group of the mock server.
+	s := acctest.NewMockServer(t)
+
+	s.Handle("SYNO.Core.Group.Member", 1, "list", func(req *mock.Request) (any, error) {
+		return map[string]any{"users": []map[string]any{{"name": s.Username}}, "total": 1}, nil
+	})
+	// Nothing must change before the removal is refused.
+	for _, method := range []string{"add", "remove"} {
+		s.Handle("SYNO.Core.Group.Member", 1, method, func(req *mock.Request) (any, error) {
+			t.Errorf("group members changed with %s: %s", method, req.Get("name"))
+			return map[string]any{}, nil
+		})
+	}
+
+	r.UnitTest(t, r.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []r.TestStep{
+			{
+				Config: `
+				resource "synology_core_group_members" "foo" {
+					group   = "administrators"
+					members = ["backup"]
+				}`,
+				ExpectError: regexp.MustCompile("Refusing to remove group member"),
+			},
+		},
+	})
+}
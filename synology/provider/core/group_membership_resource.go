@@ -0,0 +1,242 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type GroupMembershipResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Group types.String `tfsdk:"group"`
+	User  types.String `tfsdk:"user"`
+}
+
+var (
+	_ resource.Resource             = &GroupMembershipResource{}
+	_ resource.ResourceWithIdentity = &GroupMembershipResource{}
+)
+
+func NewGroupMembershipResource() resource.Resource {
+	return &GroupMembershipResource{}
+}
+
+type GroupMembershipResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *GroupMembershipResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data GroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.GroupMemberAdd(ctx, data.Group.ValueString(), data.User.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to add group member", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(data.Group.ValueString() + "/" + data.User.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource. Every attribute requires replacement.
+func (p *GroupMembershipResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data GroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The user and the group are kept.
+func (p *GroupMembershipResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data GroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.GroupMemberRemove(ctx, data.Group.ValueString(), data.User.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to remove group member", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *GroupMembershipResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "group_membership")
+}
+
+// Read implements resource.Resource.
+func (p *GroupMembershipResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data GroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := p.client.GroupMemberList(ctx, data.Group.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list group members", err.Error())
+		return
+	}
+	if !slices.Contains(members, data.User.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *GroupMembershipResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	replace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a local user to a local group, leaving the other members alone, e.g. to manage the groups of a user next to the user. Do not combine it with `synology_core_group_members` for the same group, which removes members it does not list.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the membership in the form `<group>/<user>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The name of the user.",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+		},
+	}
+}
+
+func (p *GroupMembershipResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *GroupMembershipResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, user, ok := strings.Cut(id, "/")
+	if !ok {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected <group>/<user>, got %q", id))
+		return
+	}
+
+	members, err := p.client.GroupMemberList(ctx, group)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list group members", err.Error())
+		return
+	}
+	if !slices.Contains(members, user) {
+		resp.Diagnostics.AddError("Group membership not found", fmt.Sprintf("User %s is not a member of group %s", user, group))
+		return
+	}
+
+	data := GroupMembershipResourceModel{
+		ID:    types.StringValue(id),
+		Group: types.StringValue(group),
+		User:  types.StringValue(user),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *GroupMembershipResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the membership in the form `<group>/<user>`.")
+}
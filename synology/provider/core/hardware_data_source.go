@@ -0,0 +1,202 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api/core"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HardwareDataSource{}
+
+func NewHardwareDataSource() datasource.DataSource {
+	return &HardwareDataSource{}
+}
+
+type HardwareDataSource struct {
+	client     *dsm.Client
+	coreClient core.Api
+}
+
+type HardwareDataSourceModel struct {
+	Model               types.String `tfsdk:"model"`
+	CPU                 types.String `tfsdk:"cpu"`
+	CPUCores            types.Int64  `tfsdk:"cpu_cores"`
+	MemoryMB            types.Int64  `tfsdk:"memory_mb"`
+	HardwareTranscoding types.Bool   `tfsdk:"hardware_transcoding"`
+	SSDDrives           types.Int64  `tfsdk:"ssd_drives"`
+	NVMeDrives          types.Int64  `tfsdk:"nvme_drives"`
+	PCIeCards           types.List   `tfsdk:"pcie_cards"`
+	ExpansionUnits      types.List   `tfsdk:"expansion_units"`
+}
+
+func (d *HardwareDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "hardware")
+}
+
+func (d *HardwareDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the hardware capabilities of the NAS, so that SSD cache, Surveillance Station or transcoding resources can be created only on capable models.",
+
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The model of the NAS, e.g. `DS920+`.",
+				Computed:            true,
+			},
+			"cpu": schema.StringAttribute{
+				MarkdownDescription: "The CPU of the NAS, e.g. `INTEL Celeron J4125`.",
+				Computed:            true,
+			},
+			"cpu_cores": schema.Int64Attribute{
+				MarkdownDescription: "The number of CPU cores.",
+				Computed:            true,
+			},
+			"memory_mb": schema.Int64Attribute{
+				MarkdownDescription: "The installed memory in MiB.",
+				Computed:            true,
+			},
+			"hardware_transcoding": schema.BoolAttribute{
+				MarkdownDescription: "Whether the NAS has a GPU usable for hardware transcoding.",
+				Computed:            true,
+			},
+			"ssd_drives": schema.Int64Attribute{
+				MarkdownDescription: "The number of installed SSDs, including NVMe drives.",
+				Computed:            true,
+			},
+			"nvme_drives": schema.Int64Attribute{
+				MarkdownDescription: "The number of installed NVMe drives.",
+				Computed:            true,
+			},
+			"pcie_cards": schema.ListAttribute{
+				MarkdownDescription: "The names of the cards in the PCIe slots.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"expansion_units": schema.ListAttribute{
+				MarkdownDescription: "The expansion units holding drives, e.g. `DX517-1`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *HardwareDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	info, err := d.coreClient.SystemInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to get system information, got error: %s", err),
+		)
+		return
+	}
+
+	transcoding, err := d.client.Supports(ctx, dsm.Core_Hardware_VideoTranscoding)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to get API info, got error: %s", err),
+		)
+		return
+	}
+
+	storage, err := d.client.StorageLoadInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to get storage information, got error: %s", err),
+		)
+		return
+	}
+
+	cores, _ := strconv.ParseInt(info.CPUCores, 10, 64)
+	data := HardwareDataSourceModel{
+		Model:               types.StringValue(info.Model),
+		CPU:                 types.StringValue(strings.Join(strings.Fields(info.CPUVendor+" "+info.CPUFamily+" "+info.CPUSeries), " ")),
+		CPUCores:            types.Int64Value(cores),
+		MemoryMB:            types.Int64Value(int64(info.RAMSize)),
+		HardwareTranscoding: types.BoolValue(transcoding),
+	}
+
+	cards := []string{}
+	for _, s := range info.ExternalPciSlotInfo {
+		if s.Occupied == "yes" && s.CardName != "" {
+			cards = append(cards, s.CardName)
+		}
+	}
+
+	var ssd, nvme int64
+	units := []string{}
+	for _, disk := range storage.Disks {
+		if disk.IsSSD || strings.EqualFold(disk.DiskType, "NVMe") {
+			ssd++
+		}
+		if strings.EqualFold(disk.DiskType, "NVMe") {
+			nvme++
+		}
+		if disk.Container.Type == dsm.DiskContainerEbox && !slices.Contains(units, disk.Container.Str) {
+			units = append(units, disk.Container.Str)
+		}
+	}
+	data.SSDDrives = types.Int64Value(ssd)
+	data.NVMeDrives = types.Int64Value(nvme)
+
+	v, diags := types.ListValueFrom(ctx, types.StringType, cards)
+	resp.Diagnostics.Append(diags...)
+	data.PCIeCards = v
+
+	v, diags = types.ListValueFrom(ctx, types.StringType, units)
+	resp.Diagnostics.Append(diags...)
+	data.ExpansionUnits = v
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *HardwareDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = dsm.New(client)
+	d.coreClient = client.CoreAPI()
+}
@@ -0,0 +1,183 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// workingDiskStatus lists the drive states which do not count as failed.
+var workingDiskStatus = []string{"normal", "not_init", "initialized"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HealthDataSource{}
+
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+type HealthDataSource struct {
+	client *dsm.Client
+}
+
+type HealthDataSourceModel struct {
+	Hostname        types.String `tfsdk:"hostname"`
+	Status          types.String `tfsdk:"status"`
+	Healthy         types.Bool   `tfsdk:"healthy"`
+	DegradedVolumes types.List   `tfsdk:"degraded_volumes"`
+	FailedDisks     types.List   `tfsdk:"failed_disks"`
+	UpdateAvailable types.Bool   `tfsdk:"update_available"`
+	UpdateVersion   types.String `tfsdk:"update_version"`
+}
+
+func (d *HealthDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "health")
+}
+
+func (d *HealthDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the health of the NAS, so that a plan can fail early through a precondition on unhealthy hardware.",
+
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The host name of the NAS.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The overall status shown by DSM, `normal`, `warning` or `danger`.",
+				Computed:            true,
+			},
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the status is `normal` and no volume is degraded and no drive failed.",
+				Computed:            true,
+			},
+			"degraded_volumes": schema.ListAttribute{
+				MarkdownDescription: "The paths of the volumes which are not in the `normal` state, e.g. `/volume1`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"failed_disks": schema.ListAttribute{
+				MarkdownDescription: "The names of the failed or failing drives, e.g. `Drive 2`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"update_available": schema.BoolAttribute{
+				MarkdownDescription: "Whether a DSM update is available.",
+				Computed:            true,
+			},
+			"update_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the available DSM update, null when there is none.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *HealthDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	health, err := d.client.SystemHealth(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to get system health, got error: %s", err),
+		)
+		return
+	}
+
+	storage, err := d.client.StorageLoadInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to get storage information, got error: %s", err),
+		)
+		return
+	}
+
+	update, err := d.client.UpgradeCheck(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to check for DSM updates, got error: %s", err),
+		)
+		return
+	}
+
+	volumes := []string{}
+	for _, v := range storage.Volumes {
+		if v.Status != "normal" {
+			volumes = append(volumes, v.VolPath)
+		}
+	}
+
+	disks := []string{}
+	for _, disk := range storage.Disks {
+		if !slices.Contains(workingDiskStatus, disk.Status) {
+			disks = append(disks, disk.Name)
+		}
+	}
+
+	data := HealthDataSourceModel{
+		Hostname:        types.StringValue(health.Hostname),
+		Status:          types.StringValue(health.Rule.Type),
+		Healthy:         types.BoolValue(health.Rule.Type == "normal" && len(volumes) == 0 && len(disks) == 0),
+		UpdateAvailable: types.BoolValue(update.Update.Available),
+		UpdateVersion:   types.StringNull(),
+	}
+	if update.Update.Available {
+		data.UpdateVersion = types.StringValue(update.Update.Version)
+	}
+
+	v, diags := types.ListValueFrom(ctx, types.StringType, volumes)
+	resp.Diagnostics.Append(diags...)
+	data.DegradedVolumes = v
+
+	v, diags = types.ListValueFrom(ctx, types.StringType, disks)
+	resp.Diagnostics.Append(diags...)
+	data.FailedDisks = v
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *HealthDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = dsm.New(client)
+}
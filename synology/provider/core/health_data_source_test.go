@@ -0,0 +1,37 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type HealthDataSource struct{}
+
+func TestAccHealthDataSource_basic(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		DataSourceBlock string
+	}{
+		{
+			"reports health",
+			`data "synology_core_health" "this" {}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.DataSourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("data.synology_core_health.this", "status"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,452 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type LetsEncryptCertificateResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Domains         types.List   `tfsdk:"domains"`
+	Email           types.String `tfsdk:"email"`
+	Description     types.String `tfsdk:"description"`
+	AutoRenew       types.Bool   `tfsdk:"auto_renew"`
+	RenewBeforeDays types.Int64  `tfsdk:"renew_before_days"`
+	Expires         types.String `tfsdk:"expires"`
+}
+
+// set copies cert into m, removing the description stamp of the provider.
+func (m *LetsEncryptCertificateResourceModel) set(
+	ctx context.Context,
+	cert dsm.Certificate,
+	stamp synoclient.Stamp,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(cert.ID)
+	m.Description = types.StringValue(stamp.Strip(cert.Description))
+
+	// DSM may list the subject alternative names in another order, keep the
+	// configured order if the domains match.
+	domains := cert.Domains()
+	var current []string
+	if !m.Domains.IsNull() && !m.Domains.IsUnknown() {
+		diags.Append(m.Domains.ElementsAs(ctx, &current, false)...)
+	}
+	if len(current) == 0 || current[0] != domains[0] || !sameElements(current, domains) {
+		v, d := types.ListValueFrom(ctx, types.StringType, domains)
+		diags.Append(d...)
+		m.Domains = v
+	}
+
+	expires, err := cert.Expires()
+	if err != nil {
+		diags.AddError("Failed to parse certificate expiry", err.Error())
+		return diags
+	}
+	m.Expires = types.StringValue(expires.UTC().Format(time.RFC3339))
+
+	return diags
+}
+
+func sameElements(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+var (
+	_ resource.Resource               = &LetsEncryptCertificateResource{}
+	_ resource.ResourceWithModifyPlan = &LetsEncryptCertificateResource{}
+	_ resource.ResourceWithIdentity   = &LetsEncryptCertificateResource{}
+)
+
+func NewLetsEncryptCertificateResource() resource.Resource {
+	return &LetsEncryptCertificateResource{}
+}
+
+type LetsEncryptCertificateResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *LetsEncryptCertificateResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data LetsEncryptCertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var domains []string
+	resp.Diagnostics.Append(data.Domains.ElementsAs(ctx, &domains, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := p.client.LetsEncryptCreate(ctx, p.stamp.Apply(data.Description.ValueString()), data.Email.ValueString(), domains)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to request Let's Encrypt certificate", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(res.ID)
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource. Every attribute but auto_renew,
+// renew_before_days and email replaces the certificate; the certificate is
+// renewed when ModifyPlan found it due.
+func (p *LetsEncryptCertificateResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data LetsEncryptCertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Expires.IsUnknown() {
+		resp.Diagnostics.Append(p.checkStamp(ctx, data.ID.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := p.client.LetsEncryptRenew(ctx, data.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to renew Let's Encrypt certificate", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(p.read(ctx, &data)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *LetsEncryptCertificateResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data LetsEncryptCertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.CertificateDelete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete certificate", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *LetsEncryptCertificateResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "letsencrypt_certificate")
+}
+
+// Read implements resource.Resource.
+func (p *LetsEncryptCertificateResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data LetsEncryptCertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := p.find(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list certificates", err.Error())
+		return
+	}
+	if cert == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *cert, p.stamp)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *LetsEncryptCertificateResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Requests a certificate from Let's Encrypt through DSM. The NAS must be reachable on port 80 of every domain.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domains": schema.ListAttribute{
+				MarkdownDescription: "The domains of the certificate. The first domain is the common name, the others are added as subject alternative names.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address registered with Let's Encrypt. DSM does not report it, so changing it only takes effect when the certificate is replaced.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the certificate in DSM.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_renew": schema.BoolAttribute{
+				MarkdownDescription: "Whether to renew the certificate on apply once it expires within `renew_before_days`. DSM renews Let's Encrypt certificates on its own as well.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"renew_before_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days before expiry from which `auto_renew` renews the certificate.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 89),
+				},
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "The end of the validity period of the certificate in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It plans a renewal
+// when auto_renew is set and the certificate expires within
+// renew_before_days.
+func (p *LetsEncryptCertificateResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan LetsEncryptCertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AutoRenew.ValueBool() || plan.Expires.IsUnknown() || plan.RenewBeforeDays.IsUnknown() {
+		return
+	}
+
+	expires, err := time.Parse(time.RFC3339, plan.Expires.ValueString())
+	if err != nil {
+		return
+	}
+
+	before := time.Duration(plan.RenewBeforeDays.ValueInt64()) * 24 * time.Hour
+	if time.Until(expires) < before {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires"), types.StringUnknown())...)
+	}
+}
+
+func (p *LetsEncryptCertificateResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState. The email is not
+// reported by DSM and has to be set in the configuration.
+func (p *LetsEncryptCertificateResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := p.find(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list certificates", err.Error())
+		return
+	}
+	if cert == nil {
+		resp.Diagnostics.AddError("Certificate not found", fmt.Sprintf("Certificate %s not found", id))
+		return
+	}
+
+	data := LetsEncryptCertificateResourceModel{
+		Domains:         types.ListNull(types.StringType),
+		Email:           types.StringNull(),
+		AutoRenew:       types.BoolValue(true),
+		RenewBeforeDays: types.Int64Value(30),
+	}
+	resp.Diagnostics.Append(data.set(ctx, *cert, p.stamp)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *LetsEncryptCertificateResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the certificate.")
+}
+
+func (p *LetsEncryptCertificateResource) find(ctx context.Context, id string) (*dsm.Certificate, error) {
+	list, err := p.client.CertificateList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Certificates, func(c dsm.Certificate) bool {
+		return c.ID == id
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Certificates[i], nil
+}
+
+// read refreshes data from the certificate on the NAS.
+func (p *LetsEncryptCertificateResource) read(
+	ctx context.Context,
+	data *LetsEncryptCertificateResourceModel,
+) (diags diag.Diagnostics) {
+	cert, err := p.find(ctx, data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list certificates", err.Error())
+		return
+	}
+	if cert == nil {
+		diags.AddError(
+			"Certificate not found",
+			fmt.Sprintf("Certificate %s not found after issuance", data.ID.ValueString()),
+		)
+		return
+	}
+
+	return data.set(ctx, *cert, p.stamp)
+}
+
+// checkStamp refuses changes to the certificate with id when the provider
+// requires a description stamp the certificate lacks.
+func (p *LetsEncryptCertificateResource) checkStamp(ctx context.Context, id string) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	cert, err := p.find(ctx, id)
+	if err != nil {
+		diags.AddError("Failed to list certificates", err.Error())
+		return
+	}
+	if cert == nil {
+		return
+	}
+
+	if err := p.stamp.Check("certificate", cert.Description); err != nil {
+		diags.AddError("Refusing to modify certificate", err.Error())
+	}
+	return
+}
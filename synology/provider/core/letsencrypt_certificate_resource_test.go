@@ -0,0 +1,42 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type LetsEncryptCertificateResource struct{}
+
+func TestAccLetsEncryptCertificateResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"certificate is issued",
+			`
+			resource "synology_core_letsencrypt_certificate" "foo" {
+				domains = ["nas.example.com", "www.example.com"]
+				email   = "admin@example.com"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_core_letsencrypt_certificate.foo", "id"),
+							r.TestCheckResourceAttrSet("synology_core_letsencrypt_certificate.foo", "expires"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
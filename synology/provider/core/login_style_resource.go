@@ -0,0 +1,321 @@
+package core
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api/filestation"
+	"github.com/synology-community/go-synology/pkg/util/form"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// loginImageExtensions maps the image types DSM accepts for the login page
+// to the extension of the uploaded file.
+var loginImageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+type LoginStyleResourceModel struct {
+	Directory      types.String `tfsdk:"directory"`
+	Background     types.String `tfsdk:"background"`
+	Logo           types.String `tfsdk:"logo"`
+	Title          types.String `tfsdk:"title"`
+	WelcomeMessage types.String `tfsdk:"welcome_message"`
+	BackgroundPath types.String `tfsdk:"background_path"`
+	LogoPath       types.String `tfsdk:"logo_path"`
+}
+
+var (
+	_ resource.Resource = &LoginStyleResource{}
+)
+
+func NewLoginStyleResource() resource.Resource {
+	return &LoginStyleResource{}
+}
+
+type LoginStyleResource struct {
+	client *dsm.Client
+	files  filestation.Api
+}
+
+// Create implements resource.Resource.
+func (p *LoginStyleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data LoginStyleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *LoginStyleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data LoginStyleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The default DSM login page is restored
+// and the uploaded images are removed.
+func (p *LoginStyleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data LoginStyleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.LoginThemeSet(ctx, dsm.LoginTheme{}); err != nil {
+		resp.Diagnostics.AddError("Failed to reset login style", err.Error())
+		return
+	}
+
+	var uploaded []string
+	for _, v := range []types.String{data.BackgroundPath, data.LogoPath} {
+		if !v.IsNull() {
+			uploaded = append(uploaded, v.ValueString())
+		}
+	}
+	if len(uploaded) > 0 {
+		if _, err := p.files.Delete(ctx, uploaded, true); err != nil {
+			resp.Diagnostics.AddWarning("Failed to delete login images", err.Error())
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *LoginStyleResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "login_style")
+}
+
+// Read implements resource.Resource.
+func (p *LoginStyleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data LoginStyleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	theme, err := p.client.LoginThemeGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get login style", err.Error())
+		return
+	}
+
+	data.Title = util.String(theme.Title)
+	data.WelcomeMessage = util.String(theme.WelcomeMessage)
+
+	// The image content cannot be compared with the NAS, an image which was
+	// disabled outside of Terraform is uploaded again.
+	if !theme.BackgroundEnabled {
+		data.Background = types.StringNull()
+	}
+	if !theme.LogoEnabled {
+		data.Logo = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *LoginStyleResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Customizes the DSM login page with a background image, logo, title and welcome message. Images are uploaded with File Station before they are applied. There is a single login style per NAS; destroying the resource restores the default page.",
+
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "The File Station folder the images are uploaded to, e.g. `/web/login`. It is created if missing.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"background": schema.StringAttribute{
+				MarkdownDescription: "The base64 encoded JPEG, PNG or GIF background image, e.g. from `filebase64()`.",
+				Optional:            true,
+			},
+			"logo": schema.StringAttribute{
+				MarkdownDescription: "The base64 encoded JPEG, PNG or GIF logo image.",
+				Optional:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title shown on the login page.",
+				Optional:            true,
+			},
+			"welcome_message": schema.StringAttribute{
+				MarkdownDescription: "The welcome message shown on the login page.",
+				Optional:            true,
+			},
+			"background_path": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the uploaded background image.",
+				Computed:            true,
+			},
+			"logo_path": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the uploaded logo image.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (p *LoginStyleResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.files = client.FileStationAPI()
+}
+
+// apply uploads the configured images and points the login page at them.
+func (p *LoginStyleResource) apply(ctx context.Context, data *LoginStyleResourceModel) (diags diag.Diagnostics) {
+	theme := dsm.LoginTheme{
+		Title:          data.Title.ValueString(),
+		WelcomeMessage: data.WelcomeMessage.ValueString(),
+	}
+
+	data.BackgroundPath = types.StringNull()
+	if !data.Background.IsNull() {
+		filePath, realPath, d := p.upload(ctx, data.Directory.ValueString(), "login_background", data.Background.ValueString())
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.BackgroundPath = types.StringValue(filePath)
+		theme.BackgroundEnabled = true
+		theme.BackgroundPath = realPath
+	}
+
+	data.LogoPath = types.StringNull()
+	if !data.Logo.IsNull() {
+		filePath, realPath, d := p.upload(ctx, data.Directory.ValueString(), "login_logo", data.Logo.ValueString())
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.LogoPath = types.StringValue(filePath)
+		theme.LogoEnabled = true
+		theme.LogoPath = realPath
+	}
+
+	if err := p.client.LoginThemeSet(ctx, theme); err != nil {
+		diags.AddError("Failed to set login style", err.Error())
+	}
+
+	return diags
+}
+
+// upload decodes a base64 image and uploads it to dir, returning its File
+// Station path and the absolute path DSM expects.
+func (p *LoginStyleResource) upload(
+	ctx context.Context,
+	dir, name, content string,
+) (filePath, realPath string, diags diag.Diagnostics) {
+	image, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		diags.AddError("Invalid login image", fmt.Sprintf("%s is not base64 encoded: %s", name, err))
+		return "", "", diags
+	}
+
+	ext, ok := loginImageExtensions[http.DetectContentType(image)]
+	if !ok {
+		diags.AddError(
+			"Invalid login image",
+			fmt.Sprintf("%s must be a JPEG, PNG or GIF image, got %s.", name, http.DetectContentType(image)),
+		)
+		return "", "", diags
+	}
+
+	file := form.File{Name: name + ext, Content: string(image)}
+	if _, err := p.files.Upload(ctx, dir, file, true, true); err != nil {
+		diags.AddError("Failed to upload login image", err.Error())
+		return "", "", diags
+	}
+
+	filePath = path.Join(dir, file.Name)
+	info, err := p.files.Get(ctx, filePath)
+	if err != nil {
+		diags.AddError("Failed to get login image", err.Error())
+		return "", "", diags
+	}
+
+	return filePath, info.Additional.RealPath, diags
+}
@@ -0,0 +1,424 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type NFSRuleModel struct {
+	Client    types.String `tfsdk:"client"`
+	Privilege types.String `tfsdk:"privilege"`
+	Squash    types.String `tfsdk:"squash"`
+	Async     types.Bool   `tfsdk:"async"`
+	Insecure  types.Bool   `tfsdk:"insecure"`
+	Crossmnt  types.Bool   `tfsdk:"crossmnt"`
+}
+
+func (m NFSRuleModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m NFSRuleModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"client":    types.StringType,
+		"privilege": types.StringType,
+		"squash":    types.StringType,
+		"async":     types.BoolType,
+		"insecure":  types.BoolType,
+		"crossmnt":  types.BoolType,
+	}
+}
+
+func (m NFSRuleModel) rule() dsm.NFSRule {
+	return dsm.NFSRule{
+		Client:         m.Client.ValueString(),
+		Privilege:      m.Privilege.ValueString(),
+		RootSquash:     m.Squash.ValueString(),
+		Async:          m.Async.ValueBool(),
+		Insecure:       m.Insecure.ValueBool(),
+		Crossmnt:       m.Crossmnt.ValueBool(),
+		SecurityFlavor: dsm.NFSSecurityFlavor{Sys: true},
+	}
+}
+
+func newNFSRuleModel(r dsm.NFSRule) NFSRuleModel {
+	return NFSRuleModel{
+		Client:    types.StringValue(r.Client),
+		Privilege: types.StringValue(r.Privilege),
+		Squash:    types.StringValue(r.RootSquash),
+		Async:     types.BoolValue(r.Async),
+		Insecure:  types.BoolValue(r.Insecure),
+		Crossmnt:  types.BoolValue(r.Crossmnt),
+	}
+}
+
+// nfsRuleAttributes returns the attributes of a single NFS rule, shared by
+// the rule and ruleset resources.
+func nfsRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"client": schema.StringAttribute{
+			MarkdownDescription: "Hostname, IP address or subnet of the client, or `*` for any client.",
+			Required:            true,
+		},
+		"privilege": schema.StringAttribute{
+			MarkdownDescription: "Access of the client. One of `rw` or `ro`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("rw"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("rw", "ro"),
+			},
+		},
+		"squash": schema.StringAttribute{
+			MarkdownDescription: "User mapping. One of `no_mapping`, `root_to_admin`, `root_to_guest`, `all_to_admin` or `all_to_guest`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("no_mapping"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("no_mapping", "root_to_admin", "root_to_guest", "all_to_admin", "all_to_guest"),
+			},
+		},
+		"async": schema.BoolAttribute{
+			MarkdownDescription: "Whether writes are acknowledged before they reach the disk.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"insecure": schema.BoolAttribute{
+			MarkdownDescription: "Whether connections from ports above 1024 are allowed.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"crossmnt": schema.BoolAttribute{
+			MarkdownDescription: "Whether mounted subfolders are accessible.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+func nfsShareAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The name of the shared folder.",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+type NFSRuleResourceModel struct {
+	Share     types.String `tfsdk:"share"`
+	Client    types.String `tfsdk:"client"`
+	Privilege types.String `tfsdk:"privilege"`
+	Squash    types.String `tfsdk:"squash"`
+	Async     types.Bool   `tfsdk:"async"`
+	Insecure  types.Bool   `tfsdk:"insecure"`
+	Crossmnt  types.Bool   `tfsdk:"crossmnt"`
+}
+
+func (m NFSRuleResourceModel) id() string {
+	return m.Share.ValueString() + "/" + m.Client.ValueString()
+}
+
+func (m NFSRuleResourceModel) rule() dsm.NFSRule {
+	return NFSRuleModel{
+		Client:    m.Client,
+		Privilege: m.Privilege,
+		Squash:    m.Squash,
+		Async:     m.Async,
+		Insecure:  m.Insecure,
+		Crossmnt:  m.Crossmnt,
+	}.rule()
+}
+
+func (m *NFSRuleResourceModel) set(r dsm.NFSRule) {
+	v := newNFSRuleModel(r)
+	m.Client = v.Client
+	m.Privilege = v.Privilege
+	m.Squash = v.Squash
+	m.Async = v.Async
+	m.Insecure = v.Insecure
+	m.Crossmnt = v.Crossmnt
+}
+
+var (
+	_ resource.Resource             = &NFSRuleResource{}
+	_ resource.ResourceWithIdentity = &NFSRuleResource{}
+)
+
+func NewNFSRuleResource() resource.Resource {
+	return &NFSRuleResource{}
+}
+
+type NFSRuleResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *NFSRuleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data NFSRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	share := data.Share.ValueString()
+
+	current, err := p.client.NFSSharePrivilegeLoad(ctx, share)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to load NFS rules", err.Error())
+		return
+	}
+
+	if slices.ContainsFunc(current.Rules, func(r dsm.NFSRule) bool {
+		return r.Client == data.Client.ValueString()
+	}) {
+		resp.Diagnostics.AddError(
+			"NFS rule already exists",
+			fmt.Sprintf("A rule for %s already exists, import it instead.", data.id()),
+		)
+		return
+	}
+
+	rules := append(current.Rules, data.rule())
+	if err := p.client.NFSSharePrivilegeSave(ctx, share, rules); err != nil {
+		resp.Diagnostics.AddError("Failed to save NFS rules", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Update implements resource.Resource.
+func (p *NFSRuleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state NFSRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	share := plan.Share.ValueString()
+
+	current, err := p.client.NFSSharePrivilegeLoad(ctx, share)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to load NFS rules", err.Error())
+		return
+	}
+
+	rules := current.Rules
+	i := slices.IndexFunc(rules, func(r dsm.NFSRule) bool {
+		return r.Client == state.Client.ValueString()
+	})
+	if i == -1 {
+		rules = append(rules, plan.rule())
+	} else {
+		rules[i] = plan.rule()
+	}
+
+	if err := p.client.NFSSharePrivilegeSave(ctx, share, rules); err != nil {
+		resp.Diagnostics.AddError("Failed to save NFS rules", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", plan.id())...)
+}
+
+// Delete implements resource.Resource.
+func (p *NFSRuleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data NFSRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	share := data.Share.ValueString()
+
+	current, err := p.client.NFSSharePrivilegeLoad(ctx, share)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to load NFS rules", err.Error())
+		return
+	}
+
+	rules := slices.DeleteFunc(current.Rules, func(r dsm.NFSRule) bool {
+		return r.Client == data.Client.ValueString()
+	})
+
+	if err := p.client.NFSSharePrivilegeSave(ctx, share, rules); err != nil {
+		resp.Diagnostics.AddError("Failed to save NFS rules", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *NFSRuleResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "nfs_rule")
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// Read implements resource.Resource.
+func (p *NFSRuleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data NFSRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := p.client.NFSSharePrivilegeLoad(ctx, data.Share.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to load NFS rules", err.Error())
+		return
+	}
+
+	i := slices.IndexFunc(current.Rules, func(r dsm.NFSRule) bool {
+		return r.Client == data.Client.ValueString()
+	})
+	if i == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(current.Rules[i])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Schema implements resource.Resource.
+func (p *NFSRuleResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	attributes := nfsRuleAttributes()
+	attributes["share"] = nfsShareAttribute()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the NFS rule of a single client on a shared folder. Rules of other clients are left untouched. Do not combine with `synology_core_nfs_ruleset` on the same share.",
+
+		Attributes: attributes,
+	}
+}
+
+func (p *NFSRuleResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *NFSRuleResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := parseScopedID(id, 2, "share/client")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	current, err := p.client.NFSSharePrivilegeLoad(ctx, parts[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to load NFS rules", err.Error())
+		return
+	}
+
+	i := slices.IndexFunc(current.Rules, func(r dsm.NFSRule) bool {
+		return r.Client == parts[1]
+	})
+	if i == -1 {
+		resp.Diagnostics.AddError("NFS rule not found", fmt.Sprintf("NFS rule %s not found", id))
+		return
+	}
+
+	data := NFSRuleResourceModel{Share: types.StringValue(parts[0])}
+	data.set(current.Rules[i])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *NFSRuleResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The rule in the form `share/client`.")
+}
@@ -0,0 +1,45 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type NFSRuleResource struct{}
+
+func TestAccNFSRuleResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"rule is added",
+			`
+			resource "synology_core_nfs_rule" "lan" {
+				share  = "docker"
+				client = "192.168.1.0/24"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_core_nfs_rule.lan",
+								"privilege",
+								"rw",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type NFSRulesetResourceModel struct {
+	Share types.String `tfsdk:"share"`
+	Rules types.List   `tfsdk:"rules"`
+}
+
+func (m NFSRulesetResourceModel) rules(ctx context.Context) ([]dsm.NFSRule, diag.Diagnostics) {
+	var elements []NFSRuleModel
+	diags := m.Rules.ElementsAs(ctx, &elements, true)
+
+	rules := make([]dsm.NFSRule, 0, len(elements))
+	for _, e := range elements {
+		rules = append(rules, e.rule())
+	}
+
+	return rules, diags
+}
+
+func (m *NFSRulesetResourceModel) set(ctx context.Context, rules []dsm.NFSRule) diag.Diagnostics {
+	elements := make([]NFSRuleModel, 0, len(rules))
+	for _, r := range rules {
+		elements = append(elements, newNFSRuleModel(r))
+	}
+
+	v, diags := types.ListValueFrom(ctx, NFSRuleModel{}.ModelType(), elements)
+	m.Rules = v
+
+	return diags
+}
+
+var (
+	_ resource.Resource             = &NFSRulesetResource{}
+	_ resource.ResourceWithIdentity = &NFSRulesetResource{}
+)
+
+func NewNFSRulesetResource() resource.Resource {
+	return &NFSRulesetResource{}
+}
+
+type NFSRulesetResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *NFSRulesetResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data NFSRulesetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", data.Share.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *NFSRulesetResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data NFSRulesetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", data.Share.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *NFSRulesetResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data NFSRulesetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.NFSSharePrivilegeSave(ctx, data.Share.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError("Failed to save NFS rules", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *NFSRulesetResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "nfs_ruleset")
+}
+
+// Read implements resource.Resource.
+func (p *NFSRulesetResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data NFSRulesetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := p.client.NFSSharePrivilegeLoad(ctx, data.Share.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to load NFS rules", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, current.Rules)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", data.Share.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *NFSRulesetResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete list of NFS rules of a shared folder. Rules which are not listed are removed from the NAS.",
+
+		Attributes: map[string]schema.Attribute{
+			"share": nfsShareAttribute(),
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The NFS rules of the shared folder.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nfsRuleAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (p *NFSRulesetResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *NFSRulesetResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	share, diags := util.ImportID(ctx, req, "share")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := p.client.NFSSharePrivilegeLoad(ctx, share)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to load NFS rules", err.Error())
+		return
+	}
+
+	data := NFSRulesetResourceModel{Share: types.StringValue(share)}
+	resp.Diagnostics.Append(data.set(ctx, current.Rules)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", share)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *NFSRulesetResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("share", "The name of the shared folder.")
+}
+
+func (p *NFSRulesetResource) apply(ctx context.Context, data NFSRulesetResourceModel) diag.Diagnostics {
+	rules, diags := data.rules(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := p.client.NFSSharePrivilegeSave(ctx, data.Share.ValueString(), rules); err != nil {
+		diags.AddError("Failed to save NFS rules", err.Error())
+	}
+
+	return diags
+}
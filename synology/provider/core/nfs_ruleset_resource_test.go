@@ -0,0 +1,54 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type NFSRulesetResource struct{}
+
+func TestAccNFSRulesetResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"rules are set",
+			`
+			resource "synology_core_nfs_ruleset" "docker" {
+				share = "docker"
+				rules = [
+					{
+						client    = "192.168.1.10"
+						privilege = "rw"
+					},
+					{
+						client    = "192.168.1.0/24"
+						privilege = "ro"
+					},
+				]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_core_nfs_ruleset.docker",
+								"rules.#",
+								"2",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
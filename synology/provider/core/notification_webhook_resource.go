@@ -0,0 +1,512 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// notificationEventsMu serializes the read-modify-write of the notification
+// event channels, which are shared by all webhooks.
+var notificationEventsMu sync.Mutex
+
+const defaultWebhookTemplate = `{"text": "@@TEXT@@"}`
+
+type NotificationWebhookResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	URL         types.String `tfsdk:"url"`
+	Method      types.String `tfsdk:"method"`
+	ContentType types.String `tfsdk:"content_type"`
+	Headers     types.Map    `tfsdk:"headers"`
+	Template    types.String `tfsdk:"template"`
+	Events      types.Set    `tfsdk:"events"`
+}
+
+func (m NotificationWebhookResourceModel) webhook(ctx context.Context) (dsm.NotificationWebhook, diag.Diagnostics) {
+	webhook := dsm.NotificationWebhook{
+		ID:          m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		URL:         m.URL.ValueString(),
+		Method:      strings.ToLower(m.Method.ValueString()),
+		ContentType: m.ContentType.ValueString(),
+		Headers:     map[string]string{},
+		Template:    m.Template.ValueString(),
+	}
+	diags := m.Headers.ElementsAs(ctx, &webhook.Headers, false)
+	return webhook, diags
+}
+
+func (m NotificationWebhookResourceModel) events(ctx context.Context) ([]string, diag.Diagnostics) {
+	var events []string
+	diags := m.Events.ElementsAs(ctx, &events, false)
+	return events, diags
+}
+
+func (m *NotificationWebhookResourceModel) set(ctx context.Context, webhook dsm.NotificationWebhook) diag.Diagnostics {
+	m.ID = types.StringValue(webhook.ID)
+	m.Name = types.StringValue(webhook.Name)
+	m.URL = types.StringValue(webhook.URL)
+	m.Method = types.StringValue(strings.ToUpper(webhook.Method))
+	m.ContentType = types.StringValue(webhook.ContentType)
+	m.Template = types.StringValue(webhook.Template)
+
+	headers := webhook.Headers
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	v, diags := types.MapValueFrom(ctx, types.StringType, headers)
+	m.Headers = v
+
+	return diags
+}
+
+var (
+	_ resource.Resource             = &NotificationWebhookResource{}
+	_ resource.ResourceWithIdentity = &NotificationWebhookResource{}
+)
+
+func NewNotificationWebhookResource() resource.Resource {
+	return &NotificationWebhookResource{}
+}
+
+type NotificationWebhookResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *NotificationWebhookResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data NotificationWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, diags := data.webhook(ctx)
+	resp.Diagnostics.Append(diags...)
+	events, diags := data.events(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	before, err := p.client.NotificationWebhookList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list notification webhooks", err.Error())
+		return
+	}
+
+	if err := p.client.NotificationWebhookCreate(ctx, webhook); err != nil {
+		resp.Diagnostics.AddError("Failed to create notification webhook", err.Error())
+		return
+	}
+
+	// DSM does not return the ID of the new webhook, find the new entry with
+	// the same name.
+	created, err := p.find(ctx, func(w dsm.NotificationWebhook) bool {
+		return w.Name == webhook.Name &&
+			!slices.ContainsFunc(before.Webhooks, func(b dsm.NotificationWebhook) bool {
+				return b.ID == w.ID
+			})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list notification webhooks", err.Error())
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.AddError(
+			"Notification webhook not found",
+			fmt.Sprintf("Notification webhook %s not found after creation", webhook.Name),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(created.ID)
+
+	resp.Diagnostics.Append(p.setEvents(ctx, events, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *NotificationWebhookResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state NotificationWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, diags := data.webhook(ctx)
+	resp.Diagnostics.Append(diags...)
+	events, diags := data.events(ctx)
+	resp.Diagnostics.Append(diags...)
+	previous, diags := state.events(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.NotificationWebhookSet(ctx, webhook); err != nil {
+		resp.Diagnostics.AddError("Failed to update notification webhook", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.setEvents(ctx, events, previous)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource. The events are only unsubscribed when
+// no other webhook is left, as all webhooks share them.
+func (p *NotificationWebhookResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data NotificationWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.NotificationWebhookDelete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete notification webhook", err.Error())
+		return
+	}
+
+	list, err := p.client.NotificationWebhookList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list notification webhooks", err.Error())
+		return
+	}
+
+	if len(list.Webhooks) == 0 {
+		previous, diags := data.events(ctx)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(p.setEvents(ctx, nil, previous)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *NotificationWebhookResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "notification_webhook")
+}
+
+// Read implements resource.Resource.
+func (p *NotificationWebhookResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data NotificationWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := p.find(ctx, func(w dsm.NotificationWebhook) bool {
+		return w.ID == data.ID.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list notification webhooks", err.Error())
+		return
+	}
+	if webhook == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *webhook)...)
+	resp.Diagnostics.Append(p.readEvents(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *NotificationWebhookResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a webhook DSM pushes event notifications to, e.g. for a SIEM pipeline. DSM subscribes events for all webhooks together, so every webhook receives the events of every `synology_core_notification_webhook`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the webhook.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the webhook.",
+				Required:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL notifications are sent to.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"method": schema.StringAttribute{
+				MarkdownDescription: "The HTTP method, `POST` or `GET`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("POST"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("POST", "GET"),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				MarkdownDescription: "The content type of the request body.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("application/json"),
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers, e.g. for authentication.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+			"template": schema.StringAttribute{
+				MarkdownDescription: "The request body. DSM replaces `@@TEXT@@` with the notification text.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultWebhookTemplate),
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "The tags of the DSM events to push, as listed in **Control Panel** > **Notification** > **Rules**, e.g. login failures or storage degradation.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (p *NotificationWebhookResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. All events pushed
+// to webhooks are imported.
+func (p *NotificationWebhookResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := p.find(ctx, func(w dsm.NotificationWebhook) bool {
+		return w.ID == id
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list notification webhooks", err.Error())
+		return
+	}
+	if webhook == nil {
+		resp.Diagnostics.AddError("Notification webhook not found", fmt.Sprintf("Notification webhook %s not found", id))
+		return
+	}
+
+	data := NotificationWebhookResourceModel{Events: types.SetNull(types.StringType)}
+	resp.Diagnostics.Append(data.set(ctx, *webhook)...)
+	resp.Diagnostics.Append(p.readEvents(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *NotificationWebhookResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the webhook.")
+}
+
+func (p *NotificationWebhookResource) find(
+	ctx context.Context,
+	match func(dsm.NotificationWebhook) bool,
+) (*dsm.NotificationWebhook, error) {
+	list, err := p.client.NotificationWebhookList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Webhooks, match)
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Webhooks[i], nil
+}
+
+// setEvents subscribes the webhooks to events and unsubscribes them from the
+// previous events which are no longer listed.
+func (p *NotificationWebhookResource) setEvents(ctx context.Context, events, previous []string) (diags diag.Diagnostics) {
+	notificationEventsMu.Lock()
+	defer notificationEventsMu.Unlock()
+
+	list, err := p.client.NotificationFilterList(ctx)
+	if err != nil {
+		diags.AddError("Failed to list notification events", err.Error())
+		return
+	}
+
+	known := map[string]dsm.NotificationEvent{}
+	for _, e := range list.Events {
+		known[e.Tag] = e
+	}
+
+	var unknown []string
+	for _, tag := range events {
+		if _, ok := known[tag]; !ok {
+			unknown = append(unknown, tag)
+		}
+	}
+	if len(unknown) > 0 {
+		diags.AddError(
+			"Unknown notification events",
+			fmt.Sprintf("DSM has no notification events %s.", strings.Join(unknown, ", ")),
+		)
+		return
+	}
+
+	var changed []dsm.NotificationEvent
+	for _, tag := range previous {
+		e, ok := known[tag]
+		if ok && e.Webhook && !slices.Contains(events, tag) {
+			e.Webhook = false
+			changed = append(changed, e)
+		}
+	}
+	for _, tag := range events {
+		if e := known[tag]; !e.Webhook {
+			e.Webhook = true
+			changed = append(changed, e)
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	if err := p.client.NotificationFilterSet(ctx, changed); err != nil {
+		diags.AddError("Failed to set notification events", err.Error())
+	}
+
+	return
+}
+
+// readEvents keeps the events of data which are still pushed to webhooks. If
+// data has no events yet, all events pushed to webhooks are read.
+func (p *NotificationWebhookResource) readEvents(
+	ctx context.Context,
+	data *NotificationWebhookResourceModel,
+) (diags diag.Diagnostics) {
+	list, err := p.client.NotificationFilterList(ctx)
+	if err != nil {
+		diags.AddError("Failed to list notification events", err.Error())
+		return
+	}
+
+	var current []string
+	if !data.Events.IsNull() {
+		diags.Append(data.Events.ElementsAs(ctx, &current, false)...)
+	}
+
+	events := []string{}
+	for _, e := range list.Events {
+		if e.Webhook && (data.Events.IsNull() || slices.Contains(current, e.Tag)) {
+			events = append(events, e.Tag)
+		}
+	}
+
+	v, d := types.SetValueFrom(ctx, types.StringType, events)
+	diags.Append(d...)
+	data.Events = v
+
+	return
+}
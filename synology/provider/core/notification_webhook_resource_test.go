@@ -0,0 +1,43 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type NotificationWebhookResource struct{}
+
+func TestAccNotificationWebhookResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"webhook is created",
+			`
+			resource "synology_core_notification_webhook" "foo" {
+				name   = "tf-test"
+				url    = "https://siem.example.com/hooks/synology"
+				events = ["LoginFail"]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_core_notification_webhook.foo", "id"),
+							r.TestCheckResourceAttr("synology_core_notification_webhook.foo", "method", "POST"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// packageTrustLevels maps the trust_level values to the DSM trust levels.
+var packageTrustLevels = map[string]int{
+	"synology":           dsm.PackageTrustSynology,
+	"trusted_publishers": dsm.PackageTrustPublisher,
+	"any":                dsm.PackageTrustAny,
+}
+
+type PackageCenterSettingsResourceModel struct {
+	TrustLevel types.String `tfsdk:"trust_level"`
+}
+
+var (
+	_ resource.Resource = &PackageCenterSettingsResource{}
+)
+
+func NewPackageCenterSettingsResource() resource.Resource {
+	return &PackageCenterSettingsResource{}
+}
+
+type PackageCenterSettingsResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *PackageCenterSettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data PackageCenterSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *PackageCenterSettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data PackageCenterSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The settings are left as they are.
+func (p *PackageCenterSettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *PackageCenterSettingsResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "package_center_settings")
+}
+
+// Read implements resource.Resource.
+func (p *PackageCenterSettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data PackageCenterSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *PackageCenterSettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the Package Center settings. Packages from third-party feeds such as SynoCommunity, see `synology_core_package_feed`, can only be installed with the trust level `any`. There is a single set of settings per NAS; destroying the resource leaves them unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"trust_level": schema.StringAttribute{
+				MarkdownDescription: "Whose packages can be installed: `synology` for Synology only, `trusted_publishers` for Synology and trusted publishers or `any` for any publisher.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("synology", "trusted_publishers", "any"),
+				},
+			},
+		},
+	}
+}
+
+func (p *PackageCenterSettingsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The import ID is
+// ignored as there is a single set of settings per NAS.
+func (p *PackageCenterSettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	data := PackageCenterSettingsResourceModel{}
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (p *PackageCenterSettingsResource) apply(ctx context.Context, data PackageCenterSettingsResourceModel) (diags diag.Diagnostics) {
+	s, err := p.client.PackageSettingGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get Package Center settings", err.Error())
+		return diags
+	}
+
+	s.TrustLevel = packageTrustLevels[data.TrustLevel.ValueString()]
+	if err := p.client.PackageSettingSet(ctx, *s); err != nil {
+		diags.AddError("Failed to set Package Center settings", err.Error())
+	}
+
+	return diags
+}
+
+func (p *PackageCenterSettingsResource) read(ctx context.Context, data *PackageCenterSettingsResourceModel) (diags diag.Diagnostics) {
+	s, err := p.client.PackageSettingGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get Package Center settings", err.Error())
+		return diags
+	}
+
+	for k, v := range packageTrustLevels {
+		if v == s.TrustLevel {
+			data.TrustLevel = types.StringValue(k)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,40 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type PackageCenterSettingsResource struct{}
+
+func TestAccPackageCenterSettingsResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"any publisher is trusted",
+			`
+			resource "synology_core_package_center_settings" "foo" {
+				trust_level = "any"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_core_package_center_settings.foo", "trust_level", "any"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
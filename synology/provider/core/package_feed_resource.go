@@ -7,9 +7,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/synology-community/go-synology"
 	"github.com/synology-community/go-synology/pkg/api/core"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
 )
 
 type PackageFeedResourceModel struct {
@@ -17,7 +21,10 @@ type PackageFeedResourceModel struct {
 	URL  types.String `tfsdk:"url"`
 }
 
-var _ resource.Resource = &PackageFeedResource{}
+var (
+	_ resource.Resource             = &PackageFeedResource{}
+	_ resource.ResourceWithIdentity = &PackageFeedResource{}
+)
 
 func NewPackageFeedResource() resource.Resource {
 	return &PackageFeedResource{}
@@ -60,6 +67,7 @@ func (p *PackageFeedResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", feedName)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -99,6 +107,7 @@ func (p *PackageFeedResource) Metadata(
 	resp *resource.MetadataResponse,
 ) {
 	resp.TypeName = buildName(req.ProviderTypeName, "package_feed")
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Read implements resource.Resource.
@@ -148,6 +157,7 @@ func (p *PackageFeedResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
 }
 
 // Schema implements resource.Resource.
@@ -157,17 +167,24 @@ func (p *PackageFeedResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "A resource for managing package feeds.",
+		MarkdownDescription: "A resource for managing package feeds. Packages of third-party feeds can only be installed once `synology_core_package_center_settings` accepts any publisher.",
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the package feed.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"url": schema.StringAttribute{
 				MarkdownDescription: "The URL to the package feed.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -205,6 +222,11 @@ func (f *PackageFeedResource) Configure(
 		return
 	}
 
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
 	f.client = client.CoreAPI()
 }
 
@@ -214,6 +236,12 @@ func (p *PackageFeedResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	feeds, err := p.client.PackageFeedList(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to list package feeds", err.Error())
@@ -223,7 +251,7 @@ func (p *PackageFeedResource) ImportState(
 	found := false
 	foundURL := ""
 	for _, feed := range feeds.Items {
-		if feed.Name == req.ID {
+		if feed.Name == name {
 			found = true
 			foundURL = feed.Feed
 			break
@@ -233,11 +261,21 @@ func (p *PackageFeedResource) ImportState(
 	if !found {
 		resp.Diagnostics.AddError(
 			"Package feed not found",
-			fmt.Sprintf("Package feed %s not found", req.ID),
+			fmt.Sprintf("Package feed %s not found", name),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), foundURL)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *PackageFeedResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the package feed.")
 }
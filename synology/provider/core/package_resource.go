@@ -3,39 +3,56 @@ package core
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/synology-community/go-synology"
 	"github.com/synology-community/go-synology/pkg/api"
 	"github.com/synology-community/go-synology/pkg/api/core"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
 )
 
+// packageVolumeMu serializes installs switching the default volume of
+// Package Center.
+var packageVolumeMu sync.Mutex
+
 type PackageResourceModel struct {
 	Name    types.String `tfsdk:"name"`
 	Version types.String `tfsdk:"version"`
 	File    types.String `tfsdk:"file"`
 	URL     types.String `tfsdk:"url"`
 	Wizard  types.Map    `tfsdk:"wizard"`
+	Volume  types.String `tfsdk:"volume"`
 	Beta    types.Bool   `tfsdk:"beta"`
 
 	Run types.Bool `tfsdk:"run"`
 }
 
-var _ resource.Resource = &PackageResource{}
+var (
+	_ resource.Resource             = &PackageResource{}
+	_ resource.ResourceWithIdentity = &PackageResource{}
+)
 
 func NewPackageResource() resource.Resource {
 	return &PackageResource{}
 }
 
 type PackageResource struct {
-	client core.Api
+	client    core.Api
+	dsmClient *dsm.Client
 }
 
 // Create implements resource.Resource.
@@ -85,6 +102,15 @@ func (p *PackageResource) Create(
 		data.Wizard.ElementsAs(ctx, &wizardConf, true)
 	}
 
+	if !data.Volume.IsNull() {
+		restore, diags := p.useVolume(ctx, data.Volume.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		defer func() { resp.Diagnostics.Append(restore()...) }()
+	}
+
 	err := p.client.PackageInstallCompound(ctx, core.PackageInstallCompoundRequest{
 		Name:        data.Name.ValueString(),
 		URL:         data.URL.ValueString(),
@@ -99,6 +125,7 @@ func (p *PackageResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -127,6 +154,7 @@ func (p *PackageResource) Read(
 	pkg, err := p.client.PackageGet(ctx, name)
 	if err != nil {
 		resp.State.RemoveResource(ctx)
+		return
 	}
 
 	pkgInfo, err := p.client.PackageFind(ctx, name)
@@ -158,6 +186,7 @@ func (p *PackageResource) Read(
 	}
 
 	// resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
 }
 
 // Delete implements resource.Resource.
@@ -198,6 +227,7 @@ func (p *PackageResource) Metadata(
 	resp *resource.MetadataResponse,
 ) {
 	resp.TypeName = buildName(req.ProviderTypeName, "package")
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Schema implements resource.Resource.
@@ -228,9 +258,19 @@ func (p *PackageResource) Schema(
 				Computed:            true,
 			},
 			"wizard": schema.MapAttribute{
-				MarkdownDescription: "Wizard configuration values.",
+				MarkdownDescription: "The answers to the install wizard of the package, e.g. ports or passwords. They are kept in the state and changing them reinstalls the package with the new answers.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"volume": schema.StringAttribute{
+				MarkdownDescription: "The volume to install the package to, e.g. `/volume1`. Defaults to the default volume of Package Center. Changing it reinstalls the package.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"file": schema.StringAttribute{
 				MarkdownDescription: "The file to install.",
@@ -278,7 +318,13 @@ func (f *PackageResource) Configure(
 		return
 	}
 
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
 	f.client = client.CoreAPI()
+	f.dsmClient = dsm.New(client)
 }
 
 // ImportState implements resource.ResourceWithImportState.
@@ -287,7 +333,13 @@ func (p *PackageResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	pkg, err := p.client.PackageGet(ctx, req.ID)
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pkg, err := p.client.PackageGet(ctx, name)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to find package", err.Error())
 		return
@@ -306,4 +358,47 @@ func (p *PackageResource) ImportState(
 	if pkgInfo.Link != "" {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), pkgInfo.Link)...)
 	}
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", pkg.ID)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *PackageResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the package.")
+}
+
+// useVolume makes volume the default volume of Package Center, which
+// go-synology installs packages to. The returned function restores the
+// previous default volume.
+func (p *PackageResource) useVolume(ctx context.Context, volume string) (func() diag.Diagnostics, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	packageVolumeMu.Lock()
+	s, err := p.dsmClient.PackageSettingGet(ctx)
+	if err != nil {
+		packageVolumeMu.Unlock()
+		diags.AddError("Failed to get Package Center settings", err.Error())
+		return nil, diags
+	}
+
+	previous := s.DefaultVol
+	s.DefaultVol = volume
+	if err := p.dsmClient.PackageSettingSet(ctx, *s); err != nil {
+		packageVolumeMu.Unlock()
+		diags.AddError("Failed to set the default volume of Package Center", err.Error())
+		return nil, diags
+	}
+
+	return func() (diags diag.Diagnostics) {
+		defer packageVolumeMu.Unlock()
+
+		s.DefaultVol = previous
+		if err := p.dsmClient.PackageSettingSet(ctx, *s); err != nil {
+			diags.AddError("Failed to restore the default volume of Package Center", err.Error())
+		}
+		return diags
+	}, diags
 }
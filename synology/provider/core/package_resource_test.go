@@ -41,6 +41,19 @@ func TestAccPackageResource_basic(t *testing.T) {
 				}
 			}`,
 		},
+		{
+			"mariadb on volume2",
+			`
+			resource "synology_core_package" "mariadb" {
+				name   = "MariaDB10"
+				volume = "/volume2"
+
+				wizard = {
+					pkgwizard_port              = 3307
+					pkgwizard_new_root_password = "T3stP@ssw0rd"
+				}
+			}`,
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.Name, func(t *testing.T) {
@@ -0,0 +1,415 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type PasswordPolicyResourceModel struct {
+	MinLength         types.Int64  `tfsdk:"min_length"`
+	MixedCase         types.Bool   `tfsdk:"mixed_case"`
+	Numeric           types.Bool   `tfsdk:"numeric"`
+	Special           types.Bool   `tfsdk:"special"`
+	ExcludeUsername   types.Bool   `tfsdk:"exclude_username"`
+	ExcludeCommon     types.Bool   `tfsdk:"exclude_common"`
+	History           types.Int64  `tfsdk:"history"`
+	MaxAge            types.Int64  `tfsdk:"max_age"`
+	MinAge            types.Int64  `tfsdk:"min_age"`
+	ReminderDays      types.Int64  `tfsdk:"reminder_days"`
+	AllowExpiredLogin types.Bool   `tfsdk:"allow_expired_login"`
+	OTPEnforce        types.String `tfsdk:"otp_enforce"`
+	OTPUsers          types.Set    `tfsdk:"otp_users"`
+	OTPGroups         types.Set    `tfsdk:"otp_groups"`
+}
+
+func (m PasswordPolicyResourceModel) strength() dsm.PasswordStrength {
+	return dsm.PasswordStrength{
+		MinLengthEnabled:      !m.MinLength.IsNull(),
+		MinLength:             m.MinLength.ValueInt64(),
+		MixedCase:             m.MixedCase.ValueBool(),
+		IncludeNumeric:        m.Numeric.ValueBool(),
+		IncludeSpecial:        m.Special.ValueBool(),
+		ExcludeUsername:       m.ExcludeUsername.ValueBool(),
+		ExcludeCommonPassword: m.ExcludeCommon.ValueBool(),
+		HistoryEnabled:        !m.History.IsNull(),
+		HistoryCount:          m.History.ValueInt64(),
+	}
+}
+
+func (m PasswordPolicyResourceModel) expiry() dsm.PasswordExpiry {
+	return dsm.PasswordExpiry{
+		Enabled:           !m.MaxAge.IsNull(),
+		MaxAge:            m.MaxAge.ValueInt64(),
+		MinAgeEnabled:     !m.MinAge.IsNull(),
+		MinAge:            m.MinAge.ValueInt64(),
+		ReminderEnabled:   !m.ReminderDays.IsNull(),
+		ReminderDays:      m.ReminderDays.ValueInt64(),
+		AllowExpiredLogin: m.AllowExpiredLogin.ValueBool(),
+	}
+}
+
+func (m PasswordPolicyResourceModel) otp(ctx context.Context) (dsm.OTPEnforcePolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	p := dsm.OTPEnforcePolicy{
+		Option: m.OTPEnforce.ValueString(),
+		Users:  []string{},
+		Groups: []string{},
+	}
+	if !m.OTPUsers.IsNull() {
+		diags.Append(m.OTPUsers.ElementsAs(ctx, &p.Users, false)...)
+	}
+	if !m.OTPGroups.IsNull() {
+		diags.Append(m.OTPGroups.ElementsAs(ctx, &p.Groups, false)...)
+	}
+
+	return p, diags
+}
+
+var (
+	_ resource.Resource = &PasswordPolicyResource{}
+)
+
+func NewPasswordPolicyResource() resource.Resource {
+	return &PasswordPolicyResource{}
+}
+
+type PasswordPolicyResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *PasswordPolicyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data PasswordPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *PasswordPolicyResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data PasswordPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. Every rule is turned off.
+func (p *PasswordPolicyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	data := PasswordPolicyResourceModel{
+		AllowExpiredLogin: types.BoolValue(true),
+		OTPEnforce:        types.StringValue(dsm.OTPEnforceNone),
+		OTPUsers:          types.SetNull(types.StringType),
+		OTPGroups:         types.SetNull(types.StringType),
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *PasswordPolicyResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "password_policy")
+}
+
+// Read implements resource.Resource.
+func (p *PasswordPolicyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data PasswordPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *PasswordPolicyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	days := []validator.Int64{int64validator.Between(1, 99999)}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the password strength, expiration and 2-factor authentication rules of local DSM users, as in Control Panel > Security > Account and User > Advanced. There is a single policy per NAS; destroying the resource turns every rule off.",
+
+		Attributes: map[string]schema.Attribute{
+			"min_length": schema.Int64Attribute{
+				MarkdownDescription: "The minimum password length. No minimum is enforced when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 127),
+				},
+			},
+			"mixed_case": schema.BoolAttribute{
+				MarkdownDescription: "Whether passwords must contain upper and lower case letters.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"numeric": schema.BoolAttribute{
+				MarkdownDescription: "Whether passwords must contain a digit.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"special": schema.BoolAttribute{
+				MarkdownDescription: "Whether passwords must contain a special character.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"exclude_username": schema.BoolAttribute{
+				MarkdownDescription: "Whether passwords may not contain the user name or description.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"exclude_common": schema.BoolAttribute{
+				MarkdownDescription: "Whether common passwords are rejected.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"history": schema.Int64Attribute{
+				MarkdownDescription: "The number of previous passwords which cannot be reused.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"max_age": schema.Int64Attribute{
+				MarkdownDescription: "The number of days after which passwords expire. Passwords never expire when unset.",
+				Optional:            true,
+				Validators:          days,
+			},
+			"min_age": schema.Int64Attribute{
+				MarkdownDescription: "The number of days before a password can be changed again.",
+				Optional:            true,
+				Validators:          days,
+			},
+			"reminder_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days before expiry users are reminded by email.",
+				Optional:            true,
+				Validators:          days,
+			},
+			"allow_expired_login": schema.BoolAttribute{
+				MarkdownDescription: "Whether users with an expired password can log in to change it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"otp_enforce": schema.StringAttribute{
+				MarkdownDescription: "Who must set up 2-factor authentication: `none`, `admin` for the administrators group, `user` for everyone or `custom` for `otp_users` and `otp_groups`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(dsm.OTPEnforceNone),
+				Validators: []validator.String{
+					stringvalidator.OneOf(dsm.OTPEnforceNone, dsm.OTPEnforceAdmin, dsm.OTPEnforceAll, dsm.OTPEnforceCustom),
+				},
+			},
+			"otp_users": schema.SetAttribute{
+				MarkdownDescription: "The users who must set up 2-factor authentication when `otp_enforce` is `custom`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"otp_groups": schema.SetAttribute{
+				MarkdownDescription: "The groups whose members must set up 2-factor authentication when `otp_enforce` is `custom`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (p *PasswordPolicyResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+func (p *PasswordPolicyResource) apply(ctx context.Context, data PasswordPolicyResourceModel) diag.Diagnostics {
+	otp, diags := data.otp(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The reset by email setting is not managed and kept as it is.
+	policy, err := p.client.PasswordPolicyGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get password policy", err.Error())
+		return diags
+	}
+
+	policy.Strength = data.strength()
+	if err := p.client.PasswordPolicySet(ctx, *policy); err != nil {
+		diags.AddError("Failed to set password policy", err.Error())
+		return diags
+	}
+
+	if err := p.client.PasswordExpirySet(ctx, data.expiry()); err != nil {
+		diags.AddError("Failed to set password expiry", err.Error())
+		return diags
+	}
+
+	if err := p.client.OTPEnforcePolicySet(ctx, otp); err != nil {
+		diags.AddError("Failed to set 2-factor authentication policy", err.Error())
+	}
+
+	return diags
+}
+
+func (p *PasswordPolicyResource) read(ctx context.Context, data *PasswordPolicyResourceModel) (diags diag.Diagnostics) {
+	policy, err := p.client.PasswordPolicyGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get password policy", err.Error())
+		return diags
+	}
+
+	expiry, err := p.client.PasswordExpiryGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get password expiry", err.Error())
+		return diags
+	}
+
+	otp, err := p.client.OTPEnforcePolicyGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get 2-factor authentication policy", err.Error())
+		return diags
+	}
+
+	s := policy.Strength
+	data.MinLength = types.Int64Null()
+	if s.MinLengthEnabled {
+		data.MinLength = types.Int64Value(s.MinLength)
+	}
+	data.MixedCase = types.BoolValue(s.MixedCase)
+	data.Numeric = types.BoolValue(s.IncludeNumeric)
+	data.Special = types.BoolValue(s.IncludeSpecial)
+	data.ExcludeUsername = types.BoolValue(s.ExcludeUsername)
+	data.ExcludeCommon = types.BoolValue(s.ExcludeCommonPassword)
+	data.History = types.Int64Null()
+	if s.HistoryEnabled {
+		data.History = types.Int64Value(s.HistoryCount)
+	}
+
+	data.MaxAge = types.Int64Null()
+	if expiry.Enabled {
+		data.MaxAge = util.Int64(expiry.MaxAge)
+	}
+	data.MinAge = types.Int64Null()
+	if expiry.MinAgeEnabled {
+		data.MinAge = util.Int64(expiry.MinAge)
+	}
+	data.ReminderDays = types.Int64Null()
+	if expiry.ReminderEnabled {
+		data.ReminderDays = util.Int64(expiry.ReminderDays)
+	}
+	data.AllowExpiredLogin = types.BoolValue(expiry.AllowExpiredLogin)
+
+	data.OTPEnforce = types.StringValue(otp.Option)
+	for _, e := range []struct {
+		attr   *types.Set
+		values []string
+	}{
+		{&data.OTPUsers, otp.Users},
+		{&data.OTPGroups, otp.Groups},
+	} {
+		// Keep an unset attribute null rather than an empty set.
+		if len(e.values) == 0 && e.attr.IsNull() {
+			continue
+		}
+
+		v, d := types.SetValueFrom(ctx, types.StringType, e.values)
+		diags.Append(d...)
+		*e.attr = v
+	}
+
+	return diags
+}
@@ -0,0 +1,47 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type PasswordPolicyResource struct{}
+
+func TestAccPasswordPolicyResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"strength and expiry are set",
+			`
+			resource "synology_core_password_policy" "foo" {
+				min_length  = 12
+				mixed_case  = true
+				numeric     = true
+				history     = 5
+				max_age     = 90
+				otp_enforce = "admin"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_core_password_policy.foo", "min_length", "12"),
+							r.TestCheckResourceAttr("synology_core_password_policy.foo", "max_age", "90"),
+							r.TestCheckResourceAttr("synology_core_password_policy.foo", "otp_enforce", "admin"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
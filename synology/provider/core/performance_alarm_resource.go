@@ -0,0 +1,399 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type PerformanceAlarmResourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Metric        types.String `tfsdk:"metric"`
+	Volume        types.String `tfsdk:"volume"`
+	Threshold     types.Int64  `tfsdk:"threshold"`
+	Duration      types.Int64  `tfsdk:"duration"`
+	Notifications types.Set    `tfsdk:"notifications"`
+}
+
+func (m PerformanceAlarmResourceModel) rule(ctx context.Context) (dsm.ResourceMonitorRule, diag.Diagnostics) {
+	rule := dsm.ResourceMonitorRule{
+		ID:        m.ID.ValueInt64(),
+		Name:      m.Name.ValueString(),
+		Enable:    m.Enabled.ValueBool(),
+		Metric:    m.Metric.ValueString(),
+		Target:    m.Volume.ValueString(),
+		Threshold: m.Threshold.ValueInt64(),
+		Duration:  m.Duration.ValueInt64(),
+		Notify:    []string{},
+	}
+	diags := m.Notifications.ElementsAs(ctx, &rule.Notify, false)
+	slices.Sort(rule.Notify)
+	return rule, diags
+}
+
+func (m *PerformanceAlarmResourceModel) set(ctx context.Context, rule dsm.ResourceMonitorRule) diag.Diagnostics {
+	m.ID = types.Int64Value(rule.ID)
+	m.Name = types.StringValue(rule.Name)
+	m.Enabled = types.BoolValue(rule.Enable)
+	m.Metric = types.StringValue(rule.Metric)
+	m.Threshold = types.Int64Value(rule.Threshold)
+	m.Duration = types.Int64Value(rule.Duration)
+
+	m.Volume = types.StringNull()
+	if rule.Target != "" {
+		m.Volume = types.StringValue(rule.Target)
+	}
+
+	notify := rule.Notify
+	if notify == nil {
+		notify = []string{}
+	}
+	v, diags := types.SetValueFrom(ctx, types.StringType, notify)
+	m.Notifications = v
+
+	return diags
+}
+
+var (
+	_ resource.Resource                   = &PerformanceAlarmResource{}
+	_ resource.ResourceWithValidateConfig = &PerformanceAlarmResource{}
+	_ resource.ResourceWithIdentity       = &PerformanceAlarmResource{}
+)
+
+func NewPerformanceAlarmResource() resource.Resource {
+	return &PerformanceAlarmResource{}
+}
+
+type PerformanceAlarmResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *PerformanceAlarmResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data PerformanceAlarmResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := data.rule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := p.client.ResourceMonitorRuleCreate(ctx, rule)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create performance alarm", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(id, 10))...)
+}
+
+// Update implements resource.Resource.
+func (p *PerformanceAlarmResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data PerformanceAlarmResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, diags := data.rule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.ResourceMonitorRuleSet(ctx, rule); err != nil {
+		resp.Diagnostics.AddError("Failed to update performance alarm", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
+}
+
+// Delete implements resource.Resource.
+func (p *PerformanceAlarmResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data PerformanceAlarmResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.ResourceMonitorRuleDelete(ctx, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete performance alarm", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *PerformanceAlarmResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "performance_alarm")
+}
+
+// Read implements resource.Resource.
+func (p *PerformanceAlarmResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data PerformanceAlarmResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := p.find(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list performance alarms", err.Error())
+		return
+	}
+	if rule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *rule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
+}
+
+// Schema implements resource.Resource.
+func (p *PerformanceAlarmResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Resource Monitor alarm raised when CPU, memory or volume utilization stays above a threshold.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the alarm rule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the alarm rule.",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the alarm rule is enabled.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"metric": schema.StringAttribute{
+				MarkdownDescription: "The utilization watched, `cpu`, `memory` or `volume`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						dsm.ResourceMonitorMetricCPU,
+						dsm.ResourceMonitorMetricMemory,
+						dsm.ResourceMonitorMetricVolume,
+					),
+				},
+			},
+			"volume": schema.StringAttribute{
+				MarkdownDescription: "The volume watched by a `volume` alarm, e.g. `volume1`.",
+				Optional:            true,
+			},
+			"threshold": schema.Int64Attribute{
+				MarkdownDescription: "The utilization in percent above which the alarm is raised.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "The number of minutes the utilization has to stay above `threshold`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"notifications": schema.SetAttribute{
+				MarkdownDescription: "The notification channels the alarm is sent to, `mail`, `sms`, `mobile` or `webhook`. The channels are set up under **Control Panel** > **Notification**.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("mail")})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("mail", "sms", "mobile", "webhook")),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (p *PerformanceAlarmResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data PerformanceAlarmResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Metric.IsUnknown() || data.Volume.IsUnknown() {
+		return
+	}
+
+	volume := data.Metric.ValueString() == dsm.ResourceMonitorMetricVolume
+	if volume && data.Volume.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("volume"),
+			"Missing volume",
+			"A volume alarm needs the volume to watch.",
+		)
+	}
+	if !volume && !data.Volume.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("volume"),
+			"Unexpected volume",
+			"Only volume alarms watch a volume.",
+		)
+	}
+}
+
+func (p *PerformanceAlarmResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *PerformanceAlarmResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	importID, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(importID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse ID", err.Error())
+		return
+	}
+
+	rule, err := p.find(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list performance alarms", err.Error())
+		return
+	}
+	if rule == nil {
+		resp.Diagnostics.AddError("Performance alarm not found", fmt.Sprintf("Performance alarm %d not found", id))
+		return
+	}
+
+	var data PerformanceAlarmResourceModel
+	resp.Diagnostics.Append(data.set(ctx, *rule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", importID)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *PerformanceAlarmResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the alarm rule.")
+}
+
+func (p *PerformanceAlarmResource) find(ctx context.Context, id int64) (*dsm.ResourceMonitorRule, error) {
+	list, err := p.client.ResourceMonitorRuleList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Rules, func(r dsm.ResourceMonitorRule) bool {
+		return r.ID == id
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Rules[i], nil
+}
@@ -0,0 +1,44 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type PerformanceAlarmResource struct{}
+
+func TestAccPerformanceAlarmResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"volume alarm is created",
+			`
+			resource "synology_core_performance_alarm" "foo" {
+				name      = "tf-test"
+				metric    = "volume"
+				volume    = "volume1"
+				threshold = 90
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_core_performance_alarm.foo", "id"),
+							r.TestCheckResourceAttr("synology_core_performance_alarm.foo", "duration", "5"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
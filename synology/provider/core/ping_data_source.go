@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// Privilege levels of the authenticated account.
+const (
+	privilegeAdmin = "admin"
+	privilegeUser  = "user"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PingDataSource{}
+
+func NewPingDataSource() datasource.DataSource {
+	return &PingDataSource{}
+}
+
+type PingDataSource struct {
+	client *dsm.Client
+}
+
+type PingDataSourceModel struct {
+	Model      types.String `tfsdk:"model"`
+	DSMVersion types.String `tfsdk:"dsm_version"`
+	User       types.String `tfsdk:"user"`
+	Admin      types.Bool   `tfsdk:"admin"`
+	Privilege  types.String `tfsdk:"privilege"`
+}
+
+func (d *PingDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "ping")
+}
+
+func (d *PingDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Signs in to the NAS and reports the DSM version and the privilege level of the provider account, so that a module can fail early through a precondition when the credentials lack the rights its resources need.",
+
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The model of the NAS, e.g. `DS920+`.",
+				Computed:            true,
+			},
+			"dsm_version": schema.StringAttribute{
+				MarkdownDescription: "The DSM version, e.g. `DSM 7.2.2-72806`.",
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The name of the account the provider is signed in with.",
+				Computed:            true,
+			},
+			"admin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the account belongs to the `administrators` group.",
+				Computed:            true,
+			},
+			"privilege": schema.StringAttribute{
+				MarkdownDescription: "The privilege level of the account, `admin` or `user`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PingDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	info, err := d.client.DSMInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to get DSM information, got error: %s", err),
+		)
+		return
+	}
+
+	session, err := d.client.Session(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to get the signed in account, got error: %s", err),
+		)
+		return
+	}
+
+	privilege := privilegeUser
+	if session.IsAdmin {
+		privilege = privilegeAdmin
+	}
+
+	data := PingDataSourceModel{
+		Model:      types.StringValue(info.Model),
+		DSMVersion: types.StringValue(info.Version),
+		User:       types.StringValue(session.User),
+		Admin:      types.BoolValue(session.IsAdmin),
+		Privilege:  types.StringValue(privilege),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *PingDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = dsm.New(client)
+}
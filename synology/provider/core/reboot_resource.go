@@ -0,0 +1,386 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/schedulemodel"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// Conditions under which synology_core_reboot restarts the NAS.
+const (
+	rebootWhenAlways          = "always"
+	rebootWhenSettingsChanged = "settings_changed"
+)
+
+// rebootTimeout bounds how long to wait for DSM to come back after a reboot.
+const rebootTimeout = 30 * time.Minute
+
+type RebootResourceModel struct {
+	When           types.List   `tfsdk:"when"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	Window         types.String `tfsdk:"window"`
+	WindowDuration types.Int64  `tfsdk:"window_duration"`
+	WaitForWindow  types.Bool   `tfsdk:"wait_for_window"`
+	WaitForBoot    types.Bool   `tfsdk:"wait_for_boot"`
+	Rebooted       types.Bool   `tfsdk:"rebooted"`
+}
+
+func (m RebootResourceModel) when(ctx context.Context) ([]string, diag.Diagnostics) {
+	var when []string
+	diags := m.When.ElementsAs(ctx, &when, false)
+	return when, diags
+}
+
+var (
+	_ resource.Resource                   = &RebootResource{}
+	_ resource.ResourceWithValidateConfig = &RebootResource{}
+)
+
+func NewRebootResource() resource.Resource {
+	return &RebootResource{}
+}
+
+type RebootResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *RebootResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data RebootResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	when, diags := data.when(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reboot := slices.Contains(when, rebootWhenAlways)
+	if !reboot {
+		needed, err := p.client.NeedReboot(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to check for pending reboot", err.Error())
+			return
+		}
+		reboot = needed
+	}
+
+	data.Rebooted = types.BoolValue(reboot)
+	if !reboot {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if !data.Window.IsNull() {
+		s, err := schedulemodel.ParseDSMSchedule(data.Window.ValueString(), true)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid maintenance window", err.Error())
+			return
+		}
+
+		d := time.Duration(data.WindowDuration.ValueInt64()) * time.Minute
+		now := time.Now()
+		if _, open := s.WindowStart(now, d); !open {
+			next := s.NextStart(now)
+			if !data.WaitForWindow.ValueBool() {
+				resp.Diagnostics.AddError(
+					"Outside of maintenance window",
+					fmt.Sprintf("The NAS can only be rebooted during the maintenance window, which opens next at %s.", next.Format(time.RFC3339)),
+				)
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				resp.Diagnostics.AddError("Failed to wait for maintenance window", ctx.Err().Error())
+				return
+			case <-time.After(time.Until(next)):
+			}
+		}
+	}
+
+	if err := p.client.SystemReboot(ctx); err != nil {
+		resp.Diagnostics.AddError("Failed to reboot", err.Error())
+		return
+	}
+
+	if data.WaitForBoot.ValueBool() {
+		resp.Diagnostics.Append(p.waitForBoot(ctx)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource. Only the wait settings change in place
+// and they only matter when rebooting.
+func (p *RebootResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data RebootResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource.
+func (p *RebootResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+}
+
+// Metadata implements resource.Resource.
+func (p *RebootResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "reboot")
+}
+
+// Read implements resource.Resource. A pending reboot removes the resource
+// from the state when rebooting on settings_changed, so the next apply
+// reboots.
+func (p *RebootResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data RebootResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	when, diags := data.when(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if slices.Contains(when, rebootWhenSettingsChanged) {
+		needed, err := p.client.NeedReboot(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to check for pending reboot", err.Error())
+			return
+		}
+		if needed {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *RebootResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reboots the NAS to finish changes which need a restart, such as network bonds or some package installs. Place it after the resources it finishes with `depends_on`. The reboot happens when the resource is created or `triggers` change, and is limited to a maintenance window when `window` is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"when": schema.ListAttribute{
+				MarkdownDescription: "When to reboot: `settings_changed` only if DSM reports changes waiting for a reboot, `always` on every create. With `settings_changed` a pending reboot found on refresh plans a new reboot.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue(rebootWhenSettingsChanged),
+				})),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(rebootWhenAlways, rebootWhenSettingsChanged)),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which reboot again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"window": schema.StringAttribute{
+				MarkdownDescription: "The start of the maintenance window expressed in cron, e.g. `0 2 * * 6` for Saturdays at 02:00, in the time zone of the machine running Terraform. The minute must be a single value. Reboots may happen at any time when unset.",
+				Optional:            true,
+				Validators: []validator.String{
+					dsmScheduleValidator{},
+				},
+			},
+			"window_duration": schema.Int64Attribute{
+				MarkdownDescription: "The length of the maintenance window in minutes.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(120),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"wait_for_window": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the maintenance window to open instead of failing outside of it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_boot": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait until DSM answers again after the reboot.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"rebooted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the NAS was rebooted by the last create.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (p *RebootResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data RebootResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Window.IsNull() && !data.WaitForWindow.IsNull() && data.WaitForWindow.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for_window"),
+			"Missing maintenance window",
+			"wait_for_window requires window to be set.",
+		)
+	}
+}
+
+func (p *RebootResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// waitForBoot waits for DSM to go down and answer again.
+func (p *RebootResource) waitForBoot(ctx context.Context) (diags diag.Diagnostics) {
+	ctx, cancel := context.WithTimeout(ctx, rebootTimeout)
+	defer cancel()
+
+	err := util.Poll(ctx, 5*time.Second, func() (bool, error) {
+		return p.client.Ping(ctx) != nil, nil
+	})
+	if err == nil {
+		err = util.Poll(ctx, 10*time.Second, func() (bool, error) {
+			return p.client.Ping(ctx) == nil, nil
+		})
+	}
+	if err != nil {
+		diags.AddError("Failed to wait for reboot", err.Error())
+	}
+
+	return diags
+}
+
+// dsmScheduleValidator checks that a string is a cron expression supported
+// by schedulemodel.ParseDSMSchedule.
+type dsmScheduleValidator struct{}
+
+func (v dsmScheduleValidator) Description(_ context.Context) string {
+	return "value must be a cron expression with a single minute"
+}
+
+func (v dsmScheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dsmScheduleValidator) ValidateString(
+	ctx context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := schedulemodel.ParseDSMSchedule(req.ConfigValue.ValueString(), true); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid schedule", err.Error())
+	}
+}
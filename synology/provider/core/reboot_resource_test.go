@@ -0,0 +1,58 @@
+package core_test
+
+import (
+	"regexp"
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type RebootResource struct{}
+
+func TestAccRebootResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"reboot only when settings changed",
+			`
+			resource "synology_core_reboot" "foo" {
+				when            = ["settings_changed"]
+				window          = "0 * * * *"
+				window_duration = 60
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_core_reboot.foo", "rebooted"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccRebootResource_invalidWindow(t *testing.T) {
+	r.UnitTest(t, r.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []r.TestStep{
+			{
+				Config: `
+				resource "synology_core_reboot" "foo" {
+					window = "*/5 2 * * *"
+				}`,
+				ExpectError: regexp.MustCompile("single minute"),
+			},
+		},
+	})
+}
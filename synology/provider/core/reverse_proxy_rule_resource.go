@@ -0,0 +1,571 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type ReverseProxyRuleModel struct {
+	Description         types.String `tfsdk:"description"`
+	SourceProtocol      types.String `tfsdk:"source_protocol"`
+	SourceHostname      types.String `tfsdk:"source_hostname"`
+	SourcePort          types.Int64  `tfsdk:"source_port"`
+	DestinationProtocol types.String `tfsdk:"destination_protocol"`
+	DestinationHostname types.String `tfsdk:"destination_hostname"`
+	DestinationPort     types.Int64  `tfsdk:"destination_port"`
+	HSTS                types.Bool   `tfsdk:"hsts"`
+	CustomHeaders       types.Map    `tfsdk:"custom_headers"`
+}
+
+func (m ReverseProxyRuleModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m ReverseProxyRuleModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"description":          types.StringType,
+		"source_protocol":      types.StringType,
+		"source_hostname":      types.StringType,
+		"source_port":          types.Int64Type,
+		"destination_protocol": types.StringType,
+		"destination_hostname": types.StringType,
+		"destination_port":     types.Int64Type,
+		"hsts":                 types.BoolType,
+		"custom_headers":       types.MapType{ElemType: types.StringType},
+	}
+}
+
+func reverseProxyProtocol(s string) int {
+	if s == "https" {
+		return dsm.ReverseProxyHTTPS
+	}
+	return dsm.ReverseProxyHTTP
+}
+
+func reverseProxyProtocolName(p int) string {
+	if p == dsm.ReverseProxyHTTPS {
+		return "https"
+	}
+	return "http"
+}
+
+func (m ReverseProxyRuleModel) entry(ctx context.Context) (dsm.ReverseProxyEntry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	headers := map[string]string{}
+	if !m.CustomHeaders.IsNull() && !m.CustomHeaders.IsUnknown() {
+		diags.Append(m.CustomHeaders.ElementsAs(ctx, &headers, false)...)
+	}
+
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	customHeaders := []dsm.ReverseProxyHeader{}
+	for _, k := range names {
+		customHeaders = append(customHeaders, dsm.ReverseProxyHeader{Name: k, Value: headers[k]})
+	}
+
+	frontend := dsm.ReverseProxyEndpoint{
+		Protocol: reverseProxyProtocol(m.SourceProtocol.ValueString()),
+		Fqdn:     m.SourceHostname.ValueString(),
+		Port:     int(m.SourcePort.ValueInt64()),
+	}
+	if frontend.Protocol == dsm.ReverseProxyHTTPS {
+		frontend.HTTPS = &dsm.ReverseProxyHTTPSOptions{HSTS: m.HSTS.ValueBool()}
+	}
+
+	return dsm.ReverseProxyEntry{
+		Description: m.Description.ValueString(),
+		Frontend:    frontend,
+		Backend: dsm.ReverseProxyEndpoint{
+			Protocol: reverseProxyProtocol(m.DestinationProtocol.ValueString()),
+			Fqdn:     m.DestinationHostname.ValueString(),
+			Port:     int(m.DestinationPort.ValueInt64()),
+		},
+		CustomizeHeaders: customHeaders,
+	}, diags
+}
+
+func newReverseProxyRuleModel(ctx context.Context, e dsm.ReverseProxyEntry) (ReverseProxyRuleModel, diag.Diagnostics) {
+	headers := map[string]string{}
+	for _, h := range e.CustomizeHeaders {
+		headers[h.Name] = h.Value
+	}
+
+	customHeaders, diags := types.MapValueFrom(ctx, types.StringType, headers)
+
+	return ReverseProxyRuleModel{
+		Description:         types.StringValue(e.Description),
+		SourceProtocol:      types.StringValue(reverseProxyProtocolName(e.Frontend.Protocol)),
+		SourceHostname:      types.StringValue(e.Frontend.Fqdn),
+		SourcePort:          types.Int64Value(int64(e.Frontend.Port)),
+		DestinationProtocol: types.StringValue(reverseProxyProtocolName(e.Backend.Protocol)),
+		DestinationHostname: types.StringValue(e.Backend.Fqdn),
+		DestinationPort:     types.Int64Value(int64(e.Backend.Port)),
+		HSTS:                types.BoolValue(e.Frontend.HTTPS != nil && e.Frontend.HTTPS.HSTS),
+		CustomHeaders:       customHeaders,
+	}, diags
+}
+
+// reverseProxyRuleAttributes returns the attributes of a single reverse proxy
+// rule, shared by the rule and ruleset resources.
+func reverseProxyRuleAttributes() map[string]schema.Attribute {
+	protocol := []validator.String{stringvalidator.OneOf("http", "https")}
+	port := []validator.Int64{int64validator.Between(1, 65535)}
+
+	return map[string]schema.Attribute{
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the rule. Rules of a ruleset are matched by description, so it must be unique.",
+			Required:            true,
+		},
+		"source_protocol": schema.StringAttribute{
+			MarkdownDescription: "Protocol clients connect with. One of `http` or `https`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("https"),
+			Validators:          protocol,
+		},
+		"source_hostname": schema.StringAttribute{
+			MarkdownDescription: "Hostname clients connect to, `*` matches any hostname.",
+			Required:            true,
+		},
+		"source_port": schema.Int64Attribute{
+			MarkdownDescription: "Port clients connect to.",
+			Required:            true,
+			Validators:          port,
+		},
+		"destination_protocol": schema.StringAttribute{
+			MarkdownDescription: "Protocol of the backend. One of `http` or `https`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("http"),
+			Validators:          protocol,
+		},
+		"destination_hostname": schema.StringAttribute{
+			MarkdownDescription: "Hostname or IP address of the backend.",
+			Required:            true,
+		},
+		"destination_port": schema.Int64Attribute{
+			MarkdownDescription: "Port of the backend.",
+			Required:            true,
+			Validators:          port,
+		},
+		"hsts": schema.BoolAttribute{
+			MarkdownDescription: "Whether to send the HSTS header. Only used with the `https` source protocol.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"custom_headers": schema.MapAttribute{
+			MarkdownDescription: "Headers added to requests sent to the backend.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+		},
+	}
+}
+
+type ReverseProxyRuleResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Description         types.String `tfsdk:"description"`
+	SourceProtocol      types.String `tfsdk:"source_protocol"`
+	SourceHostname      types.String `tfsdk:"source_hostname"`
+	SourcePort          types.Int64  `tfsdk:"source_port"`
+	DestinationProtocol types.String `tfsdk:"destination_protocol"`
+	DestinationHostname types.String `tfsdk:"destination_hostname"`
+	DestinationPort     types.Int64  `tfsdk:"destination_port"`
+	HSTS                types.Bool   `tfsdk:"hsts"`
+	CustomHeaders       types.Map    `tfsdk:"custom_headers"`
+	URL                 types.String `tfsdk:"url"`
+}
+
+func (m ReverseProxyRuleResourceModel) rule() ReverseProxyRuleModel {
+	return ReverseProxyRuleModel{
+		Description:         m.Description,
+		SourceProtocol:      m.SourceProtocol,
+		SourceHostname:      m.SourceHostname,
+		SourcePort:          m.SourcePort,
+		DestinationProtocol: m.DestinationProtocol,
+		DestinationHostname: m.DestinationHostname,
+		DestinationPort:     m.DestinationPort,
+		HSTS:                m.HSTS,
+		CustomHeaders:       m.CustomHeaders,
+	}
+}
+
+// set copies e into m, removing the description stamp of the provider.
+func (m *ReverseProxyRuleResourceModel) set(
+	ctx context.Context,
+	e dsm.ReverseProxyEntry,
+	stamp synoclient.Stamp,
+) diag.Diagnostics {
+	v, diags := newReverseProxyRuleModel(ctx, e)
+
+	m.ID = types.StringValue(e.UUID)
+	m.Description = types.StringValue(stamp.Strip(e.Description))
+	m.SourceProtocol = v.SourceProtocol
+	m.SourceHostname = v.SourceHostname
+	m.SourcePort = v.SourcePort
+	m.DestinationProtocol = v.DestinationProtocol
+	m.DestinationHostname = v.DestinationHostname
+	m.DestinationPort = v.DestinationPort
+	m.HSTS = v.HSTS
+	m.CustomHeaders = v.CustomHeaders
+
+	return diags
+}
+
+var (
+	_ resource.Resource             = &ReverseProxyRuleResource{}
+	_ resource.ResourceWithIdentity = &ReverseProxyRuleResource{}
+)
+
+func NewReverseProxyRuleResource() resource.Resource {
+	return &ReverseProxyRuleResource{}
+}
+
+type ReverseProxyRuleResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *ReverseProxyRuleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ReverseProxyRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, diags := data.rule().entry(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entry.Description = p.stamp.Apply(entry.Description)
+
+	before, err := p.client.ReverseProxyList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list reverse proxy rules", err.Error())
+		return
+	}
+
+	if err := p.client.ReverseProxyCreate(ctx, entry); err != nil {
+		resp.Diagnostics.AddError("Failed to create reverse proxy rule", err.Error())
+		return
+	}
+
+	// DSM does not return the UUID of the new rule, find the new entry with
+	// the same description.
+	created, err := p.find(ctx, func(e dsm.ReverseProxyEntry) bool {
+		return e.Description == entry.Description &&
+			!slices.ContainsFunc(before.Entries, func(b dsm.ReverseProxyEntry) bool {
+				return b.UUID == e.UUID
+			})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list reverse proxy rules", err.Error())
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.AddError(
+			"Reverse proxy rule not found",
+			fmt.Sprintf("Reverse proxy rule %s not found after creation", entry.Description),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *created, p.stamp)...)
+	resp.Diagnostics.Append(p.setURL(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *ReverseProxyRuleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan ReverseProxyRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, diags := plan.rule().entry(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	entry.UUID = plan.ID.ValueString()
+	entry.Description = p.stamp.Apply(entry.Description)
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, entry.UUID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.ReverseProxyUpdate(ctx, entry); err != nil {
+		resp.Diagnostics.AddError("Failed to update reverse proxy rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(plan.set(ctx, entry, p.stamp)...)
+	resp.Diagnostics.Append(p.setURL(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", plan.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *ReverseProxyRuleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ReverseProxyRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.ReverseProxyDelete(ctx, data.ID.ValueString()); err != nil {
+		existing, lerr := p.find(ctx, func(e dsm.ReverseProxyEntry) bool {
+			return e.UUID == data.ID.ValueString()
+		})
+		if lerr != nil || existing != nil {
+			resp.Diagnostics.AddError("Failed to delete reverse proxy rule", err.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ReverseProxyRuleResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "reverse_proxy_rule")
+}
+
+// Read implements resource.Resource.
+func (p *ReverseProxyRuleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ReverseProxyRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, err := p.find(ctx, func(e dsm.ReverseProxyEntry) bool {
+		return e.UUID == data.ID.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list reverse proxy rules", err.Error())
+		return
+	}
+	if entry == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *entry, p.stamp)...)
+	resp.Diagnostics.Append(p.setURL(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *ReverseProxyRuleResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	attributes := reverseProxyRuleAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The UUID of the rule.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["url"] = schema.StringAttribute{
+		MarkdownDescription: "The URL clients reach the rule with. For the `*` source hostname the first enabled DDNS hostname of the NAS is used, or the provider host if there is none.",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single reverse proxy rule of the DSM login portal. Other rules are left untouched and new rules are added after them. Do not combine with `synology_core_reverse_proxy_ruleset`.",
+
+		Attributes: attributes,
+	}
+}
+
+func (p *ReverseProxyRuleResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *ReverseProxyRuleResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, err := p.find(ctx, func(e dsm.ReverseProxyEntry) bool {
+		return e.UUID == id
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list reverse proxy rules", err.Error())
+		return
+	}
+	if entry == nil {
+		resp.Diagnostics.AddError("Reverse proxy rule not found", fmt.Sprintf("Reverse proxy rule %s not found", id))
+		return
+	}
+
+	var data ReverseProxyRuleResourceModel
+	resp.Diagnostics.Append(data.set(ctx, *entry, p.stamp)...)
+	resp.Diagnostics.Append(p.setURL(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *ReverseProxyRuleResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The UUID of the rule.")
+}
+
+func (p *ReverseProxyRuleResource) find(
+	ctx context.Context,
+	match func(dsm.ReverseProxyEntry) bool,
+) (*dsm.ReverseProxyEntry, error) {
+	list, err := p.client.ReverseProxyList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Entries, match)
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Entries[i], nil
+}
+
+// setURL sets the URL of the rule in data from its source endpoint.
+func (p *ReverseProxyRuleResource) setURL(ctx context.Context, data *ReverseProxyRuleResourceModel) (diags diag.Diagnostics) {
+	host := data.SourceHostname.ValueString()
+	if host == "*" {
+		h, err := p.client.ServiceHost(ctx)
+		if err != nil {
+			diags.AddError("Failed to list DDNS records", err.Error())
+			return
+		}
+		host = h
+	}
+
+	data.URL = types.StringValue(util.ServiceURL(data.SourceProtocol.ValueString(), host, data.SourcePort.ValueInt64()))
+	return
+}
+
+// checkStamp refuses changes to the rule with uuid when the provider requires
+// a description stamp the rule lacks.
+func (p *ReverseProxyRuleResource) checkStamp(ctx context.Context, uuid string) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	entry, err := p.find(ctx, func(e dsm.ReverseProxyEntry) bool {
+		return e.UUID == uuid
+	})
+	if err != nil {
+		diags.AddError("Failed to list reverse proxy rules", err.Error())
+		return
+	}
+	if entry == nil {
+		return
+	}
+
+	if err := p.stamp.Check("reverse proxy rule", entry.Description); err != nil {
+		diags.AddError("Refusing to modify reverse proxy rule", err.Error())
+	}
+	return
+}
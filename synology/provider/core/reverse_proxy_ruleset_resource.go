@@ -0,0 +1,303 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+type ReverseProxyRulesetResourceModel struct {
+	Rules types.List `tfsdk:"rules"`
+}
+
+func (m ReverseProxyRulesetResourceModel) entries(ctx context.Context) ([]dsm.ReverseProxyEntry, diag.Diagnostics) {
+	var elements []ReverseProxyRuleModel
+	diags := m.Rules.ElementsAs(ctx, &elements, true)
+
+	entries := make([]dsm.ReverseProxyEntry, 0, len(elements))
+	for _, e := range elements {
+		entry, d := e.entry(ctx)
+		diags.Append(d...)
+		entries = append(entries, entry)
+	}
+
+	return entries, diags
+}
+
+func (m *ReverseProxyRulesetResourceModel) set(ctx context.Context, entries []dsm.ReverseProxyEntry) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elements := make([]ReverseProxyRuleModel, 0, len(entries))
+	for _, e := range entries {
+		v, d := newReverseProxyRuleModel(ctx, e)
+		diags.Append(d...)
+		elements = append(elements, v)
+	}
+
+	v, d := types.ListValueFrom(ctx, ReverseProxyRuleModel{}.ModelType(), elements)
+	diags.Append(d...)
+	m.Rules = v
+
+	return diags
+}
+
+var (
+	_ resource.Resource = &ReverseProxyRulesetResource{}
+)
+
+func NewReverseProxyRulesetResource() resource.Resource {
+	return &ReverseProxyRulesetResource{}
+}
+
+type ReverseProxyRulesetResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ReverseProxyRulesetResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ReverseProxyRulesetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *ReverseProxyRulesetResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ReverseProxyRulesetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource.
+func (p *ReverseProxyRulesetResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	data := ReverseProxyRulesetResourceModel{
+		Rules: types.ListValueMust(ReverseProxyRuleModel{}.ModelType(), nil),
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ReverseProxyRulesetResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "reverse_proxy_ruleset")
+}
+
+// Read implements resource.Resource.
+func (p *ReverseProxyRulesetResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ReverseProxyRulesetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := p.client.ReverseProxyList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list reverse proxy rules", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, list.Entries)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *ReverseProxyRulesetResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete list of reverse proxy rules of the DSM login portal. Rules are matched with the NAS by description, rules which are not listed are deleted. The order of the rules is kept on the NAS; as DSM cannot reorder rules, moving a rule recreates it and every rule after it.",
+
+		Attributes: map[string]schema.Attribute{
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The reverse proxy rules.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: reverseProxyRuleAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (p *ReverseProxyRulesetResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The import ID is
+// ignored as there is a single reverse proxy rule list per NAS.
+func (p *ReverseProxyRulesetResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	list, err := p.client.ReverseProxyList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list reverse proxy rules", err.Error())
+		return
+	}
+
+	var data ReverseProxyRulesetResourceModel
+	resp.Diagnostics.Append(data.set(ctx, list.Entries)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply makes the reverse proxy rules of the NAS match data. Existing rules
+// are updated in place by description, missing ones created and unlisted
+// ones deleted. Rules are kept in the order of data.
+func (p *ReverseProxyRulesetResource) apply(ctx context.Context, data ReverseProxyRulesetResourceModel) diag.Diagnostics {
+	entries, diags := data.entries(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	list, err := p.client.ReverseProxyList(ctx)
+	if err != nil {
+		diags.AddError("Failed to list reverse proxy rules", err.Error())
+		return diags
+	}
+
+	existing := map[string]dsm.ReverseProxyEntry{}
+	for _, e := range list.Entries {
+		existing[e.Description] = e
+	}
+
+	wanted := map[string]bool{}
+	for _, e := range entries {
+		if wanted[e.Description] {
+			diags.AddError(
+				"Duplicate reverse proxy rule",
+				fmt.Sprintf("More than one rule has the description %q.", e.Description),
+			)
+			return diags
+		}
+		wanted[e.Description] = true
+	}
+
+	var stale []string
+	var kept []dsm.ReverseProxyEntry
+	for _, e := range list.Entries {
+		if wanted[e.Description] {
+			kept = append(kept, e)
+		} else {
+			stale = append(stale, e.UUID)
+		}
+	}
+
+	// DSM lists rules in creation order and cannot reorder them, so every
+	// rule from the first one out of place onwards is recreated.
+	for i, e := range kept {
+		if i < len(entries) && e.Description == entries[i].Description {
+			continue
+		}
+		for _, e := range kept[i:] {
+			stale = append(stale, e.UUID)
+			delete(existing, e.Description)
+		}
+		break
+	}
+
+	if len(stale) > 0 {
+		if err := p.client.ReverseProxyDelete(ctx, stale...); err != nil {
+			diags.AddError("Failed to delete reverse proxy rules", err.Error())
+			return diags
+		}
+	}
+
+	for _, e := range entries {
+		if cur, ok := existing[e.Description]; ok {
+			e.UUID = cur.UUID
+			if reflect.DeepEqual(cur, e) {
+				continue
+			}
+			err = p.client.ReverseProxyUpdate(ctx, e)
+		} else {
+			err = p.client.ReverseProxyCreate(ctx, e)
+		}
+		if err != nil {
+			diags.AddError("Failed to apply reverse proxy rule", fmt.Sprintf("%s: %s", e.Description, err))
+			return diags
+		}
+	}
+
+	return diags
+}
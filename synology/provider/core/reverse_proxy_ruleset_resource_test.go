@@ -0,0 +1,52 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ReverseProxyRulesetResource struct{}
+
+func TestAccReverseProxyRulesetResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"rules are set",
+			`
+			resource "synology_core_reverse_proxy_ruleset" "all" {
+				rules = [
+					{
+						description          = "app"
+						source_hostname      = "app.example.com"
+						source_port          = 443
+						destination_hostname = "localhost"
+						destination_port     = 8080
+					},
+				]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_core_reverse_proxy_ruleset.all",
+								"rules.#",
+								"1",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
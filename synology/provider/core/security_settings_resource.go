@@ -0,0 +1,328 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// tlsProfileMinVersions are the oldest protocol versions accepted by each TLS
+// profile. DSM 7 does not support SSLv3 with any profile.
+var tlsProfileMinVersions = map[string]string{
+	dsm.TLSProfileModern:       "TLSv1.3",
+	dsm.TLSProfileIntermediate: "TLSv1.2",
+	dsm.TLSProfileOld:          "TLSv1.0",
+}
+
+type SecuritySettingsResourceModel struct {
+	HSTS                   types.Bool   `tfsdk:"hsts"`
+	ClickjackingProtection types.Bool   `tfsdk:"clickjacking_protection"`
+	CSRFProtection         types.Bool   `tfsdk:"csrf_protection"`
+	TLSProfile             types.String `tfsdk:"tls_profile"`
+	MinTLSVersion          types.String `tfsdk:"min_tls_version"`
+}
+
+var (
+	_ resource.Resource               = &SecuritySettingsResource{}
+	_ resource.ResourceWithModifyPlan = &SecuritySettingsResource{}
+)
+
+func NewSecuritySettingsResource() resource.Resource {
+	return &SecuritySettingsResource{}
+}
+
+type SecuritySettingsResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SecuritySettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SecuritySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *SecuritySettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SecuritySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The settings are left as they are.
+func (p *SecuritySettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SecuritySettingsResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "security_settings")
+}
+
+// Read implements resource.Resource.
+func (p *SecuritySettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SecuritySettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *SecuritySettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the HTTP security settings of the DSM web server. Settings which are not configured keep their current value. There is a single set of settings per NAS; destroying the resource leaves them unchanged. Planning lists the settings which differ from the NAS.",
+
+		Attributes: map[string]schema.Attribute{
+			"hsts": schema.BoolAttribute{
+				MarkdownDescription: "Whether the `Strict-Transport-Security` header is sent, forcing browsers to use HTTPS.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"clickjacking_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether DSM sends `X-Frame-Options` so it cannot be embedded in frames of other sites.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"csrf_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether the improved protection against cross-site request forgery is enabled.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tls_profile": schema.StringAttribute{
+				MarkdownDescription: "The default TLS profile of the DSM services: `modern`, `intermediate` or `old`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dsm.TLSProfileModern, dsm.TLSProfileIntermediate, dsm.TLSProfileOld),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"min_tls_version": schema.StringAttribute{
+				MarkdownDescription: "The oldest protocol version accepted with `tls_profile`, e.g. `TLSv1.2`. SSLv3 is never accepted by DSM 7.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It lists the
+// settings the plan changes against the settings currently on the NAS.
+func (p *SecuritySettingsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || p.client == nil {
+		return
+	}
+
+	var plan SecuritySettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current SecuritySettingsResourceModel
+	if diags := p.read(ctx, &current); diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(util.PlanPreview(util.ModelDiff("", current, plan))...)
+}
+
+func (p *SecuritySettingsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The import ID is
+// ignored as there is a single set of settings per NAS.
+func (p *SecuritySettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	data := SecuritySettingsResourceModel{}
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply sends the configured settings and reads back the others.
+func (p *SecuritySettingsResource) apply(ctx context.Context, data *SecuritySettingsResourceModel) (diags diag.Diagnostics) {
+	web, err := p.client.WebDSMGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get web settings", err.Error())
+		return diags
+	}
+	if !data.HSTS.IsUnknown() && web.EnableHSTS != data.HSTS.ValueBool() {
+		web.EnableHSTS = data.HSTS.ValueBool()
+		if err := p.client.WebDSMSet(ctx, *web); err != nil {
+			diags.AddError("Failed to set web settings", err.Error())
+			return diags
+		}
+	}
+
+	security, err := p.client.SecurityDSMGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get security settings", err.Error())
+		return diags
+	}
+	changed := false
+	if !data.ClickjackingProtection.IsUnknown() && security.ClickjackingProtection != data.ClickjackingProtection.ValueBool() {
+		security.ClickjackingProtection = data.ClickjackingProtection.ValueBool()
+		changed = true
+	}
+	if !data.CSRFProtection.IsUnknown() && security.CSRFProtection != data.CSRFProtection.ValueBool() {
+		security.CSRFProtection = data.CSRFProtection.ValueBool()
+		changed = true
+	}
+	if changed {
+		if err := p.client.SecurityDSMSet(ctx, *security); err != nil {
+			diags.AddError("Failed to set security settings", err.Error())
+			return diags
+		}
+	}
+
+	if !data.TLSProfile.IsUnknown() {
+		if err := p.client.TLSProfileSet(ctx, dsm.TLSProfile{DefaultLevel: data.TLSProfile.ValueString()}); err != nil {
+			diags.AddError("Failed to set TLS profile", err.Error())
+			return diags
+		}
+	}
+
+	diags.Append(p.read(ctx, data)...)
+	return diags
+}
+
+func (p *SecuritySettingsResource) read(ctx context.Context, data *SecuritySettingsResourceModel) (diags diag.Diagnostics) {
+	web, err := p.client.WebDSMGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get web settings", err.Error())
+		return diags
+	}
+
+	security, err := p.client.SecurityDSMGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get security settings", err.Error())
+		return diags
+	}
+
+	profile, err := p.client.TLSProfileGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get TLS profile", err.Error())
+		return diags
+	}
+
+	data.HSTS = types.BoolValue(web.EnableHSTS)
+	data.ClickjackingProtection = types.BoolValue(security.ClickjackingProtection)
+	data.CSRFProtection = types.BoolValue(security.CSRFProtection)
+	data.TLSProfile = types.StringValue(profile.DefaultLevel)
+	data.MinTLSVersion = types.StringValue(tlsProfileMinVersions[profile.DefaultLevel])
+
+	return diags
+}
@@ -0,0 +1,42 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SecuritySettingsResource struct{}
+
+func TestAccSecuritySettingsResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"modern profile is set",
+			`
+			resource "synology_core_security_settings" "foo" {
+				hsts        = true
+				tls_profile = "modern"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_core_security_settings.foo", "hsts", "true"),
+							r.TestCheckResourceAttr("synology_core_security_settings.foo", "min_tls_version", "TLSv1.3"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,406 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// Services of the service access matrix.
+const (
+	serviceDrive    = "drive"
+	serviceMailPlus = "mailplus"
+	servicePhotos   = "photos"
+)
+
+// serviceApps are the applications of the services granted by application
+// privileges. MailPlus is granted by enabling the MailPlus account instead.
+var serviceApps = map[string]string{
+	serviceDrive:  dsm.AppPrivilegeDrive,
+	servicePhotos: dsm.AppPrivilegePhotos,
+}
+
+var serviceSetType = types.SetType{ElemType: types.StringType}
+
+type ServiceAccessResourceModel struct {
+	Users  types.Map `tfsdk:"users"`
+	Groups types.Map `tfsdk:"groups"`
+}
+
+// serviceMatrix are the services of users and groups, keyed by entity type
+// and name.
+type serviceMatrix map[string]map[string][]string
+
+func (m ServiceAccessResourceModel) matrix(ctx context.Context) (serviceMatrix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	matrix := serviceMatrix{
+		dsm.AppPrivilegeEntityUser:  {},
+		dsm.AppPrivilegeEntityGroup: {},
+	}
+	for entityType, v := range map[string]types.Map{
+		dsm.AppPrivilegeEntityUser:  m.Users,
+		dsm.AppPrivilegeEntityGroup: m.Groups,
+	} {
+		if !v.IsNull() && !v.IsUnknown() {
+			services := map[string][]string{}
+			diags.Append(v.ElementsAs(ctx, &services, false)...)
+			matrix[entityType] = services
+		}
+	}
+
+	return matrix, diags
+}
+
+// has reports whether the entity is granted the service.
+func (s serviceMatrix) has(entityType, name, service string) bool {
+	return slices.Contains(s[entityType][name], service)
+}
+
+// names returns the sorted names of the entities of a type in s or other.
+func (s serviceMatrix) names(other serviceMatrix, entityType string) []string {
+	var names []string
+	for _, m := range []serviceMatrix{s, other} {
+		for name := range m[entityType] {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+var (
+	_ resource.Resource = &ServiceAccessResource{}
+)
+
+func NewServiceAccessResource() resource.Resource {
+	return &ServiceAccessResource{}
+}
+
+type ServiceAccessResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ServiceAccessResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ServiceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := data.matrix(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, want, serviceMatrix{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource. Users and groups removed from the
+// matrix lose the services it granted them.
+func (p *ServiceAccessResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state ServiceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := data.matrix(ctx)
+	resp.Diagnostics.Append(diags...)
+	prior, diags := state.matrix(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, want, prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The services are revoked from every
+// user and group of the matrix.
+func (p *ServiceAccessResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ServiceAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior, diags := data.matrix(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, serviceMatrix{}, prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ServiceAccessResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "service_access")
+}
+
+// Read implements resource.Resource. Only the users and groups of the
+// matrix are read.
+func (p *ServiceAccessResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ServiceAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior, diags := data.matrix(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := p.read(ctx, prior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, e := range []struct {
+		attr       *types.Map
+		entityType string
+	}{
+		{&data.Users, dsm.AppPrivilegeEntityUser},
+		{&data.Groups, dsm.AppPrivilegeEntityGroup},
+	} {
+		if e.attr.IsNull() {
+			continue
+		}
+		v, d := types.MapValueFrom(ctx, serviceSetType, current[e.entityType])
+		resp.Diagnostics.Append(d...)
+		*e.attr = v
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *ServiceAccessResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages which users and groups may use Synology Drive, Synology Photos and MailPlus, seat style. Drive and Photos are granted with an application privilege allowing the application; MailPlus is granted by enabling the MailPlus account of a user, which takes a license. Services which are not listed for a user or group are revoked, other users and groups are left alone. The MailPlus package only needs to be installed when `mailplus` is used.",
+
+		Attributes: map[string]schema.Attribute{
+			"users": schema.MapAttribute{
+				MarkdownDescription: "The services of local users, keyed by user name. Any of `drive`, `photos` or `mailplus`.",
+				ElementType:         serviceSetType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.ValueSetsAre(setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(serviceDrive, servicePhotos, serviceMailPlus),
+					)),
+				},
+			},
+			"groups": schema.MapAttribute{
+				MarkdownDescription: "The services of the members of local groups, keyed by group name. Any of `drive` or `photos`; MailPlus accounts can only be enabled per user.",
+				ElementType:         serviceSetType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.ValueSetsAre(setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(serviceDrive, servicePhotos),
+					)),
+				},
+			},
+		},
+	}
+}
+
+func (p *ServiceAccessResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// apply grants the services of want and revokes the others from the users
+// and groups of want and prior.
+func (p *ServiceAccessResource) apply(ctx context.Context, want, prior serviceMatrix) (diags diag.Diagnostics) {
+	for _, service := range []string{serviceDrive, servicePhotos} {
+		app := serviceApps[service]
+
+		current, err := p.client.AppPrivilegeRuleList(ctx, app)
+		if err != nil {
+			diags.AddError("Failed to list application privileges", err.Error())
+			return
+		}
+
+		var set, stale []dsm.AppPrivilegeRule
+		for _, entityType := range []string{dsm.AppPrivilegeEntityUser, dsm.AppPrivilegeEntityGroup} {
+			for _, name := range want.names(prior, entityType) {
+				i := slices.IndexFunc(current.Rules, func(r dsm.AppPrivilegeRule) bool {
+					return r.EntityType == entityType && r.EntityName == name
+				})
+				allowed := i != -1 && current.Rules[i].Allowed() && !current.Rules[i].Denied()
+
+				switch {
+				case want.has(entityType, name, service) && !allowed:
+					set = append(set, newAppPrivilegeRule(app, entityType, name, privilegeAllow))
+				case !want.has(entityType, name, service) && allowed:
+					stale = append(stale, dsm.AppPrivilegeRule{EntityType: entityType, EntityName: name, AppID: app})
+				}
+			}
+		}
+
+		if len(stale) > 0 {
+			if err := p.client.AppPrivilegeRuleDelete(ctx, stale); err != nil {
+				diags.AddError("Failed to delete application privileges", err.Error())
+				return
+			}
+		}
+		if len(set) > 0 {
+			if err := p.client.AppPrivilegeRuleSet(ctx, set); err != nil {
+				diags.AddError("Failed to set application privileges", err.Error())
+				return
+			}
+		}
+	}
+
+	var accounts []dsm.MailPlusAccount
+	for _, name := range want.names(prior, dsm.AppPrivilegeEntityUser) {
+		enabled := want.has(dsm.AppPrivilegeEntityUser, name, serviceMailPlus)
+		if enabled || prior.has(dsm.AppPrivilegeEntityUser, name, serviceMailPlus) {
+			accounts = append(accounts, dsm.MailPlusAccount{Name: name, Enabled: enabled})
+		}
+	}
+	if len(accounts) > 0 {
+		if err := p.client.MailPlusAccountSet(ctx, accounts...); err != nil {
+			diags.AddError("Failed to set MailPlus accounts", err.Error())
+		}
+	}
+	return
+}
+
+// read returns the services the users and groups of prior are granted. The
+// MailPlus accounts are only read when prior uses MailPlus.
+func (p *ServiceAccessResource) read(ctx context.Context, prior serviceMatrix) (serviceMatrix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current := serviceMatrix{}
+	for _, entityType := range []string{dsm.AppPrivilegeEntityUser, dsm.AppPrivilegeEntityGroup} {
+		current[entityType] = map[string][]string{}
+		for name := range prior[entityType] {
+			current[entityType][name] = []string{}
+		}
+	}
+
+	for _, service := range []string{serviceDrive, servicePhotos} {
+		list, err := p.client.AppPrivilegeRuleList(ctx, serviceApps[service])
+		if err != nil {
+			diags.AddError("Failed to list application privileges", err.Error())
+			return nil, diags
+		}
+		for _, r := range list.Rules {
+			services, ok := current[r.EntityType][r.EntityName]
+			if ok && r.Allowed() && !r.Denied() {
+				current[r.EntityType][r.EntityName] = append(services, service)
+			}
+		}
+	}
+
+	mailplus := false
+	for _, services := range prior[dsm.AppPrivilegeEntityUser] {
+		mailplus = mailplus || slices.Contains(services, serviceMailPlus)
+	}
+	if !mailplus {
+		return current, diags
+	}
+
+	list, err := p.client.MailPlusAccountList(ctx)
+	if err != nil {
+		diags.AddError("Failed to list MailPlus accounts", err.Error())
+		return nil, diags
+	}
+	users := current[dsm.AppPrivilegeEntityUser]
+	for _, a := range list.Accounts {
+		if services, ok := users[a.Name]; ok && a.Enabled {
+			users[a.Name] = append(services, serviceMailPlus)
+		}
+	}
+
+	return current, diags
+}
@@ -0,0 +1,176 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ShareConsumersDataSource{}
+
+func NewShareConsumersDataSource() datasource.DataSource {
+	return &ShareConsumersDataSource{}
+}
+
+type ShareConsumersDataSource struct {
+	client client.Api
+}
+
+type ShareConsumerModel struct {
+	Type    types.String `tfsdk:"type"`
+	Name    types.String `tfsdk:"name"`
+	Path    types.String `tfsdk:"path"`
+	Running types.Bool   `tfsdk:"running"`
+}
+
+func (m ShareConsumerModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m ShareConsumerModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":    types.StringType,
+		"name":    types.StringType,
+		"path":    types.StringType,
+		"running": types.BoolType,
+	}
+}
+
+type ShareConsumersDataSourceModel struct {
+	Share     types.String `tfsdk:"share"`
+	InUse     types.Bool   `tfsdk:"in_use"`
+	Consumers types.List   `tfsdk:"consumers"`
+}
+
+func (d *ShareConsumersDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "share_consumers")
+}
+
+func (d *ShareConsumersDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the services, packages and containers using a shared folder, e.g. to assert with a precondition that nothing uses a shared folder before it is destroyed. Objects the provider account may not list, e.g. without administrator privileges, and those of packages which are not installed are left out.",
+
+		Attributes: map[string]schema.Attribute{
+			"share": schema.StringAttribute{
+				MarkdownDescription: "The name of the shared folder, e.g. `docker`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"in_use": schema.BoolAttribute{
+				MarkdownDescription: "Whether any consumer is running, i.e. removing the shared folder would break it.",
+				Computed:            true,
+			},
+			"consumers": schema.ListNestedAttribute{
+				MarkdownDescription: "The objects using the shared folder or a folder within it, by type and name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the consumer, e.g. `container project`, `NFS export`, `remote folder`, `Web Station virtual host`, `Download Station`, `Hyper Backup task`, `Shared Folder Sync task` or `Snapshot Replication plan`.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the consumer, e.g. the container project, the NFS client or the host name of the virtual host.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "The File Station path the consumer uses, e.g. `/docker/media/config`.",
+							Computed:            true,
+						},
+						"running": schema.BoolAttribute{
+							MarkdownDescription: "Whether the consumer uses the folder right now, such as a running container project or a mounted remote folder. Tasks only use it when they run.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ShareConsumersDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data ShareConsumersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deps, err := synoclient.Dependents(ctx, d.client, "/"+strings.Trim(data.Share.ValueString(), "/"))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to list the consumers of the shared folder, got error: %s", err),
+		)
+		return
+	}
+
+	consumers := []ShareConsumerModel{}
+	inUse := false
+	for _, dep := range deps {
+		consumers = append(consumers, ShareConsumerModel{
+			Type:    types.StringValue(dep.Kind),
+			Name:    types.StringValue(dep.Name),
+			Path:    types.StringValue(dep.Path),
+			Running: types.BoolValue(dep.Running),
+		})
+		inUse = inUse || dep.Running
+	}
+
+	v, diags := types.ListValueFrom(ctx, ShareConsumerModel{}.ModelType(), consumers)
+	resp.Diagnostics.Append(diags...)
+	data.Consumers = v
+	data.InUse = types.BoolValue(inUse)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ShareConsumersDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,379 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// Access levels of a user or group on a shared folder.
+const (
+	accessReadWrite = "read_write"
+	accessReadOnly  = "read_only"
+	accessNoAccess  = "no_access"
+)
+
+type SharePermissionsResourceModel struct {
+	Share  types.String `tfsdk:"share"`
+	Users  types.Map    `tfsdk:"users"`
+	Groups types.Map    `tfsdk:"groups"`
+}
+
+var (
+	_ resource.Resource             = &SharePermissionsResource{}
+	_ resource.ResourceWithIdentity = &SharePermissionsResource{}
+)
+
+func NewSharePermissionsResource() resource.Resource {
+	return &SharePermissionsResource{}
+}
+
+type SharePermissionsResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SharePermissionsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SharePermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", data.Share.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *SharePermissionsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SharePermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", data.Share.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *SharePermissionsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data SharePermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Users = types.MapNull(types.StringType)
+	data.Groups = types.MapNull(types.StringType)
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SharePermissionsResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "share_permissions")
+}
+
+// Read implements resource.Resource.
+func (p *SharePermissionsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SharePermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", data.Share.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *SharePermissionsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	accessValidators := []validator.Map{
+		mapvalidator.ValueStringsAre(
+			stringvalidator.OneOf(accessReadWrite, accessReadOnly, accessNoAccess),
+		),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete list of local user and group permissions of a shared folder. Users and groups which are not listed lose their explicit permission on the share. All changes are applied with a single call per principal type.",
+
+		Attributes: map[string]schema.Attribute{
+			"share": schema.StringAttribute{
+				MarkdownDescription: "The name of the shared folder.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.MapAttribute{
+				MarkdownDescription: "Access of local users, keyed by user name. One of `read_write`, `read_only` or `no_access`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          accessValidators,
+			},
+			"groups": schema.MapAttribute{
+				MarkdownDescription: "Access of local groups, keyed by group name. One of `read_write`, `read_only` or `no_access`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          accessValidators,
+			},
+		},
+	}
+}
+
+func (p *SharePermissionsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *SharePermissionsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	share, diags := util.ImportID(ctx, req, "share")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := SharePermissionsResourceModel{
+		Share:  types.StringValue(share),
+		Users:  types.MapNull(types.StringType),
+		Groups: types.MapNull(types.StringType),
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", share)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *SharePermissionsResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("share", "The name of the shared folder.")
+}
+
+// apply makes the share permissions match data. For each principal type the
+// current permissions are listed and every difference, including the removal
+// of unlisted entries, is sent in a single set call.
+func (p *SharePermissionsResource) apply(
+	ctx context.Context,
+	data SharePermissionsResourceModel,
+) (diags diag.Diagnostics) {
+	share := data.Share.ValueString()
+
+	for userGroupType, m := range map[string]types.Map{
+		dsm.UserGroupTypeLocalUser:  data.Users,
+		dsm.UserGroupTypeLocalGroup: data.Groups,
+	} {
+		want := map[string]string{}
+		if !m.IsNull() && !m.IsUnknown() {
+			diags.Append(m.ElementsAs(ctx, &want, false)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		current, err := p.client.SharePermissionList(ctx, share, userGroupType)
+		if err != nil {
+			diags.AddError("Failed to list share permissions", err.Error())
+			return diags
+		}
+
+		changes := diffSharePermissions(current.Items, want)
+		if len(changes) == 0 {
+			continue
+		}
+
+		if err := p.client.SharePermissionSet(ctx, share, userGroupType, changes); err != nil {
+			diags.AddError("Failed to set share permissions", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (p *SharePermissionsResource) read(
+	ctx context.Context,
+	data *SharePermissionsResourceModel,
+) (diags diag.Diagnostics) {
+	share := data.Share.ValueString()
+
+	for userGroupType, attr := range map[string]*types.Map{
+		dsm.UserGroupTypeLocalUser:  &data.Users,
+		dsm.UserGroupTypeLocalGroup: &data.Groups,
+	} {
+		current, err := p.client.SharePermissionList(ctx, share, userGroupType)
+		if err != nil {
+			diags.AddError("Failed to list share permissions", err.Error())
+			return diags
+		}
+
+		access := map[string]string{}
+		for _, e := range current.Items {
+			if e.Explicit() {
+				access[e.Name] = sharePermissionAccess(e)
+			}
+		}
+
+		// Keep an unset attribute null rather than an empty map.
+		if len(access) == 0 && attr.IsNull() {
+			continue
+		}
+
+		v, d := types.MapValueFrom(ctx, types.StringType, access)
+		diags.Append(d...)
+		*attr = v
+	}
+
+	return diags
+}
+
+func sharePermissionAccess(p dsm.SharePermission) string {
+	switch {
+	case p.IsDeny:
+		return accessNoAccess
+	case p.IsWritable:
+		return accessReadWrite
+	default:
+		return accessReadOnly
+	}
+}
+
+// diffSharePermissions returns the entries which must be sent to turn current
+// into want. Explicit entries missing from want are cleared.
+func diffSharePermissions(current []dsm.SharePermission, want map[string]string) []dsm.SharePermission {
+	var changes []dsm.SharePermission
+
+	seen := map[string]bool{}
+	for _, e := range current {
+		seen[e.Name] = true
+
+		access, ok := want[e.Name]
+		switch {
+		case !ok && e.Explicit():
+			changes = append(changes, dsm.SharePermission{Name: e.Name})
+		case ok && (!e.Explicit() || sharePermissionAccess(e) != access):
+			changes = append(changes, newSharePermission(e.Name, access))
+		}
+	}
+
+	var added []string
+	for name := range want {
+		if !seen[name] {
+			added = append(added, name)
+		}
+	}
+	sort.Strings(added)
+
+	for _, name := range added {
+		changes = append(changes, newSharePermission(name, want[name]))
+	}
+
+	return changes
+}
+
+func newSharePermission(name, access string) dsm.SharePermission {
+	return dsm.SharePermission{
+		Name:       name,
+		IsReadonly: access == accessReadOnly,
+		IsWritable: access == accessReadWrite,
+		IsDeny:     access == accessNoAccess,
+	}
+}
@@ -0,0 +1,56 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SharePermissionsResource struct{}
+
+func TestAccSharePermissionsResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"users and groups are set",
+			`
+			resource "synology_core_share_permissions" "foo" {
+				share = "docker"
+				users = {
+					admin = "read_write"
+					guest = "no_access"
+				}
+				groups = {
+					users = "read_only"
+				}
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_core_share_permissions.foo",
+								"users.admin",
+								"read_write",
+							),
+							r.TestCheckResourceAttr(
+								"synology_core_share_permissions.foo",
+								"groups.users",
+								"read_only",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
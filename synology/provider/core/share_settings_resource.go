@@ -0,0 +1,339 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type ShareSettingsResourceModel struct {
+	Share                  types.String `tfsdk:"share"`
+	AccessBasedEnumeration types.Bool   `tfsdk:"access_based_enumeration"`
+	HideInNetworkPlaces    types.Bool   `tfsdk:"hide_in_network_places"`
+	RecycleBin             types.Bool   `tfsdk:"recycle_bin"`
+	RecycleBinAdminOnly    types.Bool   `tfsdk:"recycle_bin_admin_only"`
+	SnapshotBrowsing       types.Bool   `tfsdk:"snapshot_browsing"`
+	Compression            types.Bool   `tfsdk:"compression"`
+}
+
+// merge overwrites the settings of s which are configured in m and reports
+// whether any of them changed.
+func (m ShareSettingsResourceModel) merge(s *dsm.ShareSettings) bool {
+	changed := false
+	for _, f := range []struct {
+		value types.Bool
+		field *bool
+	}{
+		{m.AccessBasedEnumeration, &s.HideUnreadable},
+		{m.HideInNetworkPlaces, &s.Hidden},
+		{m.RecycleBin, &s.EnableRecycleBin},
+		{m.RecycleBinAdminOnly, &s.RecycleBinAdminOnly},
+		{m.SnapshotBrowsing, &s.EnableSnapshotBrowsing},
+		{m.Compression, &s.EnableShareCompress},
+	} {
+		if f.value.IsUnknown() || f.value.IsNull() || *f.field == f.value.ValueBool() {
+			continue
+		}
+		*f.field = f.value.ValueBool()
+		changed = true
+	}
+	return changed
+}
+
+func (m *ShareSettingsResourceModel) set(s dsm.ShareSettings) {
+	m.AccessBasedEnumeration = types.BoolValue(s.HideUnreadable)
+	m.HideInNetworkPlaces = types.BoolValue(s.Hidden)
+	m.RecycleBin = types.BoolValue(s.EnableRecycleBin)
+	m.RecycleBinAdminOnly = types.BoolValue(s.RecycleBinAdminOnly)
+	m.SnapshotBrowsing = types.BoolValue(s.EnableSnapshotBrowsing)
+	m.Compression = types.BoolValue(s.EnableShareCompress)
+}
+
+var (
+	_ resource.Resource               = &ShareSettingsResource{}
+	_ resource.ResourceWithIdentity   = &ShareSettingsResource{}
+	_ resource.ResourceWithModifyPlan = &ShareSettingsResource{}
+)
+
+func NewShareSettingsResource() resource.Resource {
+	return &ShareSettingsResource{}
+}
+
+type ShareSettingsResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ShareSettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ShareSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data, nil, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", data.Share.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *ShareSettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ShareSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data, req.Private, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", data.Share.ValueString())...)
+}
+
+// Delete implements resource.Resource. The settings are left as they are.
+func (p *ShareSettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ShareSettingsResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "share_settings")
+}
+
+// Read implements resource.Resource.
+func (p *ShareSettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ShareSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", data.Share.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *ShareSettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	setting := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the advanced options of an existing shared folder. Settings which are not configured keep their current value; destroying the resource leaves them unchanged. Updates fail when the options were changed outside of Terraform, e.g. in DSM, since they were last read.",
+
+		Attributes: map[string]schema.Attribute{
+			"share": schema.StringAttribute{
+				MarkdownDescription: "The name of the shared folder. Changing it manages another shared folder instead of renaming this one.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_based_enumeration": setting("Whether sub-folders and files are hidden from users without permissions on them."),
+			"hide_in_network_places":   setting("Whether the shared folder is hidden in My Network Places."),
+			"recycle_bin":              setting("Whether deleted files are moved to the recycle bin of the shared folder."),
+			"recycle_bin_admin_only":   setting("Whether only administrators can access the recycle bin."),
+			"snapshot_browsing":        setting("Whether snapshots of the shared folder are visible to users in the `#snapshot` folder."),
+			"compression":              setting("Whether files are compressed by Btrfs. Needs data checksum, which can only be enabled when the shared folder is created on a Btrfs volume."),
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It rejects
+// compression on shared folders without data checksum.
+func (p *ShareSettingsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || p.client == nil {
+		return
+	}
+
+	var plan ShareSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Share.IsUnknown() || !plan.Compression.ValueBool() {
+		return
+	}
+
+	current, err := p.client.ShareSettingsGet(ctx, plan.Share.ValueString())
+	if err != nil {
+		// The shared folder may be created by the same apply.
+		return
+	}
+
+	if !current.EnableShareCow {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("compression"),
+			"Compression not supported",
+			fmt.Sprintf("The shared folder %s has no data checksum, it must be created with data checksum on a Btrfs volume to be compressed.", plan.Share.ValueString()),
+		)
+	}
+}
+
+func (p *ShareSettingsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *ShareSettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	share, diags := util.ImportID(ctx, req, "share")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := ShareSettingsResourceModel{Share: types.StringValue(share)}
+	resp.Diagnostics.Append(p.read(ctx, &data, resp.Private)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "share", share)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *ShareSettingsResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("share", "The name of the shared folder.")
+}
+
+// apply sends the configured settings and reads back the others. On update,
+// prior is the private state of the last read and the settings are only sent
+// when they did not change since.
+func (p *ShareSettingsResource) apply(
+	ctx context.Context,
+	data *ShareSettingsResourceModel,
+	prior, private util.PrivateState,
+) (diags diag.Diagnostics) {
+	current, err := p.client.ShareSettingsGet(ctx, data.Share.ValueString())
+	if err != nil {
+		diags.AddError("Failed to get shared folder", err.Error())
+		return diags
+	}
+
+	if prior != nil {
+		diags.Append(util.CheckRevision(ctx, prior, "shared folder "+data.Share.ValueString(), current)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if data.merge(current) {
+		if err := p.client.ShareSettingsSet(ctx, *current); err != nil {
+			diags.AddError("Failed to set shared folder", err.Error())
+			return diags
+		}
+	}
+
+	diags.Append(p.read(ctx, data, private)...)
+	return diags
+}
+
+// read reads the settings and records their revision in private.
+func (p *ShareSettingsResource) read(
+	ctx context.Context,
+	data *ShareSettingsResourceModel,
+	private util.PrivateState,
+) (diags diag.Diagnostics) {
+	current, err := p.client.ShareSettingsGet(ctx, data.Share.ValueString())
+	if err != nil {
+		diags.AddError("Failed to get shared folder", err.Error())
+		return diags
+	}
+
+	data.set(*current)
+	diags.Append(util.SetRevision(ctx, private, current)...)
+	return diags
+}
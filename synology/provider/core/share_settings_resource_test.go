@@ -0,0 +1,96 @@
+package core_test
+
+import (
+	"fmt"
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+)
+
+type ShareSettingsResource struct{}
+
+func TestAccShareSettingsResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"advanced options are set",
+			`
+			resource "synology_core_share_settings" "foo" {
+				share                    = "docker"
+				access_based_enumeration = true
+				hide_in_network_places   = true
+				recycle_bin              = true
+				recycle_bin_admin_only   = true
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_core_share_settings.foo",
+								"access_based_enumeration",
+								"true",
+							),
+							r.TestCheckResourceAttr(
+								"synology_core_share_settings.foo",
+								"recycle_bin_admin_only",
+								"true",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccShareSettingsResource_replace(t *testing.T) {
+	// Changing share is only tested against the mock server.
+	s := acctest.NewMockServer(t, mock.WithShare("media"))
+
+	r.UnitTest(t, r.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []r.TestStep{
+			{
+				Config: `
+				resource "synology_core_share_settings" "foo" {
+					share       = "docker"
+					recycle_bin = true
+				}`,
+			},
+			{
+				Config: `
+				resource "synology_core_share_settings" "foo" {
+					share       = "media"
+					recycle_bin = true
+				}`,
+				ConfigPlanChecks: r.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("synology_core_share_settings.foo", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("synology_core_share_settings.foo", "share", "media"),
+					func(*terraform.State) error {
+						if _, ok := s.Share("docker"); !ok {
+							return fmt.Errorf("shared folder docker was renamed")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,389 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/schedulemodel"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type SharedFolderSyncTaskResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Shares       types.Set    `tfsdk:"shares"`
+	Server       types.String `tfsdk:"server"`
+	Port         types.Int64  `tfsdk:"port"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Encrypt      types.Bool   `tfsdk:"encrypt"`
+	SyncOnChange types.Bool   `tfsdk:"sync_on_change"`
+	Schedule     types.String `tfsdk:"schedule"`
+}
+
+func (m SharedFolderSyncTaskResourceModel) task(ctx context.Context) (dsm.ShareSyncTask, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	task := dsm.ShareSyncTask{
+		ID:           m.ID.ValueInt64(),
+		Name:         m.Name.ValueString(),
+		Shares:       []string{},
+		Server:       m.Server.ValueString(),
+		Port:         m.Port.ValueInt64(),
+		Username:     m.Username.ValueString(),
+		Password:     m.Password.ValueString(),
+		Encrypt:      m.Encrypt.ValueBool(),
+		SyncOnChange: m.SyncOnChange.ValueBool(),
+	}
+	diags.Append(m.Shares.ElementsAs(ctx, &task.Shares, false)...)
+	slices.Sort(task.Shares)
+
+	if spec := m.Schedule.ValueString(); spec != "" {
+		s, err := schedulemodel.ParseDSMSchedule(spec, true)
+		if err != nil {
+			diags.AddAttributeError(path.Root("schedule"), "Invalid sync schedule", err.Error())
+			return task, diags
+		}
+		task.Schedule = dsm.ShareSyncSchedule{
+			Enabled:    true,
+			Hour:       s.Hour,
+			Minute:     s.Minute,
+			RepeatHour: s.RepeatHour,
+			WeekDay:    s.WeekDay(),
+		}
+	}
+
+	return task, diags
+}
+
+func (m *SharedFolderSyncTaskResourceModel) set(ctx context.Context, task dsm.ShareSyncTask) diag.Diagnostics {
+	m.ID = types.Int64Value(task.ID)
+	m.Name = types.StringValue(task.Name)
+	m.Server = types.StringValue(task.Server)
+	m.Port = types.Int64Value(task.Port)
+	m.Username = types.StringValue(task.Username)
+	m.Encrypt = types.BoolValue(task.Encrypt)
+	m.SyncOnChange = types.BoolValue(task.SyncOnChange)
+
+	// DSM stores the schedule in its own format, an unscheduled task is the
+	// only change detected.
+	if !task.Schedule.Enabled {
+		m.Schedule = types.StringNull()
+	}
+
+	v, diags := types.SetValueFrom(ctx, types.StringType, task.Shares)
+	m.Shares = v
+
+	return diags
+}
+
+var (
+	_ resource.Resource             = &SharedFolderSyncTaskResource{}
+	_ resource.ResourceWithIdentity = &SharedFolderSyncTaskResource{}
+)
+
+func NewSharedFolderSyncTaskResource() resource.Resource {
+	return &SharedFolderSyncTaskResource{}
+}
+
+type SharedFolderSyncTaskResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SharedFolderSyncTaskResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SharedFolderSyncTaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, diags := data.task(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := p.client.ShareSyncTaskCreate(ctx, task)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create shared folder sync task", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(id, 10))...)
+}
+
+// Update implements resource.Resource.
+func (p *SharedFolderSyncTaskResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SharedFolderSyncTaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, diags := data.task(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.ShareSyncTaskSet(ctx, task); err != nil {
+		resp.Diagnostics.AddError("Failed to update shared folder sync task", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
+}
+
+// Delete implements resource.Resource. The synced folders on the server are
+// kept.
+func (p *SharedFolderSyncTaskResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data SharedFolderSyncTaskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.ShareSyncTaskDelete(ctx, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete shared folder sync task", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SharedFolderSyncTaskResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "shared_folder_sync_task")
+}
+
+// Read implements resource.Resource.
+func (p *SharedFolderSyncTaskResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SharedFolderSyncTaskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := p.find(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list shared folder sync tasks", err.Error())
+		return
+	}
+	if task == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *task)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
+}
+
+// Schema implements resource.Resource.
+func (p *SharedFolderSyncTaskResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Shared Folder Sync task mirroring shared folders one way to another Synology NAS. The Shared Folder Sync server has to be enabled on the destination.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the sync task.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the sync task.",
+				Required:            true,
+			},
+			"shares": schema.SetAttribute{
+				MarkdownDescription: "The shared folders mirrored to the server, e.g. `docker`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "Address of the destination NAS.",
+				Required:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the rsync service on the destination NAS.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(873),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "A user of the destination NAS with rsync permission.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of `username`.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"encrypt": schema.BoolAttribute{
+				MarkdownDescription: "Whether to encrypt the transfer.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"sync_on_change": schema.BoolAttribute{
+				MarkdownDescription: "Whether to sync whenever a file of the shared folders is modified.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Sync schedule expressed in cron, e.g. `0 1 * * *`. The minute must be a single value and the hours evenly spaced. The task only runs on demand or on change when unset.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (p *SharedFolderSyncTaskResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The password and
+// schedule are not imported and have to be set in the configuration.
+func (p *SharedFolderSyncTaskResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	importID, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(importID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse ID", err.Error())
+		return
+	}
+
+	task, err := p.find(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list shared folder sync tasks", err.Error())
+		return
+	}
+	if task == nil {
+		resp.Diagnostics.AddError("Shared folder sync task not found", fmt.Sprintf("Shared folder sync task %d not found", id))
+		return
+	}
+
+	data := SharedFolderSyncTaskResourceModel{
+		Password: types.StringNull(),
+		Schedule: types.StringNull(),
+	}
+	resp.Diagnostics.Append(data.set(ctx, *task)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", importID)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *SharedFolderSyncTaskResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the sync task.")
+}
+
+func (p *SharedFolderSyncTaskResource) find(ctx context.Context, id int64) (*dsm.ShareSyncTask, error) {
+	list, err := p.client.ShareSyncTaskList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Tasks, func(t dsm.ShareSyncTask) bool {
+		return t.ID == id
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Tasks[i], nil
+}
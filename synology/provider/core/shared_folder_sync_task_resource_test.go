@@ -0,0 +1,46 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SharedFolderSyncTaskResource struct{}
+
+func TestAccSharedFolderSyncTaskResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"sync task is created",
+			`
+			resource "synology_core_shared_folder_sync_task" "foo" {
+				name     = "tf-test"
+				shares   = ["docker"]
+				server   = "backup.example.com"
+				username = "sync"
+				password = "secret"
+				schedule = "0 1 * * *"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_core_shared_folder_sync_task.foo", "id"),
+							r.TestCheckResourceAttr("synology_core_shared_folder_sync_task.foo", "port", "873"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
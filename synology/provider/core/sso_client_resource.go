@@ -0,0 +1,308 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type SSOClientResourceModel struct {
+	Protocol      types.String `tfsdk:"protocol"`
+	Name          types.String `tfsdk:"name"`
+	DefaultLogin  types.Bool   `tfsdk:"default_login"`
+	WellKnownURL  types.String `tfsdk:"well_known_url"`
+	ClientID      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+	Scope         types.String `tfsdk:"scope"`
+	UsernameClaim types.String `tfsdk:"username_claim"`
+	RedirectURI   types.String `tfsdk:"redirect_uri"`
+	SAMLMetadata  types.String `tfsdk:"saml_metadata"`
+}
+
+func (m SSOClientResourceModel) settings() dsm.SSOClient {
+	s := dsm.SSOClient{
+		Enabled:      true,
+		Protocol:     m.Protocol.ValueString(),
+		Name:         m.Name.ValueString(),
+		DefaultLogin: m.DefaultLogin.ValueBool(),
+	}
+
+	switch s.Protocol {
+	case dsm.SSOProtocolOIDC:
+		s.OIDCWellKnownURL = m.WellKnownURL.ValueString()
+		s.OIDCClientID = m.ClientID.ValueString()
+		s.OIDCClientSecret = m.ClientSecret.ValueString()
+		s.OIDCScope = m.Scope.ValueString()
+		s.OIDCUsernameClaim = m.UsernameClaim.ValueString()
+		s.OIDCRedirectURI = m.RedirectURI.ValueString()
+	case dsm.SSOProtocolSAML:
+		s.SAMLMetadata = m.SAMLMetadata.ValueString()
+	}
+
+	return s
+}
+
+// set updates m from the NAS. The client secret is kept as DSM does not
+// return it.
+func (m *SSOClientResourceModel) set(s dsm.SSOClient) {
+	m.Protocol = types.StringValue(s.Protocol)
+	m.Name = types.StringValue(s.Name)
+	m.DefaultLogin = types.BoolValue(s.DefaultLogin)
+
+	switch s.Protocol {
+	case dsm.SSOProtocolOIDC:
+		m.WellKnownURL = util.String(s.OIDCWellKnownURL)
+		m.ClientID = util.String(s.OIDCClientID)
+		m.Scope = types.StringValue(s.OIDCScope)
+		m.UsernameClaim = types.StringValue(s.OIDCUsernameClaim)
+		m.RedirectURI = util.String(s.OIDCRedirectURI)
+	case dsm.SSOProtocolSAML:
+		m.SAMLMetadata = util.String(s.SAMLMetadata)
+	}
+}
+
+var (
+	_ resource.Resource                   = &SSOClientResource{}
+	_ resource.ResourceWithValidateConfig = &SSOClientResource{}
+)
+
+func NewSSOClientResource() resource.Resource {
+	return &SSOClientResource{}
+}
+
+type SSOClientResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SSOClientResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SSOClientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.SSOClientSet(ctx, data.settings()); err != nil {
+		resp.Diagnostics.AddError("Failed to set SSO client", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *SSOClientResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SSOClientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.SSOClientSet(ctx, data.settings()); err != nil {
+		resp.Diagnostics.AddError("Failed to set SSO client", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. SSO is disabled, the local DSM login
+// stays available at all times.
+func (p *SSOClientResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	if err := p.client.SSOClientSet(ctx, dsm.SSOClient{}); err != nil {
+		resp.Diagnostics.AddError("Failed to disable SSO client", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SSOClientResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "sso_client")
+}
+
+// Read implements resource.Resource.
+func (p *SSOClientResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SSOClientResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := p.client.SSOClientGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get SSO client", err.Error())
+		return
+	}
+
+	if !settings.Enabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*settings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *SSOClientResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lets DSM users sign in with an external OpenID Connect or SAML identity provider, as in Control Panel > Domain/LDAP > SSO Client. There is a single SSO client per NAS. Destroying the resource disables SSO.",
+
+		Attributes: map[string]schema.Attribute{
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The SSO protocol, either `oidc` or `saml`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dsm.SSOProtocolOIDC, dsm.SSOProtocolSAML),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the identity provider shown on the login button.",
+				Required:            true,
+			},
+			"default_login": schema.BoolAttribute{
+				MarkdownDescription: "Whether the login page redirects to the identity provider by default.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"well_known_url": schema.StringAttribute{
+				MarkdownDescription: "The OpenID Connect discovery URL of the identity provider. Required for `oidc`.",
+				Optional:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The OpenID Connect client ID. Required for `oidc`.",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The OpenID Connect client secret. Required for `oidc`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "The OpenID Connect scopes requested, separated by spaces.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("openid email"),
+			},
+			"username_claim": schema.StringAttribute{
+				MarkdownDescription: "The claim holding the DSM user name.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("preferred_username"),
+			},
+			"redirect_uri": schema.StringAttribute{
+				MarkdownDescription: "The redirect URI registered with the identity provider, e.g. `https://nas.example.com:5001`.",
+				Optional:            true,
+			},
+			"saml_metadata": schema.StringAttribute{
+				MarkdownDescription: "The SAML metadata XML of the identity provider. Required for `saml`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (p *SSOClientResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data SSOClientResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Protocol.IsUnknown() {
+		return
+	}
+
+	required := map[string]attr.Value{}
+	switch data.Protocol.ValueString() {
+	case dsm.SSOProtocolOIDC:
+		required["well_known_url"] = data.WellKnownURL
+		required["client_id"] = data.ClientID
+		required["client_secret"] = data.ClientSecret
+	case dsm.SSOProtocolSAML:
+		required["saml_metadata"] = data.SAMLMetadata
+	}
+
+	for name, v := range required {
+		if v.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing SSO client attribute",
+				fmt.Sprintf("%s is required when protocol is %q.", name, data.Protocol.ValueString()),
+			)
+		}
+	}
+}
+
+func (p *SSOClientResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
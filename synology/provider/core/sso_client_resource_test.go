@@ -0,0 +1,48 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SSOClientResource struct{}
+
+func TestAccSSOClientResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"oidc provider is set",
+			`
+			resource "synology_core_sso_client" "foo" {
+				protocol       = "oidc"
+				name           = "Example"
+				well_known_url = "https://id.example.com/.well-known/openid-configuration"
+				client_id      = "dsm"
+				client_secret  = "secret"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_core_sso_client.foo",
+								"scope",
+								"openid email",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+type SynologyAccountResourceModel struct {
+	Account types.String `tfsdk:"account"`
+	Token   types.String `tfsdk:"token"`
+}
+
+var (
+	_ resource.Resource                = &SynologyAccountResource{}
+	_ resource.ResourceWithImportState = &SynologyAccountResource{}
+)
+
+func NewSynologyAccountResource() resource.Resource {
+	return &SynologyAccountResource{}
+}
+
+type SynologyAccountResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SynologyAccountResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SynologyAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := p.client.SynologyAccountGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Synology Account", err.Error())
+		return
+	}
+	if current.LoggedIn && current.Account != data.Account.ValueString() {
+		resp.Diagnostics.AddError(
+			"NAS bound to another Synology Account",
+			fmt.Sprintf("The NAS is bound to %s. Unbind it in Control Panel first.", current.Account),
+		)
+		return
+	}
+
+	if err := p.client.SynologyAccountLogin(ctx, data.Account.ValueString(), data.Token.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to bind Synology Account", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource. A new token is only used to bind the
+// NAS again.
+func (p *SynologyAccountResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SynologyAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The NAS is unbound, which stops
+// QuickConnect and the C2 services.
+func (p *SynologyAccountResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	if err := p.client.SynologyAccountLogout(ctx); err != nil {
+		resp.Diagnostics.AddError("Failed to unbind Synology Account", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SynologyAccountResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "synology_account")
+}
+
+// Read implements resource.Resource. The resource is removed when the NAS is
+// no longer bound to the account, so the next apply binds it again.
+func (p *SynologyAccountResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SynologyAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := p.client.SynologyAccountGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Synology Account", err.Error())
+		return
+	}
+	if !current.LoggedIn || current.Account != data.Account.ValueString() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *SynologyAccountResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Binds the NAS to a Synology Account, a prerequisite for QuickConnect and Synology C2 backup destinations. There is a single binding per NAS; creating the resource fails while the NAS is bound to another account. Destroying the resource unbinds the NAS.",
+
+		Attributes: map[string]schema.Attribute{
+			"account": schema.StringAttribute{
+				MarkdownDescription: "The email address of the Synology Account.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "An access token of the account, used instead of its password and second factor. It is only used to bind the NAS; changing it does not bind the NAS again.",
+				Required:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (p *SynologyAccountResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The import ID is
+// ignored as a NAS is bound to a single Synology Account. The token is not
+// returned by DSM and has to be set in the configuration.
+func (p *SynologyAccountResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	current, err := p.client.SynologyAccountGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Synology Account", err.Error())
+		return
+	}
+	if !current.LoggedIn {
+		resp.Diagnostics.AddError("NAS not bound", "The NAS is not bound to a Synology Account.")
+		return
+	}
+
+	data := SynologyAccountResourceModel{
+		Account: types.StringValue(current.Account),
+		Token:   types.StringNull(),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -2,24 +2,49 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"regexp"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/synology-community/go-synology"
 	"github.com/synology-community/go-synology/pkg/api/core"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/schedulemodel"
 	"github.com/synology-community/terraform-provider-synology/synology/util"
 )
 
+type TaskBeepModel struct {
+	Duration types.Int64 `tfsdk:"duration"`
+}
+
+type TaskServiceControlModel struct {
+	Action   types.String `tfsdk:"action"`
+	Packages types.Set    `tfsdk:"packages"`
+	Services types.Set    `tfsdk:"services"`
+}
+
+type TaskRecycleBinModel struct {
+	Shares        types.Set   `tfsdk:"shares"`
+	OlderThanDays types.Int64 `tfsdk:"older_than_days"`
+}
+
 type TaskResourceModel struct {
 	ID   types.Int64  `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
@@ -27,21 +52,38 @@ type TaskResourceModel struct {
 	Service types.String `tfsdk:"service"`
 	Script  types.String `tfsdk:"script"`
 
-	Schedule types.String `tfsdk:"schedule"`
-	User     types.String `tfsdk:"user"`
+	Schedule   types.String `tfsdk:"schedule"`
+	Recurrence types.Object `tfsdk:"recurrence"`
+	User       types.String `tfsdk:"user"`
+
+	ScheduleDescription types.String `tfsdk:"schedule_description"`
 
 	Run  types.Bool   `tfsdk:"run"`
 	When types.String `tfsdk:"when"`
+
+	Beep           types.Object `tfsdk:"beep"`
+	ServiceControl types.Object `tfsdk:"service_control"`
+	RecycleBin     types.Object `tfsdk:"recycle_bin"`
 }
 
-var _ resource.Resource = &TaskResource{}
+// builtin reports whether data describes one of the DSM built-in tasks
+// instead of a script.
+func (m TaskResourceModel) builtin() bool {
+	return !m.Beep.IsNull() || !m.ServiceControl.IsNull() || !m.RecycleBin.IsNull()
+}
+
+var (
+	_ resource.Resource             = &TaskResource{}
+	_ resource.ResourceWithIdentity = &TaskResource{}
+)
 
 func NewTaskResource() resource.Resource {
 	return &TaskResource{}
 }
 
 type TaskResource struct {
-	client core.Api
+	client    core.Api
+	dsmClient *dsm.Client
 }
 
 // Create implements resource.Resource.
@@ -61,28 +103,50 @@ func (p *TaskResource) Create(
 		return
 	}
 
-	taskReq, err := getTaskRequest(data)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to create task", err.Error())
-		return
-	}
+	var res *core.TaskResult
+	if data.builtin() {
+		taskReq, diags := getBuiltinTaskRequest(ctx, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	var taskCreate func(ctx context.Context, req core.TaskRequest) (*core.TaskResult, error)
-	if taskReq.Owner == "root" {
-		taskCreate = p.client.RootTaskCreate
+		var err error
+		res, err = p.dsmClient.BuiltinTaskCreate(ctx, taskReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Task install failed", err.Error())
+			return
+		}
 	} else {
-		taskCreate = p.client.TaskCreate
-	}
+		taskReq, err := getTaskRequest(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create task", err.Error())
+			return
+		}
 
-	res, err := taskCreate(ctx, taskReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Task install failed", err.Error())
-		return
+		var taskCreate func(ctx context.Context, req core.TaskRequest) (*core.TaskResult, error)
+		if taskReq.Owner == "root" {
+			taskCreate = p.client.RootTaskCreate
+		} else {
+			taskCreate = p.client.TaskCreate
+		}
+
+		res, err = taskCreate(ctx, taskReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Task install failed", err.Error())
+			return
+		}
 	}
 
 	data.ID = types.Int64PointerValue(res.ID)
+	if rev, err := p.revision(ctx, data.ID.ValueInt64()); err == nil {
+		resp.Diagnostics.Append(util.SetRevision(ctx, resp.Private, rev)...)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -111,25 +175,54 @@ func (p *TaskResource) Update(
 		return
 	}
 
-	taskReq, err := getTaskRequest(plan)
+	current, err := p.revision(ctx, state.ID.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create task", err.Error())
+		resp.Diagnostics.AddError("Failed to find task", err.Error())
 		return
 	}
+	resp.Diagnostics.Append(util.CheckRevision(ctx, req.Private, "task "+state.Name.ValueString(), current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.builtin() {
+		taskReq, diags := getBuiltinTaskRequest(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	taskReq.ID = state.ID.ValueInt64Pointer()
+		taskReq.ID = state.ID.ValueInt64Pointer()
 
-	var taskUpdate func(ctx context.Context, req core.TaskRequest) (*core.TaskResult, error)
-	if taskReq.Owner == "root" {
-		taskUpdate = p.client.RootTaskUpdate
+		if _, err := p.dsmClient.BuiltinTaskUpdate(ctx, taskReq); err != nil {
+			resp.Diagnostics.AddError("Task install failed", err.Error())
+			return
+		}
 	} else {
-		taskUpdate = p.client.TaskUpdate
+		taskReq, err := getTaskRequest(ctx, plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create task", err.Error())
+			return
+		}
+
+		taskReq.ID = state.ID.ValueInt64Pointer()
+
+		var taskUpdate func(ctx context.Context, req core.TaskRequest) (*core.TaskResult, error)
+		if taskReq.Owner == "root" {
+			taskUpdate = p.client.RootTaskUpdate
+		} else {
+			taskUpdate = p.client.TaskUpdate
+		}
+
+		_, err = taskUpdate(ctx, taskReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Task install failed", err.Error())
+			return
+		}
 	}
 
-	_, err = taskUpdate(ctx, taskReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Task install failed", err.Error())
-		return
+	if rev, err := p.revision(ctx, state.ID.ValueInt64()); err == nil {
+		resp.Diagnostics.Append(util.SetRevision(ctx, resp.Private, rev)...)
 	}
 
 	if plan.Run.ValueBool() != state.Run.ValueBool() {
@@ -217,11 +310,17 @@ func (p *TaskResource) Read(
 	}
 
 	taskID := data.ID.ValueInt64()
-	_, err := p.client.TaskGet(ctx, taskID)
+	rev, err := p.revision(ctx, taskID)
 	if err != nil {
 		resp.State.RemoveResource(ctx)
+		return
 	}
 
+	resp.Diagnostics.Append(util.SetRevision(ctx, resp.Private, rev)...)
+
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(taskID, 10))...)
+
 	// resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -244,15 +343,22 @@ func (p *TaskResource) Schema(
 				Required:            true,
 			},
 			"schedule": schema.StringAttribute{
-				MarkdownDescription: "Schedule expressed in cron.",
+				MarkdownDescription: "Schedule expressed in cron. DSM tasks run at a single time of the day, or repeat every few minutes or hours between a first and a last time, e.g. `*/15 8-18 * * 1-5` every 15 minutes from 08:00 to 18:45 on weekdays. They run either on days of the week or on a single day of every 1, 3, 6 or 12 months, e.g. `0 3 1 1,7 *`; unlike cron, a schedule cannot restrict both the day of the month and the day of the week.",
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(
-							`(@(annually|yearly|monthly|weekly|daily|hourly|reboot))|(@every (\d+(ns|us|µs|ms|s|m|h))+)|((((\d+,)+\d+|(\d+(\/|-)\d+)|\d+|\*) ?){5,7})`,
-						),
-						"value must contain a valid cron expression",
-					),
+					cronValidator{},
+				},
+			},
+			"recurrence": schedulemodel.Attribute(
+				"The schedule of the task as structured days and times instead of `schedule`.",
+				schedulemodel.CheckTask,
+				"schedule",
+			),
+			"schedule_description": schema.StringAttribute{
+				MarkdownDescription: "The schedule in words, e.g. `At 03:00 on weekdays`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					describeSchedule{},
 				},
 			},
 			"service": schema.StringAttribute{
@@ -262,6 +368,78 @@ func (p *TaskResource) Schema(
 			"script": schema.StringAttribute{
 				MarkdownDescription: "Script content to run in the task.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("beep"),
+						path.MatchRoot("service_control"),
+						path.MatchRoot("recycle_bin"),
+					),
+				},
+			},
+			"beep": schema.SingleNestedAttribute{
+				MarkdownDescription: "Makes the task a built-in beep control task, which beeps the NAS. Built-in tasks run as `root`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"duration": schema.Int64Attribute{
+						MarkdownDescription: "The number of seconds to beep.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(60),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 86400),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("service_control"), path.MatchRoot("recycle_bin")),
+				},
+			},
+			"service_control": schema.SingleNestedAttribute{
+				MarkdownDescription: "Makes the task a built-in service task, which starts or stops packages and system services. Built-in tasks run as `root`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"action": schema.StringAttribute{
+						MarkdownDescription: "Whether to `start` or `stop` the services.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("start", "stop"),
+						},
+					},
+					"packages": schema.SetAttribute{
+						MarkdownDescription: "The IDs of the packages, e.g. `SurveillanceStation`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("services")),
+						},
+					},
+					"services": schema.SetAttribute{
+						MarkdownDescription: "The names of the system services, e.g. `nfs-server`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("recycle_bin")),
+				},
+			},
+			"recycle_bin": schema.SingleNestedAttribute{
+				MarkdownDescription: "Makes the task a built-in task emptying the recycle bins of shared folders. Built-in tasks run as `root`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"shares": schema.SetAttribute{
+						MarkdownDescription: "The shared folders whose recycle bins are emptied. All shared folders when unset.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"older_than_days": schema.Int64Attribute{
+						MarkdownDescription: "Only delete files which were deleted more than this number of days ago. All files when unset.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
 			},
 			"user": schema.StringAttribute{
 				MarkdownDescription: "The user that will execute the task.",
@@ -310,7 +488,13 @@ func (f *TaskResource) Configure(
 		return
 	}
 
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
 	f.client = client.CoreAPI()
+	f.dsmClient = dsm.New(client)
 }
 
 func (p *TaskResource) ImportState(
@@ -318,7 +502,13 @@ func (p *TaskResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
+	importID, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(importID, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to parse ID", err.Error())
 		return
@@ -330,67 +520,53 @@ func (p *TaskResource) ImportState(
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), task.ID)...)
-}
-
-func newTaskSchedule() core.TaskSchedule {
-	return core.TaskSchedule{
-		WeekDay:              "0,1,2,3,4,5,6",
-		MonthlyWeek:          []string{},
-		RepeatMinStoreConfig: []int64{1, 5, 10, 15, 20, 30},
-		RepeatHourStoreConfig: []int64{
-			1,
-			2,
-			3,
-			4,
-			5,
-			6,
-			7,
-			8,
-			9,
-			10,
-			11,
-			12,
-			13,
-			14,
-			15,
-			16,
-			17,
-			18,
-			19,
-			20,
-			21,
-			22,
-			23,
-		},
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), task.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), task.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), task.Owner)...)
+	if rev, err := p.revision(ctx, id); err == nil {
+		resp.Diagnostics.Append(util.SetRevision(ctx, resp.Private, rev)...)
 	}
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", importID)...)
 }
 
-func parseSchedule(c string) (res core.TaskSchedule, err error) {
-	if c == "" {
-		return
-	}
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *TaskResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the task.")
+}
 
-	s, err := util.ParseStandard(c)
+// revision returns the complete task id for util.Revision, including its
+// script or other settings, its schedule and its notifications, so that edits
+// made in DSM are detected. The time of its next run, which changes as the
+// task runs, is left out.
+func (p *TaskResource) revision(ctx context.Context, id int64) (dsm.TaskDetail, error) {
+	t, err := p.dsmClient.TaskGet(ctx, id)
 	if err != nil {
-		return
+		return nil, err
 	}
+	delete(t, "next_trigger_time")
+	return t, nil
+}
 
-	t := newTaskSchedule()
-
-	t.Minute = s.Minute
-	t.Hour = s.Hour
-	t.RepeatDate = s.RepeatDate
-	t.RepeatHour = s.RepeatHour
-	t.RepeatMin = s.RepeatMin
-
-	if t.DateType == 0 && t.RepeatDate == 0 {
-		t.RepeatDate = 1001
+func getTaskSchedule(ctx context.Context, data TaskResourceModel) (core.TaskSchedule, error) {
+	spec, diags := schedulemodel.Resolve(ctx, data.Schedule, data.Recurrence)
+	if diags.HasError() {
+		return core.TaskSchedule{}, errors.New(diags.Errors()[0].Detail())
+	}
+	if spec != "" {
+		return schedulemodel.TaskSchedule(spec)
 	}
+
+	t := schedulemodel.NewTaskSchedule()
+	pkgRunTime := time.Now().Local().Add(-time.Minute * 5)
+	t.Date = fmt.Sprintf("%d-%02d-%02d", pkgRunTime.Year(), pkgRunTime.Month(), pkgRunTime.Day())
 	return t, nil
 }
 
-func getTaskRequest(data TaskResourceModel) (taskReq core.TaskRequest, err error) {
+func getTaskRequest(ctx context.Context, data TaskResourceModel) (taskReq core.TaskRequest, err error) {
 	taskType := "script"
 
 	if !data.Script.IsNull() && !data.Script.IsUnknown() && data.Script.ValueString() != "" {
@@ -409,19 +585,136 @@ func getTaskRequest(data TaskResourceModel) (taskReq core.TaskRequest, err error
 		},
 	}
 
-	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() && data.Schedule.ValueString() != "" {
-		schedule, e := parseSchedule(data.Schedule.ValueString())
-		if e != nil {
-			err = e
-			return
-		}
-		taskReq.Schedule = schedule
-	} else {
-		t := newTaskSchedule()
-		pkgRunTime := time.Now().Local().Add(-time.Minute * 5)
-		t.Date = fmt.Sprintf("%d-%02d-%02d", pkgRunTime.Year(), pkgRunTime.Month(), pkgRunTime.Day())
-		taskReq.Schedule = t
+	taskReq.Schedule, err = getTaskSchedule(ctx, data)
+	if err != nil {
+		return
 	}
 
 	return taskReq, nil
 }
+
+// getBuiltinTaskRequest returns the request for the built-in task configured
+// in data. Built-in tasks always run as root.
+func getBuiltinTaskRequest(ctx context.Context, data TaskResourceModel) (taskReq dsm.BuiltinTaskRequest, diags diag.Diagnostics) {
+	if data.User.ValueString() != "root" {
+		diags.AddAttributeError(path.Root("user"), "Invalid user", "Built-in tasks run as root, set user to \"root\".")
+		return
+	}
+
+	taskReq = dsm.BuiltinTaskRequest{
+		Name:      data.Name.ValueString(),
+		RealOwner: "root",
+		Owner:     "root",
+		Enable:    true,
+	}
+
+	switch {
+	case !data.Beep.IsNull():
+		var m TaskBeepModel
+		diags.Append(data.Beep.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+		taskReq.Type = dsm.TaskTypeBeep
+		taskReq.Extra = dsm.BeepTaskExtra{Duration: m.Duration.ValueInt64()}
+	case !data.ServiceControl.IsNull():
+		var m TaskServiceControlModel
+		diags.Append(data.ServiceControl.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+		var packages, services []string
+		diags.Append(m.Packages.ElementsAs(ctx, &packages, true)...)
+		diags.Append(m.Services.ElementsAs(ctx, &services, true)...)
+		extra := dsm.ServiceTaskExtra{Action: m.Action.ValueString(), Services: []dsm.TaskService{}}
+		for _, id := range packages {
+			extra.Services = append(extra.Services, dsm.TaskService{ID: id, Type: "package"})
+		}
+		for _, id := range services {
+			extra.Services = append(extra.Services, dsm.TaskService{ID: id, Type: "service"})
+		}
+		taskReq.Type = dsm.TaskTypeService
+		taskReq.Extra = extra
+	case !data.RecycleBin.IsNull():
+		var m TaskRecycleBinModel
+		diags.Append(data.RecycleBin.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+		extra := dsm.RecycleBinTaskExtra{
+			CleanAllShares: m.Shares.IsNull(),
+			Shares:         []string{},
+			Days:           m.OlderThanDays.ValueInt64(),
+		}
+		diags.Append(m.Shares.ElementsAs(ctx, &extra.Shares, true)...)
+		taskReq.Type = dsm.TaskTypeRecycleBin
+		taskReq.Extra = extra
+	}
+
+	schedule, err := getTaskSchedule(ctx, data)
+	if err != nil {
+		diags.AddAttributeError(path.Root("schedule"), "Invalid schedule", err.Error())
+		return
+	}
+	taskReq.Schedule = schedule
+
+	return taskReq, diags
+}
+
+// cronValidator checks that a string is a cron expression DSM tasks can
+// express, reporting the invalid field.
+type cronValidator struct{}
+
+func (v cronValidator) Description(_ context.Context) string {
+	return "value must contain a valid cron expression"
+}
+
+func (v cronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronValidator) ValidateString(
+	ctx context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := schedulemodel.CheckTask(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid schedule", err.Error())
+	}
+}
+
+// describeSchedule plans the value of schedule_description from the schedule
+// or recurrence attribute.
+type describeSchedule struct{}
+
+func (m describeSchedule) Description(_ context.Context) string {
+	return "Describes the schedule in words."
+}
+
+func (m describeSchedule) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m describeSchedule) PlanModifyString(
+	ctx context.Context,
+	req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse,
+) {
+	var cron types.String
+	var recurrence types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schedule"), &cron)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("recurrence"), &recurrence)...)
+	if resp.Diagnostics.HasError() || cron.IsUnknown() || recurrence.IsUnknown() {
+		return
+	}
+
+	spec, diags := schedulemodel.Resolve(ctx, cron, recurrence)
+	if diags.HasError() {
+		return
+	}
+
+	if spec == "" {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	// Invalid schedules are reported by the validators of both attributes.
+	if s, err := util.ParseStandard(spec); err == nil {
+		resp.PlanValue = types.StringValue(s.Describe())
+	}
+}
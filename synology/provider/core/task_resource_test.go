@@ -2,6 +2,7 @@ package core_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -30,6 +31,65 @@ func TestAccTaskResource_basic(t *testing.T) {
 				when = "apply"
 			}`,
 		},
+		{
+			"window",
+			`
+			resource "synology_core_task" "test" {
+				name = "Poll queue"
+
+				script = "/volume1/scripts/poll.sh"
+				user = "terraform"
+
+				schedule = "*/15 8-18 * * 1-5"
+			}`,
+		},
+		{
+			"recurrence",
+			`
+			resource "synology_core_task" "test" {
+				name = "Poll queue on weekdays"
+
+				script = "/volume1/scripts/poll.sh"
+				user = "terraform"
+
+				recurrence = {
+					at             = "08:00"
+					days           = ["mon", "tue", "wed", "thu", "fri"]
+					repeat_minutes = 15
+					last_hour      = 18
+				}
+			}`,
+		},
+		{
+			"recycle bin",
+			`
+			resource "synology_core_task" "test" {
+				name = "Empty recycle bins"
+				user = "root"
+
+				recycle_bin = {
+					shares          = ["media"]
+					older_than_days = 30
+				}
+
+				schedule = "0 3 * * *"
+			}`,
+		},
+		{
+			"service control",
+			`
+			resource "synology_core_task" "test" {
+				name = "Stop Surveillance Station"
+				user = "root"
+
+				service_control = {
+					action   = "stop"
+					packages = ["SurveillanceStation"]
+				}
+
+				schedule = "0 1 * * *"
+			}`,
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.Name, func(t *testing.T) {
@@ -49,6 +109,7 @@ func TestAccTaskResource_basic(t *testing.T) {
 									return nil
 								},
 							),
+							r.TestCheckResourceAttrSet("synology_core_task.test", "schedule_description"),
 						),
 					},
 				},
@@ -56,3 +117,22 @@ func TestAccTaskResource_basic(t *testing.T) {
 		})
 	}
 }
+
+func TestAccTaskResource_invalidSchedule(t *testing.T) {
+	r.UnitTest(t, r.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []r.TestStep{
+			{
+				Config: `
+				resource "synology_core_task" "test" {
+					name   = "Test Invalid"
+					script = "echo 'Hello, World!'"
+					user   = "terraform"
+
+					schedule = "0 0 1 * 1"
+				}`,
+				ExpectError: regexp.MustCompile("not on both"),
+			},
+		},
+	})
+}
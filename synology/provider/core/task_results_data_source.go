@@ -0,0 +1,192 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// defaultTaskResultsLimit is the number of runs returned when no limit is
+// configured.
+const defaultTaskResultsLimit = 10
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TaskResultsDataSource{}
+
+func NewTaskResultsDataSource() datasource.DataSource {
+	return &TaskResultsDataSource{}
+}
+
+type TaskResultsDataSource struct {
+	client *dsm.Client
+}
+
+type TaskResultModel struct {
+	StartTime  types.String `tfsdk:"start_time"`
+	StopTime   types.String `tfsdk:"stop_time"`
+	Running    types.Bool   `tfsdk:"running"`
+	ExitCode   types.Int64  `tfsdk:"exit_code"`
+	OutputPath types.String `tfsdk:"output_path"`
+}
+
+func (m TaskResultModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m TaskResultModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start_time":  types.StringType,
+		"stop_time":   types.StringType,
+		"running":     types.BoolType,
+		"exit_code":   types.Int64Type,
+		"output_path": types.StringType,
+	}
+}
+
+type TaskResultsDataSourceModel struct {
+	TaskID  types.Int64 `tfsdk:"task_id"`
+	Limit   types.Int64 `tfsdk:"limit"`
+	Results types.List  `tfsdk:"results"`
+}
+
+func (d *TaskResultsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "task_results")
+}
+
+func (d *TaskResultsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the recent runs of a scheduled task, e.g. to assert with a postcondition that the last run of a backup script exited with 0.",
+
+		Attributes: map[string]schema.Attribute{
+			"task_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the task, e.g. `synology_core_task.backup.id`.",
+				Required:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of runs, defaults to %d.", defaultTaskResultsLimit),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "The runs of the task, newest first. DSM only keeps the runs of tasks which save their output.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "The RFC 3339 time the run started.",
+							Computed:            true,
+						},
+						"stop_time": schema.StringAttribute{
+							MarkdownDescription: "The RFC 3339 time the run finished, empty while it runs.",
+							Computed:            true,
+						},
+						"running": schema.BoolAttribute{
+							MarkdownDescription: "Whether the run has not finished yet.",
+							Computed:            true,
+						},
+						"exit_code": schema.Int64Attribute{
+							MarkdownDescription: "The exit code of the run.",
+							Computed:            true,
+						},
+						"output_path": schema.StringAttribute{
+							MarkdownDescription: "The folder on the NAS holding the output of the run.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TaskResultsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data TaskResultsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int64(defaultTaskResultsLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	history, err := d.client.TaskHistoryList(ctx, data.TaskID.ValueInt64(), limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to list task runs, got error: %s", err),
+		)
+		return
+	}
+
+	results := []TaskResultModel{}
+	for _, h := range history.History {
+		if int64(len(results)) == limit {
+			break
+		}
+		results = append(results, TaskResultModel{
+			StartTime:  types.StringValue(util.UnixTime(h.StartTime)),
+			StopTime:   types.StringValue(util.UnixTime(h.StopTime)),
+			Running:    types.BoolValue(h.StopTime == 0),
+			ExitCode:   types.Int64Value(h.ExitCode),
+			OutputPath: types.StringValue(h.OutputPath),
+		})
+	}
+
+	v, diags := types.ListValueFrom(ctx, TaskResultModel{}.ModelType(), results)
+	resp.Diagnostics.Append(diags...)
+	data.Results = v
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *TaskResultsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = dsm.New(client)
+}
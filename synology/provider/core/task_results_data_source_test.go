@@ -0,0 +1,48 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type TaskResultsDataSource struct{}
+
+func TestAccTaskResultsDataSource_basic(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		DataSourceBlock string
+	}{
+		{
+			"lists task runs",
+			`
+			resource "synology_core_task" "test" {
+				name   = "Test Results"
+				script = "echo 'Hello, World!'"
+				user   = "terraform"
+				run    = true
+			}
+
+			data "synology_core_task_results" "test" {
+				task_id = synology_core_task.test.id
+				limit   = 5
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.DataSourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("data.synology_core_task_results.test", "results.#"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
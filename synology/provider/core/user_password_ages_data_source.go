@@ -0,0 +1,178 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserPasswordAgesDataSource{}
+
+func NewUserPasswordAgesDataSource() datasource.DataSource {
+	return &UserPasswordAgesDataSource{}
+}
+
+type UserPasswordAgesDataSource struct {
+	client *dsm.Client
+}
+
+type UserPasswordAgeModel struct {
+	Name                 types.String `tfsdk:"name"`
+	LastChange           types.String `tfsdk:"last_change"`
+	AgeDays              types.Int64  `tfsdk:"age_days"`
+	PasswordNeverExpires types.Bool   `tfsdk:"password_never_expires"`
+}
+
+func (m UserPasswordAgeModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m UserPasswordAgeModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":                   types.StringType,
+		"last_change":            types.StringType,
+		"age_days":               types.Int64Type,
+		"password_never_expires": types.BoolType,
+	}
+}
+
+type UserPasswordAgesDataSourceModel struct {
+	MinAgeDays types.Int64 `tfsdk:"min_age_days"`
+	Users      types.List  `tfsdk:"users"`
+}
+
+func (d *UserPasswordAgesDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "user_password_ages")
+}
+
+func (d *UserPasswordAgesDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists how long ago the local users last changed their password, e.g. for compliance reports or to assert with a postcondition that no password is older than the rotation period.",
+
+		Attributes: map[string]schema.Attribute{
+			"min_age_days": schema.Int64Attribute{
+				MarkdownDescription: "Only list users whose password is at least this many days old. Users whose password age is unknown are always listed.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The users, sorted as DSM lists them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the user.",
+							Computed:            true,
+						},
+						"last_change": schema.StringAttribute{
+							MarkdownDescription: "The RFC 3339 time the password was last changed, empty if DSM does not know.",
+							Computed:            true,
+						},
+						"age_days": schema.Int64Attribute{
+							MarkdownDescription: "The number of whole days since the password was last changed, null if DSM does not know.",
+							Computed:            true,
+						},
+						"password_never_expires": schema.BoolAttribute{
+							MarkdownDescription: "Whether the password is exempt from password expiry.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserPasswordAgesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data UserPasswordAgesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := d.client.UserList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to list users, got error: %s", err),
+		)
+		return
+	}
+
+	now := time.Now()
+	users := []UserPasswordAgeModel{}
+	for _, u := range list.Users {
+		age := types.Int64Null()
+		if u.PasswordLastChange != 0 {
+			days := int64(now.Sub(time.Unix(u.PasswordLastChange, 0)).Hours() / 24)
+			if days < data.MinAgeDays.ValueInt64() {
+				continue
+			}
+			age = types.Int64Value(days)
+		}
+		users = append(users, UserPasswordAgeModel{
+			Name:                 types.StringValue(u.Name),
+			LastChange:           types.StringValue(util.UnixTime(u.PasswordLastChange)),
+			AgeDays:              age,
+			PasswordNeverExpires: types.BoolValue(u.PasswordNeverExpires),
+		})
+	}
+
+	v, diags := types.ListValueFrom(ctx, UserPasswordAgeModel{}.ModelType(), users)
+	resp.Diagnostics.Append(diags...)
+	data.Users = v
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *UserPasswordAgesDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = dsm.New(client)
+}
@@ -0,0 +1,463 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// findUser returns the local user with the name, or nil if there is none.
+func findUser(ctx context.Context, client *dsm.Client, name string) (*dsm.User, error) {
+	list, err := client.UserList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Users, func(u dsm.User) bool {
+		return u.Name == name
+	})
+	if i == -1 {
+		return nil, nil
+	}
+	return &list.Users[i], nil
+}
+
+type UserResourceModel struct {
+	Name                 types.String `tfsdk:"name"`
+	UID                  types.Int64  `tfsdk:"uid"`
+	Description          types.String `tfsdk:"description"`
+	Email                types.String `tfsdk:"email"`
+	Password             types.String `tfsdk:"password"`
+	PasswordWO           types.String `tfsdk:"password_wo"`
+	PasswordWOVersion    types.Int64  `tfsdk:"password_wo_version"`
+	ForcePasswordChange  types.Bool   `tfsdk:"force_password_change"`
+	PasswordNeverExpires types.Bool   `tfsdk:"password_never_expires"`
+}
+
+func (m UserResourceModel) request(stamp synoclient.Stamp) dsm.UserRequest {
+	return dsm.UserRequest{
+		Name:                 m.Name.ValueString(),
+		Description:          stamp.Apply(m.Description.ValueString()),
+		Email:                m.Email.ValueString(),
+		PasswordNeverExpires: m.PasswordNeverExpires.ValueBool(),
+	}
+}
+
+// set updates m from u, removing the description stamp of the provider.
+// Passwords are never read back, and whether the user still has to change the
+// password is not reported by DSM.
+func (m *UserResourceModel) set(u dsm.User, stamp synoclient.Stamp) {
+	m.Name = types.StringValue(u.Name)
+	m.UID = types.Int64Value(u.UID)
+	m.Description = types.StringNull()
+	if d := stamp.Strip(u.Description); d != "" {
+		m.Description = types.StringValue(d)
+	}
+	m.Email = types.StringNull()
+	if u.Email != "" {
+		m.Email = types.StringValue(u.Email)
+	}
+	m.PasswordNeverExpires = types.BoolValue(u.PasswordNeverExpires)
+}
+
+var (
+	_ resource.Resource                   = &UserResource{}
+	_ resource.ResourceWithIdentity       = &UserResource{}
+	_ resource.ResourceWithValidateConfig = &UserResource{}
+)
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+	// self is the account the provider is logged in as.
+	self string
+}
+
+// Create implements resource.Resource.
+func (p *UserResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, diags := userPassword(ctx, req.Config.GetAttribute, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := data.request(p.stamp)
+	user.Password = password
+	user.ForcePasswordChange = data.ForcePasswordChange.ValueBool()
+	if err := p.client.UserCreate(ctx, user); err != nil {
+		resp.Diagnostics.AddError("Failed to create user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource. The password is only sent when
+// `password` or `password_wo_version` changed.
+func (p *UserResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := data.request(p.stamp)
+	rotate := !data.Password.Equal(state.Password) || !data.PasswordWOVersion.Equal(state.PasswordWOVersion)
+	if rotate {
+		password, diags := userPassword(ctx, req.Config.GetAttribute, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		user.Password = password
+	}
+	user.ForcePasswordChange = data.ForcePasswordChange.ValueBool() &&
+		(rotate || !data.ForcePasswordChange.Equal(state.ForcePasswordChange))
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, user.Name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.UserSet(ctx, user); err != nil {
+		resp.Diagnostics.AddError("Failed to update user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource. The home folder of the user is removed
+// by DSM. The account the provider is logged in as is never deleted.
+func (p *UserResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if p.self != "" && strings.EqualFold(data.Name.ValueString(), p.self) {
+		resp.Diagnostics.AddError(
+			"Refusing to delete user",
+			fmt.Sprintf("The user %s is the account the provider is logged in as. Remove the resource from the state with terraform state rm, or connect as another administrator to delete it.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.UserDelete(ctx, data.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete user", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *UserResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "user")
+}
+
+// Read implements resource.Resource.
+func (p *UserResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := findUser(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list users", err.Error())
+		return
+	}
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*user, p.stamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *UserResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a local DSM user. The password can be kept out of the state with `password_wo`, which needs Terraform 1.11 or later, and is rotated by bumping `password_wo_version`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uid": schema.Int64Attribute{
+				MarkdownDescription: "The numeric ID of the user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the user.",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address notifications and password reset mails are sent to.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the user, stored in the state. Changes made outside of Terraform are not detected. Exactly one of `password` or `password_wo` must be set.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The password of the user, which is never stored in the plan or state. It is only sent when the user is created or `password_wo_version` changes.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Any number, changed to send `password_wo` again, e.g. to rotate the password.",
+				Optional:            true,
+			},
+			"force_password_change": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has to choose a new password at the next login. Applied when the user is created, the password is set or the attribute is turned on; DSM does not report whether the user already did. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"password_never_expires": schema.BoolAttribute{
+				MarkdownDescription: "Whether the password is exempt from the password expiry of `synology_core_password_policy`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (p *UserResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Password.IsUnknown() || data.PasswordWO.IsUnknown() {
+		return
+	}
+
+	if data.Password.IsNull() && data.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing password",
+			"Either password or password_wo must be set.",
+		)
+	}
+	if data.PasswordWO.IsNull() && !data.PasswordWOVersion.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password_wo_version"),
+			"Unexpected password version",
+			"password_wo_version is only used with password_wo.",
+		)
+	}
+}
+
+func (p *UserResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+	p.self = synoclient.UserOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState. The password is
+// not read back and must be set in the configuration.
+func (p *UserResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := findUser(ctx, p.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list users", err.Error())
+		return
+	}
+	if user == nil {
+		resp.Diagnostics.AddError("User not found", fmt.Sprintf("User %s not found", name))
+		return
+	}
+
+	data := UserResourceModel{
+		Password:            types.StringNull(),
+		PasswordWO:          types.StringNull(),
+		PasswordWOVersion:   types.Int64Null(),
+		ForcePasswordChange: types.BoolValue(false),
+	}
+	data.set(*user, p.stamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *UserResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the user.")
+}
+
+// refresh reads the UID of the user of data after a change.
+func (p *UserResource) refresh(ctx context.Context, data *UserResourceModel) (diags diag.Diagnostics) {
+	user, err := findUser(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list users", err.Error())
+		return
+	}
+	if user == nil {
+		diags.AddError("User not found", fmt.Sprintf("User %s not found after it was saved", data.Name.ValueString()))
+		return
+	}
+
+	data.UID = types.Int64Value(user.UID)
+	return
+}
+
+// checkStamp refuses changes to the user with the name when the provider
+// requires a description stamp the user lacks.
+func (p *UserResource) checkStamp(ctx context.Context, name string) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	user, err := findUser(ctx, p.client, name)
+	if err != nil {
+		diags.AddError("Failed to list users", err.Error())
+		return
+	}
+	if user == nil {
+		return
+	}
+
+	if err := p.stamp.Check("user", user.Description); err != nil {
+		diags.AddError("Refusing to modify user", err.Error())
+	}
+	return
+}
+
+// userPassword returns the password to send, `password` from the plan or the
+// write only `password_wo`, which is only part of the configuration.
+func userPassword(
+	ctx context.Context,
+	config func(context.Context, path.Path, any) diag.Diagnostics,
+	data UserResourceModel,
+) (string, diag.Diagnostics) {
+	if !data.Password.IsNull() {
+		return data.Password.ValueString(), nil
+	}
+
+	var wo types.String
+	diags := config(ctx, path.Root("password_wo"), &wo)
+	return wo.ValueString(), diags
+}
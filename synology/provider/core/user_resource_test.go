@@ -0,0 +1,42 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type UserResource struct{}
+
+func TestAccUserResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"user is created with a write only password",
+			`
+			resource "synology_core_user" "foo" {
+				name                = "svc-backup"
+				password_wo         = "Sup3r-Secret!"
+				password_wo_version = 1
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckNoResourceAttr("synology_core_user.foo", "password_wo"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type VolumeDeduplicationResourceModel struct {
+	Volume  types.String `tfsdk:"volume"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+var (
+	_ resource.Resource               = &VolumeDeduplicationResource{}
+	_ resource.ResourceWithIdentity   = &VolumeDeduplicationResource{}
+	_ resource.ResourceWithModifyPlan = &VolumeDeduplicationResource{}
+)
+
+func NewVolumeDeduplicationResource() resource.Resource {
+	return &VolumeDeduplicationResource{}
+}
+
+type VolumeDeduplicationResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *VolumeDeduplicationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data VolumeDeduplicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "volume", data.Volume.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *VolumeDeduplicationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data VolumeDeduplicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "volume", data.Volume.ValueString())...)
+}
+
+// Delete implements resource.Resource. The setting is left as it is.
+func (p *VolumeDeduplicationResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *VolumeDeduplicationResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "volume_deduplication")
+}
+
+// Read implements resource.Resource.
+func (p *VolumeDeduplicationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data VolumeDeduplicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "volume", data.Volume.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *VolumeDeduplicationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages data deduplication of a Btrfs volume. Only some models offer deduplication, planning fails on the others. Destroying the resource leaves the setting unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"volume": schema.StringAttribute{
+				MarkdownDescription: "The path of the volume, e.g. `/volume1`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether data deduplication is enabled.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It fails when the
+// model does not offer deduplication.
+func (p *VolumeDeduplicationResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || p.client == nil {
+		return
+	}
+
+	ok, err := p.client.Supports(ctx, dsm.Storage_CGI_BtrfsDedupe)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get API info", err.Error())
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Deduplication not supported",
+			"This Synology station does not offer data deduplication.",
+		)
+	}
+}
+
+func (p *VolumeDeduplicationResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *VolumeDeduplicationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	volume, diags := util.ImportID(ctx, req, "volume")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := VolumeDeduplicationResourceModel{Volume: types.StringValue(volume)}
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "volume", volume)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *VolumeDeduplicationResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("volume", "The path of the volume.")
+}
+
+func (p *VolumeDeduplicationResource) apply(ctx context.Context, data *VolumeDeduplicationResourceModel) (diags diag.Diagnostics) {
+	if err := p.client.DedupSet(ctx, dsm.VolumeDedup{
+		VolumePath: data.Volume.ValueString(),
+		Enabled:    data.Enabled.ValueBool(),
+	}); err != nil {
+		diags.AddError("Failed to set deduplication", err.Error())
+		return diags
+	}
+
+	diags.Append(p.read(ctx, data)...)
+	return diags
+}
+
+func (p *VolumeDeduplicationResource) read(ctx context.Context, data *VolumeDeduplicationResourceModel) (diags diag.Diagnostics) {
+	d, err := p.client.DedupGet(ctx, data.Volume.ValueString())
+	if err != nil {
+		diags.AddError("Failed to get deduplication", err.Error())
+		return diags
+	}
+
+	data.Enabled = types.BoolValue(d.Enabled)
+	return diags
+}
@@ -0,0 +1,45 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type VolumeDeduplicationResource struct{}
+
+func TestAccVolumeDeduplicationResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"deduplication is enabled",
+			`
+			resource "synology_core_volume_deduplication" "foo" {
+				volume  = "/volume1"
+				enabled = true
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_core_volume_deduplication.foo",
+								"enabled",
+								"true",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
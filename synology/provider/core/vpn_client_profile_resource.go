@@ -0,0 +1,466 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/util/form"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type VPNClientProfileResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Protocol     types.String `tfsdk:"protocol"`
+	Server       types.String `tfsdk:"server"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	PresharedKey types.String `tfsdk:"preshared_key"`
+	Config       types.String `tfsdk:"config"`
+	Reconnect    types.Bool   `tfsdk:"reconnect"`
+	Connected    types.Bool   `tfsdk:"connected"`
+	Status       types.String `tfsdk:"status"`
+}
+
+func (m VPNClientProfileResourceModel) l2tp() dsm.L2TPProfile {
+	return dsm.L2TPProfile{
+		ID:           m.ID.ValueString(),
+		Name:         m.Name.ValueString(),
+		Server:       m.Server.ValueString(),
+		User:         m.Username.ValueString(),
+		Password:     m.Password.ValueString(),
+		PresharedKey: m.PresharedKey.ValueString(),
+		Reconnect:    m.Reconnect.ValueBool(),
+	}
+}
+
+func (m VPNClientProfileResourceModel) openVPN() dsm.OpenVPNProfile {
+	return dsm.OpenVPNProfile{
+		ID:        m.ID.ValueString(),
+		Name:      m.Name.ValueString(),
+		User:      m.Username.ValueString(),
+		Password:  m.Password.ValueString(),
+		Reconnect: m.Reconnect.ValueBool(),
+		Config: form.File{
+			Name:    m.Name.ValueString() + ".ovpn",
+			Content: m.Config.ValueString(),
+		},
+	}
+}
+
+func (m *VPNClientProfileResourceModel) set(profile dsm.VPNProfile) {
+	m.ID = types.StringValue(profile.ID)
+	m.Name = types.StringValue(profile.Name)
+	m.Protocol = types.StringValue(profile.Protocol)
+	m.Reconnect = types.BoolValue(profile.Reconnect)
+	m.Status = types.StringValue(profile.Status)
+	m.Connected = types.BoolValue(profile.Status != dsm.VPNStatusDisconnected)
+
+	m.Username = types.StringNull()
+	if profile.User != "" {
+		m.Username = types.StringValue(profile.User)
+	}
+
+	// OpenVPN profiles take the server from their configuration.
+	if profile.Protocol == dsm.VPNProtocolL2TP {
+		m.Server = types.StringValue(profile.Server)
+	}
+}
+
+var (
+	_ resource.Resource                   = &VPNClientProfileResource{}
+	_ resource.ResourceWithValidateConfig = &VPNClientProfileResource{}
+	_ resource.ResourceWithIdentity       = &VPNClientProfileResource{}
+)
+
+func NewVPNClientProfileResource() resource.Resource {
+	return &VPNClientProfileResource{}
+}
+
+type VPNClientProfileResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *VPNClientProfileResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data VPNClientProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id string
+	var err error
+	switch data.Protocol.ValueString() {
+	case dsm.VPNProtocolL2TP:
+		id, err = p.client.L2TPProfileCreate(ctx, data.l2tp())
+	case dsm.VPNProtocolOpenVPN:
+		id, err = p.client.OpenVPNProfileCreate(ctx, data.openVPN())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create VPN client profile", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+
+	resp.Diagnostics.Append(p.connect(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *VPNClientProfileResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data VPNClientProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	switch data.Protocol.ValueString() {
+	case dsm.VPNProtocolL2TP:
+		err = p.client.L2TPProfileSet(ctx, data.l2tp())
+	case dsm.VPNProtocolOpenVPN:
+		err = p.client.OpenVPNProfileSet(ctx, data.openVPN())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update VPN client profile", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.connect(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource. A connected profile is disconnected
+// first.
+func (p *VPNClientProfileResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data VPNClientProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Connected = types.BoolValue(false)
+	resp.Diagnostics.Append(p.connect(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	switch data.Protocol.ValueString() {
+	case dsm.VPNProtocolL2TP:
+		err = p.client.L2TPProfileDelete(ctx, data.ID.ValueString())
+	case dsm.VPNProtocolOpenVPN:
+		err = p.client.OpenVPNProfileDelete(ctx, data.ID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete VPN client profile", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *VPNClientProfileResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "vpn_client_profile")
+}
+
+// Read implements resource.Resource.
+func (p *VPNClientProfileResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data VPNClientProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := p.find(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list VPN client profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*profile)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *VPNClientProfileResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a VPN client profile of the NAS under **Control Panel** > **Network** > **Network Interface**. DSM supports OpenVPN and L2TP/IPsec client profiles, WireGuard is not offered by the DSM VPN client. The NAS keeps a single VPN connection, so at most one profile should be `connected`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the profile.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the profile.",
+				Required:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The VPN protocol, `openvpn` or `l2tp`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(dsm.VPNProtocolOpenVPN, dsm.VPNProtocolL2TP),
+				},
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "The address of the L2TP server. OpenVPN profiles take the server from `config`.",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The user to authenticate as.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of `username`. DSM does not return the password, changes made outside Terraform are not detected.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"preshared_key": schema.StringAttribute{
+				MarkdownDescription: "The IPsec pre-shared key of an L2TP profile.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "The content of the `.ovpn` configuration of an OpenVPN profile, e.g. read with `file()`. Inline certificates and keys are uploaded with it.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"reconnect": schema.BoolAttribute{
+				MarkdownDescription: "Whether DSM reconnects when the VPN connection is lost.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"connected": schema.BoolAttribute{
+				MarkdownDescription: "Whether the NAS is connected with the profile. A connection which is still being established counts as connected.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The connection status, `connected`, `connecting` or `disconnected`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (p *VPNClientProfileResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data VPNClientProfileResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Protocol.IsUnknown() {
+		return
+	}
+
+	l2tp := data.Protocol.ValueString() == dsm.VPNProtocolL2TP
+	for _, a := range []struct {
+		name  string
+		value types.String
+		l2tp  bool
+	}{
+		{"server", data.Server, true},
+		{"preshared_key", data.PresharedKey, true},
+		{"config", data.Config, false},
+	} {
+		switch {
+		case a.l2tp == l2tp && a.value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(a.name),
+				"Missing "+a.name,
+				fmt.Sprintf("%s profiles need %s.", data.Protocol.ValueString(), a.name),
+			)
+		case a.l2tp != l2tp && !a.value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(a.name),
+				"Unexpected "+a.name,
+				fmt.Sprintf("%s profiles do not take %s.", data.Protocol.ValueString(), a.name),
+			)
+		}
+	}
+}
+
+func (p *VPNClientProfileResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. Credentials and
+// OpenVPN configurations cannot be read back and have to be configured again.
+func (p *VPNClientProfileResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := p.find(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list VPN client profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		resp.Diagnostics.AddError("VPN client profile not found", fmt.Sprintf("VPN client profile %s not found", id))
+		return
+	}
+
+	data := VPNClientProfileResourceModel{
+		Server:       types.StringNull(),
+		Password:     types.StringNull(),
+		PresharedKey: types.StringNull(),
+		Config:       types.StringNull(),
+	}
+	data.set(*profile)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *VPNClientProfileResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the profile.")
+}
+
+// connect connects or disconnects the profile of data as requested by
+// data.Connected and records the resulting status.
+func (p *VPNClientProfileResource) connect(ctx context.Context, data *VPNClientProfileResourceModel) (diags diag.Diagnostics) {
+	profile, err := p.find(ctx, data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list VPN client profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		diags.AddError(
+			"VPN client profile not found",
+			fmt.Sprintf("VPN client profile %s not found", data.ID.ValueString()),
+		)
+		return
+	}
+
+	status := profile.Status
+	connected := status != dsm.VPNStatusDisconnected
+	switch want := data.Connected.ValueBool(); {
+	case want && !connected:
+		err = p.client.VPNProfileConnect(ctx, profile.ID, profile.Protocol)
+		status = dsm.VPNStatusConnecting
+	case !want && connected:
+		err = p.client.VPNProfileDisconnect(ctx, profile.ID, profile.Protocol)
+		status = dsm.VPNStatusDisconnected
+	}
+	if err != nil {
+		diags.AddError("Failed to change VPN connection", err.Error())
+		return
+	}
+
+	data.Status = types.StringValue(status)
+	return
+}
+
+func (p *VPNClientProfileResource) find(ctx context.Context, id string) (*dsm.VPNProfile, error) {
+	list, err := p.client.VPNProfileList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Profiles, func(v dsm.VPNProfile) bool {
+		return v.ID == id
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Profiles[i], nil
+}
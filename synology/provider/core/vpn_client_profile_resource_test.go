@@ -0,0 +1,46 @@
+package core_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type VPNClientProfileResource struct{}
+
+func TestAccVPNClientProfileResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"L2TP profile is created",
+			`
+			resource "synology_core_vpn_client_profile" "foo" {
+				name          = "tf-test"
+				protocol      = "l2tp"
+				server        = "vpn.example.com"
+				username      = "nas"
+				password      = "secret"
+				preshared_key = "shared-secret"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_core_vpn_client_profile.foo", "id"),
+							r.TestCheckResourceAttr("synology_core_vpn_client_profile.foo", "status", "disconnected"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
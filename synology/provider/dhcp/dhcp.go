@@ -0,0 +1,22 @@
+// Package dhcp contains the resources of the DHCP server of DSM.
+package dhcp
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func buildName(providerName, resourceName string) string {
+	return providerName + "_dhcp_" + resourceName
+}
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewScopeResource,
+		NewReservationResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
@@ -0,0 +1,348 @@
+package dhcp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// reservationsMu serializes the read-modify-write cycles of the reservation
+// lists, several reservations of an interface are usually applied in
+// parallel.
+var reservationsMu sync.Mutex
+
+// normalizeMAC returns mac in the lower case, colon separated form DSM
+// stores.
+func normalizeMAC(mac string) string {
+	if hw, err := net.ParseMAC(mac); err == nil {
+		return hw.String()
+	}
+	return strings.ToLower(mac)
+}
+
+type ReservationResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Interface types.String `tfsdk:"interface"`
+	MAC       types.String `tfsdk:"mac"`
+	IP        types.String `tfsdk:"ip"`
+	Hostname  types.String `tfsdk:"hostname"`
+}
+
+func (m ReservationResourceModel) reservation() dsm.DHCPReservation {
+	return dsm.DHCPReservation{
+		MAC:      normalizeMAC(m.MAC.ValueString()),
+		IP:       m.IP.ValueString(),
+		Hostname: m.Hostname.ValueString(),
+	}
+}
+
+func (m *ReservationResourceModel) set(r dsm.DHCPReservation) {
+	m.IP = types.StringValue(r.IP)
+	m.Hostname = types.StringValue(r.Hostname)
+}
+
+var (
+	_ resource.Resource             = &ReservationResource{}
+	_ resource.ResourceWithIdentity = &ReservationResource{}
+)
+
+func NewReservationResource() resource.Resource {
+	return &ReservationResource{}
+}
+
+type ReservationResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ReservationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ReservationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.Interface.ValueString() + "/" + normalizeMAC(data.MAC.ValueString()))
+
+	resp.Diagnostics.Append(p.update(ctx, data.Interface.ValueString(), func(list []dsm.DHCPReservation) []dsm.DHCPReservation {
+		list = removeReservation(list, data.MAC.ValueString())
+		return append(list, data.reservation())
+	})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *ReservationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ReservationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.update(ctx, data.Interface.ValueString(), func(list []dsm.DHCPReservation) []dsm.DHCPReservation {
+		list = removeReservation(list, data.MAC.ValueString())
+		return append(list, data.reservation())
+	})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *ReservationResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ReservationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.update(ctx, data.Interface.ValueString(), func(list []dsm.DHCPReservation) []dsm.DHCPReservation {
+		return removeReservation(list, data.MAC.ValueString())
+	})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ReservationResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "reservation")
+}
+
+// Read implements resource.Resource.
+func (p *ReservationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ReservationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r, err := p.find(ctx, data.Interface.ValueString(), data.MAC.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list DHCP reservations", err.Error())
+		return
+	}
+	if r == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*r)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *ReservationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reserves an address of a `synology_dhcp_scope` for a MAC address. Other reservations of the interface are left untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the reservation in the form `<interface>/<mac>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The network interface of the DHCP server.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mac": schema.StringAttribute{
+				MarkdownDescription: "The MAC address of the client, e.g. `00:11:32:aa:bb:cc`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				MarkdownDescription: "The address handed out to the client.",
+				Required:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the client.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (p *ReservationResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *ReservationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ifname, mac, ok := strings.Cut(id, "/")
+	if !ok {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected <interface>/<mac>, got %q", id))
+		return
+	}
+
+	r, err := p.find(ctx, ifname, mac)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list DHCP reservations", err.Error())
+		return
+	}
+	if r == nil {
+		resp.Diagnostics.AddError("DHCP reservation not found", fmt.Sprintf("DHCP reservation %s not found", id))
+		return
+	}
+
+	data := ReservationResourceModel{
+		ID:        types.StringValue(id),
+		Interface: types.StringValue(ifname),
+		MAC:       types.StringValue(mac),
+	}
+	data.set(*r)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *ReservationResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the reservation in the form `<interface>/<mac>`.")
+}
+
+func removeReservation(list []dsm.DHCPReservation, mac string) []dsm.DHCPReservation {
+	mac = normalizeMAC(mac)
+	return slices.DeleteFunc(list, func(r dsm.DHCPReservation) bool {
+		return normalizeMAC(r.MAC) == mac
+	})
+}
+
+func (p *ReservationResource) find(ctx context.Context, ifname, mac string) (*dsm.DHCPReservation, error) {
+	list, err := p.client.DHCPReservationList(ctx, ifname)
+	if err != nil {
+		return nil, err
+	}
+
+	mac = normalizeMAC(mac)
+	i := slices.IndexFunc(list, func(r dsm.DHCPReservation) bool {
+		return normalizeMAC(r.MAC) == mac
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list[i], nil
+}
+
+// update replaces the reservations of the interface ifname with the result
+// of modify.
+func (p *ReservationResource) update(
+	ctx context.Context,
+	ifname string,
+	modify func([]dsm.DHCPReservation) []dsm.DHCPReservation,
+) (diags diag.Diagnostics) {
+	reservationsMu.Lock()
+	defer reservationsMu.Unlock()
+
+	list, err := p.client.DHCPReservationList(ctx, ifname)
+	if err != nil {
+		diags.AddError("Failed to list DHCP reservations", err.Error())
+		return
+	}
+
+	if err := p.client.DHCPReservationSet(ctx, ifname, modify(list)); err != nil {
+		diags.AddError("Failed to set DHCP reservations", err.Error())
+	}
+	return
+}
@@ -0,0 +1,42 @@
+package dhcp_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ReservationResource struct{}
+
+func TestAccReservationResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"reservation is created",
+			`
+			resource "synology_dhcp_reservation" "foo" {
+				interface = "eth1"
+				mac       = "00:11:32:AA:BB:CC"
+				ip        = "10.10.0.20"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_dhcp_reservation.foo", "id", "eth1/00:11:32:aa:bb:cc"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
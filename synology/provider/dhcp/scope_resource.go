@@ -0,0 +1,321 @@
+package dhcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type ScopeResourceModel struct {
+	Interface  types.String `tfsdk:"interface"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	StartIP    types.String `tfsdk:"start_ip"`
+	EndIP      types.String `tfsdk:"end_ip"`
+	Netmask    types.String `tfsdk:"netmask"`
+	Gateway    types.String `tfsdk:"gateway"`
+	LeaseTime  types.Int64  `tfsdk:"lease_time"`
+	DNSServers types.List   `tfsdk:"dns_servers"`
+	DomainName types.String `tfsdk:"domain_name"`
+}
+
+func (m ScopeResourceModel) server(ctx context.Context) (dsm.DHCPServer, diag.Diagnostics) {
+	server := dsm.DHCPServer{
+		Enable: m.Enabled.ValueBool(),
+		Ranges: []dsm.DHCPRange{{
+			StartIP:   m.StartIP.ValueString(),
+			EndIP:     m.EndIP.ValueString(),
+			Netmask:   m.Netmask.ValueString(),
+			Gateway:   m.Gateway.ValueString(),
+			LeaseTime: m.LeaseTime.ValueInt64(),
+		}},
+		DNSServers: []string{},
+		DomainName: m.DomainName.ValueString(),
+	}
+	diags := m.DNSServers.ElementsAs(ctx, &server.DNSServers, false)
+	return server, diags
+}
+
+func (m *ScopeResourceModel) set(ctx context.Context, server dsm.DHCPServer) diag.Diagnostics {
+	m.Enabled = types.BoolValue(server.Enable)
+	if len(server.Ranges) > 0 {
+		r := server.Ranges[0]
+		m.StartIP = types.StringValue(r.StartIP)
+		m.EndIP = types.StringValue(r.EndIP)
+		m.Netmask = types.StringValue(r.Netmask)
+		m.Gateway = types.StringValue(r.Gateway)
+		m.LeaseTime = types.Int64Value(r.LeaseTime)
+	}
+	m.DomainName = types.StringValue(server.DomainName)
+
+	dns := server.DNSServers
+	if dns == nil {
+		dns = []string{}
+	}
+	v, diags := types.ListValueFrom(ctx, types.StringType, dns)
+	m.DNSServers = v
+	return diags
+}
+
+var (
+	_ resource.Resource             = &ScopeResource{}
+	_ resource.ResourceWithIdentity = &ScopeResource{}
+)
+
+func NewScopeResource() resource.Resource {
+	return &ScopeResource{}
+}
+
+type ScopeResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ScopeResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ScopeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "interface", data.Interface.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *ScopeResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ScopeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "interface", data.Interface.ValueString())...)
+}
+
+// Delete implements resource.Resource. The DHCP server of the interface is
+// disabled, its range is kept.
+func (p *ScopeResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ScopeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Enabled = types.BoolValue(false)
+	resp.Diagnostics.Append(p.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ScopeResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "scope")
+}
+
+// Read implements resource.Resource.
+func (p *ScopeResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ScopeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := p.client.DHCPServerGet(ctx, data.Interface.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get DHCP server", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *server)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "interface", data.Interface.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *ScopeResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DHCP server of a network interface of the NAS. Deleting the resource disables the DHCP server of the interface.",
+
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				MarkdownDescription: "The network interface to serve, e.g. `eth0`, `ovs_eth0` or the VLAN interface `eth0.10`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the DHCP server is running.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"start_ip": schema.StringAttribute{
+				MarkdownDescription: "The first address handed out.",
+				Required:            true,
+			},
+			"end_ip": schema.StringAttribute{
+				MarkdownDescription: "The last address handed out.",
+				Required:            true,
+			},
+			"netmask": schema.StringAttribute{
+				MarkdownDescription: "The subnet mask of the scope, e.g. `255.255.255.0`.",
+				Required:            true,
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "The default gateway announced to clients.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"lease_time": schema.Int64Attribute{
+				MarkdownDescription: "The lease time in seconds.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(86400),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"dns_servers": schema.ListAttribute{
+				MarkdownDescription: "The DNS servers announced to clients.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"domain_name": schema.StringAttribute{
+				MarkdownDescription: "The domain name announced to clients.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (p *ScopeResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *ScopeResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	ifname, diags := util.ImportID(ctx, req, "interface")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface"), ifname)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "interface", ifname)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *ScopeResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("interface", "The network interface of the DHCP server.")
+}
+
+func (p *ScopeResource) apply(ctx context.Context, data ScopeResourceModel) diag.Diagnostics {
+	server, diags := data.server(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := p.client.DHCPServerSet(ctx, data.Interface.ValueString(), server); err != nil {
+		diags.AddError("Failed to set DHCP server", err.Error())
+	}
+
+	return diags
+}
@@ -0,0 +1,44 @@
+package dhcp_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ScopeResource struct{}
+
+func TestAccScopeResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"DHCP server is enabled",
+			`
+			resource "synology_dhcp_scope" "foo" {
+				interface = "eth1"
+				start_ip  = "10.10.0.100"
+				end_ip    = "10.10.0.199"
+				netmask   = "255.255.255.0"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_dhcp_scope.foo", "enabled", "true"),
+							r.TestCheckResourceAttr("synology_dhcp_scope.foo", "lease_time", "86400"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Package directoryserver contains the resources of the Synology Directory
+// Server package, which makes the NAS an Active Directory domain controller.
+package directoryserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func buildName(providerName, resourceName string) string {
+	return providerName + "_directory_server_" + resourceName
+}
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewDomainResource,
+		NewOUResource,
+		NewUserResource,
+		NewGroupResource,
+		NewPasswordPolicyResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
@@ -0,0 +1,288 @@
+package directoryserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type DomainResourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	NetBIOSName   types.String `tfsdk:"netbios_name"`
+	AdminPassword types.String `tfsdk:"admin_password"`
+	ForestLevel   types.String `tfsdk:"forest_level"`
+	DNSForwarder  types.String `tfsdk:"dns_forwarder"`
+	BaseDN        types.String `tfsdk:"base_dn"`
+}
+
+func (m *DomainResourceModel) set(d dsm.DirectoryDomain) {
+	m.Name = types.StringValue(d.Name)
+	m.NetBIOSName = types.StringValue(d.NetBIOSName)
+	m.ForestLevel = types.StringValue(d.ForestLevel)
+	m.DNSForwarder = types.StringValue(d.DNSForwarder)
+	m.BaseDN = types.StringValue(d.BaseDN)
+}
+
+var (
+	_ resource.Resource             = &DomainResource{}
+	_ resource.ResourceWithIdentity = &DomainResource{}
+)
+
+func NewDomainResource() resource.Resource {
+	return &DomainResource{}
+}
+
+type DomainResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *DomainResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := p.client.DirectoryDomainGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Directory Server domain", err.Error())
+		return
+	}
+	if domain.Created {
+		resp.Diagnostics.AddError(
+			"Directory Server domain already exists",
+			fmt.Sprintf("The NAS already serves the domain %s. Import it instead.", domain.Name),
+		)
+		return
+	}
+
+	if err := p.client.DirectoryDomainCreate(ctx, dsm.DirectoryDomainCreateRequest{
+		Name:          data.Name.ValueString(),
+		NetBIOSName:   data.NetBIOSName.ValueString(),
+		AdminPassword: data.AdminPassword.ValueString(),
+		ForestLevel:   data.ForestLevel.ValueString(),
+		DNSForwarder:  data.DNSForwarder.ValueString(),
+	}); err != nil {
+		resp.Diagnostics.AddError("Failed to create Directory Server domain", err.Error())
+		return
+	}
+
+	domain, err = p.client.DirectoryDomainGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Directory Server domain", err.Error())
+		return
+	}
+
+	data.set(*domain)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource. Only the admin password, which is used
+// when the domain is created, can change without replacing the domain.
+func (p *DomainResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource. The domain is left in place, as
+// Directory Server cannot remove it short of uninstalling the package.
+func (p *DomainResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *DomainResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "domain")
+}
+
+// Read implements resource.Resource.
+func (p *DomainResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := p.client.DirectoryDomainGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Directory Server domain", err.Error())
+		return
+	}
+	if !domain.Created || !strings.EqualFold(domain.Name, data.Name.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*domain)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *DomainResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	replace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provisions the Active Directory domain of the Synology Directory Server package, making the NAS its domain controller. There is a single domain per NAS and it cannot be changed once created; destroying the resource leaves the domain in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The DNS name of the domain, e.g. `corp.example.com`.",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"netbios_name": schema.StringAttribute{
+				MarkdownDescription: "The NetBIOS name (workgroup) of the domain, e.g. `CORP`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 15),
+				},
+				PlanModifiers: replace,
+			},
+			"admin_password": schema.StringAttribute{
+				MarkdownDescription: "The password of the domain `Administrator`. It is only used when the domain is created.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"forest_level": schema.StringAttribute{
+				MarkdownDescription: "The forest functional level, e.g. `2008_R2`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("2008_R2"),
+				PlanModifiers:       replace,
+			},
+			"dns_forwarder": schema.StringAttribute{
+				MarkdownDescription: "The DNS server queries outside of the domain are forwarded to.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers:       replace,
+			},
+			"base_dn": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the domain, e.g. `DC=corp,DC=example,DC=com`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (p *DomainResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The admin password
+// is not read back and must be set in the configuration.
+func (p *DomainResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := p.client.DirectoryDomainGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Directory Server domain", err.Error())
+		return
+	}
+	if !domain.Created || !strings.EqualFold(domain.Name, name) {
+		resp.Diagnostics.AddError("Directory Server domain not found", fmt.Sprintf("Directory Server domain %s not found", name))
+		return
+	}
+
+	var data DomainResourceModel
+	data.set(*domain)
+	data.AdminPassword = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *DomainResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The DNS name of the domain.")
+}
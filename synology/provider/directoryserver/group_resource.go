@@ -0,0 +1,402 @@
+package directoryserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// findGroup returns the domain group with the name, or nil if there is none.
+func findGroup(ctx context.Context, client *dsm.Client, name string) (*dsm.DirectoryGroup, error) {
+	list, err := client.DirectoryGroupList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Groups, func(g dsm.DirectoryGroup) bool {
+		return strings.EqualFold(g.Name, name)
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Groups[i], nil
+}
+
+type GroupResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	DN          types.String `tfsdk:"dn"`
+	Container   types.String `tfsdk:"container"`
+	Description types.String `tfsdk:"description"`
+	Scope       types.String `tfsdk:"scope"`
+	Type        types.String `tfsdk:"type"`
+	Members     types.Set    `tfsdk:"members"`
+}
+
+func (m GroupResourceModel) group(ctx context.Context, stamp synoclient.Stamp) (dsm.DirectoryGroup, diag.Diagnostics) {
+	g := dsm.DirectoryGroup{
+		Name:        m.Name.ValueString(),
+		Container:   m.Container.ValueString(),
+		Description: stamp.Apply(m.Description.ValueString()),
+		Scope:       m.Scope.ValueString(),
+		Type:        m.Type.ValueString(),
+		Members:     []string{},
+	}
+	diags := m.Members.ElementsAs(ctx, &g.Members, false)
+
+	return g, diags
+}
+
+// set copies g into m, removing the description stamp of the provider.
+func (m *GroupResourceModel) set(
+	ctx context.Context,
+	g dsm.DirectoryGroup,
+	stamp synoclient.Stamp,
+) (diags diag.Diagnostics) {
+	m.Name = types.StringValue(g.Name)
+	m.DN = types.StringValue(g.DN)
+	m.Container = types.StringValue(g.Container)
+	m.Description = types.StringValue(stamp.Strip(g.Description))
+	m.Scope = types.StringValue(g.Scope)
+	m.Type = types.StringValue(g.Type)
+	m.Members, diags = types.SetValueFrom(ctx, types.StringType, g.Members)
+	return
+}
+
+var (
+	_ resource.Resource             = &GroupResource{}
+	_ resource.ResourceWithIdentity = &GroupResource{}
+)
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+type GroupResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *GroupResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data GroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := data.group(ctx, p.stamp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryGroupCreate(ctx, group); err != nil {
+		resp.Diagnostics.AddError("Failed to create domain group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *GroupResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data GroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := data.group(ctx, p.stamp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryGroupSet(ctx, data.Name.ValueString(), group); err != nil {
+		resp.Diagnostics.AddError("Failed to update domain group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *GroupResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data GroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryGroupDelete(ctx, data.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete domain group", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *GroupResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "group")
+}
+
+// Read implements resource.Resource.
+func (p *GroupResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data GroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := findGroup(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list domain groups", err.Error())
+		return
+	}
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *group, p.stamp)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *GroupResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	replace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a group of the Directory Server domain and its members.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"dn": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the group.",
+				Computed:            true,
+			},
+			"container": schema.StringAttribute{
+				MarkdownDescription: "The DN of the organizational unit or container holding the group. Defaults to the `Users` container of the domain.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the group.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "The scope of the group: `domain_local`, `global` or `universal`. Defaults to `global`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(dsm.DirectoryGroupGlobal),
+				Validators: []validator.String{
+					stringvalidator.OneOf(dsm.DirectoryGroupDomainLocal, dsm.DirectoryGroupGlobal, dsm.DirectoryGroupUniversal),
+				},
+				PlanModifiers: replace,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the group: `security` or `distribution`. Defaults to `security`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(dsm.DirectoryGroupSecurity),
+				Validators: []validator.String{
+					stringvalidator.OneOf(dsm.DirectoryGroupSecurity, dsm.DirectoryGroupDistribution),
+				},
+				PlanModifiers: replace,
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "The names of the users and groups in the group. Members added outside of Terraform are removed.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (p *GroupResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *GroupResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := findGroup(ctx, p.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list domain groups", err.Error())
+		return
+	}
+	if group == nil {
+		resp.Diagnostics.AddError("Domain group not found", fmt.Sprintf("Domain group %s not found", name))
+		return
+	}
+
+	var data GroupResourceModel
+	resp.Diagnostics.Append(data.set(ctx, *group, p.stamp)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *GroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the group.")
+}
+
+// refresh reads back the DN and container the domain assigned to the group.
+func (p *GroupResource) refresh(ctx context.Context, data *GroupResourceModel) (diags diag.Diagnostics) {
+	group, err := findGroup(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list domain groups", err.Error())
+		return
+	}
+	if group == nil {
+		diags.AddError("Domain group not found", fmt.Sprintf("Domain group %s not found", data.Name.ValueString()))
+		return
+	}
+
+	data.DN = types.StringValue(group.DN)
+	data.Container = types.StringValue(group.Container)
+	return
+}
+
+// checkStamp refuses changes to the group with the name when the provider
+// requires a description stamp the group lacks.
+func (p *GroupResource) checkStamp(ctx context.Context, name string) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	group, err := findGroup(ctx, p.client, name)
+	if err != nil {
+		diags.AddError("Failed to list domain groups", err.Error())
+		return
+	}
+	if group == nil {
+		return
+	}
+
+	if err := p.stamp.Check("domain group", group.Description); err != nil {
+		diags.AddError("Refusing to modify domain group", err.Error())
+	}
+	return
+}
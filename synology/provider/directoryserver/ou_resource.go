@@ -0,0 +1,323 @@
+package directoryserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// findOU returns the organizational unit with the DN, or nil if there is
+// none. DNs are compared case-insensitively, as in LDAP.
+func findOU(ctx context.Context, client *dsm.Client, dn string) (*dsm.DirectoryOU, error) {
+	list, err := client.DirectoryOUList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.OUs, func(o dsm.DirectoryOU) bool {
+		return strings.EqualFold(o.DN, dn)
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.OUs[i], nil
+}
+
+// ouDN returns the DN of the organizational unit name within container.
+func ouDN(name, container string) string {
+	return "OU=" + name + "," + container
+}
+
+type OUResourceModel struct {
+	DN          types.String `tfsdk:"dn"`
+	Name        types.String `tfsdk:"name"`
+	Container   types.String `tfsdk:"container"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m OUResourceModel) ou(stamp synoclient.Stamp) dsm.DirectoryOU {
+	return dsm.DirectoryOU{
+		Name:        m.Name.ValueString(),
+		Container:   m.Container.ValueString(),
+		Description: stamp.Apply(m.Description.ValueString()),
+	}
+}
+
+// set updates m from o, removing the description stamp of the provider.
+func (m *OUResourceModel) set(o dsm.DirectoryOU, stamp synoclient.Stamp) {
+	m.DN = types.StringValue(o.DN)
+	m.Name = types.StringValue(o.Name)
+	m.Container = types.StringValue(o.Container)
+	m.Description = types.StringValue(stamp.Strip(o.Description))
+}
+
+var (
+	_ resource.Resource             = &OUResource{}
+	_ resource.ResourceWithIdentity = &OUResource{}
+)
+
+func NewOUResource() resource.Resource {
+	return &OUResource{}
+}
+
+type OUResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *OUResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data OUResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryOUCreate(ctx, data.ou(p.stamp)); err != nil {
+		resp.Diagnostics.AddError("Failed to create organizational unit", err.Error())
+		return
+	}
+
+	data.DN = types.StringValue(ouDN(data.Name.ValueString(), data.Container.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "dn", data.DN.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *OUResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data OUResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.DN.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryOUSet(ctx, data.DN.ValueString(), data.ou(p.stamp)); err != nil {
+		resp.Diagnostics.AddError("Failed to update organizational unit", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "dn", data.DN.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *OUResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data OUResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.DN.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryOUDelete(ctx, data.DN.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete organizational unit", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *OUResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "ou")
+}
+
+// Read implements resource.Resource.
+func (p *OUResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data OUResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ou, err := findOU(ctx, p.client, data.DN.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list organizational units", err.Error())
+		return
+	}
+	if ou == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*ou, p.stamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "dn", data.DN.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *OUResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an organizational unit of the Directory Server domain. Only empty organizational units can be destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"dn": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the organizational unit, e.g. `OU=Staff,DC=corp,DC=example,DC=com`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organizational unit.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"container": schema.StringAttribute{
+				MarkdownDescription: "The DN of the domain or organizational unit holding the organizational unit, e.g. `synology_directory_server_domain.corp.base_dn`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the organizational unit.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (p *OUResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *OUResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	dn, diags := util.ImportID(ctx, req, "dn")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ou, err := findOU(ctx, p.client, dn)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list organizational units", err.Error())
+		return
+	}
+	if ou == nil {
+		resp.Diagnostics.AddError("Organizational unit not found", fmt.Sprintf("Organizational unit %s not found", dn))
+		return
+	}
+
+	var data OUResourceModel
+	data.set(*ou, p.stamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "dn", data.DN.ValueString())...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *OUResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("dn", "The distinguished name of the organizational unit.")
+}
+
+// checkStamp refuses changes to the organizational unit with the DN when the
+// provider requires a description stamp the unit lacks.
+func (p *OUResource) checkStamp(ctx context.Context, dn string) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	ou, err := findOU(ctx, p.client, dn)
+	if err != nil {
+		diags.AddError("Failed to list organizational units", err.Error())
+		return
+	}
+	if ou == nil {
+		return
+	}
+
+	if err := p.stamp.Check("organizational unit", ou.Description); err != nil {
+		diags.AddError("Refusing to modify organizational unit", err.Error())
+	}
+	return
+}
@@ -0,0 +1,47 @@
+package directoryserver_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type OUResource struct{}
+
+func TestAccOUResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"organizational unit is created",
+			`
+			resource "synology_directory_server_domain" "foo" {
+				name           = "tf-test.example.com"
+				netbios_name   = "TFTEST"
+				admin_password = "Tf-Test-Passw0rd"
+			}
+
+			resource "synology_directory_server_ou" "foo" {
+				name      = "tf-test"
+				container = synology_directory_server_domain.foo.base_dn
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_directory_server_ou.foo", "dn"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package directoryserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// defaultPasswordPolicy is the password policy of a new domain, which
+// destroying the resource restores.
+var defaultPasswordPolicy = dsm.DirectoryPasswordPolicy{
+	Complexity: true,
+	MinLength:  7,
+	History:    24,
+	MinAge:     1,
+	MaxAge:     42,
+}
+
+type PasswordPolicyResourceModel struct {
+	Complexity       types.Bool  `tfsdk:"complexity"`
+	MinLength        types.Int64 `tfsdk:"min_length"`
+	History          types.Int64 `tfsdk:"history"`
+	MinAge           types.Int64 `tfsdk:"min_age"`
+	MaxAge           types.Int64 `tfsdk:"max_age"`
+	LockoutThreshold types.Int64 `tfsdk:"lockout_threshold"`
+	LockoutDuration  types.Int64 `tfsdk:"lockout_duration"`
+}
+
+func (m PasswordPolicyResourceModel) policy() dsm.DirectoryPasswordPolicy {
+	return dsm.DirectoryPasswordPolicy{
+		Complexity:       m.Complexity.ValueBool(),
+		MinLength:        m.MinLength.ValueInt64(),
+		History:          m.History.ValueInt64(),
+		MinAge:           m.MinAge.ValueInt64(),
+		MaxAge:           m.MaxAge.ValueInt64(),
+		LockoutThreshold: m.LockoutThreshold.ValueInt64(),
+		LockoutDuration:  m.LockoutDuration.ValueInt64(),
+	}
+}
+
+func (m *PasswordPolicyResourceModel) set(p dsm.DirectoryPasswordPolicy) {
+	m.Complexity = types.BoolValue(p.Complexity)
+	m.MinLength = types.Int64Value(p.MinLength)
+	m.History = types.Int64Value(p.History)
+	m.MinAge = types.Int64Value(p.MinAge)
+	m.MaxAge = types.Int64Value(p.MaxAge)
+	m.LockoutThreshold = types.Int64Value(p.LockoutThreshold)
+	m.LockoutDuration = types.Int64Value(p.LockoutDuration)
+}
+
+var (
+	_ resource.Resource = &PasswordPolicyResource{}
+)
+
+func NewPasswordPolicyResource() resource.Resource {
+	return &PasswordPolicyResource{}
+}
+
+type PasswordPolicyResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *PasswordPolicyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data PasswordPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryPasswordPolicySet(ctx, data.policy()); err != nil {
+		resp.Diagnostics.AddError("Failed to set domain password policy", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *PasswordPolicyResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data PasswordPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryPasswordPolicySet(ctx, data.policy()); err != nil {
+		resp.Diagnostics.AddError("Failed to set domain password policy", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The defaults of a new domain are
+// restored.
+func (p *PasswordPolicyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	if err := p.client.DirectoryPasswordPolicySet(ctx, defaultPasswordPolicy); err != nil {
+		resp.Diagnostics.AddError("Failed to set domain password policy", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *PasswordPolicyResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "password_policy")
+}
+
+// Read implements resource.Resource.
+func (p *PasswordPolicyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data PasswordPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := p.client.DirectoryPasswordPolicyGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get domain password policy", err.Error())
+		return
+	}
+
+	data.set(*policy)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *PasswordPolicyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	days := []validator.Int64{int64validator.Between(0, 999)}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the password and account lockout policy of the Directory Server domain. There is a single policy per domain; destroying the resource restores the defaults of a new domain.",
+
+		Attributes: map[string]schema.Attribute{
+			"complexity": schema.BoolAttribute{
+				MarkdownDescription: "Whether passwords must contain characters of three of the four classes upper case, lower case, digits and symbols, and not the account name. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(defaultPasswordPolicy.Complexity),
+			},
+			"min_length": schema.Int64Attribute{
+				MarkdownDescription: "The minimum password length, `0` for none. Defaults to `7`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultPasswordPolicy.MinLength),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 14),
+				},
+			},
+			"history": schema.Int64Attribute{
+				MarkdownDescription: "The number of previous passwords which cannot be reused, `0` for none. Defaults to `24`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultPasswordPolicy.History),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 24),
+				},
+			},
+			"min_age": schema.Int64Attribute{
+				MarkdownDescription: "The number of days before a password can be changed again, `0` for none. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultPasswordPolicy.MinAge),
+				Validators:          days,
+			},
+			"max_age": schema.Int64Attribute{
+				MarkdownDescription: "The number of days after which passwords expire, `0` for never. Defaults to `42`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultPasswordPolicy.MaxAge),
+				Validators:          days,
+			},
+			"lockout_threshold": schema.Int64Attribute{
+				MarkdownDescription: "The number of failed logons after which an account is locked out, `0` for never.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 999),
+				},
+			},
+			"lockout_duration": schema.Int64Attribute{
+				MarkdownDescription: "The number of minutes a locked out account stays locked, `0` until an administrator unlocks it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 99999),
+				},
+			},
+		},
+	}
+}
+
+func (p *PasswordPolicyResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
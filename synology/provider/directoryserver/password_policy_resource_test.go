@@ -0,0 +1,42 @@
+package directoryserver_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type PasswordPolicyResource struct{}
+
+func TestAccPasswordPolicyResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"policy is set",
+			`
+			resource "synology_directory_server_password_policy" "foo" {
+				min_length        = 12
+				lockout_threshold = 5
+				lockout_duration  = 30
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_directory_server_password_policy.foo", "complexity", "true"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
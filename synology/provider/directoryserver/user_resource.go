@@ -0,0 +1,406 @@
+package directoryserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// findUser returns the domain user with the name, or nil if there is none.
+// Account names are case-insensitive in Active Directory.
+func findUser(ctx context.Context, client *dsm.Client, name string) (*dsm.DirectoryUser, error) {
+	list, err := client.DirectoryUserList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Users, func(u dsm.DirectoryUser) bool {
+		return strings.EqualFold(u.Name, name)
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Users[i], nil
+}
+
+type UserResourceModel struct {
+	Name                 types.String `tfsdk:"name"`
+	DN                   types.String `tfsdk:"dn"`
+	Container            types.String `tfsdk:"container"`
+	Password             types.String `tfsdk:"password"`
+	DisplayName          types.String `tfsdk:"display_name"`
+	Email                types.String `tfsdk:"email"`
+	Description          types.String `tfsdk:"description"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	PasswordNeverExpires types.Bool   `tfsdk:"password_never_expires"`
+	MustChangePassword   types.Bool   `tfsdk:"must_change_password"`
+}
+
+func (m UserResourceModel) user(stamp synoclient.Stamp) dsm.DirectoryUser {
+	return dsm.DirectoryUser{
+		Name:                 m.Name.ValueString(),
+		Container:            m.Container.ValueString(),
+		DisplayName:          m.DisplayName.ValueString(),
+		Email:                m.Email.ValueString(),
+		Description:          stamp.Apply(m.Description.ValueString()),
+		Disabled:             !m.Enabled.ValueBool(),
+		PasswordNeverExpires: m.PasswordNeverExpires.ValueBool(),
+		MustChangePassword:   m.MustChangePassword.ValueBool(),
+	}
+}
+
+// set updates m from u, removing the description stamp of the provider. The
+// password is never read back and whether it must be changed is kept as
+// configured, as DSM clears it once the user did.
+func (m *UserResourceModel) set(u dsm.DirectoryUser, stamp synoclient.Stamp) {
+	m.Name = types.StringValue(u.Name)
+	m.DN = types.StringValue(u.DN)
+	m.Container = types.StringValue(u.Container)
+	m.DisplayName = types.StringValue(u.DisplayName)
+	m.Email = types.StringValue(u.Email)
+	m.Description = types.StringValue(stamp.Strip(u.Description))
+	m.Enabled = types.BoolValue(!u.Disabled)
+	m.PasswordNeverExpires = types.BoolValue(u.PasswordNeverExpires)
+}
+
+var (
+	_ resource.Resource             = &UserResource{}
+	_ resource.ResourceWithIdentity = &UserResource{}
+)
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *UserResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryUserCreate(ctx, data.user(p.stamp), data.Password.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to create domain user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *UserResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is only sent when it changed, so that updating other
+	// attributes does not count as a password change.
+	password := ""
+	if !data.Password.Equal(state.Password) {
+		password = data.Password.ValueString()
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryUserSet(ctx, data.Name.ValueString(), data.user(p.stamp), password); err != nil {
+		resp.Diagnostics.AddError("Failed to update domain user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *UserResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DirectoryUserDelete(ctx, data.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete domain user", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *UserResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "user")
+}
+
+// Read implements resource.Resource.
+func (p *UserResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := findUser(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list domain users", err.Error())
+		return
+	}
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*user, p.stamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *UserResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user of the Directory Server domain.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The account name (sAMAccountName) of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dn": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the user.",
+				Computed:            true,
+			},
+			"container": schema.StringAttribute{
+				MarkdownDescription: "The DN of the organizational unit or container holding the user. Defaults to the `Users` container of the domain.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the user. It must follow the password policy of the domain, see `synology_directory_server_password_policy`. Changes made outside of Terraform are not detected.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user can log in. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"password_never_expires": schema.BoolAttribute{
+				MarkdownDescription: "Whether the password is exempt from the maximum password age of the domain.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"must_change_password": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user must change the password at the next logon. It is sent when the user is created or updated and not read back.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (p *UserResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState. The password is
+// not read back and must be set in the configuration.
+func (p *UserResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := findUser(ctx, p.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list domain users", err.Error())
+		return
+	}
+	if user == nil {
+		resp.Diagnostics.AddError("Domain user not found", fmt.Sprintf("Domain user %s not found", name))
+		return
+	}
+
+	data := UserResourceModel{
+		Password:           types.StringNull(),
+		MustChangePassword: types.BoolValue(user.MustChangePassword),
+	}
+	data.set(*user, p.stamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *UserResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The account name of the user.")
+}
+
+// refresh reads back the DN and container the domain assigned to the user.
+func (p *UserResource) refresh(ctx context.Context, data *UserResourceModel) (diags diag.Diagnostics) {
+	user, err := findUser(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list domain users", err.Error())
+		return
+	}
+	if user == nil {
+		diags.AddError("Domain user not found", fmt.Sprintf("Domain user %s not found", data.Name.ValueString()))
+		return
+	}
+
+	data.DN = types.StringValue(user.DN)
+	data.Container = types.StringValue(user.Container)
+	return
+}
+
+// checkStamp refuses changes to the user with the name when the provider
+// requires a description stamp the user lacks.
+func (p *UserResource) checkStamp(ctx context.Context, name string) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	user, err := findUser(ctx, p.client, name)
+	if err != nil {
+		diags.AddError("Failed to list domain users", err.Error())
+		return
+	}
+	if user == nil {
+		return
+	}
+
+	if err := p.stamp.Check("domain user", user.Description); err != nil {
+		diags.AddError("Refusing to modify domain user", err.Error())
+	}
+	return
+}
@@ -0,0 +1,22 @@
+// Package dns contains the resources of the DNS Server package.
+package dns
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func buildName(providerName, resourceName string) string {
+	return providerName + "_dns_" + resourceName
+}
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewZoneResource,
+		NewRecordResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
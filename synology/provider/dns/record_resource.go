@@ -0,0 +1,425 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type RecordResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Zone  types.String `tfsdk:"zone"`
+	Name  types.String `tfsdk:"name"`
+	Type  types.String `tfsdk:"type"`
+	TTL   types.Int64  `tfsdk:"ttl"`
+	Value types.String `tfsdk:"value"`
+}
+
+// recordID returns the ID of a record, which is unique as DNS Server does not
+// keep identical records twice.
+func recordID(zone, name, rrType, value string) string {
+	return strings.Join([]string{zone, name, rrType, value}, "/")
+}
+
+// recordOwner returns the fully qualified owner DNS Server expects for the
+// record name within domain. `@` is the zone apex.
+func recordOwner(name, domain string) string {
+	if name == "@" || name == "" {
+		return domain + "."
+	}
+	return name + "." + domain + "."
+}
+
+// recordName is the reverse of recordOwner.
+func recordName(owner, domain string) string {
+	owner = strings.TrimSuffix(owner, ".")
+	if owner == domain {
+		return "@"
+	}
+	return strings.TrimSuffix(owner, "."+domain)
+}
+
+func (m RecordResourceModel) record(zone dsm.DNSZone) dsm.DNSRecord {
+	return dsm.DNSRecord{
+		ZoneName:   zone.ZoneID,
+		DomainName: zone.DomainName,
+		Owner:      recordOwner(m.Name.ValueString(), zone.DomainName),
+		Type:       m.Type.ValueString(),
+		TTL:        strconv.FormatInt(m.TTL.ValueInt64(), 10),
+		Info:       m.Value.ValueString(),
+	}
+}
+
+func (m *RecordResourceModel) set(r dsm.DNSRecord) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ttl, err := strconv.ParseInt(r.TTL, 10, 64)
+	if err != nil {
+		diags.AddError("Invalid DNS record TTL", fmt.Sprintf("DNS Server returned TTL %q: %s", r.TTL, err))
+	}
+
+	m.Zone = types.StringValue(r.DomainName)
+	m.Name = types.StringValue(recordName(r.Owner, r.DomainName))
+	m.Type = types.StringValue(r.Type)
+	m.TTL = types.Int64Value(ttl)
+	m.Value = types.StringValue(r.Info)
+	m.ID = types.StringValue(recordID(r.DomainName, m.Name.ValueString(), r.Type, r.Info))
+
+	return diags
+}
+
+var (
+	_ resource.Resource             = &RecordResource{}
+	_ resource.ResourceWithIdentity = &RecordResource{}
+)
+
+func NewRecordResource() resource.Resource {
+	return &RecordResource{}
+}
+
+type RecordResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *RecordResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data RecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, diags := p.zone(ctx, data.Zone.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if zone == nil {
+		resp.Diagnostics.AddError("DNS zone not found", fmt.Sprintf("DNS zone %s not found", data.Zone.ValueString()))
+		return
+	}
+
+	if err := p.client.DNSRecordCreate(ctx, data.record(*zone)); err != nil {
+		resp.Diagnostics.AddError("Failed to create DNS record", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(recordID(
+		data.Zone.ValueString(), data.Name.ValueString(), data.Type.ValueString(), data.Value.ValueString(),
+	))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource. Only the TTL can change, DNS Server
+// records are replaced as they have no ID of their own.
+func (p *RecordResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state RecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, diags := p.zone(ctx, plan.Zone.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if zone == nil {
+		resp.Diagnostics.AddError("DNS zone not found", fmt.Sprintf("DNS zone %s not found", plan.Zone.ValueString()))
+		return
+	}
+
+	if err := p.client.DNSRecordDelete(ctx, state.record(*zone)); err != nil {
+		resp.Diagnostics.AddError("Failed to delete DNS record", err.Error())
+		return
+	}
+
+	if err := p.client.DNSRecordCreate(ctx, plan.record(*zone)); err != nil {
+		resp.Diagnostics.AddError("Failed to create DNS record", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", plan.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *RecordResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data RecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, diags := p.zone(ctx, data.Zone.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if zone == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := p.client.DNSRecordDelete(ctx, data.record(*zone)); err != nil {
+		resp.Diagnostics.AddError("Failed to delete DNS record", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *RecordResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "record")
+}
+
+// Read implements resource.Resource.
+func (p *RecordResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data RecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record, diags := p.find(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if record == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(*record)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *RecordResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	replace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a resource record of a primary zone of the DNS Server package.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the record in the form `<zone>/<name>/<type>/<value>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "The domain name of the zone.",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the record relative to the zone, `@` for the zone itself.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("@"),
+				PlanModifiers:       replace,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the record. One of `A`, `AAAA`, `CNAME`, `PTR` or `TXT`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "AAAA", "CNAME", "PTR", "TXT"),
+				},
+				PlanModifiers: replace,
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "The time to live of the record in seconds.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(86400),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The data of the record, e.g. an IP address for `A` records or a fully qualified name with a trailing dot for `CNAME` and `PTR` records.",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+		},
+	}
+}
+
+func (p *RecordResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *RecordResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) != 4 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected <zone>/<name>/<type>/<value>, got %q", id),
+		)
+		return
+	}
+
+	data := RecordResourceModel{
+		Zone:  types.StringValue(parts[0]),
+		Name:  types.StringValue(parts[1]),
+		Type:  types.StringValue(parts[2]),
+		Value: types.StringValue(parts[3]),
+	}
+
+	record, diags := p.find(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if record == nil {
+		resp.Diagnostics.AddError("DNS record not found", fmt.Sprintf("DNS record %s not found", id))
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(*record)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *RecordResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the record in the form `<zone>/<name>/<type>/<value>`.")
+}
+
+// zone returns the zone with the domain name, or nil if there is none.
+func (p *RecordResource) zone(ctx context.Context, name string) (*dsm.DNSZone, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	list, err := p.client.DNSZoneList(ctx)
+	if err != nil {
+		diags.AddError("Failed to list DNS zones", err.Error())
+		return nil, diags
+	}
+
+	i := slices.IndexFunc(list.Items, func(z dsm.DNSZone) bool {
+		return z.DomainName == name
+	})
+	if i == -1 {
+		return nil, diags
+	}
+
+	return &list.Items[i], diags
+}
+
+// find returns the record of the zone matching the name, type and value of
+// data, or nil if there is none.
+func (p *RecordResource) find(ctx context.Context, data RecordResourceModel) (*dsm.DNSRecord, diag.Diagnostics) {
+	zone, diags := p.zone(ctx, data.Zone.ValueString())
+	if diags.HasError() || zone == nil {
+		return nil, diags
+	}
+
+	list, err := p.client.DNSRecordList(ctx, zone.ZoneID, zone.DomainName)
+	if err != nil {
+		diags.AddError("Failed to list DNS records", err.Error())
+		return nil, diags
+	}
+
+	owner := recordOwner(data.Name.ValueString(), zone.DomainName)
+	i := slices.IndexFunc(list.Items, func(r dsm.DNSRecord) bool {
+		return r.Owner == owner && r.Type == data.Type.ValueString() && r.Info == data.Value.ValueString()
+	})
+	if i == -1 {
+		return nil, diags
+	}
+
+	return &list.Items[i], diags
+}
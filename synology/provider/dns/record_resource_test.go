@@ -0,0 +1,51 @@
+package dns_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type RecordResource struct{}
+
+func TestAccRecordResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"A record is created",
+			`
+			resource "synology_dns_zone" "foo" {
+				name = "tf-test.example.com"
+			}
+
+			resource "synology_dns_record" "foo" {
+				zone  = synology_dns_zone.foo.name
+				name  = "nas"
+				type  = "A"
+				value = "192.168.1.2"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_dns_record.foo",
+								"id",
+								"tf-test.example.com/nas/A/192.168.1.2",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,443 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// zoneTypes maps the zone types of the schema to the DNS Server ones.
+var zoneTypes = map[string]string{
+	"primary":   dsm.DNSZoneMaster,
+	"secondary": dsm.DNSZoneSlave,
+}
+
+type ZoneResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	PrimaryServers types.List   `tfsdk:"primary_servers"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	AllowTransfer  types.List   `tfsdk:"allow_transfer"`
+}
+
+func (m ZoneResourceModel) domainType() string {
+	name := strings.TrimSuffix(m.Name.ValueString(), ".")
+	if strings.HasSuffix(name, ".in-addr.arpa") || strings.HasSuffix(name, ".ip6.arpa") {
+		return dsm.DNSDomainReverse
+	}
+	return dsm.DNSDomainForward
+}
+
+func (m ZoneResourceModel) conf(ctx context.Context) (dsm.DNSZoneConf, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	conf := dsm.DNSZoneConf{
+		ZoneID:           m.ID.ValueString(),
+		Enabled:          m.Enabled.ValueBool(),
+		MasterIPs:        []string{},
+		TransferAllowIPs: []string{},
+	}
+	if !m.PrimaryServers.IsNull() && !m.PrimaryServers.IsUnknown() {
+		diags.Append(m.PrimaryServers.ElementsAs(ctx, &conf.MasterIPs, false)...)
+	}
+	if !m.AllowTransfer.IsNull() && !m.AllowTransfer.IsUnknown() {
+		diags.Append(m.AllowTransfer.ElementsAs(ctx, &conf.TransferAllowIPs, false)...)
+	}
+	conf.EnableTransfer = len(conf.TransferAllowIPs) > 0
+
+	return conf, diags
+}
+
+func (m *ZoneResourceModel) set(ctx context.Context, zone dsm.DNSZone, conf dsm.DNSZoneConf) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(zone.ZoneID)
+	m.Name = types.StringValue(zone.DomainName)
+	for k, v := range zoneTypes {
+		if v == zone.ZoneType {
+			m.Type = types.StringValue(k)
+		}
+	}
+	m.Enabled = types.BoolValue(conf.Enabled)
+
+	if zone.ZoneType == dsm.DNSZoneSlave {
+		v, d := types.ListValueFrom(ctx, types.StringType, conf.MasterIPs)
+		diags.Append(d...)
+		m.PrimaryServers = v
+	} else {
+		m.PrimaryServers = types.ListNull(types.StringType)
+	}
+
+	transfer := []string{}
+	if conf.EnableTransfer {
+		transfer = conf.TransferAllowIPs
+	}
+	v, d := types.ListValueFrom(ctx, types.StringType, transfer)
+	diags.Append(d...)
+	m.AllowTransfer = v
+
+	return diags
+}
+
+var (
+	_ resource.Resource                   = &ZoneResource{}
+	_ resource.ResourceWithIdentity       = &ZoneResource{}
+	_ resource.ResourceWithValidateConfig = &ZoneResource{}
+)
+
+func NewZoneResource() resource.Resource {
+	return &ZoneResource{}
+}
+
+type ZoneResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ZoneResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conf, diags := data.conf(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneType := zoneTypes[data.Type.ValueString()]
+	create := dsm.DNSZoneCreateRequest{
+		DomainName: data.Name.ValueString(),
+		DomainType: data.domainType(),
+		ZoneType:   zoneType,
+	}
+	if zoneType == dsm.DNSZoneSlave {
+		create.MasterIPs = conf.MasterIPs
+	}
+
+	if err := p.client.DNSZoneCreate(ctx, create); err != nil {
+		resp.Diagnostics.AddError("Failed to create DNS zone", err.Error())
+		return
+	}
+
+	zone, err := p.find(ctx, func(z dsm.DNSZone) bool {
+		return z.DomainName == data.Name.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list DNS zones", err.Error())
+		return
+	}
+	if zone == nil {
+		resp.Diagnostics.AddError(
+			"DNS zone not found",
+			fmt.Sprintf("DNS zone %s not found after creation", data.Name.ValueString()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(zone.ZoneID)
+	conf.ZoneID = zone.ZoneID
+
+	if err := p.client.DNSZoneConfSet(ctx, conf); err != nil {
+		resp.Diagnostics.AddError("Failed to set DNS zone settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *ZoneResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conf, diags := data.conf(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DNSZoneConfSet(ctx, conf); err != nil {
+		resp.Diagnostics.AddError("Failed to set DNS zone settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *ZoneResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DNSZoneDelete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete DNS zone", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ZoneResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "zone")
+}
+
+// Read implements resource.Resource.
+func (p *ZoneResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := p.find(ctx, func(z dsm.DNSZone) bool {
+		return z.ZoneID == data.ID.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list DNS zones", err.Error())
+		return
+	}
+	if zone == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data, *zone)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *ZoneResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a zone of the DNS Server package. Zones under `in-addr.arpa` or `ip6.arpa` are created as reverse zones.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The DNS Server ID of the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The domain name of the zone, e.g. `home.example.com` or `1.168.192.in-addr.arpa`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the zone. One of `primary` or `secondary`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("primary"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("primary", "secondary"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_servers": schema.ListAttribute{
+				MarkdownDescription: "IP addresses of the primary servers a `secondary` zone is transferred from.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone is served.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"allow_transfer": schema.ListAttribute{
+				MarkdownDescription: "IP addresses or subnets allowed to transfer the zone. Zone transfers are disabled when empty.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (p *ZoneResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data ZoneResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+
+	secondary := data.Type.ValueString() == "secondary"
+	if secondary && data.PrimaryServers.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary_servers"),
+			"Missing DNS zone attribute",
+			"primary_servers is required when type is \"secondary\".",
+		)
+	}
+	if !secondary && !data.PrimaryServers.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary_servers"),
+			"Invalid DNS zone attribute",
+			"primary_servers can only be set when type is \"secondary\".",
+		)
+	}
+}
+
+func (p *ZoneResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. Zones are
+// imported by domain name.
+func (p *ZoneResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := p.find(ctx, func(z dsm.DNSZone) bool {
+		return z.DomainName == name
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list DNS zones", err.Error())
+		return
+	}
+	if zone == nil {
+		resp.Diagnostics.AddError("DNS zone not found", fmt.Sprintf("DNS zone %s not found", name))
+		return
+	}
+
+	var data ZoneResourceModel
+	resp.Diagnostics.Append(p.read(ctx, &data, *zone)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *ZoneResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The domain name of the zone.")
+}
+
+func (p *ZoneResource) read(ctx context.Context, data *ZoneResourceModel, zone dsm.DNSZone) (diags diag.Diagnostics) {
+	conf, err := p.client.DNSZoneConfGet(ctx, zone.ZoneID)
+	if err != nil {
+		diags.AddError("Failed to get DNS zone settings", err.Error())
+		return
+	}
+
+	diags.Append(data.set(ctx, zone, *conf)...)
+	return
+}
+
+func (p *ZoneResource) find(ctx context.Context, match func(dsm.DNSZone) bool) (*dsm.DNSZone, error) {
+	list, err := p.client.DNSZoneList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Items, match)
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Items[i], nil
+}
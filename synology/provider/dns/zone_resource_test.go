@@ -0,0 +1,41 @@
+package dns_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ZoneResource struct{}
+
+func TestAccZoneResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"primary zone is created",
+			`
+			resource "synology_dns_zone" "foo" {
+				name = "tf-test.example.com"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_dns_zone.foo", "type", "primary"),
+							r.TestCheckResourceAttrSet("synology_dns_zone.foo", "id"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Package downloadstation contains the resources of the Download Station package.
+package downloadstation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func buildName(providerName, resourceName string) string {
+	return providerName + "_download_station_" + resourceName
+}
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewSettingsResource,
+		NewTaskResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
@@ -0,0 +1,288 @@
+package downloadstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+type SettingsResourceModel struct {
+	DefaultDestination types.String `tfsdk:"default_destination"`
+	BTMaxDownload      types.Int64  `tfsdk:"bt_max_download"`
+	BTMaxUpload        types.Int64  `tfsdk:"bt_max_upload"`
+	HTTPMaxDownload    types.Int64  `tfsdk:"http_max_download"`
+	ScheduleEnabled    types.Bool   `tfsdk:"schedule_enabled"`
+}
+
+var (
+	_ resource.Resource = &SettingsResource{}
+)
+
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{}
+}
+
+type SettingsResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *SettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The settings are left as they are.
+func (p *SettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SettingsResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "settings")
+}
+
+// Read implements resource.Resource.
+func (p *SettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *SettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the settings of the Download Station package. Settings which are not configured keep their current value. There is a single set of settings per NAS; destroying the resource leaves them unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"default_destination": schema.StringAttribute{
+				MarkdownDescription: "The shared folder tasks download to by default, e.g. `downloads/incoming`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bt_max_download":   rateAttribute("The BitTorrent download limit"),
+			"bt_max_upload":     rateAttribute("The BitTorrent upload limit"),
+			"http_max_download": rateAttribute("The FTP and HTTP download limit"),
+			"schedule_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether downloads follow the schedule set up in Download Station.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func rateAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description + " in KB/s, `0` for no limit.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (p *SettingsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The import ID is
+// ignored as there is a single set of settings per NAS.
+func (p *SettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply sends the configured settings and reads back the others.
+func (p *SettingsResource) apply(ctx context.Context, data *SettingsResourceModel) (diags diag.Diagnostics) {
+	if !data.DefaultDestination.IsUnknown() {
+		location := dsm.DownloadLocation{DefaultDestination: data.DefaultDestination.ValueString()}
+		if err := p.client.DownloadLocationSet(ctx, location); err != nil {
+			diags.AddError("Failed to set download destination", err.Error())
+			return
+		}
+	}
+
+	if !data.BTMaxDownload.IsUnknown() || !data.BTMaxUpload.IsUnknown() {
+		bt, err := p.client.DownloadBTGet(ctx)
+		if err != nil {
+			diags.AddError("Failed to get BitTorrent settings", err.Error())
+			return
+		}
+		if !data.BTMaxDownload.IsUnknown() {
+			bt.MaxDownloadRate = data.BTMaxDownload.ValueInt64()
+		}
+		if !data.BTMaxUpload.IsUnknown() {
+			bt.MaxUploadRate = data.BTMaxUpload.ValueInt64()
+		}
+		if err := p.client.DownloadBTSet(ctx, *bt); err != nil {
+			diags.AddError("Failed to set BitTorrent settings", err.Error())
+			return
+		}
+	}
+
+	if !data.HTTPMaxDownload.IsUnknown() {
+		rate := dsm.DownloadRate{MaxDownloadRate: data.HTTPMaxDownload.ValueInt64()}
+		if err := p.client.DownloadFtpHttpSet(ctx, rate); err != nil {
+			diags.AddError("Failed to set FTP and HTTP settings", err.Error())
+			return
+		}
+	}
+
+	if !data.ScheduleEnabled.IsUnknown() {
+		scheduler := dsm.DownloadScheduler{Enable: data.ScheduleEnabled.ValueBool()}
+		if err := p.client.DownloadSchedulerSet(ctx, scheduler); err != nil {
+			diags.AddError("Failed to set download schedule", err.Error())
+			return
+		}
+	}
+
+	diags.Append(p.read(ctx, data)...)
+	return
+}
+
+func (p *SettingsResource) read(ctx context.Context, data *SettingsResourceModel) (diags diag.Diagnostics) {
+	location, err := p.client.DownloadLocationGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get download destination", err.Error())
+		return
+	}
+	data.DefaultDestination = types.StringValue(location.DefaultDestination)
+
+	bt, err := p.client.DownloadBTGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get BitTorrent settings", err.Error())
+		return
+	}
+	data.BTMaxDownload = types.Int64Value(bt.MaxDownloadRate)
+	data.BTMaxUpload = types.Int64Value(bt.MaxUploadRate)
+
+	ftpHTTP, err := p.client.DownloadFtpHttpGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get FTP and HTTP settings", err.Error())
+		return
+	}
+	data.HTTPMaxDownload = types.Int64Value(ftpHTTP.MaxDownloadRate)
+
+	scheduler, err := p.client.DownloadSchedulerGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get download schedule", err.Error())
+		return
+	}
+	data.ScheduleEnabled = types.BoolValue(scheduler.Enable)
+
+	return
+}
@@ -0,0 +1,43 @@
+package downloadstation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SettingsResource struct{}
+
+func TestAccSettingsResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"download station settings are applied",
+			`
+			resource "synology_download_station_settings" "foo" {
+				default_destination = "downloads"
+				bt_max_upload       = 500
+				schedule_enabled    = false
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_download_station_settings.foo", "default_destination", "downloads"),
+							r.TestCheckResourceAttr("synology_download_station_settings.foo", "bt_max_upload", "500"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package downloadstation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type TaskResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	URI         types.String `tfsdk:"uri"`
+	Destination types.String `tfsdk:"destination"`
+	Title       types.String `tfsdk:"title"`
+	Size        types.Int64  `tfsdk:"size"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func (m *TaskResourceModel) set(task dsm.DownloadTask) {
+	m.ID = types.StringValue(task.ID)
+	m.Title = types.StringValue(task.Title)
+	m.Size = types.Int64Value(task.Size)
+
+	status, ok := dsm.DownloadTaskStatuses[task.Status]
+	if !ok {
+		status = strconv.Itoa(task.Status)
+	}
+	m.Status = types.StringValue(status)
+
+	if d := task.Additional.Detail; d.URI != "" {
+		m.URI = types.StringValue(d.URI)
+		m.Destination = types.StringValue(d.Destination)
+	}
+}
+
+var (
+	_ resource.Resource             = &TaskResource{}
+	_ resource.ResourceWithIdentity = &TaskResource{}
+)
+
+func NewTaskResource() resource.Resource {
+	return &TaskResource{}
+}
+
+type TaskResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *TaskResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data TaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := p.client.DownloadTaskCreate(ctx, data.URI.ValueString(), data.Destination.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create download task", err.Error())
+		return
+	}
+
+	task, err := p.find(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list download tasks", err.Error())
+		return
+	}
+	if task == nil {
+		resp.Diagnostics.AddError(
+			"Download task not found",
+			fmt.Sprintf("Download task %s not found after creation", id),
+		)
+		return
+	}
+
+	data.set(*task)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource. Every attribute replaces the task.
+func (p *TaskResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data TaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource. Downloaded files are kept.
+func (p *TaskResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data TaskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DownloadTaskDelete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete download task", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *TaskResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "task")
+}
+
+// Read implements resource.Resource.
+func (p *TaskResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data TaskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := p.find(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list download tasks", err.Error())
+		return
+	}
+	if task == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*task)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *TaskResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Download Station task fetching a file to a shared folder. Destroying the task keeps the downloaded files.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the task.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				MarkdownDescription: "The HTTP, FTP or magnet URI to download.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "The shared folder to download to, e.g. `downloads/artifacts`. Defaults to the default destination of Download Station.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the task, usually the file name.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "The size of the download in bytes, `0` while unknown.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the task, e.g. `downloading`, `finished` or `seeding`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (p *TaskResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *TaskResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := p.find(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list download tasks", err.Error())
+		return
+	}
+	if task == nil {
+		resp.Diagnostics.AddError("Download task not found", fmt.Sprintf("Download task %s not found", id))
+		return
+	}
+
+	var data TaskResourceModel
+	data.set(*task)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *TaskResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the task.")
+}
+
+func (p *TaskResource) find(ctx context.Context, id string) (*dsm.DownloadTask, error) {
+	list, err := p.client.DownloadTaskList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Tasks, func(t dsm.DownloadTask) bool {
+		return t.ID == id
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Tasks[i], nil
+}
@@ -0,0 +1,42 @@
+package downloadstation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type TaskResource struct{}
+
+func TestAccTaskResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"download task is created",
+			`
+			resource "synology_download_station_task" "foo" {
+				uri         = "https://releases.ubuntu.com/24.04/SHA256SUMS"
+				destination = "downloads"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_download_station_task.foo", "id"),
+							r.TestCheckResourceAttrSet("synology_download_station_task.foo", "status"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
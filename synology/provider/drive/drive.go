@@ -0,0 +1,21 @@
+// Package drive contains the resources of the Synology Drive Server package.
+package drive
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func buildName(providerName, resourceName string) string {
+	return providerName + "_drive_" + resourceName
+}
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewShareSyncResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
@@ -0,0 +1,453 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/schedulemodel"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type FolderPairModel struct {
+	LocalPath  types.String `tfsdk:"local_path"`
+	RemotePath types.String `tfsdk:"remote_path"`
+	Direction  types.String `tfsdk:"direction"`
+}
+
+func (m FolderPairModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m FolderPairModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"local_path":  types.StringType,
+		"remote_path": types.StringType,
+		"direction":   types.StringType,
+	}
+}
+
+type ShareSyncResourceModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Server   types.String `tfsdk:"server"`
+	Port     types.Int64  `tfsdk:"port"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	SSL      types.Bool   `tfsdk:"ssl"`
+	Folders  types.List   `tfsdk:"folders"`
+	Schedule types.String `tfsdk:"schedule"`
+	Status   types.String `tfsdk:"status"`
+}
+
+func (m ShareSyncResourceModel) connection(ctx context.Context) (dsm.DriveShareSync, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	conn := dsm.DriveShareSync{
+		ID:       m.ID.ValueInt64(),
+		Server:   m.Server.ValueString(),
+		Port:     m.Port.ValueInt64(),
+		Username: m.Username.ValueString(),
+		Password: m.Password.ValueString(),
+		SSL:      m.SSL.ValueBool(),
+		Pairs:    []dsm.DriveSyncPair{},
+	}
+
+	var folders []FolderPairModel
+	diags.Append(m.Folders.ElementsAs(ctx, &folders, false)...)
+	for _, f := range folders {
+		conn.Pairs = append(conn.Pairs, dsm.DriveSyncPair{
+			LocalPath:  f.LocalPath.ValueString(),
+			RemotePath: f.RemotePath.ValueString(),
+			Direction:  f.Direction.ValueString(),
+		})
+	}
+
+	if spec := m.Schedule.ValueString(); spec != "" {
+		s, err := schedulemodel.ParseDSMSchedule(spec, true)
+		if err != nil {
+			diags.AddAttributeError(path.Root("schedule"), "Invalid sync schedule", err.Error())
+			return conn, diags
+		}
+		conn.Schedule = dsm.DriveShareSyncSchedule{
+			Enabled:    true,
+			Hour:       s.Hour,
+			Minute:     s.Minute,
+			RepeatHour: s.RepeatHour,
+			WeekDay:    s.WeekDay(),
+		}
+	}
+
+	return conn, diags
+}
+
+func (m *ShareSyncResourceModel) set(ctx context.Context, conn dsm.DriveShareSync) diag.Diagnostics {
+	m.ID = types.Int64Value(conn.ID)
+	m.Server = types.StringValue(conn.Server)
+	m.Port = types.Int64Value(conn.Port)
+	m.Username = types.StringValue(conn.Username)
+	m.SSL = types.BoolValue(conn.SSL)
+	m.Status = types.StringValue(conn.Status)
+
+	// DSM stores the schedule in its own format, an unscheduled connection is
+	// the only change detected.
+	if !conn.Schedule.Enabled {
+		m.Schedule = types.StringNull()
+	}
+
+	folders := make([]FolderPairModel, 0, len(conn.Pairs))
+	for _, p := range conn.Pairs {
+		folders = append(folders, FolderPairModel{
+			LocalPath:  types.StringValue(p.LocalPath),
+			RemotePath: types.StringValue(p.RemotePath),
+			Direction:  types.StringValue(p.Direction),
+		})
+	}
+
+	v, diags := types.ListValueFrom(ctx, FolderPairModel{}.ModelType(), folders)
+	m.Folders = v
+
+	return diags
+}
+
+var (
+	_ resource.Resource             = &ShareSyncResource{}
+	_ resource.ResourceWithIdentity = &ShareSyncResource{}
+)
+
+func NewShareSyncResource() resource.Resource {
+	return &ShareSyncResource{}
+}
+
+type ShareSyncResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ShareSyncResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ShareSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, diags := data.connection(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := p.client.DriveShareSyncCreate(ctx, conn)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create ShareSync connection", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(id)
+	data.Status = types.StringValue("")
+	if created, err := p.find(ctx, id); err == nil && created != nil {
+		data.Status = types.StringValue(created.Status)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(id, 10))...)
+}
+
+// Update implements resource.Resource.
+func (p *ShareSyncResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state ShareSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, diags := data.connection(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Password.Equal(state.Password) {
+		conn.Password = ""
+	}
+
+	if err := p.client.DriveShareSyncSet(ctx, conn); err != nil {
+		resp.Diagnostics.AddError("Failed to update ShareSync connection", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
+}
+
+// Delete implements resource.Resource. The synced files are kept on both
+// sides.
+func (p *ShareSyncResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ShareSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.DriveShareSyncDelete(ctx, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete ShareSync connection", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ShareSyncResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "sharesync")
+}
+
+// Read implements resource.Resource.
+func (p *ShareSyncResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ShareSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := p.find(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list ShareSync connections", err.Error())
+		return
+	}
+	if conn == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *conn)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
+}
+
+// Schema implements resource.Resource.
+func (p *ShareSyncResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Synology Drive ShareSync connection syncing folders of the NAS with the Drive Server of another Synology NAS, as in Synology Drive ShareSync. Synology Drive Server has to run on both.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the connection.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "The address or QuickConnect ID of the remote NAS.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port of Synology Drive Server on the remote NAS.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(6690),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "A user of the remote NAS allowed to use Synology Drive.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of `username`.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"ssl": schema.BoolAttribute{
+				MarkdownDescription: "Whether to encrypt the connection.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"folders": schema.ListNestedAttribute{
+				MarkdownDescription: "The folder pairs to sync.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"local_path": schema.StringAttribute{
+							MarkdownDescription: "The File Station path of the folder on this NAS, e.g. `/projects`.",
+							Required:            true,
+						},
+						"remote_path": schema.StringAttribute{
+							MarkdownDescription: "The path of the folder on the remote NAS, e.g. `/team-folder/projects`.",
+							Required:            true,
+						},
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "The direction changes are synced in: `bidirectional`, `download_only` from the remote NAS or `upload_only` to it. Defaults to `bidirectional`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(dsm.DriveSyncBidirectional),
+							Validators: []validator.String{
+								stringvalidator.OneOf(dsm.DriveSyncBidirectional, dsm.DriveSyncDownloadOnly, dsm.DriveSyncUploadOnly),
+							},
+						},
+					},
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Sync schedule expressed in cron, e.g. `0 1 * * *`. The minute must be a single value and the hours evenly spaced. Changes are synced continuously when unset.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The sync status reported by DSM.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (p *ShareSyncResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The password and
+// schedule are not imported and have to be set in the configuration.
+func (p *ShareSyncResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	importID, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(importID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse ID", err.Error())
+		return
+	}
+
+	conn, err := p.find(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list ShareSync connections", err.Error())
+		return
+	}
+	if conn == nil {
+		resp.Diagnostics.AddError("ShareSync connection not found", fmt.Sprintf("ShareSync connection %d not found", id))
+		return
+	}
+
+	data := ShareSyncResourceModel{
+		Password: types.StringNull(),
+		Schedule: types.StringNull(),
+	}
+	resp.Diagnostics.Append(data.set(ctx, *conn)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", importID)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *ShareSyncResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the connection.")
+}
+
+func (p *ShareSyncResource) find(ctx context.Context, id int64) (*dsm.DriveShareSync, error) {
+	list, err := p.client.DriveShareSyncList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Connections, func(c dsm.DriveShareSync) bool {
+		return c.ID == id
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Connections[i], nil
+}
@@ -0,0 +1,47 @@
+package drive_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ShareSyncResource struct{}
+
+func TestAccShareSyncResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"connection is created",
+			`
+			resource "synology_drive_sharesync" "foo" {
+				server   = "remote.example.com"
+				username = "sync"
+				password = "Tf-Test-Passw0rd"
+				folders = [{
+					local_path  = "/docker"
+					remote_path = "/backup/docker"
+					direction   = "upload_only"
+				}]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_drive_sharesync.foo", "id"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
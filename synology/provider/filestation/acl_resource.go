@@ -0,0 +1,433 @@
+package filestation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// aclMu serializes the read-modify-write cycles of ACLs, several entries of
+// the same path are usually applied in parallel.
+var aclMu sync.Mutex
+
+type ACLResourceModel struct {
+	Path          types.String `tfsdk:"path"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	Principal     types.String `tfsdk:"principal"`
+	Type          types.String `tfsdk:"type"`
+	Permissions   types.Set    `tfsdk:"permissions"`
+	Inheritance   types.Set    `tfsdk:"inheritance"`
+}
+
+func (m ACLResourceModel) id() string {
+	return strings.Join([]string{
+		m.PrincipalType.ValueString(),
+		m.Principal.ValueString(),
+		m.Type.ValueString(),
+		m.Path.ValueString(),
+	}, ":")
+}
+
+// matches reports whether e is the entry of m, ignoring inherited entries.
+func (m ACLResourceModel) matches(e dsm.ACE) bool {
+	return !e.Inherited &&
+		e.OwnerType == m.PrincipalType.ValueString() &&
+		e.OwnerName == m.Principal.ValueString() &&
+		e.IsAllow == (m.Type.ValueString() == "allow")
+}
+
+func (m ACLResourceModel) entry(ctx context.Context) (dsm.ACE, diag.Diagnostics) {
+	var permissions, inheritance []string
+	diags := m.Permissions.ElementsAs(ctx, &permissions, false)
+	diags.Append(m.Inheritance.ElementsAs(ctx, &inheritance, false)...)
+
+	e := dsm.ACE{
+		OwnerType:  m.PrincipalType.ValueString(),
+		OwnerName:  m.Principal.ValueString(),
+		IsAllow:    m.Type.ValueString() == "allow",
+		Permission: map[string]bool{},
+		Inherit:    map[string]bool{},
+	}
+	for _, p := range dsm.ACLPermissions {
+		e.Permission[p] = slices.Contains(permissions, p)
+	}
+	for _, i := range dsm.ACLInheritance {
+		e.Inherit[i] = slices.Contains(inheritance, i)
+	}
+
+	return e, diags
+}
+
+func (m *ACLResourceModel) set(ctx context.Context, e dsm.ACE) diag.Diagnostics {
+	m.PrincipalType = types.StringValue(e.OwnerType)
+	m.Principal = types.StringValue(e.OwnerName)
+	m.Type = types.StringValue("deny")
+	if e.IsAllow {
+		m.Type = types.StringValue("allow")
+	}
+
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+	m.Permissions, d = types.SetValueFrom(ctx, types.StringType, enabledACLFlags(e.Permission))
+	diags.Append(d...)
+	m.Inheritance, d = types.SetValueFrom(ctx, types.StringType, enabledACLFlags(e.Inherit))
+	diags.Append(d...)
+
+	return diags
+}
+
+func enabledACLFlags(flags map[string]bool) []string {
+	enabled := []string{}
+	for k, v := range flags {
+		if v {
+			enabled = append(enabled, k)
+		}
+	}
+	sort.Strings(enabled)
+	return enabled
+}
+
+var (
+	_ resource.Resource             = &ACLResource{}
+	_ resource.ResourceWithIdentity = &ACLResource{}
+)
+
+func NewACLResource() resource.Resource {
+	return &ACLResource{}
+}
+
+type ACLResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (f *ACLResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ACLResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, diags := data.entry(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aclMu.Lock()
+	defer aclMu.Unlock()
+
+	acl, err := f.client.ACLGet(ctx, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get ACL", err.Error())
+		return
+	}
+
+	if slices.ContainsFunc(acl.Entries, data.matches) {
+		resp.Diagnostics.AddError(
+			"ACL entry already exists",
+			fmt.Sprintf("An entry %s already exists, import it instead.", data.id()),
+		)
+		return
+	}
+
+	acl.Entries = append(acl.Entries, entry)
+	if err := f.client.ACLSet(ctx, data.Path.ValueString(), *acl); err != nil {
+		resp.Diagnostics.AddError("Failed to set ACL", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Update implements resource.Resource.
+func (f *ACLResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ACLResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, diags := data.entry(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aclMu.Lock()
+	defer aclMu.Unlock()
+
+	acl, err := f.client.ACLGet(ctx, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get ACL", err.Error())
+		return
+	}
+
+	if i := slices.IndexFunc(acl.Entries, data.matches); i != -1 {
+		acl.Entries[i] = entry
+	} else {
+		acl.Entries = append(acl.Entries, entry)
+	}
+
+	if err := f.client.ACLSet(ctx, data.Path.ValueString(), *acl); err != nil {
+		resp.Diagnostics.AddError("Failed to set ACL", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Delete implements resource.Resource.
+func (f *ACLResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ACLResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aclMu.Lock()
+	defer aclMu.Unlock()
+
+	acl, err := f.client.ACLGet(ctx, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get ACL", err.Error())
+		return
+	}
+
+	acl.Entries = slices.DeleteFunc(acl.Entries, data.matches)
+	if err := f.client.ACLSet(ctx, data.Path.ValueString(), *acl); err != nil {
+		resp.Diagnostics.AddError("Failed to set ACL", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (f *ACLResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "acl")
+}
+
+// Read implements resource.Resource.
+func (f *ACLResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ACLResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acl, err := f.client.ACLGet(ctx, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get ACL", err.Error())
+		return
+	}
+
+	i := slices.IndexFunc(acl.Entries, data.matches)
+	if i == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, acl.Entries[i])...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Schema implements resource.Resource.
+func (f *ACLResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	inheritance := make([]attr.Value, 0, len(dsm.ACLInheritance))
+	for _, i := range dsm.ACLInheritance {
+		inheritance = append(inheritance, types.StringValue(i))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single Windows ACL entry of a file or folder. Other entries of the path are left untouched, entries inherited from parent folders cannot be managed.",
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the file or folder, e.g. `/docker/config`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				MarkdownDescription: "Whether `principal` is a `user` or a `group`. Defaults to `user`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(dsm.ACLOwnerUser),
+				Validators: []validator.String{
+					stringvalidator.OneOf(dsm.ACLOwnerUser, dsm.ACLOwnerGroup),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal": schema.StringAttribute{
+				MarkdownDescription: "The name of the user or group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Whether the entry grants or denies `permissions`. One of `allow` or `deny`. Defaults to `allow`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("allow"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("allow", "deny"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "The permissions of the entry: `read_data`, `write_data`, `exe_file`, `append_data`, `delete`, `delete_sub`, `read_attr`, `write_attr`, `read_ext_attr`, `write_ext_attr`, `read_perm`, `change_perm` or `take_ownership`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(dsm.ACLPermissions...)),
+				},
+			},
+			"inheritance": schema.SetAttribute{
+				MarkdownDescription: "What the entry applies to: `this_folder`, `child_folders`, `child_files` and `all_descendants`, which extends it beyond the direct children. Defaults to all of them.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, inheritance)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(dsm.ACLInheritance...)),
+				},
+			},
+		},
+	}
+}
+
+func (f *ACLResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	f.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The ID has the
+// form `principal_type:principal:type:path`.
+func (f *ACLResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := strings.SplitN(id, ":", 4)
+	if len(parts) != 4 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("expected an ID of the form principal_type:principal:type:path, got %q", id),
+		)
+		return
+	}
+
+	data := ACLResourceModel{
+		PrincipalType: types.StringValue(parts[0]),
+		Principal:     types.StringValue(parts[1]),
+		Type:          types.StringValue(parts[2]),
+		Path:          types.StringValue(parts[3]),
+	}
+
+	acl, err := f.client.ACLGet(ctx, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get ACL", err.Error())
+		return
+	}
+
+	i := slices.IndexFunc(acl.Entries, data.matches)
+	if i == -1 {
+		resp.Diagnostics.AddError("ACL entry not found", fmt.Sprintf("ACL entry %s not found", id))
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, acl.Entries[i])...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *ACLResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The entry as `principal_type:principal:type:path`.")
+}
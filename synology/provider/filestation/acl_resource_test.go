@@ -0,0 +1,51 @@
+package filestation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ACLResource struct{}
+
+func TestAccACLResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"read access is granted to a user",
+			`
+			resource "synology_filestation_acl" "foo" {
+				path        = "/docker/config"
+				principal   = "guest"
+				permissions = ["read_data", "read_attr", "read_ext_attr", "read_perm"]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_filestation_acl.foo",
+								"type",
+								"allow",
+							),
+							r.TestCheckResourceAttr(
+								"synology_filestation_acl.foo",
+								"permissions.#",
+								"4",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
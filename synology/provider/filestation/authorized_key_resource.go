@@ -0,0 +1,344 @@
+package filestation
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api/core"
+	"github.com/synology-community/go-synology/pkg/api/filestation"
+	"github.com/synology-community/go-synology/pkg/util/form"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// authorizedKeysMu serializes the read-modify-write cycles of authorized_keys
+// files, several keys of the same user are usually applied in parallel.
+var authorizedKeysMu sync.Mutex
+
+// authorizedKeyID returns the key type and data of an authorized_keys line,
+// ignoring options and comments.
+func authorizedKeyID(line string) string {
+	fields := strings.Fields(line)
+	for i, f := range fields {
+		if strings.HasPrefix(f, "ssh-") || strings.HasPrefix(f, "ecdsa-") || strings.HasPrefix(f, "sk-") {
+			if i+1 < len(fields) {
+				return f + " " + fields[i+1]
+			}
+		}
+	}
+	return strings.TrimSpace(line)
+}
+
+// containsAuthorizedKey reports whether content holds key.
+func containsAuthorizedKey(content, key string) bool {
+	id := authorizedKeyID(key)
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) != "" && authorizedKeyID(line) == id {
+			return true
+		}
+	}
+	return false
+}
+
+// removeAuthorizedKey returns content without the lines holding key.
+func removeAuthorizedKey(content, key string) string {
+	id := authorizedKeyID(key)
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(content, "\n"), "\n") {
+		if strings.TrimSpace(line) == "" || authorizedKeyID(line) == id {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+type AuthorizedKeyResourceModel struct {
+	User           types.String `tfsdk:"user"`
+	Key            types.String `tfsdk:"key"`
+	Home           types.String `tfsdk:"home"`
+	FixPermissions types.Bool   `tfsdk:"fix_permissions"`
+	Path           types.String `tfsdk:"path"`
+}
+
+var (
+	_ resource.Resource = &AuthorizedKeyResource{}
+)
+
+func NewAuthorizedKeyResource() resource.Resource {
+	return &AuthorizedKeyResource{}
+}
+
+type AuthorizedKeyResource struct {
+	client filestation.Api
+	core   core.Api
+}
+
+// Create implements resource.Resource.
+func (f *AuthorizedKeyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data AuthorizedKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Home.IsNull() || data.Home.IsUnknown() {
+		data.Home = types.StringValue("/homes/" + data.User.ValueString())
+	}
+	data.Path = types.StringValue(path.Join(data.Home.ValueString(), ".ssh", "authorized_keys"))
+
+	authorizedKeysMu.Lock()
+	defer authorizedKeysMu.Unlock()
+
+	content, err := f.read(ctx, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read authorized keys", err.Error())
+		return
+	}
+
+	if !containsAuthorizedKey(content, data.Key.ValueString()) {
+		content += strings.TrimSpace(data.Key.ValueString()) + "\n"
+		resp.Diagnostics.Append(f.write(ctx, data, content)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource. Only fix_permissions can change in
+// place and it only matters when the file is written.
+func (f *AuthorizedKeyResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data AuthorizedKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource.
+func (f *AuthorizedKeyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data AuthorizedKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authorizedKeysMu.Lock()
+	defer authorizedKeysMu.Unlock()
+
+	content, err := f.read(ctx, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read authorized keys", err.Error())
+		return
+	}
+
+	if containsAuthorizedKey(content, data.Key.ValueString()) {
+		resp.Diagnostics.Append(f.write(ctx, data, removeAuthorizedKey(content, data.Key.ValueString()))...)
+	}
+}
+
+// Metadata implements resource.Resource.
+func (f *AuthorizedKeyResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "authorized_key")
+}
+
+// Read implements resource.Resource.
+func (f *AuthorizedKeyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data AuthorizedKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := f.read(ctx, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read authorized keys", err.Error())
+		return
+	}
+
+	if !containsAuthorizedKey(content, data.Key.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (f *AuthorizedKeyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An SSH public key in the `authorized_keys` file of a DSM user, for passwordless rsync and replication logins. Other keys in the file are left untouched. Requires the user home service.",
+
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The DSM user allowed to log in with the key.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The public key in `authorized_keys` format, e.g. `ssh-ed25519 AAAA... backup@host`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"home": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the user's home folder. Defaults to `/homes/<user>`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fix_permissions": schema.BoolAttribute{
+				MarkdownDescription: "Whether to restrict the permissions of the home folder, `.ssh` and `authorized_keys` after writing, as required by the SSH server. This runs a one-off root task.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the `authorized_keys` file.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (f *AuthorizedKeyResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	f.client = client.FileStationAPI()
+	f.core = client.CoreAPI()
+}
+
+// read returns the content of an authorized_keys file, or an empty string if
+// it does not exist yet.
+func (f *AuthorizedKeyResource) read(ctx context.Context, p string) (string, error) {
+	if _, err := f.client.Get(ctx, p); err != nil {
+		if err.Error() == "Result is empty" {
+			return "", nil
+		}
+		return "", err
+	}
+
+	file, err := f.client.Download(ctx, p, "download")
+	if err != nil {
+		return "", err
+	}
+	return file.Content, nil
+}
+
+func (f *AuthorizedKeyResource) write(
+	ctx context.Context,
+	data AuthorizedKeyResourceModel,
+	content string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	p := data.Path.ValueString()
+	if _, err := f.client.Upload(ctx, path.Dir(p), form.File{
+		Name:    path.Base(p),
+		Content: content,
+	}, true, true); err != nil {
+		diags.AddError("Failed to write authorized keys", err.Error())
+		return diags
+	}
+
+	if data.FixPermissions.ValueBool() {
+		diags.Append(f.fixPermissions(ctx, data)...)
+	}
+
+	return diags
+}
+
+// fixPermissions makes the user own their .ssh folder and removes group and
+// other write access along the way, using a root task as File Station cannot
+// change POSIX modes.
+func (f *AuthorizedKeyResource) fixPermissions(ctx context.Context, data AuthorizedKeyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	home, err := f.client.Get(ctx, data.Home.ValueString())
+	if err != nil {
+		diags.AddError("Failed to find home folder", err.Error())
+		return diags
+	}
+
+	dir := home.Additional.RealPath
+	user := data.User.ValueString()
+	script := fmt.Sprintf(
+		"chmod go-w '%[1]s' && chown -R '%[2]s' '%[1]s/.ssh' && chmod 700 '%[1]s/.ssh' && chmod 600 '%[1]s/.ssh/authorized_keys'",
+		dir, user,
+	)
+
+	diags.Append(util.RunRootScript(ctx, f.core, "terraform authorized_keys "+user, script)...)
+
+	return diags
+}
@@ -0,0 +1,45 @@
+package filestation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type AuthorizedKeyResource struct{}
+
+func TestAccAuthorizedKeyResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"key is added to the home folder",
+			`
+			resource "synology_filestation_authorized_key" "default" {
+				user = "admin"
+				key  = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIG7kq2bJ1Xl4m0vUX3VJ5P9vHPk6ebXik1n6mKkqiRwD terraform@test"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_filestation_authorized_key.default",
+								"path",
+								"/homes/admin/.ssh/authorized_keys",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
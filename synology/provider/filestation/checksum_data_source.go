@@ -0,0 +1,146 @@
+package filestation
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api/filestation"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ChecksumDataSource{}
+
+func NewChecksumDataSource() datasource.DataSource {
+	return &ChecksumDataSource{}
+}
+
+type ChecksumDataSource struct {
+	client filestation.Api
+}
+
+type ChecksumDataSourceModel struct {
+	Path      types.String `tfsdk:"path"`
+	Algorithm types.String `tfsdk:"algorithm"`
+	Checksum  types.String `tfsdk:"checksum"`
+}
+
+func (d *ChecksumDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "checksum")
+}
+
+func (d *ChecksumDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Computes the checksum of a file on the NAS, e.g. to replace a container when its configuration file changes. MD5 is computed by File Station, the SHA checksums download the file.",
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the file.",
+				Required:            true,
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: "The checksum algorithm. One of `md5`, `sha1` or `sha256`. Defaults to `md5`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("md5", "sha1", "sha256"),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "The hex encoded checksum of the file.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ChecksumDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data ChecksumDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p := data.Path.ValueString()
+
+	var h hash.Hash
+	switch data.Algorithm.ValueString() {
+	case "sha1":
+		h = sha1.New()
+	case "sha256":
+		h = sha256.New()
+	default:
+		md5, err := d.client.MD5(ctx, p)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"API request failed",
+				fmt.Sprintf("Unable to compute MD5 of %s, got error: %s", p, err),
+			)
+			return
+		}
+		data.Checksum = types.StringValue(md5.MD5)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	file, err := d.client.Download(ctx, p, "download")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to download %s, got error: %s", p, err),
+		)
+		return
+	}
+
+	h.Write([]byte(file.Content))
+	data.Checksum = types.StringValue(hex.EncodeToString(h.Sum(nil)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ChecksumDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client.FileStationAPI()
+}
@@ -0,0 +1,41 @@
+package filestation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ChecksumDataSource struct{}
+
+func TestAccChecksumDataSource_basic(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		DataSourceBlock string
+	}{
+		{
+			"sha256 of a file",
+			`
+			data "synology_filestation_checksum" "config" {
+				path      = "/docker/compose.yaml"
+				algorithm = "sha256"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.DataSourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("data.synology_filestation_checksum.config", "checksum"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -25,7 +25,10 @@ type File struct {
 }
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &CloudInitResource{}
+var (
+	_ resource.Resource             = &CloudInitResource{}
+	_ resource.ResourceWithIdentity = &CloudInitResource{}
+)
 
 func NewCloudInitResource() resource.Resource {
 	return &CloudInitResource{}
@@ -122,6 +125,7 @@ func (f *CloudInitResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Delete implements resource.Resource.
@@ -181,6 +185,8 @@ func (f *CloudInitResource) Read(
 			continue
 		}
 	}
+
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Update implements resource.Resource.
@@ -256,6 +262,7 @@ func (f *CloudInitResource) Update(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Metadata implements resource.Resource.
@@ -265,6 +272,7 @@ func (f *CloudInitResource) Metadata(
 	resp *resource.MetadataResponse,
 ) {
 	resp.TypeName = buildName(req.ProviderTypeName, "cloud_init")
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Schema implements resource.Resource.
@@ -361,7 +369,12 @@ func (f *CloudInitResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	p := req.ID
+	p, diags := util.ImportID(ctx, req, "path")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	basedir := filepath.Dir(p)
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), p)...)
@@ -405,4 +418,15 @@ func (f *CloudInitResource) ImportState(
 			continue
 		}
 	}
+
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", p)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *CloudInitResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("path", "The path of the cloud-init ISO file.")
 }
@@ -0,0 +1,274 @@
+package filestation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api/filestation"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// extractTimeout bounds the wait for a background extraction.
+const extractTimeout = 30 * time.Minute
+
+type ExtractedArchiveResourceModel struct {
+	Archive     types.String `tfsdk:"archive"`
+	Destination types.String `tfsdk:"destination"`
+	Overwrite   types.Bool   `tfsdk:"overwrite"`
+	KeepDirs    types.Bool   `tfsdk:"keep_dirs"`
+	Password    types.String `tfsdk:"password"`
+	ArchiveMD5  types.String `tfsdk:"archive_md5"`
+}
+
+var (
+	_ resource.Resource               = &ExtractedArchiveResource{}
+	_ resource.ResourceWithModifyPlan = &ExtractedArchiveResource{}
+)
+
+func NewExtractedArchiveResource() resource.Resource {
+	return &ExtractedArchiveResource{}
+}
+
+type ExtractedArchiveResource struct {
+	client filestation.Api
+	dsm    *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (f *ExtractedArchiveResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ExtractedArchiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(f.extract(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource. The archive is extracted again, e.g.
+// after its content changed.
+func (f *ExtractedArchiveResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ExtractedArchiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(f.extract(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The extracted files are kept, as the
+// destination may hold other files.
+func (f *ExtractedArchiveResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (f *ExtractedArchiveResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "extracted_archive")
+}
+
+// Read implements resource.Resource. archive_md5 keeps the checksum of the
+// extracted archive, ModifyPlan compares it with the current one.
+func (f *ExtractedArchiveResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ExtractedArchiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := f.client.Get(ctx, data.Destination.ValueString()); err != nil {
+		if _, ok := err.(filestation.FileNotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to get destination folder", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (f *ExtractedArchiveResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Extracts a zip, tar or 7z archive on the NAS into a folder, e.g. to deploy a static site to Web Station. The archive is extracted again whenever its content changes. Upload the archive with `synology_filestation_file`.",
+
+		Attributes: map[string]schema.Attribute{
+			"archive": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the archive.",
+				Required:            true,
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the folder to extract into.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"overwrite": schema.BoolAttribute{
+				MarkdownDescription: "Whether existing files are overwritten. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"keep_dirs": schema.BoolAttribute{
+				MarkdownDescription: "Whether the folder structure of the archive is kept. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of an encrypted archive.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"archive_md5": schema.StringAttribute{
+				MarkdownDescription: "The MD5 checksum of the archive when it was last extracted.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It plans another
+// extraction when the checksum of the archive differs from the extracted one.
+func (f *ExtractedArchiveResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || f.client == nil {
+		return
+	}
+
+	var plan ExtractedArchiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Archive.IsUnknown() {
+		return
+	}
+
+	md5, err := f.client.MD5(ctx, plan.Archive.ValueString())
+	if err != nil {
+		// The archive may be uploaded by the same apply.
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("archive_md5"), types.StringUnknown())...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("archive_md5"), types.StringValue(md5.MD5))...)
+}
+
+func (f *ExtractedArchiveResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	f.client = client.FileStationAPI()
+	f.dsm = dsm.New(client)
+}
+
+// extract extracts the archive of data and waits for the background task to
+// finish, then records the checksum of the archive.
+func (f *ExtractedArchiveResource) extract(ctx context.Context, data *ExtractedArchiveResourceModel) (diags diag.Diagnostics) {
+	archive := data.Archive.ValueString()
+
+	taskID, err := f.dsm.ExtractStart(ctx, dsm.ExtractRequest{
+		FilePath:       archive,
+		DestFolderPath: data.Destination.ValueString(),
+		Overwrite:      data.Overwrite.ValueBool(),
+		KeepDir:        data.KeepDirs.ValueBool(),
+		Password:       data.Password.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Failed to extract archive", err.Error())
+		return diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, extractTimeout)
+	defer cancel()
+
+	err = util.Poll(ctx, 2*time.Second, func() (bool, error) {
+		status, err := f.dsm.ExtractStatus(ctx, taskID)
+		if err != nil {
+			return false, err
+		}
+		return status.Finished, nil
+	})
+	if err != nil {
+		diags.AddError("Failed to extract archive", err.Error())
+		return diags
+	}
+
+	md5, err := f.client.MD5(ctx, archive)
+	if err != nil {
+		diags.AddError("Failed to compute archive checksum", err.Error())
+		return diags
+	}
+	data.ArchiveMD5 = types.StringValue(md5.MD5)
+
+	return diags
+}
@@ -0,0 +1,44 @@
+package filestation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ExtractedArchiveResource struct{}
+
+func TestAccExtractedArchiveResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"archive is extracted into a folder",
+			`
+			resource "synology_filestation_extracted_archive" "site" {
+				archive     = "/web/releases/site.zip"
+				destination = "/web/site"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet(
+								"synology_filestation_extracted_archive.site",
+								"archive_md5",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package filestation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// findFavorite returns the favorite of path, or nil if there is none.
+func findFavorite(ctx context.Context, client *dsm.Client, path string) (*dsm.Favorite, error) {
+	list, err := client.FavoriteList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Favorites, func(f dsm.Favorite) bool {
+		return f.Path == path
+	})
+	if i == -1 {
+		return nil, nil
+	}
+	return &list.Favorites[i], nil
+}
+
+type FavoriteResourceModel struct {
+	Path   types.String `tfsdk:"path"`
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+}
+
+func (m *FavoriteResourceModel) set(f dsm.Favorite) {
+	m.Path = types.StringValue(f.Path)
+	m.Name = types.StringValue(f.Name)
+	m.Status = types.StringValue(f.Status)
+}
+
+var (
+	_ resource.Resource             = &FavoriteResource{}
+	_ resource.ResourceWithIdentity = &FavoriteResource{}
+)
+
+func NewFavoriteResource() resource.Resource {
+	return &FavoriteResource{}
+}
+
+type FavoriteResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (f *FavoriteResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data FavoriteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := f.client.FavoriteAdd(ctx, data.Path.ValueString(), data.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to add favorite", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(f.refresh(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
+}
+
+// Update implements resource.Resource. Only the name can change.
+func (f *FavoriteResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data FavoriteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := f.client.FavoriteEdit(ctx, data.Path.ValueString(), data.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to rename favorite", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(f.refresh(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
+}
+
+// Delete implements resource.Resource. The folder is kept.
+func (f *FavoriteResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data FavoriteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := f.client.FavoriteDelete(ctx, data.Path.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete favorite", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (f *FavoriteResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "favorite")
+}
+
+// Read implements resource.Resource. A favorite renamed in File Station is
+// detected as drift.
+func (f *FavoriteResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data FavoriteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fav, err := findFavorite(ctx, f.client, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list favorites", err.Error())
+		return
+	}
+	if fav == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*fav)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (f *FavoriteResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a File Station favorite, a named shortcut to a folder shown in the File Station sidebar. Favorites belong to the user the provider logs in as, so provision them with a provider configured for the account that should see them.",
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the folder, e.g. `/docker/logs`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name the favorite is shown with.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "`valid`, or `broken` when the folder no longer exists.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (f *FavoriteResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	f.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (f *FavoriteResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	p, diags := util.ImportID(ctx, req, "path")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fav, err := findFavorite(ctx, f.client, p)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list favorites", err.Error())
+		return
+	}
+	if fav == nil {
+		resp.Diagnostics.AddError("Favorite not found", fmt.Sprintf("No favorite of %s", p))
+		return
+	}
+
+	var data FavoriteResourceModel
+	data.set(*fav)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", p)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *FavoriteResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("path", "The path of the folder.")
+}
+
+// refresh reads the status of the favorite of data after a change.
+func (f *FavoriteResource) refresh(ctx context.Context, data *FavoriteResourceModel) (diags diag.Diagnostics) {
+	data.Status = types.StringNull()
+
+	fav, err := findFavorite(ctx, f.client, data.Path.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list favorites", err.Error())
+		return
+	}
+	if fav != nil {
+		data.Status = types.StringValue(fav.Status)
+	}
+	return
+}
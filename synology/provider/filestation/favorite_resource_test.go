@@ -0,0 +1,41 @@
+package filestation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type FavoriteResource struct{}
+
+func TestAccFavoriteResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"folder is added to favorites",
+			`
+			resource "synology_filestation_favorite" "foo" {
+				path = "/docker/logs"
+				name = "Logs"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_filestation_favorite.foo", "status", "valid"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,331 @@
+package filestation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type FileRequestResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Folder   types.String `tfsdk:"folder"`
+	Name     types.String `tfsdk:"name"`
+	Message  types.String `tfsdk:"message"`
+	Password types.String `tfsdk:"password"`
+	Expires  types.String `tfsdk:"expires"`
+	URL      types.String `tfsdk:"url"`
+	Status   types.String `tfsdk:"status"`
+}
+
+// set updates m from l. The password is never read back, it is only cleared
+// when DSM reports the request has none.
+func (m *FileRequestResourceModel) set(l dsm.SharingLink) {
+	m.ID = types.StringValue(l.ID)
+	m.Folder = types.StringValue(l.Path)
+	m.Name = types.StringValue(l.RequestName)
+	m.Message = types.StringNull()
+	if l.RequestInfo != "" {
+		m.Message = types.StringValue(l.RequestInfo)
+	}
+	m.Expires = types.StringNull()
+	if len(l.DateExpired) >= 10 {
+		m.Expires = types.StringValue(l.DateExpired[:10])
+	}
+	if !l.HasPassword {
+		m.Password = types.StringNull()
+	}
+	m.URL = types.StringValue(l.URL)
+	m.Status = types.StringValue(l.Status)
+}
+
+var (
+	_ resource.Resource             = &FileRequestResource{}
+	_ resource.ResourceWithIdentity = &FileRequestResource{}
+)
+
+func NewFileRequestResource() resource.Resource {
+	return &FileRequestResource{}
+}
+
+type FileRequestResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (f *FileRequestResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data FileRequestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := f.client.SharingCreate(ctx, dsm.SharingCreateRequest{
+		Path:         data.Folder.ValueString(),
+		Password:     data.Password.ValueString(),
+		DateExpired:  data.Expires.ValueString(),
+		EnableUpload: true,
+		FileRequest:  true,
+		RequestName:  data.Name.ValueString(),
+		RequestInfo:  data.Message.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create file request", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(link.ID)
+	resp.Diagnostics.Append(f.refresh(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource. The request keeps its URL.
+func (f *FileRequestResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data FileRequestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, message := data.Name.ValueString(), data.Message.ValueString()
+	if err := f.client.SharingEdit(ctx, dsm.SharingEditRequest{
+		ID:           data.ID.ValueString(),
+		Password:     data.Password.ValueString(),
+		DateExpired:  data.Expires.ValueString(),
+		EnableUpload: true,
+		RequestName:  &name,
+		RequestInfo:  &message,
+	}); err != nil {
+		resp.Diagnostics.AddError("Failed to update file request", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(f.refresh(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource. Uploaded files are kept.
+func (f *FileRequestResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data FileRequestResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := f.client.SharingDelete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete file request", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (f *FileRequestResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_file_request"
+}
+
+// Read implements resource.Resource.
+func (f *FileRequestResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data FileRequestResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := findSharingLink(ctx, f.client, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list file requests", err.Error())
+		return
+	}
+	if link == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*link)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (f *FileRequestResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	state := []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a File Station file request, a public link visitors can upload files to a folder with but not browse it. Requests belong to the user the provider logs in as.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the request, which is also the last element of its URL.",
+				Computed:            true,
+				PlanModifiers:       state,
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the folder uploaded files are stored in, e.g. `/intake/invoices`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The title of the request shown to uploaders.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "A message shown to uploaders, e.g. which files to upload.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password uploaders have to enter. The request is not protected when unset.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 16),
+				},
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "The date the request expires at, as `YYYY-MM-DD`. The request never expires when unset.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(expiryDate, "value must be a date like 2025-12-31"),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the request.",
+				Computed:            true,
+				PlanModifiers:       state,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the request reported by DSM, e.g. `valid` or `expired`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (f *FileRequestResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	f.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The password is
+// not read back and must be set in the configuration.
+func (f *FileRequestResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := findSharingLink(ctx, f.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list file requests", err.Error())
+		return
+	}
+	if link == nil || !link.FileRequest {
+		resp.Diagnostics.AddError("File request not found", fmt.Sprintf("File request %s not found", id))
+		return
+	}
+
+	data := FileRequestResourceModel{Password: types.StringNull()}
+	data.set(*link)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *FileRequestResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the request.")
+}
+
+// refresh reads the URL and the status of the request of data after a change.
+func (f *FileRequestResource) refresh(ctx context.Context, data *FileRequestResourceModel) (diags diag.Diagnostics) {
+	link, err := findSharingLink(ctx, f.client, data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list file requests", err.Error())
+		return
+	}
+	if link == nil {
+		diags.AddError("File request not found", fmt.Sprintf("File request %s not found after it was saved", data.ID.ValueString()))
+		return
+	}
+
+	data.URL = types.StringValue(link.URL)
+	data.Status = types.StringValue(link.Status)
+	return
+}
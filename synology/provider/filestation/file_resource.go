@@ -23,10 +23,14 @@ import (
 	client "github.com/synology-community/go-synology"
 	"github.com/synology-community/go-synology/pkg/api/filestation"
 	"github.com/synology-community/go-synology/pkg/util/form"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &FileResource{}
+var (
+	_ resource.Resource             = &FileResource{}
+	_ resource.ResourceWithIdentity = &FileResource{}
+)
 
 func NewFileResource() resource.Resource {
 	return &FileResource{}
@@ -49,6 +53,8 @@ type FileResourceModel struct {
 	CreateTime    timetypes.RFC3339 `tfsdk:"create_time"`
 	RealPath      types.String      `tfsdk:"real_path"`
 	MD5           types.String      `tfsdk:"md5"`
+
+	UploadBandwidthLimit types.Int64 `tfsdk:"upload_bandwidth_limit"`
 }
 
 // Create implements resource.Resource.
@@ -104,7 +110,7 @@ func (f *FileResource) Create(
 	defer cancel()
 
 	// Upload the file
-	_, err := f.client.Upload(dctx, fileDir, form.File{
+	_, err := f.client.Upload(uploadContext(dctx, data.UploadBandwidthLimit), fileDir, form.File{
 		Name:    fileName,
 		Content: fileContent,
 	}, createParents, overwrite)
@@ -137,6 +143,7 @@ func (f *FileResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Delete implements resource.Resource.
@@ -204,6 +211,8 @@ func (f *FileResource) Read(
 			resp.State.SetAttribute(ctx, path.Root("md5"), data.MD5)
 		}
 	}
+
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Update implements resource.Resource.
@@ -251,7 +260,7 @@ func (f *FileResource) Update(
 
 	// Upload the file
 	_, err := f.client.Upload(
-		ctx,
+		uploadContext(ctx, data.UploadBandwidthLimit),
 		fileDir,
 		form.File{
 			Name:    fileName,
@@ -286,6 +295,7 @@ func (f *FileResource) Update(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Metadata implements resource.Resource.
@@ -295,6 +305,7 @@ func (f *FileResource) Metadata(
 	resp *resource.MetadataResponse,
 ) {
 	resp.TypeName = buildName(req.ProviderTypeName, "file")
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Schema implements resource.Resource.
@@ -347,6 +358,7 @@ func (f *FileResource) Schema(
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"upload_bandwidth_limit": uploadBandwidthLimitAttribute(),
 			"access_time": schema.StringAttribute{
 				MarkdownDescription: "The time the file was last accessed.",
 				Computed:            true,
@@ -405,3 +417,12 @@ func (f *FileResource) Configure(
 
 	f.client = client.FileStationAPI()
 }
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *FileResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("path", "The path of the file.")
+}
@@ -1,23 +1,60 @@
 package filestation
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
 )
 
 func buildName(providerName, resourceName string) string {
 	return providerName + "_filestation_" + resourceName
 }
 
+func uploadBandwidthLimitAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: "Maximum speed in KB/s at which the file is uploaded. Overrides the `upload_bandwidth_limit` of the provider.",
+		Optional:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// uploadContext returns the context to upload a file under, applying the
+// upload bandwidth limit of the resource if one is set.
+func uploadContext(ctx context.Context, limit types.Int64) context.Context {
+	if limit.IsNull() || limit.IsUnknown() {
+		return ctx
+	}
+
+	return synoclient.WithUploadLimit(ctx, limit.ValueInt64())
+}
+
 func Resources() []func() resource.Resource {
 	return []func() resource.Resource{
 		NewFileResource,
 		NewCloudInitResource,
 		NewFolderResource,
 		NewIsoResource,
+		NewAuthorizedKeyResource,
+		NewACLResource,
+		NewSymlinkResource,
+		NewRemoteFolderResource,
+		NewExtractedArchiveResource,
+		NewFavoriteResource,
+		NewSharingLinkResource,
+		NewFileRequestResource,
 	}
 }
 
 func DataSources() []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewChecksumDataSource,
+	}
 }
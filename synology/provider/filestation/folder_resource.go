@@ -13,17 +13,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/synology-community/go-synology"
 	"github.com/synology-community/go-synology/pkg/api/filestation"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &FolderResource{}
+var (
+	_ resource.Resource             = &FolderResource{}
+	_ resource.ResourceWithIdentity = &FolderResource{}
+)
 
 func NewFolderResource() resource.Resource {
 	return &FolderResource{}
 }
 
 type FolderResource struct {
-	client filestation.Api
+	client   filestation.Api
+	provider *synoclient.Client
 }
 
 // FolderResourceModel describes the resource data model.
@@ -31,6 +37,7 @@ type FolderResourceModel struct {
 	Path          types.String `tfsdk:"path"`
 	CreateParents types.Bool   `tfsdk:"create_parents"`
 	RealPath      types.String `tfsdk:"real_path"`
+	ProtectData   types.Bool   `tfsdk:"protect_data"`
 }
 
 // Create implements resource.Resource.
@@ -105,6 +112,7 @@ func (f *FolderResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Delete implements resource.Resource.
@@ -116,7 +124,24 @@ func (f *FolderResource) Delete(
 	var data FolderResourceModel
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	path := data.Path.ValueString()
+	if data.ProtectData.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Folder is protected",
+			fmt.Sprintf(
+				"Folder %q has protect_data enabled and was not deleted. Set protect_data = false and apply before destroying it.",
+				path,
+			),
+		)
+		return
+	}
+	if err := f.provider.ReleaseDependents(ctx, path); err != nil {
+		resp.Diagnostics.AddError("Folder is in use", err.Error())
+		return
+	}
 	// Start Delete the file
 	_, err := f.client.Delete(ctx, []string{path}, true)
 	if err != nil {
@@ -175,6 +200,8 @@ func (f *FolderResource) Read(
 		data.RealPath = types.StringValue(file.Additional.RealPath)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	}
+
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Update implements resource.Resource.
@@ -192,8 +219,12 @@ func (f *FolderResource) Update(
 		data.CreateParents = types.BoolValue(true)
 	}
 
+	resp.Diagnostics.Append(
+		req.Plan.GetAttribute(ctx, path.Root("protect_data"), &data.ProtectData)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Metadata implements resource.Resource.
@@ -203,6 +234,7 @@ func (f *FolderResource) Metadata(
 	resp *resource.MetadataResponse,
 ) {
 	resp.TypeName = buildName(req.ProviderTypeName, "folder")
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Schema implements resource.Resource.
@@ -229,6 +261,12 @@ func (f *FolderResource) Schema(
 				MarkdownDescription: "The real path of the folder.",
 				Computed:            true,
 			},
+			"protect_data": schema.BoolAttribute{
+				MarkdownDescription: "If true, destroying the folder fails instead of deleting it and its contents. Defaults to `true`, set to `false` and apply before destroying the folder.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 		},
 	}
 }
@@ -258,6 +296,7 @@ func (f *FolderResource) Configure(
 	}
 
 	f.client = client.FileStationAPI()
+	f.provider, _ = req.ProviderData.(*synoclient.Client)
 }
 
 func (f *FolderResource) ImportState(
@@ -265,10 +304,15 @@ func (f *FolderResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	p := req.ID
+	p, diags := util.ImportID(ctx, req, "path")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), p)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("create_parents"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("protect_data"), true)...)
 
 	files, err := f.client.List(ctx, p)
 	if err != nil {
@@ -285,4 +329,15 @@ func (f *FolderResource) ImportState(
 			continue
 		}
 	}
+
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", p)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *FolderResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("path", "The path of the folder.")
 }
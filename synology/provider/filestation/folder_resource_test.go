@@ -22,6 +22,7 @@ func TestAccFolderResource_basic(t *testing.T) {
 			resource "synology_filestation_folder" "default" {
 				path = "/docker/foo/bar"
 				create_parents = true
+				protect_data = false
 			}`,
 		},
 	}
@@ -72,3 +73,36 @@ func TestAccFolderResource_basic(t *testing.T) {
 		})
 	}
 }
+
+func TestAccFolderResource_protectData(t *testing.T) {
+	acctest.NewMockServer(t)
+
+	config := func(protect string) string {
+		return fmt.Sprintf(`
+		resource "synology_filestation_folder" "default" {
+			path = "/docker/protected"
+			%s
+		}`, protect)
+	}
+
+	r.UnitTest(t, r.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []r.TestStep{
+			{
+				// Folders are protected unless protect_data is set.
+				Config: config(""),
+				Check:  r.TestCheckResourceAttr("synology_filestation_folder.default", "protect_data", "true"),
+			},
+			{
+				Config:      config(""),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Folder is protected"),
+			},
+			{
+				// Lift the protection so that the folder can be destroyed
+				// at the end of the test.
+				Config: config("protect_data = false"),
+			},
+		},
+	})
+}
@@ -20,7 +20,10 @@ import (
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &IsoResource{}
+var (
+	_ resource.Resource             = &IsoResource{}
+	_ resource.ResourceWithIdentity = &IsoResource{}
+)
 
 func NewIsoResource() resource.Resource {
 	return &IsoResource{}
@@ -42,6 +45,8 @@ type IsoResourceModel struct {
 	ChangeTime    timetypes.RFC3339 `tfsdk:"change_time"`
 	CreateTime    timetypes.RFC3339 `tfsdk:"create_time"`
 	RealPath      types.String      `tfsdk:"real_path"`
+
+	UploadBandwidthLimit types.Int64 `tfsdk:"upload_bandwidth_limit"`
 }
 
 // Create implements resource.Resource.
@@ -91,7 +96,7 @@ func (f *IsoResource) Create(
 	}
 
 	// Upload the file
-	_, err = f.client.Upload(ctx, fileDir, form.File{
+	_, err = f.client.Upload(uploadContext(ctx, data.UploadBandwidthLimit), fileDir, form.File{
 		Name:    fileName,
 		Content: iso,
 	}, createParents, overwrite)
@@ -119,6 +124,7 @@ func (f *IsoResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Delete implements resource.Resource.
@@ -179,6 +185,8 @@ func (f *IsoResource) Read(
 	data.ChangeTime = timetypes.NewRFC3339TimeValue(file.Additional.Time.Ctime.Time)
 	data.CreateTime = timetypes.NewRFC3339TimeValue(file.Additional.Time.Crtime.Time)
 	data.RealPath = types.StringValue(file.Additional.RealPath)
+
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Update implements resource.Resource.
@@ -222,7 +230,7 @@ func (f *IsoResource) Update(
 	}
 
 	// Upload the file
-	_, err = f.client.Upload(ctx, fileDir, form.File{
+	_, err = f.client.Upload(uploadContext(ctx, data.UploadBandwidthLimit), fileDir, form.File{
 		Name:    fileName,
 		Content: iso,
 	}, data.CreateParents.ValueBool(),
@@ -251,6 +259,7 @@ func (f *IsoResource) Update(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
 }
 
 // Metadata implements resource.Resource.
@@ -260,6 +269,7 @@ func (f *IsoResource) Metadata(
 	resp *resource.MetadataResponse,
 ) {
 	resp.TypeName = buildName(req.ProviderTypeName, "iso")
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Schema implements resource.Resource.
@@ -308,6 +318,7 @@ func (f *IsoResource) Schema(
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"upload_bandwidth_limit": uploadBandwidthLimitAttribute(),
 			"access_time": schema.StringAttribute{
 				MarkdownDescription: "The time the file was last accessed.",
 				Computed:            true,
@@ -368,7 +379,12 @@ func (f *IsoResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	p := req.ID
+	p, diags := util.ImportID(ctx, req, "path")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	basedir := filepath.Dir(p)
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), p)...)
@@ -412,4 +428,15 @@ func (f *IsoResource) ImportState(
 			continue
 		}
 	}
+
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", p)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *IsoResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("path", "The path of the ISO file.")
 }
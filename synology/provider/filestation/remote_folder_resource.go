@@ -0,0 +1,316 @@
+package filestation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type RemoteFolderResourceModel struct {
+	Protocol     types.String `tfsdk:"protocol"`
+	Server       types.String `tfsdk:"server"`
+	RemoteFolder types.String `tfsdk:"remote_folder"`
+	MountPoint   types.String `tfsdk:"mount_point"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	AutoMount    types.Bool   `tfsdk:"auto_mount"`
+	Status       types.String `tfsdk:"status"`
+}
+
+func (m RemoteFolderResourceModel) mount() dsm.RemoteMount {
+	return dsm.RemoteMount{
+		MountType:    m.Protocol.ValueString(),
+		Server:       m.Server.ValueString(),
+		RemoteFolder: m.RemoteFolder.ValueString(),
+		MountPoint:   m.MountPoint.ValueString(),
+		User:         m.Username.ValueString(),
+		Password:     m.Password.ValueString(),
+		AutoMount:    m.AutoMount.ValueBool(),
+	}
+}
+
+var (
+	_ resource.Resource                   = &RemoteFolderResource{}
+	_ resource.ResourceWithValidateConfig = &RemoteFolderResource{}
+	_ resource.ResourceWithIdentity       = &RemoteFolderResource{}
+)
+
+func NewRemoteFolderResource() resource.Resource {
+	return &RemoteFolderResource{}
+}
+
+type RemoteFolderResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (f *RemoteFolderResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data RemoteFolderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := f.client.RemoteMountCreate(ctx, data.mount()); err != nil {
+		resp.Diagnostics.AddError("Failed to mount remote folder", err.Error())
+		return
+	}
+
+	m, err := f.find(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list remote folders", err.Error())
+		return
+	}
+
+	data.Status = types.StringNull()
+	if m != nil {
+		data.Status = types.StringValue(m.Status)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "mount_point", data.MountPoint.ValueString())...)
+}
+
+// Update implements resource.Resource. Every attribute but the computed
+// status requires replacement.
+func (f *RemoteFolderResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data RemoteFolderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "mount_point", data.MountPoint.ValueString())...)
+}
+
+// Delete implements resource.Resource. The mount point folder is kept.
+func (f *RemoteFolderResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data RemoteFolderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := f.client.RemoteMountDelete(ctx, data.Protocol.ValueString(), data.MountPoint.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to unmount remote folder", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (f *RemoteFolderResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "remote_folder")
+}
+
+// Read implements resource.Resource.
+func (f *RemoteFolderResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data RemoteFolderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	m, err := f.find(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list remote folders", err.Error())
+		return
+	}
+	if m == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Server = types.StringValue(m.Server)
+	data.RemoteFolder = types.StringValue(m.RemoteFolder)
+	data.AutoMount = types.BoolValue(m.AutoMount)
+	data.Status = types.StringValue(m.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "mount_point", data.MountPoint.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (f *RemoteFolderResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	replace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mounts a remote CIFS or NFS folder on an empty File Station folder, as with File Station's Mount Remote Folder. DSM cannot change a mount, so every change remounts the folder.",
+
+		Attributes: map[string]schema.Attribute{
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol of the remote folder. One of `cifs` or `nfs`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dsm.RemoteMountCIFS, dsm.RemoteMountNFS),
+				},
+				PlanModifiers: replace,
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "The host name or IP address of the remote server.",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"remote_folder": schema.StringAttribute{
+				MarkdownDescription: "The shared folder on the server for CIFS, e.g. `backup`, or the exported path for NFS, e.g. `/volume1/backup`.",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"mount_point": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the empty folder to mount on, e.g. `/backup/remote`.",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The user to log in to the CIFS server with.",
+				Optional:            true,
+				PlanModifiers:       replace,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of `username`.",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers:       replace,
+			},
+			"auto_mount": schema.BoolAttribute{
+				MarkdownDescription: "Whether the folder is mounted again at startup. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The mount status reported by DSM.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (f *RemoteFolderResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data RemoteFolderResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Protocol.ValueString() != dsm.RemoteMountNFS {
+		return
+	}
+	for name, v := range map[string]types.String{
+		"username": data.Username,
+		"password": data.Password,
+	} {
+		if !v.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid remote folder",
+				fmt.Sprintf("%s is only used with protocol cifs.", name),
+			)
+		}
+	}
+}
+
+func (f *RemoteFolderResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	f.client = dsm.New(client)
+}
+
+// find returns the mount of data, or nil if the folder is not mounted.
+func (f *RemoteFolderResource) find(ctx context.Context, data RemoteFolderResourceModel) (*dsm.RemoteMount, error) {
+	list, err := f.client.RemoteMountList(ctx, data.Protocol.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Items, func(m dsm.RemoteMount) bool {
+		return m.MountPoint == data.MountPoint.ValueString()
+	})
+	if i == -1 {
+		return nil, nil
+	}
+	return &list.Items[i], nil
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *RemoteFolderResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("mount_point", "The folder the remote folder is mounted on.")
+}
@@ -0,0 +1,47 @@
+package filestation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type RemoteFolderResource struct{}
+
+func TestAccRemoteFolderResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"nfs export is mounted",
+			`
+			resource "synology_filestation_remote_folder" "foo" {
+				protocol      = "nfs"
+				server        = "192.168.1.20"
+				remote_folder = "/volume1/backup"
+				mount_point   = "/docker/remote"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_filestation_remote_folder.foo",
+								"auto_mount",
+								"true",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
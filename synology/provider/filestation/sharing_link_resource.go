@@ -0,0 +1,404 @@
+package filestation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+var expiryDate = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// findSharingLink returns the sharing link with the ID, or nil if there is
+// none.
+func findSharingLink(ctx context.Context, client *dsm.Client, id string) (*dsm.SharingLink, error) {
+	list, err := client.SharingList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Links, func(l dsm.SharingLink) bool {
+		return l.ID == id
+	})
+	if i == -1 {
+		return nil, nil
+	}
+	return &list.Links[i], nil
+}
+
+type SharingLinkResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Path         types.String `tfsdk:"path"`
+	Password     types.String `tfsdk:"password"`
+	Expires      types.String `tfsdk:"expires"`
+	AllowUpload  types.Bool   `tfsdk:"allow_upload"`
+	Sensitive    types.Bool   `tfsdk:"sensitive"`
+	URL          types.String `tfsdk:"url"`
+	SensitiveURL types.String `tfsdk:"sensitive_url"`
+	IsFolder     types.Bool   `tfsdk:"is_folder"`
+	Status       types.String `tfsdk:"status"`
+}
+
+// setURL stores url in the attribute selected by sensitive.
+func (m *SharingLinkResourceModel) setURL(url string) {
+	m.URL = types.StringNull()
+	m.SensitiveURL = types.StringNull()
+	if m.Sensitive.ValueBool() {
+		m.SensitiveURL = types.StringValue(url)
+	} else {
+		m.URL = types.StringValue(url)
+	}
+}
+
+// set updates m from l. The password is never read back, it is only cleared
+// when DSM reports the link has none.
+func (m *SharingLinkResourceModel) set(l dsm.SharingLink) {
+	m.ID = types.StringValue(l.ID)
+	m.Path = types.StringValue(l.Path)
+	m.Expires = types.StringNull()
+	if len(l.DateExpired) >= 10 {
+		m.Expires = types.StringValue(l.DateExpired[:10])
+	}
+	m.AllowUpload = types.BoolValue(l.EnableUpload)
+	m.IsFolder = types.BoolValue(l.IsFolder)
+	m.Status = types.StringValue(l.Status)
+	if !l.HasPassword {
+		m.Password = types.StringNull()
+	}
+	m.setURL(l.URL)
+}
+
+var (
+	_ resource.Resource               = &SharingLinkResource{}
+	_ resource.ResourceWithIdentity   = &SharingLinkResource{}
+	_ resource.ResourceWithModifyPlan = &SharingLinkResource{}
+)
+
+func NewSharingLinkResource() resource.Resource {
+	return &SharingLinkResource{}
+}
+
+type SharingLinkResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (f *SharingLinkResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SharingLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := f.client.SharingCreate(ctx, dsm.SharingCreateRequest{
+		Path:         data.Path.ValueString(),
+		Password:     data.Password.ValueString(),
+		DateExpired:  data.Expires.ValueString(),
+		EnableUpload: data.AllowUpload.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create sharing link", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(link.ID)
+	resp.Diagnostics.Append(f.refresh(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource. The link keeps its URL.
+func (f *SharingLinkResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SharingLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := f.client.SharingEdit(ctx, dsm.SharingEditRequest{
+		ID:           data.ID.ValueString(),
+		Password:     data.Password.ValueString(),
+		DateExpired:  data.Expires.ValueString(),
+		EnableUpload: data.AllowUpload.ValueBool(),
+	}); err != nil {
+		resp.Diagnostics.AddError("Failed to update sharing link", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(f.refresh(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource. The shared file or folder is kept.
+func (f *SharingLinkResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data SharingLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := f.client.SharingDelete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete sharing link", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (f *SharingLinkResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sharing_link"
+}
+
+// Read implements resource.Resource.
+func (f *SharingLinkResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SharingLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := findSharingLink(ctx, f.client, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list sharing links", err.Error())
+		return
+	}
+	if link == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*link)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (f *SharingLinkResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	state := []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a File Station sharing link, a public URL to a file or folder which can be opened without a DSM account. Links belong to the user the provider logs in as.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the link, which is also the last element of its URL.",
+				Computed:            true,
+				PlanModifiers:       state,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the shared file or folder, e.g. `/public/release.zip`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password visitors have to enter. The link is not protected when unset.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 16),
+				},
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "The date the link expires at, as `YYYY-MM-DD`. The link never expires when unset.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(expiryDate, "value must be a date like 2025-12-31"),
+				},
+			},
+			"allow_upload": schema.BoolAttribute{
+				MarkdownDescription: "Whether visitors can upload files to a shared folder. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"sensitive": schema.BoolAttribute{
+				MarkdownDescription: "Whether the URL is stored in `sensitive_url` instead of `url`, which keeps it out of plan output and logs. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the link, unless `sensitive` is set.",
+				Computed:            true,
+				PlanModifiers:       state,
+			},
+			"sensitive_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the link when `sensitive` is set.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers:       state,
+			},
+			"is_folder": schema.BoolAttribute{
+				MarkdownDescription: "Whether `path` is a folder.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the link reported by DSM, e.g. `valid` or `expired`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. When `sensitive`
+// changes, the URL moves to the other attribute.
+func (f *SharingLinkResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SharingLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Sensitive.IsUnknown() || plan.Sensitive.Equal(state.Sensitive) {
+		return
+	}
+
+	url := state.URL.ValueString()
+	if state.Sensitive.ValueBool() {
+		url = state.SensitiveURL.ValueString()
+	}
+	plan.setURL(url)
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("url"), plan.URL)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sensitive_url"), plan.SensitiveURL)...)
+}
+
+func (f *SharingLinkResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	f.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The password is
+// not read back and must be set in the configuration.
+func (f *SharingLinkResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := findSharingLink(ctx, f.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list sharing links", err.Error())
+		return
+	}
+	if link == nil || link.FileRequest {
+		resp.Diagnostics.AddError("Sharing link not found", fmt.Sprintf("Sharing link %s not found", id))
+		return
+	}
+
+	data := SharingLinkResourceModel{
+		Password:  types.StringNull(),
+		Sensitive: types.BoolValue(false),
+	}
+	data.set(*link)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *SharingLinkResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the link.")
+}
+
+// refresh reads the URL and the computed attributes of the link of data after
+// a change.
+func (f *SharingLinkResource) refresh(ctx context.Context, data *SharingLinkResourceModel) (diags diag.Diagnostics) {
+	link, err := findSharingLink(ctx, f.client, data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list sharing links", err.Error())
+		return
+	}
+	if link == nil {
+		diags.AddError("Sharing link not found", fmt.Sprintf("Sharing link %s not found after it was saved", data.ID.ValueString()))
+		return
+	}
+
+	data.IsFolder = types.BoolValue(link.IsFolder)
+	data.Status = types.StringValue(link.Status)
+	data.setURL(link.URL)
+	return
+}
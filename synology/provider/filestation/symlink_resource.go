@@ -0,0 +1,228 @@
+package filestation
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api/core"
+	"github.com/synology-community/go-synology/pkg/api/filestation"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type SymlinkResourceModel struct {
+	Path   types.String `tfsdk:"path"`
+	Target types.String `tfsdk:"target"`
+}
+
+var (
+	_ resource.Resource             = &SymlinkResource{}
+	_ resource.ResourceWithIdentity = &SymlinkResource{}
+)
+
+func NewSymlinkResource() resource.Resource {
+	return &SymlinkResource{}
+}
+
+type SymlinkResource struct {
+	client filestation.Api
+	core   core.Api
+}
+
+// Create implements resource.Resource.
+func (f *SymlinkResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SymlinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, diags := f.realPath(ctx, data.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
+	target, diags := f.realPath(ctx, data.Target.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without -f an existing file at the path is never replaced.
+	script := fmt.Sprintf("ln -sn %s %s", util.ShellQuote(target), util.ShellQuote(link))
+	resp.Diagnostics.Append(util.RunRootScript(ctx, f.core, "terraform symlink "+data.Path.ValueString(), script)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := f.client.Get(ctx, data.Path.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create symlink",
+			fmt.Sprintf("%s was not created, it may already exist: %s", data.Path.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
+}
+
+// Update implements resource.Resource. Every attribute requires replacement.
+func (f *SymlinkResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SymlinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
+}
+
+// Delete implements resource.Resource. The link is only removed while it is
+// still a symlink, the target is left untouched.
+func (f *SymlinkResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data SymlinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, diags := f.realPath(ctx, data.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	script := fmt.Sprintf("[ -L %[1]s ] && rm %[1]s", util.ShellQuote(link))
+	resp.Diagnostics.Append(util.RunRootScript(ctx, f.core, "terraform symlink "+data.Path.ValueString(), script)...)
+}
+
+// Metadata implements resource.Resource.
+func (f *SymlinkResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "symlink")
+}
+
+// Read implements resource.Resource.
+func (f *SymlinkResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SymlinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := f.client.Get(ctx, data.Path.ValueString()); err != nil {
+		if _, ok := err.(filestation.FileNotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to get symlink", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Path.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (f *SymlinkResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a symbolic link, e.g. to share a folder of one shared folder in another. File Station cannot create links, so they are made by a temporary root task.",
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The File Station path of the link, e.g. `/web/media`. The parent folder must exist.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The File Station path the link points to, e.g. `/video/library`. It must be inside a shared folder rather than a shared folder itself.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (f *SymlinkResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	f.client = client.FileStationAPI()
+	f.core = client.CoreAPI()
+}
+
+// realPath returns the volume path of the File Station path p, which need
+// not exist as long as its parent folder does.
+func (f *SymlinkResource) realPath(ctx context.Context, p string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parent, err := f.client.Get(ctx, path.Dir(p))
+	if err != nil {
+		diags.AddError("Failed to find folder", fmt.Sprintf("%s: %s", path.Dir(p), err))
+		return "", diags
+	}
+
+	return path.Join(parent.Additional.RealPath, path.Base(p)), diags
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *SymlinkResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("path", "The path of the link.")
+}
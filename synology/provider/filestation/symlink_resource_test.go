@@ -0,0 +1,45 @@
+package filestation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SymlinkResource struct{}
+
+func TestAccSymlinkResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"link to a folder of another share",
+			`
+			resource "synology_filestation_symlink" "foo" {
+				path   = "/docker/media"
+				target = "/video/library"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_filestation_symlink.foo",
+								"target",
+								"/video/library",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package hyperbackup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// Backup task kinds of the backup_tasks data source.
+const (
+	backupKindHyperBackup         = "hyper_backup"
+	backupKindActiveBackup        = "active_backup"
+	backupKindSnapshotReplication = "snapshot_replication"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BackupTasksDataSource{}
+
+func NewBackupTasksDataSource() datasource.DataSource {
+	return &BackupTasksDataSource{}
+}
+
+type BackupTasksDataSource struct {
+	client *dsm.Client
+}
+
+type BackupTaskModel struct {
+	Kind            types.String `tfsdk:"kind"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	LastResult      types.String `tfsdk:"last_result"`
+	LastSuccessTime types.String `tfsdk:"last_success_time"`
+	SizeBytes       types.Int64  `tfsdk:"size_bytes"`
+}
+
+func (m BackupTaskModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m BackupTaskModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"kind":              types.StringType,
+		"id":                types.StringType,
+		"name":              types.StringType,
+		"last_result":       types.StringType,
+		"last_success_time": types.StringType,
+		"size_bytes":        types.Int64Type,
+	}
+}
+
+type BackupTasksDataSourceModel struct {
+	Tasks types.List `tfsdk:"tasks"`
+}
+
+func (d *BackupTasksDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_backup_tasks"
+}
+
+func (d *BackupTasksDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Hyper Backup, Active Backup for Business and Snapshot Replication tasks with the time of their last successful run, e.g. to assert with a postcondition that every backup is recent. Packages which are not installed are skipped.",
+
+		Attributes: map[string]schema.Attribute{
+			"tasks": schema.ListNestedAttribute{
+				MarkdownDescription: "The backup tasks.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "The package running the task: `hyper_backup`, `active_backup` or `snapshot_replication`.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the task within its package.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the task; the shared folder for Snapshot Replication.",
+							Computed:            true,
+						},
+						"last_result": schema.StringAttribute{
+							MarkdownDescription: "The result of the last run as reported by the package, e.g. `success`.",
+							Computed:            true,
+						},
+						"last_success_time": schema.StringAttribute{
+							MarkdownDescription: "The RFC 3339 time of the last successful run, empty if there is none. Snapshot Replication only reports its last sync, so a plan whose last sync failed has none.",
+							Computed:            true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The size of the backup data in bytes; the size of the last sync for Snapshot Replication.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BackupTasksDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data BackupTasksDataSourceModel
+	tasks := []BackupTaskModel{}
+
+	supports := func(name string) bool {
+		ok, err := d.client.Supports(ctx, name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"API request failed",
+				fmt.Sprintf("Unable to get API info, got error: %s", err),
+			)
+		}
+		return ok
+	}
+	failed := func(what string, err error) {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to list %s, got error: %s", what, err),
+		)
+	}
+
+	if supports(dsm.Backup_Task) {
+		list, err := d.client.BackupTaskList(ctx)
+		if err != nil {
+			failed("Hyper Backup tasks", err)
+			return
+		}
+		for _, t := range list.Tasks {
+			versions, err := d.client.BackupVersionList(ctx, t.TaskID)
+			if err != nil {
+				failed("Hyper Backup versions", err)
+				return
+			}
+
+			// Every version is a completed backup, the newest comes first.
+			var last int64
+			if len(versions.Versions) > 0 {
+				last = versions.Versions[0].Time
+			}
+
+			tasks = append(tasks, BackupTaskModel{
+				Kind:            types.StringValue(backupKindHyperBackup),
+				ID:              types.StringValue(strconv.FormatInt(t.TaskID, 10)),
+				Name:            types.StringValue(t.Name),
+				LastResult:      types.StringValue(t.LastResult),
+				LastSuccessTime: types.StringValue(util.UnixTime(last)),
+				SizeBytes:       types.Int64Value(t.UsedSize),
+			})
+		}
+	}
+
+	if supports(dsm.ActiveBackup_Task) {
+		list, err := d.client.ActiveBackupTaskList(ctx)
+		if err != nil {
+			failed("Active Backup tasks", err)
+			return
+		}
+		for _, t := range list.Tasks {
+			tasks = append(tasks, BackupTaskModel{
+				Kind:            types.StringValue(backupKindActiveBackup),
+				ID:              types.StringValue(strconv.FormatInt(t.TaskID, 10)),
+				Name:            types.StringValue(t.Name),
+				LastResult:      types.StringValue(t.LastResult),
+				LastSuccessTime: types.StringValue(util.UnixTime(t.LastSuccessTime)),
+				SizeBytes:       types.Int64Value(t.UsedSize),
+			})
+		}
+	}
+
+	if supports(dsm.DR_Plan) {
+		list, err := d.client.ReplicationPlanList(ctx)
+		if err != nil {
+			failed("replication plans", err)
+			return
+		}
+		for _, p := range list.Plans {
+			var last int64
+			if p.LastSyncResult == dsm.BackupResultSuccess {
+				last = p.LastSyncTime
+			}
+
+			tasks = append(tasks, BackupTaskModel{
+				Kind:            types.StringValue(backupKindSnapshotReplication),
+				ID:              types.StringValue(p.PlanID),
+				Name:            types.StringValue(p.Share),
+				LastResult:      types.StringValue(p.LastSyncResult),
+				LastSuccessTime: types.StringValue(util.UnixTime(last)),
+				SizeBytes:       types.Int64Value(p.LastSyncSize),
+			})
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v, diags := types.ListValueFrom(ctx, BackupTaskModel{}.ModelType(), tasks)
+	resp.Diagnostics.Append(diags...)
+	data.Tasks = v
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *BackupTasksDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = dsm.New(client)
+}
@@ -0,0 +1,206 @@
+package hyperbackup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DestinationsDataSource{}
+
+// NewC2DestinationsDataSource lists the Synology C2 Storage destinations.
+func NewC2DestinationsDataSource() datasource.DataSource {
+	return &DestinationsDataSource{
+		name:         "c2_destinations",
+		label:        "Synology C2 Storage",
+		transferType: dsm.BackupTransferC2,
+	}
+}
+
+// NewVaultDestinationsDataSource lists the Hyper Backup Vault destinations on
+// peer NAS.
+func NewVaultDestinationsDataSource() datasource.DataSource {
+	return &DestinationsDataSource{
+		name:         "vault_destinations",
+		label:        "Hyper Backup Vault",
+		transferType: dsm.BackupTransferVault,
+	}
+}
+
+type DestinationsDataSource struct {
+	client *dsm.Client
+
+	name         string
+	label        string
+	transferType string
+}
+
+type DestinationModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Host   types.String `tfsdk:"host"`
+	Port   types.Int64  `tfsdk:"port"`
+	Bucket types.String `tfsdk:"bucket"`
+	Region types.String `tfsdk:"region"`
+}
+
+func (m DestinationModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: m.AttrType()}
+}
+
+func (m DestinationModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":     types.StringType,
+		"name":   types.StringType,
+		"host":   types.StringType,
+		"port":   types.Int64Type,
+		"bucket": types.StringType,
+		"region": types.StringType,
+	}
+}
+
+func newDestinationModel(r dsm.BackupRepository) DestinationModel {
+	return DestinationModel{
+		ID:     types.StringValue(strconv.Itoa(r.ID)),
+		Name:   types.StringValue(r.Name),
+		Host:   types.StringValue(r.Host),
+		Port:   types.Int64Value(int64(r.Port)),
+		Bucket: types.StringValue(r.Bucket),
+		Region: types.StringValue(r.Region),
+	}
+}
+
+type DestinationsDataSourceModel struct {
+	Destinations types.List `tfsdk:"destinations"`
+	IDs          types.Map  `tfsdk:"ids"`
+}
+
+func (d *DestinationsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, d.name)
+}
+
+func (d *DestinationsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists the %s destinations known to Hyper Backup.", d.label),
+
+		Attributes: map[string]schema.Attribute{
+			"destinations": schema.ListNestedAttribute{
+				MarkdownDescription: "The destinations.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The repository ID used by backup tasks.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the destination.",
+							Computed:            true,
+						},
+						"host": schema.StringAttribute{
+							MarkdownDescription: "The host of the destination, if remote.",
+							Computed:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "The port of the destination, if remote.",
+							Computed:            true,
+						},
+						"bucket": schema.StringAttribute{
+							MarkdownDescription: "The bucket of the destination, if any.",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "The region of the destination, if any.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				MarkdownDescription: "Repository IDs by destination name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *DestinationsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data DestinationsDataSourceModel
+
+	list, err := d.client.BackupRepositoryList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to list backup destinations, got error: %s", err),
+		)
+		return
+	}
+
+	destinations := []DestinationModel{}
+	ids := map[string]string{}
+	for _, r := range list.Repositories {
+		if r.TransferType != d.transferType {
+			continue
+		}
+		destinations = append(destinations, newDestinationModel(r))
+		ids[r.Name] = strconv.Itoa(r.ID)
+	}
+
+	v, diags := types.ListValueFrom(ctx, DestinationModel{}.ModelType(), destinations)
+	resp.Diagnostics.Append(diags...)
+	data.Destinations = v
+
+	m, diags := types.MapValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.IDs = m
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *DestinationsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = dsm.New(client)
+}
@@ -0,0 +1,44 @@
+package hyperbackup_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type DestinationsDataSource struct{}
+
+func TestAccDestinationsDataSource_basic(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		DataSourceBlock string
+		Address         string
+	}{
+		{
+			"lists C2 destinations",
+			`data "synology_hyper_backup_c2_destinations" "all" {}`,
+			"data.synology_hyper_backup_c2_destinations.all",
+		},
+		{
+			"lists Vault destinations",
+			`data "synology_hyper_backup_vault_destinations" "all" {}`,
+			"data.synology_hyper_backup_vault_destinations.all",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.DataSourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet(tt.Address, "ids.%"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
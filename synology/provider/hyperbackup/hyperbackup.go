@@ -0,0 +1,26 @@
+package hyperbackup
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func buildName(providerName, resourceName string) string {
+	return providerName + "_hyper_backup_" + resourceName
+}
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewIntegrityCheckResource,
+		NewRestoreResource,
+		NewRsyncTaskResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewC2DestinationsDataSource,
+		NewVaultDestinationsDataSource,
+		NewBackupTasksDataSource,
+	}
+}
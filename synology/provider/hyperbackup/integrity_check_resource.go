@@ -0,0 +1,328 @@
+package hyperbackup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/schedulemodel"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// pollInterval is the interval at which long running Hyper Backup jobs are
+// polled for completion.
+const pollInterval = 10 * time.Second
+
+type IntegrityCheckResourceModel struct {
+	TaskID     types.Int64  `tfsdk:"task_id"`
+	Schedule   types.String `tfsdk:"schedule"`
+	TimeLimit  types.Int64  `tfsdk:"time_limit"`
+	CheckData  types.Bool   `tfsdk:"check_data"`
+	RunOnApply types.Bool   `tfsdk:"run_on_apply"`
+	Triggers   types.Map    `tfsdk:"triggers"`
+	Wait       types.Bool   `tfsdk:"wait"`
+	LastResult types.String `tfsdk:"last_result"`
+}
+
+// integritySchedule converts a cron expression with a single minute and hour
+// into the integrity check schedule of a task.
+func integritySchedule(spec string, timeLimit int64) (dsm.BackupIntegritySchedule, error) {
+	s, err := schedulemodel.ParseDSMSchedule(spec, false)
+	if err != nil {
+		return dsm.BackupIntegritySchedule{}, err
+	}
+
+	return dsm.BackupIntegritySchedule{
+		Enabled:   true,
+		Minute:    s.Minute,
+		Hour:      s.Hour,
+		WeekDay:   s.WeekDay(),
+		TimeLimit: timeLimit,
+	}, nil
+}
+
+var (
+	_ resource.Resource = &IntegrityCheckResource{}
+)
+
+func NewIntegrityCheckResource() resource.Resource {
+	return &IntegrityCheckResource{}
+}
+
+type IntegrityCheckResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *IntegrityCheckResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data IntegrityCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.setSchedule(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RunOnApply.ValueBool() {
+		resp.Diagnostics.Append(p.run(ctx, &data)...)
+	}
+	if data.LastResult.IsUnknown() {
+		data.LastResult = types.StringValue("")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *IntegrityCheckResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state IntegrityCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.setSchedule(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastResult = state.LastResult
+	if plan.RunOnApply.ValueBool() && !plan.Triggers.Equal(state.Triggers) {
+		resp.Diagnostics.Append(p.run(ctx, &plan)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (p *IntegrityCheckResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data IntegrityCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Schedule = types.StringNull()
+	resp.Diagnostics.Append(p.setSchedule(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *IntegrityCheckResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "integrity_check")
+}
+
+// Read implements resource.Resource.
+func (p *IntegrityCheckResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data IntegrityCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := p.client.BackupTaskGet(ctx, data.TaskID.ValueInt64())
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.LastResult = types.StringValue(task.Integrity)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *IntegrityCheckResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the integrity check of a Hyper Backup task. The check can be scheduled, run on apply, or both.",
+
+		Attributes: map[string]schema.Attribute{
+			"task_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the backup task.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Schedule of the integrity check expressed in cron, e.g. `0 3 * * 0`. The minute and hour must be single values. No check is scheduled when unset.",
+				Optional:            true,
+			},
+			"time_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum duration of a scheduled check in hours, `0` for no limit.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"check_data": schema.BoolAttribute{
+				MarkdownDescription: "Whether to check the backed up data in addition to the backup index. This takes considerably longer.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"run_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Whether to run a check when the resource is created and whenever `triggers` change.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which run a new check when changed and `run_on_apply` is set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"wait": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for a check run on apply to finish and fail if it does not succeed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"last_result": schema.StringAttribute{
+				MarkdownDescription: "The result of the last integrity check of the task.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (p *IntegrityCheckResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+func (p *IntegrityCheckResource) setSchedule(ctx context.Context, data IntegrityCheckResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schedule := dsm.BackupIntegritySchedule{}
+	if spec := data.Schedule.ValueString(); spec != "" {
+		s, err := integritySchedule(spec, data.TimeLimit.ValueInt64())
+		if err != nil {
+			diags.AddError("Invalid integrity check schedule", err.Error())
+			return diags
+		}
+		schedule = s
+	}
+
+	if err := p.client.BackupTaskIntegrityScheduleSet(ctx, data.TaskID.ValueInt64(), schedule); err != nil {
+		diags.AddError("Failed to set integrity check schedule", err.Error())
+	}
+
+	return diags
+}
+
+// run starts an integrity check and, if requested, waits for it to finish.
+func (p *IntegrityCheckResource) run(ctx context.Context, data *IntegrityCheckResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	id := data.TaskID.ValueInt64()
+
+	if err := p.client.BackupTaskIntegrityCheck(ctx, id, data.CheckData.ValueBool()); err != nil {
+		diags.AddError("Failed to start integrity check", err.Error())
+		return diags
+	}
+
+	if !data.Wait.ValueBool() {
+		return diags
+	}
+
+	err := util.Poll(ctx, pollInterval, func() (bool, error) {
+		status, err := p.client.BackupTaskStatus(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return status.State != dsm.BackupStateChecking, nil
+	})
+	if err != nil {
+		diags.AddError("Failed to wait for integrity check", err.Error())
+		return diags
+	}
+
+	task, err := p.client.BackupTaskGet(ctx, id)
+	if err != nil {
+		diags.AddError("Failed to read backup task", err.Error())
+		return diags
+	}
+
+	data.LastResult = types.StringValue(task.Integrity)
+	if task.Integrity != dsm.BackupResultSuccess {
+		diags.AddError(
+			"Integrity check failed",
+			fmt.Sprintf("The integrity check of task %d finished with result %q.", id, task.Integrity),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,45 @@
+package hyperbackup_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type IntegrityCheckResource struct{}
+
+func TestAccIntegrityCheckResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"weekly schedule",
+			`
+			resource "synology_hyper_backup_integrity_check" "weekly" {
+				task_id  = 1
+				schedule = "0 3 * * 0"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_hyper_backup_integrity_check.weekly",
+								"time_limit",
+								"0",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
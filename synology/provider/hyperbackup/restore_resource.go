@@ -0,0 +1,267 @@
+package hyperbackup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type RestoreResourceModel struct {
+	TaskID     types.Int64  `tfsdk:"task_id"`
+	VersionID  types.Int64  `tfsdk:"version_id"`
+	Paths      types.List   `tfsdk:"paths"`
+	TargetPath types.String `tfsdk:"target_path"`
+	Overwrite  types.Bool   `tfsdk:"overwrite"`
+	Triggers   types.Map    `tfsdk:"triggers"`
+	Wait       types.Bool   `tfsdk:"wait"`
+}
+
+var (
+	_ resource.Resource = &RestoreResource{}
+)
+
+func NewRestoreResource() resource.Resource {
+	return &RestoreResource{}
+}
+
+type RestoreResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *RestoreResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data RestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.TaskID.ValueInt64()
+
+	if data.VersionID.IsNull() || data.VersionID.IsUnknown() {
+		list, err := p.client.BackupVersionList(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list backup versions", err.Error())
+			return
+		}
+		if len(list.Versions) == 0 {
+			resp.Diagnostics.AddError(
+				"No backup version",
+				fmt.Sprintf("Task %d has no version to restore.", id),
+			)
+			return
+		}
+		data.VersionID = types.Int64Value(list.Versions[0].VersionID)
+	}
+
+	var paths []string
+	resp.Diagnostics.Append(data.Paths.ElementsAs(ctx, &paths, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.BackupRestoreStart(ctx, dsm.BackupRestoreStartRequest{
+		TaskID:     id,
+		VersionID:  data.VersionID.ValueInt64(),
+		Paths:      paths,
+		TargetPath: data.TargetPath.ValueString(),
+		Overwrite:  data.Overwrite.ValueBool(),
+	}); err != nil {
+		resp.Diagnostics.AddError("Failed to start restore", err.Error())
+		return
+	}
+
+	if data.Wait.ValueBool() {
+		var status *dsm.BackupRestoreStatusResponse
+		err := util.Poll(ctx, pollInterval, func() (bool, error) {
+			s, err := p.client.BackupRestoreStatus(ctx, id)
+			status = s
+			return err == nil && s.State != dsm.BackupStateRestoring, err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to wait for restore", err.Error())
+			return
+		}
+		if status.State == dsm.BackupStateFailed {
+			resp.Diagnostics.AddError(
+				"Restore failed",
+				fmt.Sprintf("Restoring version %d of task %d failed: %s", data.VersionID.ValueInt64(), id, status.Error),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource. Every attribute except wait requires
+// replacement, so there is nothing to do on the NAS.
+func (p *RestoreResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data RestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. Restored files are left in place.
+func (p *RestoreResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *RestoreResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "restore")
+}
+
+// Read implements resource.Resource.
+func (p *RestoreResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data RestoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *RestoreResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores a version of a Hyper Backup task to a folder on the NAS when created. Change `triggers` to restore again, for instance in recurring disaster recovery tests. Destroying the resource leaves the restored files in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"task_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the backup task.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"version_id": schema.Int64Attribute{
+				MarkdownDescription: "The version to restore. Defaults to the latest version at creation time.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"paths": schema.ListAttribute{
+				MarkdownDescription: "The paths within the backup to restore, e.g. `/docker/data`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_path": schema.StringAttribute{
+				MarkdownDescription: "The folder to restore into, e.g. `/restore-test`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"overwrite": schema.BoolAttribute{
+				MarkdownDescription: "Whether to overwrite existing files in the target folder.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which restore again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the restore to finish and fail if it does not succeed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (p *RestoreResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
@@ -0,0 +1,45 @@
+package hyperbackup_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type RestoreResource struct{}
+
+func TestAccRestoreResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"latest version",
+			`
+			resource "synology_hyper_backup_restore" "test" {
+				task_id     = 1
+				paths       = ["/docker"]
+				target_path = "/restore-test"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet(
+								"synology_hyper_backup_restore.test",
+								"version_id",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
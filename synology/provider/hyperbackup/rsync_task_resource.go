@@ -0,0 +1,442 @@
+package hyperbackup
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/schedulemodel"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// volumePrefix matches the volume DSM may prefix the backup folders with.
+var volumePrefix = regexp.MustCompile(`^/volume\d+(/|$)`)
+
+// normalizeSource returns the backup folder p in the form `/share/dir`. DSM
+// returns the folders with or without their volume and trailing slash.
+func normalizeSource(p string) string {
+	p = volumePrefix.ReplaceAllString("/"+strings.Trim(p, "/"), "")
+	return "/" + strings.Trim(p, "/")
+}
+
+// normalizeSources returns the sorted normalized backup folders.
+func normalizeSources(sources []string) []string {
+	out := make([]string, 0, len(sources))
+	for _, s := range sources {
+		out = append(out, normalizeSource(s))
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+type RsyncTaskResourceModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Sources        types.Set    `tfsdk:"sources"`
+	Host           types.String `tfsdk:"host"`
+	Port           types.Int64  `tfsdk:"port"`
+	Module         types.String `tfsdk:"module"`
+	Path           types.String `tfsdk:"path"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	SSHKey         types.String `tfsdk:"ssh_key"`
+	SSH            types.Bool   `tfsdk:"ssh"`
+	Schedule       types.String `tfsdk:"schedule"`
+	Recurrence     types.Object `tfsdk:"recurrence"`
+	BandwidthLimit types.Int64  `tfsdk:"bandwidth_limit"`
+	LastBackupTime types.String `tfsdk:"last_backup_time"`
+	LastResult     types.String `tfsdk:"last_result"`
+}
+
+func (m RsyncTaskResourceModel) settings(ctx context.Context) (dsm.BackupTaskSettings, diag.Diagnostics) {
+	var sources []string
+	diags := m.Sources.ElementsAs(ctx, &sources, false)
+
+	task := dsm.BackupTaskSettings{
+		TaskID:       m.ID.ValueInt64(),
+		Name:         m.Name.ValueString(),
+		TransferType: dsm.BackupTransferRsync,
+		Sources:      normalizeSources(sources),
+		Rsync: &dsm.BackupRsyncTarget{
+			Host:      m.Host.ValueString(),
+			Port:      m.Port.ValueInt64(),
+			Module:    m.Module.ValueString(),
+			Path:      m.Path.ValueString(),
+			Username:  m.Username.ValueString(),
+			Password:  m.Password.ValueString(),
+			SSHKey:    m.SSHKey.ValueString(),
+			EnableSSH: m.SSH.ValueBool(),
+		},
+		BandwidthLimit: m.BandwidthLimit.ValueInt64(),
+	}
+
+	spec, d := schedulemodel.Resolve(ctx, m.Schedule, m.Recurrence)
+	diags.Append(d...)
+	if spec != "" {
+		s, err := schedulemodel.BackupSchedule(spec)
+		if err != nil {
+			diags.AddAttributeError(path.Root("schedule"), "Invalid rsync schedule", err.Error())
+			return task, diags
+		}
+		task.Schedule = s
+	}
+
+	return task, diags
+}
+
+func (m *RsyncTaskResourceModel) set(ctx context.Context, task dsm.BackupTask) diag.Diagnostics {
+	m.ID = types.Int64Value(task.TaskID)
+	m.Name = types.StringValue(task.Name)
+	m.BandwidthLimit = types.Int64Value(task.BandwidthLimit)
+
+	if r := task.Rsync; r != nil {
+		m.Host = types.StringValue(r.Host)
+		m.Port = types.Int64Value(r.Port)
+		m.Module = util.String(r.Module)
+		m.Path = types.StringValue(r.Path)
+		m.Username = types.StringValue(r.Username)
+		m.SSH = types.BoolValue(r.EnableSSH)
+	}
+
+	m.setStatus(task)
+
+	// The configured folders are kept when they only differ from the ones
+	// of DSM in form or order.
+	var configured []string
+	diags := m.Sources.ElementsAs(ctx, &configured, true)
+	if slices.Equal(normalizeSources(configured), normalizeSources(task.Sources)) {
+		return diags
+	}
+
+	v, d := types.SetValueFrom(ctx, types.StringType, normalizeSources(task.Sources))
+	diags.Append(d...)
+	m.Sources = v
+
+	return diags
+}
+
+func (m *RsyncTaskResourceModel) setStatus(task dsm.BackupTask) {
+	m.LastBackupTime = types.StringValue(task.LastTime)
+	m.LastResult = types.StringValue(task.LastResult)
+}
+
+var (
+	_ resource.Resource             = &RsyncTaskResource{}
+	_ resource.ResourceWithIdentity = &RsyncTaskResource{}
+)
+
+func NewRsyncTaskResource() resource.Resource {
+	return &RsyncTaskResource{}
+}
+
+type RsyncTaskResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *RsyncTaskResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data RsyncTaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, diags := data.settings(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := p.client.BackupTaskCreate(ctx, task)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create rsync task", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(id)
+	data.setStatus(dsm.BackupTask{})
+	if created, err := p.client.BackupTaskGet(ctx, id); err == nil {
+		data.setStatus(*created)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(id, 10))...)
+}
+
+// Update implements resource.Resource.
+func (p *RsyncTaskResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data RsyncTaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, diags := data.settings(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.BackupTaskSet(ctx, task); err != nil {
+		resp.Diagnostics.AddError("Failed to update rsync task", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
+}
+
+// Delete implements resource.Resource.
+func (p *RsyncTaskResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data RsyncTaskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.BackupTaskDelete(ctx, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete rsync task", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *RsyncTaskResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_rsync_task"
+}
+
+// Read implements resource.Resource.
+func (p *RsyncTaskResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data RsyncTaskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := p.client.BackupTaskGet(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *task)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
+}
+
+// Schema implements resource.Resource.
+func (p *RsyncTaskResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Hyper Backup task copying shared folders to a remote rsync server, for targets which are not Synology NAS.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the backup task.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the task.",
+				Required:            true,
+			},
+			"sources": schema.SetAttribute{
+				MarkdownDescription: "The folders to back up, e.g. `/docker`. Folders are compared without their volume and trailing slash, so `/volume1/docker/` matches `/docker`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Address of the rsync server.",
+				Required:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the rsync server. Defaults to `22`, the SSH port.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(22),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"module": schema.StringAttribute{
+				MarkdownDescription: "The rsync module on the server, if it runs an rsync daemon.",
+				Optional:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The directory on the server, or within `module`, receiving the backup.",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The user to log in as.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of `username`. Conflicts with `ssh_key`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password"), path.MatchRoot("ssh_key")),
+				},
+			},
+			"ssh_key": schema.StringAttribute{
+				MarkdownDescription: "A PEM encoded private key authorized for `username`. Conflicts with `password`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"ssh": schema.BoolAttribute{
+				MarkdownDescription: "Whether to transfer over SSH. Required for `ssh_key`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Backup schedule expressed in cron, e.g. `0 2 * * *`. The minute must be a single value and the hours evenly spaced. The task only runs on demand when unset.",
+				Optional:            true,
+			},
+			"recurrence": schedulemodel.Attribute(
+				"The backup schedule as structured days and times instead of `schedule`.",
+				schedulemodel.CheckBackup,
+				"schedule",
+			),
+			"bandwidth_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum transfer rate in KB/s, `0` for no limit.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"last_backup_time": schema.StringAttribute{
+				MarkdownDescription: "The time the last backup ran, empty before the first backup.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_result": schema.StringAttribute{
+				MarkdownDescription: "The result of the last backup, e.g. `success`, empty before the first backup.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (p *RsyncTaskResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The credentials are
+// not returned by DSM and have to be set in the configuration.
+func (p *RsyncTaskResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	importID, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(importID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", importID)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *RsyncTaskResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the backup task.")
+}
@@ -0,0 +1,60 @@
+package hyperbackup_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type RsyncTaskResource struct{}
+
+func TestAccRsyncTaskResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"nightly over ssh",
+			`
+			resource "synology_rsync_task" "offsite" {
+				name     = "offsite"
+				sources  = ["/docker"]
+				host     = "backup.local"
+				path     = "/srv/backup"
+				username = "backup"
+				password = "secret"
+				schedule = "0 2 * * *"
+			}`,
+		},
+		{
+			"sources with volume and trailing slash",
+			`
+			resource "synology_rsync_task" "offsite" {
+				name     = "offsite"
+				sources  = ["/volume1/photo/", "/docker"]
+				host     = "backup.local"
+				path     = "/srv/backup"
+				username = "backup"
+				password = "secret"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_rsync_task.offsite", "id"),
+							r.TestCheckResourceAttr("synology_rsync_task.offsite", "port", "22"),
+							r.TestCheckResourceAttrSet("synology_rsync_task.offsite", "last_result"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package ldapserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// findGroup returns the directory group with the name, or nil if there is
+// none.
+func findGroup(ctx context.Context, client *dsm.Client, name string) (*dsm.LDAPServerGroup, error) {
+	list, err := client.LDAPServerGroupList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Groups, func(g dsm.LDAPServerGroup) bool {
+		return g.Name == name
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Groups[i], nil
+}
+
+type GroupResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	GID         types.Int64  `tfsdk:"gid"`
+	Description types.String `tfsdk:"description"`
+	Members     types.Set    `tfsdk:"members"`
+}
+
+func (m GroupResourceModel) group(ctx context.Context, stamp synoclient.Stamp) (dsm.LDAPServerGroup, diag.Diagnostics) {
+	g := dsm.LDAPServerGroup{
+		Name:        m.Name.ValueString(),
+		GID:         m.GID.ValueInt64(),
+		Description: stamp.Apply(m.Description.ValueString()),
+		Members:     []string{},
+	}
+	diags := m.Members.ElementsAs(ctx, &g.Members, false)
+
+	return g, diags
+}
+
+// set copies g into m, removing the description stamp of the provider.
+func (m *GroupResourceModel) set(
+	ctx context.Context,
+	g dsm.LDAPServerGroup,
+	stamp synoclient.Stamp,
+) (diags diag.Diagnostics) {
+	m.Name = types.StringValue(g.Name)
+	m.GID = types.Int64Value(g.GID)
+	m.Description = types.StringValue(stamp.Strip(g.Description))
+	m.Members, diags = types.SetValueFrom(ctx, types.StringType, g.Members)
+	return
+}
+
+var (
+	_ resource.Resource             = &GroupResource{}
+	_ resource.ResourceWithIdentity = &GroupResource{}
+)
+
+func NewGroupResource() resource.Resource {
+	return &GroupResource{}
+}
+
+type GroupResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *GroupResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data GroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := data.group(ctx, p.stamp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.LDAPServerGroupCreate(ctx, group); err != nil {
+		resp.Diagnostics.AddError("Failed to create LDAP group", err.Error())
+		return
+	}
+
+	created, err := findGroup(ctx, p.client, group.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list LDAP groups", err.Error())
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.AddError("LDAP group not found", fmt.Sprintf("LDAP group %s not found", group.Name))
+		return
+	}
+	data.GID = types.Int64Value(created.GID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *GroupResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data GroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := data.group(ctx, p.stamp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.LDAPServerGroupSet(ctx, group); err != nil {
+		resp.Diagnostics.AddError("Failed to update LDAP group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *GroupResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data GroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.LDAPServerGroupDelete(ctx, data.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete LDAP group", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *GroupResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "group")
+}
+
+// Read implements resource.Resource.
+func (p *GroupResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data GroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := findGroup(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list LDAP groups", err.Error())
+		return
+	}
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *group, p.stamp)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *GroupResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a group of the directory served by the LDAP Server package and its members.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name (cn) of the group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gid": schema.Int64Attribute{
+				MarkdownDescription: "The numeric group ID (gidNumber) assigned by the server.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the group.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "The names of the users in the group. Members added outside of Terraform are removed.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (p *GroupResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *GroupResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := findGroup(ctx, p.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list LDAP groups", err.Error())
+		return
+	}
+	if group == nil {
+		resp.Diagnostics.AddError("LDAP group not found", fmt.Sprintf("LDAP group %s not found", name))
+		return
+	}
+
+	var data GroupResourceModel
+	resp.Diagnostics.Append(data.set(ctx, *group, p.stamp)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *GroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the group.")
+}
+
+// checkStamp refuses changes to the group with the name when the provider
+// requires a description stamp the group lacks.
+func (p *GroupResource) checkStamp(ctx context.Context, name string) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	group, err := findGroup(ctx, p.client, name)
+	if err != nil {
+		diags.AddError("Failed to list LDAP groups", err.Error())
+		return
+	}
+	if group == nil {
+		return
+	}
+
+	if err := p.stamp.Check("LDAP group", group.Description); err != nil {
+		diags.AddError("Refusing to modify LDAP group", err.Error())
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package ldapserver_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type GroupResource struct{}
+
+func TestAccGroupResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"group is created with members",
+			`
+			resource "synology_ldap_server_user" "foo" {
+				name     = "tf-test"
+				password = "Tf-Test-Passw0rd"
+			}
+
+			resource "synology_ldap_server_group" "foo" {
+				name    = "tf-test"
+				members = [synology_ldap_server_user.foo.name]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_ldap_server_group.foo", "members.#", "1"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Package ldapserver contains the resources of the LDAP Server package, which
+// makes the NAS an LDAP directory for other hosts.
+package ldapserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func buildName(providerName, resourceName string) string {
+	return providerName + "_ldap_server_" + resourceName
+}
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewSettingsResource,
+		NewUserResource,
+		NewGroupResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
@@ -0,0 +1,246 @@
+package ldapserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+type SettingsResourceModel struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	FQDN     types.String `tfsdk:"fqdn"`
+	Password types.String `tfsdk:"password"`
+	BaseDN   types.String `tfsdk:"base_dn"`
+	BindDN   types.String `tfsdk:"bind_dn"`
+}
+
+func (m SettingsResourceModel) setting() dsm.LDAPServerSetting {
+	return dsm.LDAPServerSetting{
+		Enabled: m.Enabled.ValueBool(),
+		FQDN:    m.FQDN.ValueString(),
+	}
+}
+
+func (m *SettingsResourceModel) set(s dsm.LDAPServerSetting) {
+	m.Enabled = types.BoolValue(s.Enabled)
+	m.FQDN = types.StringValue(s.FQDN)
+	m.BaseDN = types.StringValue(s.BaseDN)
+	m.BindDN = types.StringValue(s.BindDN)
+}
+
+var (
+	_ resource.Resource = &SettingsResource{}
+)
+
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{}
+}
+
+type SettingsResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.LDAPServerSettingSet(ctx, data.setting(), data.Password.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to set LDAP Server settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *SettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password := ""
+	if !data.Password.Equal(state.Password) {
+		password = data.Password.ValueString()
+	}
+
+	if err := p.client.LDAPServerSettingSet(ctx, data.setting(), password); err != nil {
+		resp.Diagnostics.AddError("Failed to set LDAP Server settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The server is disabled, its users and
+// groups are kept.
+func (p *SettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting := data.setting()
+	setting.Enabled = false
+	if err := p.client.LDAPServerSettingSet(ctx, setting, ""); err != nil {
+		resp.Diagnostics.AddError("Failed to disable LDAP Server", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SettingsResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "settings")
+}
+
+// Read implements resource.Resource.
+func (p *SettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *SettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the directory served by the LDAP Server package, which other hosts can bind to. Joining the NAS itself to a directory is configured in Control Panel > Domain/LDAP instead. There is a single directory per NAS; destroying the resource disables the server and keeps its users and groups.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the LDAP server is running. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"fqdn": schema.StringAttribute{
+				MarkdownDescription: "The domain name the base DN is derived from, e.g. `example.com` for `dc=example,dc=com`.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the bind DN. Changes made outside of Terraform are not detected.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"base_dn": schema.StringAttribute{
+				MarkdownDescription: "The base DN of the directory, e.g. `dc=example,dc=com`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bind_dn": schema.StringAttribute{
+				MarkdownDescription: "The DN of the directory manager, e.g. `uid=root,cn=users,dc=example,dc=com`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (p *SettingsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// refresh reads the settings back into data, keeping the password.
+func (p *SettingsResource) refresh(ctx context.Context, data *SettingsResourceModel) (diags diag.Diagnostics) {
+	setting, err := p.client.LDAPServerSettingGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get LDAP Server settings", err.Error())
+		return
+	}
+
+	data.set(*setting)
+	return
+}
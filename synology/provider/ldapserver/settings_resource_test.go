@@ -0,0 +1,41 @@
+package ldapserver_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SettingsResource struct{}
+
+func TestAccSettingsResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"server is enabled",
+			`
+			resource "synology_ldap_server_settings" "foo" {
+				fqdn     = "tf-test.example.com"
+				password = "Tf-Test-Passw0rd"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_ldap_server_settings.foo", "base_dn", "dc=tf-test,dc=example,dc=com"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
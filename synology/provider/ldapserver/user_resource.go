@@ -0,0 +1,371 @@
+package ldapserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// findUser returns the directory user with the name, or nil if there is none.
+func findUser(ctx context.Context, client *dsm.Client, name string) (*dsm.LDAPServerUser, error) {
+	list, err := client.LDAPServerUserList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Users, func(u dsm.LDAPServerUser) bool {
+		return u.Name == name
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Users[i], nil
+}
+
+type UserResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	UID         types.Int64  `tfsdk:"uid"`
+	Password    types.String `tfsdk:"password"`
+	FullName    types.String `tfsdk:"full_name"`
+	Email       types.String `tfsdk:"email"`
+	Description types.String `tfsdk:"description"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+func (m UserResourceModel) user(stamp synoclient.Stamp) dsm.LDAPServerUser {
+	return dsm.LDAPServerUser{
+		Name:        m.Name.ValueString(),
+		UID:         m.UID.ValueInt64(),
+		FullName:    m.FullName.ValueString(),
+		Email:       m.Email.ValueString(),
+		Description: stamp.Apply(m.Description.ValueString()),
+		Expired:     !m.Enabled.ValueBool(),
+	}
+}
+
+// set updates m from u, removing the description stamp of the provider. The
+// password is never read back.
+func (m *UserResourceModel) set(u dsm.LDAPServerUser, stamp synoclient.Stamp) {
+	m.Name = types.StringValue(u.Name)
+	m.UID = types.Int64Value(u.UID)
+	m.FullName = types.StringValue(u.FullName)
+	m.Email = types.StringValue(u.Email)
+	m.Description = types.StringValue(stamp.Strip(u.Description))
+	m.Enabled = types.BoolValue(!u.Expired)
+}
+
+var (
+	_ resource.Resource             = &UserResource{}
+	_ resource.ResourceWithIdentity = &UserResource{}
+)
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *UserResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.LDAPServerUserCreate(ctx, data.user(p.stamp), data.Password.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to create LDAP user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.refresh(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *UserResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is only sent when it changed, so that updating other
+	// attributes does not count as a password change.
+	password := ""
+	if !data.Password.Equal(state.Password) {
+		password = data.Password.ValueString()
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.LDAPServerUserSet(ctx, data.user(p.stamp), password); err != nil {
+		resp.Diagnostics.AddError("Failed to update LDAP user", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *UserResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.LDAPServerUserDelete(ctx, data.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete LDAP user", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *UserResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "user")
+}
+
+// Read implements resource.Resource.
+func (p *UserResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := findUser(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list LDAP users", err.Error())
+		return
+	}
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*user, p.stamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *UserResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user of the directory served by the LDAP Server package.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name (uid) of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uid": schema.Int64Attribute{
+				MarkdownDescription: "The numeric user ID (uidNumber) assigned by the server.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the user. Changes made outside of Terraform are not detected.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "The full name (cn) of the user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user can bind. Disabled users are marked as expired. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (p *UserResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState. The password is
+// not read back and must be set in the configuration.
+func (p *UserResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := findUser(ctx, p.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list LDAP users", err.Error())
+		return
+	}
+	if user == nil {
+		resp.Diagnostics.AddError("LDAP user not found", fmt.Sprintf("LDAP user %s not found", name))
+		return
+	}
+
+	data := UserResourceModel{Password: types.StringNull()}
+	data.set(*user, p.stamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *UserResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the user.")
+}
+
+// refresh reads back the user ID the server assigned.
+func (p *UserResource) refresh(ctx context.Context, data *UserResourceModel) (diags diag.Diagnostics) {
+	user, err := findUser(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list LDAP users", err.Error())
+		return
+	}
+	if user == nil {
+		diags.AddError("LDAP user not found", fmt.Sprintf("LDAP user %s not found", data.Name.ValueString()))
+		return
+	}
+
+	data.UID = types.Int64Value(user.UID)
+	return
+}
+
+// checkStamp refuses changes to the user with the name when the provider
+// requires a description stamp the user lacks.
+func (p *UserResource) checkStamp(ctx context.Context, name string) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	user, err := findUser(ctx, p.client, name)
+	if err != nil {
+		diags.AddError("Failed to list LDAP users", err.Error())
+		return
+	}
+	if user == nil {
+		return
+	}
+
+	if err := p.stamp.Check("LDAP user", user.Description); err != nil {
+		diags.AddError("Refusing to modify LDAP user", err.Error())
+	}
+	return
+}
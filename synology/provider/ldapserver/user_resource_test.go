@@ -0,0 +1,41 @@
+package ldapserver_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type UserResource struct{}
+
+func TestAccUserResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"user is created",
+			`
+			resource "synology_ldap_server_user" "foo" {
+				name     = "tf-test"
+				password = "Tf-Test-Passw0rd"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_ldap_server_user.foo", "uid"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/go-synology/pkg/api/filestation"
+	"github.com/synology-community/go-synology/pkg/models"
+	"github.com/synology-community/go-synology/pkg/util/form"
+)
+
+// defaultLockTTL is how long a lock taken with lock_path is held when
+// lock_ttl is not set. The lock is renewed while the provider runs, so it
+// only needs to outlive a provider which was killed before releasing it.
+const defaultLockTTL = 2 * time.Minute
+
+// heldLocks are the locks taken by this provider process by path, with the
+// function stopping their renewal.
+var (
+	heldLocks   = map[string]heldLock{}
+	heldLocksMu sync.Mutex
+)
+
+type heldLock struct {
+	fs    filestation.Api
+	owner string
+	stop  context.CancelFunc
+}
+
+// applyLock is the content of the lock file written with lock_path.
+type applyLock struct {
+	Owner   string    `json:"owner"`
+	Expires time.Time `json:"expires"`
+}
+
+// lockOwner returns the owner of the lock when neither lock_owner nor
+// SYNOLOGY_LOCK_OWNER is set: the host running Terraform.
+func lockOwner() string {
+	if h, err := os.Hostname(); err == nil {
+		return h
+	}
+	return "terraform"
+}
+
+// holdLock takes the lock file at p for owner, see acquireLock, and renews it
+// every third of ttl until ReleaseLocks is called.
+func holdLock(ctx context.Context, fs filestation.Api, p, owner string, ttl time.Duration) error {
+	if err := acquireLock(ctx, fs, p, owner, ttl); err != nil {
+		return err
+	}
+
+	heldLocksMu.Lock()
+	defer heldLocksMu.Unlock()
+
+	if _, ok := heldLocks[p]; ok {
+		return nil
+	}
+
+	renewCtx, stop := context.WithCancel(context.Background())
+	heldLocks[p] = heldLock{fs: fs, owner: owner, stop: stop}
+
+	go func() {
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-renewCtx.Done():
+				return
+			case <-ticker.C:
+				if err := acquireLock(renewCtx, fs, p, owner, ttl); err != nil && renewCtx.Err() == nil {
+					log.Printf("[WARN] Unable to renew the lock file %s: %s", p, err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// ReleaseLocks stops renewing the locks taken with lock_path and deletes the
+// lock files which are still held by this process. Terraform does not tell
+// providers when a run ends, so it is called once the provider server stops.
+func ReleaseLocks(ctx context.Context) error {
+	heldLocksMu.Lock()
+	defer heldLocksMu.Unlock()
+
+	var errs []error
+	for p, l := range heldLocks {
+		l.stop()
+		delete(heldLocks, p)
+
+		current, err := readLock(ctx, l.fs, p)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if current == nil || current.Owner != l.owner {
+			continue
+		}
+		if _, err := l.fs.Delete(ctx, []string{p}, false); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// acquireLock takes the lock file at p for owner until ttl from now. A lock
+// of another owner is only taken over once it has expired, a lock of owner
+// is renewed.
+func acquireLock(ctx context.Context, fs filestation.Api, p, owner string, ttl time.Duration) error {
+	now := time.Now()
+
+	current, err := readLock(ctx, fs, p)
+	if err != nil {
+		return err
+	}
+	if current != nil && current.Owner != owner && now.Before(current.Expires) {
+		return fmt.Errorf("%s is held by %s until %s", p, current.Owner, current.Expires.Format(time.RFC3339))
+	}
+
+	content, err := json.Marshal(applyLock{Owner: owner, Expires: now.Add(ttl)})
+	if err != nil {
+		return err
+	}
+	if _, err := fs.Upload(ctx, path.Dir(p), form.File{
+		Name:    path.Base(p),
+		Content: string(content),
+	}, true, true); err != nil {
+		return err
+	}
+
+	// Another run may have written the lock at the same time, the last write
+	// wins.
+	current, err = readLock(ctx, fs, p)
+	if err != nil {
+		return err
+	}
+	if current == nil || current.Owner != owner {
+		return fmt.Errorf("%s was taken by another run", p)
+	}
+
+	return nil
+}
+
+// readLock returns the lock file at p, or nil if there is none.
+func readLock(ctx context.Context, fs filestation.Api, p string) (*applyLock, error) {
+	files, err := fs.List(ctx, path.Dir(p))
+	if err != nil {
+		// 408: the folder of the lock file does not exist yet.
+		var apiErr api.ApiError
+		if errors.As(err, &apiErr) && apiErr.Code == 408 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !slices.ContainsFunc(files.Files, func(f models.File) bool { return f.Path == p }) {
+		return nil, nil
+	}
+
+	file, err := fs.Download(ctx, p, "download")
+	if err != nil {
+		return nil, err
+	}
+
+	var lock applyLock
+	if err := json.Unmarshal([]byte(file.Content), &lock); err != nil {
+		return nil, fmt.Errorf("%s is not a lock file: %w", p, err)
+	}
+	return &lock, nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest/mock"
+)
+
+func TestAcquireLock(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer(mock.WithShare("terraform"))
+	defer s.Close()
+
+	c, err := client.New(api.Options{Host: s.Host()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+		t.Fatal(err)
+	}
+	fs := c.FileStationAPI()
+
+	const p = "/terraform/locks/apply.lock"
+
+	if err := acquireLock(ctx, fs, p, "ci-1", time.Hour); err != nil {
+		t.Fatalf("acquireLock() of a free lock error = %v", err)
+	}
+	if err := acquireLock(ctx, fs, p, "ci-1", time.Hour); err != nil {
+		t.Errorf("acquireLock() by the owner error = %v", err)
+	}
+	if err := acquireLock(ctx, fs, p, "ci-2", time.Hour); err == nil {
+		t.Error("acquireLock() of a held lock succeeded")
+	}
+
+	stale, _ := json.Marshal(applyLock{Owner: "ci-1", Expires: time.Now().Add(-time.Minute)})
+	s.WriteFile(p, stale)
+
+	if err := acquireLock(ctx, fs, p, "ci-2", time.Hour); err != nil {
+		t.Errorf("acquireLock() of a stale lock error = %v", err)
+	}
+	if lock, err := readLock(ctx, fs, p); err != nil || lock.Owner != "ci-2" {
+		t.Errorf("readLock() = %+v, %v", lock, err)
+	}
+}
+
+func TestHoldLock(t *testing.T) {
+	ctx := context.Background()
+
+	s := mock.NewServer(mock.WithShare("terraform"))
+	defer s.Close()
+
+	c, err := client.New(api.Options{Host: s.Host()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Login(ctx, api.LoginOptions{Username: s.Username, Password: s.Password}); err != nil {
+		t.Fatal(err)
+	}
+	fs := c.FileStationAPI()
+
+	const p = "/terraform/apply.lock"
+	const ttl = 300 * time.Millisecond
+
+	if err := holdLock(ctx, fs, p, "ci-1", ttl); err != nil {
+		t.Fatalf("holdLock() error = %v", err)
+	}
+
+	// The lock must outlive its TTL while it is held.
+	time.Sleep(2 * ttl)
+	if err := acquireLock(ctx, fs, p, "ci-2", ttl); err == nil {
+		t.Error("acquireLock() of a held lock succeeded")
+	}
+
+	if err := ReleaseLocks(ctx); err != nil {
+		t.Fatalf("ReleaseLocks() error = %v", err)
+	}
+	if lock, err := readLock(ctx, fs, p); err != nil || lock != nil {
+		t.Errorf("readLock() after release = %+v, %v", lock, err)
+	}
+}
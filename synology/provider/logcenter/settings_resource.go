@@ -0,0 +1,482 @@
+package logcenter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+type RemoteSyslogModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Protocol types.String `tfsdk:"protocol"`
+	Format   types.String `tfsdk:"format"`
+	TLS      types.Bool   `tfsdk:"tls"`
+}
+
+func (m RemoteSyslogModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":     types.StringType,
+		"port":     types.Int64Type,
+		"protocol": types.StringType,
+		"format":   types.StringType,
+		"tls":      types.BoolType,
+	}
+}
+
+type ArchiveModel struct {
+	Path       types.String `tfsdk:"path"`
+	MaxEntries types.Int64  `tfsdk:"max_entries"`
+	Keep       types.Int64  `tfsdk:"keep"`
+}
+
+func (m ArchiveModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"path":        types.StringType,
+		"max_entries": types.Int64Type,
+		"keep":        types.Int64Type,
+	}
+}
+
+type NotificationModel struct {
+	Severity types.String `tfsdk:"severity"`
+	Count    types.Int64  `tfsdk:"count"`
+	Period   types.Int64  `tfsdk:"period"`
+}
+
+func (m NotificationModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"severity": types.StringType,
+		"count":    types.Int64Type,
+		"period":   types.Int64Type,
+	}
+}
+
+type SettingsResourceModel struct {
+	RemoteSyslog types.Object `tfsdk:"remote_syslog"`
+	Archive      types.Object `tfsdk:"archive"`
+	Notification types.Object `tfsdk:"notification"`
+}
+
+var (
+	_ resource.Resource                   = &SettingsResource{}
+	_ resource.ResourceWithValidateConfig = &SettingsResource{}
+)
+
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{}
+}
+
+type SettingsResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *SettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The settings are left as they are.
+func (p *SettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SettingsResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "settings")
+}
+
+// Read implements resource.Resource.
+func (p *SettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *SettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages log forwarding, archiving and notifications of Log Center. Each feature is turned off when its block is unset. There is a single set of settings per NAS; destroying the resource leaves them unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"remote_syslog": schema.SingleNestedAttribute{
+				MarkdownDescription: "The syslog server logs are forwarded to.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The hostname or IP address of the syslog server.",
+						Required:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The port of the syslog server.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(514),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
+					},
+					"protocol": schema.StringAttribute{
+						MarkdownDescription: "The transport protocol, `udp` or `tcp`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("udp"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("udp", "tcp"),
+						},
+					},
+					"format": schema.StringAttribute{
+						MarkdownDescription: "The log format, `bsd` (RFC 3164) or `ietf` (RFC 5424).",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("ietf"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("bsd", "ietf"),
+						},
+					},
+					"tls": schema.BoolAttribute{
+						MarkdownDescription: "Whether to encrypt the connection with TLS. Requires the `tcp` protocol.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"archive": schema.SingleNestedAttribute{
+				MarkdownDescription: "The rotation of local logs into archives.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						MarkdownDescription: "The folder archives are written to, e.g. `/volume1/logs`.",
+						Required:            true,
+					},
+					"max_entries": schema.Int64Attribute{
+						MarkdownDescription: "The number of log entries after which the logs are archived.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(1000000),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"keep": schema.Int64Attribute{
+						MarkdownDescription: "The number of archives to keep, `0` keeps all of them.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+			"notification": schema.SingleNestedAttribute{
+				MarkdownDescription: "The threshold above which DSM sends a notification about the logs.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"severity": schema.StringAttribute{
+						MarkdownDescription: "The lowest severity counted, one of `emerg`, `alert`, `crit`, `err`, `warning`, `notice` or `info`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("err"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("emerg", "alert", "crit", "err", "warning", "notice", "info"),
+						},
+					},
+					"count": schema.Int64Attribute{
+						MarkdownDescription: "The number of logs within `period` which triggers a notification.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(1),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"period": schema.Int64Attribute{
+						MarkdownDescription: "The period logs are counted over, in minutes.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(5),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (p *SettingsResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.RemoteSyslog.IsNull() || data.RemoteSyslog.IsUnknown() {
+		return
+	}
+
+	var remote RemoteSyslogModel
+	resp.Diagnostics.Append(data.RemoteSyslog.As(ctx, &remote, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if remote.TLS.ValueBool() && !remote.Protocol.IsUnknown() && remote.Protocol.ValueString() != "tcp" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("remote_syslog").AtName("tls"),
+			"TLS requires TCP",
+			"Set protocol to \"tcp\" to forward logs over TLS.",
+		)
+	}
+}
+
+func (p *SettingsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The import ID is
+// ignored as there is a single set of settings per NAS.
+func (p *SettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply sends the configured settings and reads them back.
+func (p *SettingsResource) apply(ctx context.Context, data *SettingsResourceModel) (diags diag.Diagnostics) {
+	remote := dsm.LogCenterClient{Enable: !data.RemoteSyslog.IsNull()}
+	if remote.Enable {
+		var m RemoteSyslogModel
+		diags.Append(data.RemoteSyslog.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+		remote.Server = m.Host.ValueString()
+		remote.Port = m.Port.ValueInt64()
+		remote.Protocol = m.Protocol.ValueString()
+		remote.Format = m.Format.ValueString()
+		remote.Secure = m.TLS.ValueBool()
+	} else if current, err := p.client.LogCenterClientGet(ctx); err == nil {
+		remote = *current
+		remote.Enable = false
+	} else {
+		diags.AddError("Failed to get log forwarding settings", err.Error())
+	}
+
+	archive := dsm.LogCenterArchive{Enable: !data.Archive.IsNull()}
+	if archive.Enable {
+		var m ArchiveModel
+		diags.Append(data.Archive.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+		archive.Path = m.Path.ValueString()
+		archive.Threshold = m.MaxEntries.ValueInt64()
+		archive.Keep = m.Keep.ValueInt64()
+	} else if current, err := p.client.LogCenterArchiveGet(ctx); err == nil {
+		archive = *current
+		archive.Enable = false
+	} else {
+		diags.AddError("Failed to get log archive settings", err.Error())
+	}
+
+	notify := dsm.LogNotify{Enable: !data.Notification.IsNull()}
+	if notify.Enable {
+		var m NotificationModel
+		diags.Append(data.Notification.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+		notify.Level = m.Severity.ValueString()
+		notify.Count = m.Count.ValueInt64()
+		notify.Period = m.Period.ValueInt64()
+	} else if current, err := p.client.LogNotifyGet(ctx); err == nil {
+		notify = *current
+		notify.Enable = false
+	} else {
+		diags.AddError("Failed to get log notification settings", err.Error())
+	}
+	if diags.HasError() {
+		return
+	}
+
+	if err := p.client.LogCenterClientSet(ctx, remote); err != nil {
+		diags.AddError("Failed to set log forwarding settings", err.Error())
+		return
+	}
+	if err := p.client.LogCenterArchiveSet(ctx, archive); err != nil {
+		diags.AddError("Failed to set log archive settings", err.Error())
+		return
+	}
+	if err := p.client.LogNotifySet(ctx, notify); err != nil {
+		diags.AddError("Failed to set log notification settings", err.Error())
+		return
+	}
+
+	diags.Append(p.read(ctx, data)...)
+	return
+}
+
+func (p *SettingsResource) read(ctx context.Context, data *SettingsResourceModel) (diags diag.Diagnostics) {
+	remote, err := p.client.LogCenterClientGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get log forwarding settings", err.Error())
+		return
+	}
+
+	data.RemoteSyslog = types.ObjectNull(RemoteSyslogModel{}.AttrType())
+	if remote.Enable {
+		v, d := types.ObjectValueFrom(ctx, RemoteSyslogModel{}.AttrType(), RemoteSyslogModel{
+			Host:     types.StringValue(remote.Server),
+			Port:     types.Int64Value(remote.Port),
+			Protocol: types.StringValue(remote.Protocol),
+			Format:   types.StringValue(remote.Format),
+			TLS:      types.BoolValue(remote.Secure),
+		})
+		diags.Append(d...)
+		data.RemoteSyslog = v
+	}
+
+	archive, err := p.client.LogCenterArchiveGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get log archive settings", err.Error())
+		return
+	}
+
+	data.Archive = types.ObjectNull(ArchiveModel{}.AttrType())
+	if archive.Enable {
+		v, d := types.ObjectValueFrom(ctx, ArchiveModel{}.AttrType(), ArchiveModel{
+			Path:       types.StringValue(archive.Path),
+			MaxEntries: types.Int64Value(archive.Threshold),
+			Keep:       types.Int64Value(archive.Keep),
+		})
+		diags.Append(d...)
+		data.Archive = v
+	}
+
+	notify, err := p.client.LogNotifyGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get log notification settings", err.Error())
+		return
+	}
+
+	data.Notification = types.ObjectNull(NotificationModel{}.AttrType())
+	if notify.Enable {
+		v, d := types.ObjectValueFrom(ctx, NotificationModel{}.AttrType(), NotificationModel{
+			Severity: types.StringValue(notify.Level),
+			Count:    types.Int64Value(notify.Count),
+			Period:   types.Int64Value(notify.Period),
+		})
+		diags.Append(d...)
+		data.Notification = v
+	}
+
+	return
+}
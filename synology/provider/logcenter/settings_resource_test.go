@@ -0,0 +1,46 @@
+package logcenter_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SettingsResource struct{}
+
+func TestAccSettingsResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"settings are applied",
+			`
+			resource "synology_log_center_settings" "foo" {
+				remote_syslog = {
+					host     = "syslog.example.com"
+					port     = 6514
+					protocol = "tcp"
+					tls      = true
+				}
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_log_center_settings.foo", "remote_syslog.format", "ietf"),
+							r.TestCheckNoResourceAttr("synology_log_center_settings.foo", "archive"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
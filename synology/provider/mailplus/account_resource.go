@@ -0,0 +1,216 @@
+package mailplus
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type AccountResourceModel struct {
+	User types.String `tfsdk:"user"`
+}
+
+var (
+	_ resource.Resource             = &AccountResource{}
+	_ resource.ResourceWithIdentity = &AccountResource{}
+)
+
+func NewAccountResource() resource.Resource {
+	return &AccountResource{}
+}
+
+type AccountResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *AccountResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data AccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.MailPlusAccountSet(ctx, dsm.MailPlusAccount{
+		Name:    data.User.ValueString(),
+		Enabled: true,
+	}); err != nil {
+		resp.Diagnostics.AddError("Failed to enable MailPlus account", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "user", data.User.ValueString())...)
+}
+
+// Update implements resource.Resource. The only attribute forces
+// replacement.
+func (p *AccountResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data AccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "user", data.User.ValueString())...)
+}
+
+// Delete implements resource.Resource. The account is disabled, releasing
+// its license; the mailbox is kept.
+func (p *AccountResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data AccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.MailPlusAccountSet(ctx, dsm.MailPlusAccount{
+		Name:    data.User.ValueString(),
+		Enabled: false,
+	}); err != nil {
+		resp.Diagnostics.AddError("Failed to disable MailPlus account", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *AccountResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "account")
+}
+
+// Read implements resource.Resource.
+func (p *AccountResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data AccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := p.client.MailPlusAccountList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list MailPlus accounts", err.Error())
+		return
+	}
+
+	if !slices.ContainsFunc(list.Accounts, func(a dsm.MailPlusAccount) bool {
+		return a.Name == data.User.ValueString() && a.Enabled
+	}) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "user", data.User.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *AccountResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enables MailPlus for a DSM user, assigning one of the MailPlus licenses. Destroying the resource disables the account and releases the license; the mailbox is kept.",
+
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The name of the DSM user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (p *AccountResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *AccountResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	user, diags := util.ImportID(ctx, req, "user")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), user)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "user", user)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *AccountResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("user", "The name of the DSM user.")
+}
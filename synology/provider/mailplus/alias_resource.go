@@ -0,0 +1,395 @@
+package mailplus
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type AliasResourceModel struct {
+	Address   types.String `tfsdk:"address"`
+	Domain    types.String `tfsdk:"domain"`
+	Name      types.String `tfsdk:"name"`
+	Users     types.Set    `tfsdk:"users"`
+	Groups    types.Set    `tfsdk:"groups"`
+	Addresses types.Set    `tfsdk:"addresses"`
+}
+
+func (m AliasResourceModel) alias(ctx context.Context, domainID int64) (dsm.MailPlusAlias, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	alias := dsm.MailPlusAlias{
+		Name:     m.Name.ValueString(),
+		DomainID: domainID,
+		Members:  []dsm.MailPlusAliasMember{},
+	}
+	for memberType, set := range map[string]types.Set{
+		dsm.MailPlusMemberUser:     m.Users,
+		dsm.MailPlusMemberGroup:    m.Groups,
+		dsm.MailPlusMemberExternal: m.Addresses,
+	} {
+		var names []string
+		diags.Append(set.ElementsAs(ctx, &names, false)...)
+		slices.Sort(names)
+		for _, name := range names {
+			alias.Members = append(alias.Members, dsm.MailPlusAliasMember{Type: memberType, Name: name})
+		}
+	}
+
+	return alias, diags
+}
+
+func (m *AliasResourceModel) set(ctx context.Context, alias dsm.MailPlusAlias) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	members := map[string][]string{
+		dsm.MailPlusMemberUser:     {},
+		dsm.MailPlusMemberGroup:    {},
+		dsm.MailPlusMemberExternal: {},
+	}
+	for _, member := range alias.Members {
+		members[member.Type] = append(members[member.Type], member.Name)
+	}
+
+	var d diag.Diagnostics
+	m.Users, d = types.SetValueFrom(ctx, types.StringType, members[dsm.MailPlusMemberUser])
+	diags.Append(d...)
+	m.Groups, d = types.SetValueFrom(ctx, types.StringType, members[dsm.MailPlusMemberGroup])
+	diags.Append(d...)
+	m.Addresses, d = types.SetValueFrom(ctx, types.StringType, members[dsm.MailPlusMemberExternal])
+	diags.Append(d...)
+
+	return diags
+}
+
+var (
+	_ resource.Resource             = &AliasResource{}
+	_ resource.ResourceWithIdentity = &AliasResource{}
+)
+
+func NewAliasResource() resource.Resource {
+	return &AliasResource{}
+}
+
+type AliasResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *AliasResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data AliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, diags := p.domain(ctx, data.Domain.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, diags := data.alias(ctx, domain.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.MailPlusAliasCreate(ctx, alias); err != nil {
+		resp.Diagnostics.AddError("Failed to create MailPlus alias", err.Error())
+		return
+	}
+
+	data.Address = types.StringValue(data.Name.ValueString() + "@" + data.Domain.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "address", data.Address.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *AliasResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data AliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, diags := p.domain(ctx, data.Domain.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, diags := data.alias(ctx, domain.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.MailPlusAliasSet(ctx, alias); err != nil {
+		resp.Diagnostics.AddError("Failed to update MailPlus alias", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "address", data.Address.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *AliasResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data AliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := findDomain(ctx, p.client, data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list MailPlus domains", err.Error())
+		return
+	}
+
+	// Aliases are deleted with their domain.
+	if domain != nil {
+		if err := p.client.MailPlusAliasDelete(ctx, domain.ID, data.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to delete MailPlus alias", err.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *AliasResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "alias")
+}
+
+// Read implements resource.Resource.
+func (p *AliasResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data AliasResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, diags := p.find(ctx, data.Domain.ValueString(), data.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if alias == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *alias)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "address", data.Address.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *AliasResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	members := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: description,
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a MailPlus alias forwarding the mail of an address to users, groups and external addresses.",
+
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The mail address of the alias.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name of the `synology_mailplus_domain` of the alias.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The local part of the address, e.g. `sales` for `sales@example.com`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users":     members("DSM users receiving the mail of the alias."),
+			"groups":    members("DSM groups whose members receive the mail of the alias."),
+			"addresses": members("External mail addresses receiving the mail of the alias."),
+		},
+	}
+}
+
+func (p *AliasResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. Aliases are
+// imported by mail address.
+func (p *AliasResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	address, diags := util.ImportID(ctx, req, "address")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, domain, ok := strings.Cut(address, "@")
+	if !ok {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected <name>@<domain>, got %q", address))
+		return
+	}
+
+	alias, diags := p.find(ctx, domain, name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if alias == nil {
+		resp.Diagnostics.AddError("MailPlus alias not found", fmt.Sprintf("MailPlus alias %s not found", address))
+		return
+	}
+
+	data := AliasResourceModel{
+		Address: types.StringValue(address),
+		Domain:  types.StringValue(domain),
+		Name:    types.StringValue(name),
+	}
+	resp.Diagnostics.Append(data.set(ctx, *alias)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "address", address)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *AliasResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("address", "The mail address of the alias.")
+}
+
+// domain returns the mail domain with the name, failing if there is none.
+func (p *AliasResource) domain(ctx context.Context, name string) (*dsm.MailPlusDomain, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	domain, err := findDomain(ctx, p.client, name)
+	if err != nil {
+		diags.AddError("Failed to list MailPlus domains", err.Error())
+		return nil, diags
+	}
+	if domain == nil {
+		diags.AddError("MailPlus domain not found", fmt.Sprintf("MailPlus domain %s not found", name))
+	}
+
+	return domain, diags
+}
+
+func (p *AliasResource) find(ctx context.Context, domainName, name string) (*dsm.MailPlusAlias, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	domain, err := findDomain(ctx, p.client, domainName)
+	if err != nil {
+		diags.AddError("Failed to list MailPlus domains", err.Error())
+		return nil, diags
+	}
+	if domain == nil {
+		return nil, diags
+	}
+
+	list, err := p.client.MailPlusAliasList(ctx, domain.ID)
+	if err != nil {
+		diags.AddError("Failed to list MailPlus aliases", err.Error())
+		return nil, diags
+	}
+
+	i := slices.IndexFunc(list.Aliases, func(a dsm.MailPlusAlias) bool {
+		return a.Name == name
+	})
+	if i == -1 {
+		return nil, diags
+	}
+
+	return &list.Aliases[i], diags
+}
@@ -0,0 +1,46 @@
+package mailplus_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type AliasResource struct{}
+
+func TestAccAliasResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"alias is created",
+			`
+			resource "synology_mailplus_domain" "foo" {
+				name = "tf-test.example.com"
+			}
+
+			resource "synology_mailplus_alias" "foo" {
+				domain    = synology_mailplus_domain.foo.name
+				name      = "sales"
+				addresses = ["sales@example.org"]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_mailplus_alias.foo", "address", "sales@tf-test.example.com"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
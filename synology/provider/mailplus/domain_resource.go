@@ -0,0 +1,308 @@
+package mailplus
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// findDomain returns the mail domain with the name, or nil if there is none.
+func findDomain(ctx context.Context, client *dsm.Client, name string) (*dsm.MailPlusDomain, error) {
+	list, err := client.MailPlusDomainList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Domains, func(d dsm.MailPlusDomain) bool {
+		return d.Name == name
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Domains[i], nil
+}
+
+type DomainResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m DomainResourceModel) domain(stamp synoclient.Stamp) dsm.MailPlusDomain {
+	return dsm.MailPlusDomain{
+		ID:          m.ID.ValueInt64(),
+		Name:        m.Name.ValueString(),
+		Description: stamp.Apply(m.Description.ValueString()),
+	}
+}
+
+func (m *DomainResourceModel) set(d dsm.MailPlusDomain, stamp synoclient.Stamp) {
+	m.ID = types.Int64Value(d.ID)
+	m.Name = types.StringValue(d.Name)
+	m.Description = types.StringValue(stamp.Strip(d.Description))
+}
+
+var (
+	_ resource.Resource             = &DomainResource{}
+	_ resource.ResourceWithIdentity = &DomainResource{}
+)
+
+func NewDomainResource() resource.Resource {
+	return &DomainResource{}
+}
+
+type DomainResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *DomainResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := p.client.MailPlusDomainCreate(ctx, data.domain(p.stamp))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create MailPlus domain", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(res.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *DomainResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.MailPlusDomainSet(ctx, data.domain(p.stamp)); err != nil {
+		resp.Diagnostics.AddError("Failed to update MailPlus domain", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *DomainResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.MailPlusDomainDelete(ctx, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete MailPlus domain", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *DomainResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "domain")
+}
+
+// Read implements resource.Resource.
+func (p *DomainResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := findDomain(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list MailPlus domains", err.Error())
+		return
+	}
+	if domain == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*domain, p.stamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *DomainResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a mail domain of the MailPlus Server package.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The MailPlus ID of the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The domain name, e.g. `example.com`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the domain.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (p *DomainResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *DomainResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := findDomain(ctx, p.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list MailPlus domains", err.Error())
+		return
+	}
+	if domain == nil {
+		resp.Diagnostics.AddError("MailPlus domain not found", fmt.Sprintf("MailPlus domain %s not found", name))
+		return
+	}
+
+	var data DomainResourceModel
+	data.set(*domain, p.stamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *DomainResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The domain name.")
+}
+
+// checkStamp refuses changes to the domain with name when the provider
+// requires a description stamp the domain lacks.
+func (p *DomainResource) checkStamp(ctx context.Context, name string) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	domain, err := findDomain(ctx, p.client, name)
+	if err != nil {
+		diags.AddError("Failed to list MailPlus domains", err.Error())
+		return
+	}
+	if domain == nil {
+		return
+	}
+
+	if err := p.stamp.Check("MailPlus domain", domain.Description); err != nil {
+		diags.AddError("Refusing to modify MailPlus domain", err.Error())
+	}
+	return
+}
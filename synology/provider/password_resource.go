@@ -15,7 +15,9 @@ type PasswordResourceModel struct {
 	Result   types.String `tfsdk:"result"`
 }
 
-var _ resource.Resource = &PasswordResource{}
+var (
+	_ resource.Resource = &PasswordResource{}
+)
 
 func NewPasswordResource() resource.Resource {
 	return &PasswordResource{}
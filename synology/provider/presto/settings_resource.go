@@ -0,0 +1,305 @@
+package presto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+type SettingsResourceModel struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Port               types.Int64  `tfsdk:"port"`
+	DataPort           types.Int64  `tfsdk:"data_port"`
+	Shares             types.Set    `tfsdk:"shares"`
+	LicenseKey         types.String `tfsdk:"license_key"`
+	LicenseStatus      types.String `tfsdk:"license_status"`
+	LicenseConnections types.Int64  `tfsdk:"license_connections"`
+}
+
+func (m SettingsResourceModel) setting(ctx context.Context) (dsm.PrestoSetting, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s := dsm.PrestoSetting{
+		Enabled:   m.Enabled.ValueBool(),
+		Port:      m.Port.ValueInt64(),
+		DataPort:  m.DataPort.ValueInt64(),
+		AllShares: m.Shares.IsNull(),
+	}
+	if !m.Shares.IsNull() {
+		diags.Append(m.Shares.ElementsAs(ctx, &s.Shares, false)...)
+	}
+
+	return s, diags
+}
+
+func (m *SettingsResourceModel) set(ctx context.Context, s dsm.PrestoSetting) (diags diag.Diagnostics) {
+	m.Enabled = types.BoolValue(s.Enabled)
+	m.Port = types.Int64Value(s.Port)
+	m.DataPort = types.Int64Value(s.DataPort)
+	m.Shares = types.SetNull(types.StringType)
+	if !s.AllShares {
+		m.Shares, diags = types.SetValueFrom(ctx, types.StringType, s.Shares)
+	}
+	return
+}
+
+var (
+	_ resource.Resource = &SettingsResource{}
+)
+
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{}
+}
+
+type SettingsResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data, !data.LicenseKey.IsNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *SettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	activate := !data.LicenseKey.IsNull() && !data.LicenseKey.Equal(state.LicenseKey)
+	resp.Diagnostics.Append(p.apply(ctx, &data, activate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The server is disabled, the license
+// stays activated.
+func (p *SettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, diags := data.setting(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting.Enabled = false
+	if err := p.client.PrestoSettingSet(ctx, setting); err != nil {
+		resp.Diagnostics.AddError("Failed to disable Presto File Server", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SettingsResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "settings")
+}
+
+// Read implements resource.Resource.
+func (p *SettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := p.client.PrestoSettingGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Presto File Server settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *setting)...)
+	resp.Diagnostics.Append(p.readLicense(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *SettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	port := []validator.Int64{int64validator.Between(1, 65535)}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the settings of the Presto File Server package, which speeds up transfers of large files over high latency links such as a WAN. There are single settings per NAS; destroying the resource disables the server.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether Presto File Server accepts connections. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The TCP port clients connect to. Defaults to `3001`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3001),
+				Validators:          port,
+			},
+			"data_port": schema.Int64Attribute{
+				MarkdownDescription: "The UDP port file data is transferred over. It has to be forwarded along with `port` for transfers over the internet. Defaults to `3001`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3001),
+				Validators:          port,
+			},
+			"shares": schema.SetAttribute{
+				MarkdownDescription: "The shared folders Presto clients can access, subject to the permissions of the user. Every shared folder is exposed when unset.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"license_key": schema.StringAttribute{
+				MarkdownDescription: "A license key to activate, which requires the NAS to reach the Synology license server. The key is activated when it is set or changed; removing it keeps the license.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"license_status": schema.StringAttribute{
+				MarkdownDescription: "The status of the license reported by DSM.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"license_connections": schema.Int64Attribute{
+				MarkdownDescription: "The number of concurrent client connections the license allows.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (p *SettingsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// apply activates the license key when activate is set, writes the settings
+// and reads the license back into data.
+func (p *SettingsResource) apply(ctx context.Context, data *SettingsResourceModel, activate bool) diag.Diagnostics {
+	setting, diags := data.setting(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	if activate {
+		if err := p.client.PrestoLicenseActivate(ctx, data.LicenseKey.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("license_key"), "Failed to activate Presto File Server license", err.Error())
+			return diags
+		}
+	}
+
+	if err := p.client.PrestoSettingSet(ctx, setting); err != nil {
+		diags.AddError("Failed to set Presto File Server settings", err.Error())
+		return diags
+	}
+
+	diags.Append(p.readLicense(ctx, data)...)
+	return diags
+}
+
+func (p *SettingsResource) readLicense(ctx context.Context, data *SettingsResourceModel) (diags diag.Diagnostics) {
+	license, err := p.client.PrestoLicenseGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get Presto File Server license", err.Error())
+		return
+	}
+
+	data.LicenseStatus = types.StringValue(license.Status)
+	data.LicenseConnections = types.Int64Value(license.Connections)
+	return
+}
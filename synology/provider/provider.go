@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -14,14 +18,35 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/synology-community/go-synology"
 	"github.com/synology-community/go-synology/pkg/api"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
 	"github.com/synology-community/terraform-provider-synology/synology/provider/container"
 	"github.com/synology-community/terraform-provider-synology/synology/provider/core"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/dhcp"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/directoryserver"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/dns"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/downloadstation"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/drive"
 	"github.com/synology-community/terraform-provider-synology/synology/provider/filestation"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/hyperbackup"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/ldapserver"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/logcenter"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/mailplus"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/presto"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/proxyserver"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/radiusserver"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/snapshot"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/ssoserver"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/storageanalyzer"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/surveillance"
 	"github.com/synology-community/terraform-provider-synology/synology/provider/virtualization"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/webstation"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+	"go.opentelemetry.io/otel"
 )
 
 const (
@@ -30,6 +55,9 @@ const (
 	SYNOLOGY_PASSWORD_ENV_VAR        = "SYNOLOGY_PASSWORD"
 	SYNOLOGY_OTP_SECRET_ENV_VAR      = "SYNOLOGY_OTP_SECRET"
 	SYNOLOGY_SKIP_CERT_CHECK_ENV_VAR = "SYNOLOGY_SKIP_CERT_CHECK"
+	SYNOLOGY_LOCK_OWNER_ENV_VAR      = "SYNOLOGY_LOCK_OWNER"
+	SYNOLOGY_USER_AGENT_ENV_VAR      = "SYNOLOGY_USER_AGENT"
+	SYNOLOGY_LAUNCH_APP_ENV_VAR      = "SYNOLOGY_LAUNCH_APP"
 )
 
 // Ensure SynologyProvider satisfies various provider interfaces.
@@ -45,8 +73,40 @@ type SynologyProviderModel struct {
 	Password      types.String `tfsdk:"password"`
 	OtpSecret     types.String `tfsdk:"otp_secret"`
 	SkipCertCheck types.Bool   `tfsdk:"skip_cert_check"`
+	WaitForReady  types.Bool   `tfsdk:"wait_for_ready"`
+	ReadyTimeout  types.String `tfsdk:"ready_timeout"`
+	BusyTimeout   types.String `tfsdk:"busy_timeout"`
+
+	DefaultDescriptionSuffix types.String `tfsdk:"default_description_suffix"`
+	RequireDescriptionSuffix types.Bool   `tfsdk:"require_description_suffix"`
+
+	LockPath  types.String `tfsdk:"lock_path"`
+	LockOwner types.String `tfsdk:"lock_owner"`
+	LockTTL   types.String `tfsdk:"lock_ttl"`
+
+	ReadOnly types.Bool `tfsdk:"read_only"`
+
+	FileConcurrency types.Int64 `tfsdk:"file_concurrency"`
+
+	UploadBandwidthLimit types.Int64 `tfsdk:"upload_bandwidth_limit"`
+
+	StopDependents types.Bool `tfsdk:"stop_dependents"`
+
+	UserAgent types.String `tfsdk:"user_agent"`
+	LaunchApp types.String `tfsdk:"launch_app"`
 }
 
+// lowEndMemoryMB is the memory size below which a model counts as low-end.
+const lowEndMemoryMB = 2048
+
+// defaultReadyTimeout is how long the provider waits for DSM to answer when
+// wait_for_ready is set without ready_timeout.
+const defaultReadyTimeout = 10 * time.Minute
+
+// defaultBusyTimeout is how long storage changes wait for DSM to be no longer
+// busy when busy_timeout is not set.
+const defaultBusyTimeout = 30 * time.Minute
+
 func (p *SynologyProvider) Metadata(
 	ctx context.Context,
 	req provider.MetadataRequest,
@@ -86,6 +146,68 @@ func (p *SynologyProvider) Schema(
 				Description: "Whether to skip SSL certificate checks.",
 				Optional:    true,
 			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: "Whether to wait for the Synology station to answer before logging in, e.g. while it boots or restarts after a package update.",
+				Optional:    true,
+			},
+			"ready_timeout": schema.StringAttribute{
+				Description: "How long to wait for the Synology station with wait_for_ready, as a duration such as '5m'. Defaults to '10m'.",
+				Optional:    true,
+			},
+			"busy_timeout": schema.StringAttribute{
+				Description: "How long storage changes rejected because the Synology station is busy, e.g. while it checks a volume or expands a pool, wait for it by polling the system status before they fail, as a duration such as '1h'. '0s' fails them at once. Defaults to '30m'.",
+				Optional:    true,
+			},
+			"default_description_suffix": schema.StringAttribute{
+				Description: "Suffix, such as 'managed-by-terraform', appended to the description of objects written by resources with a description attribute, e.g. users, groups and reverse proxy rules. Objects without one, such as tasks and firewall rules, are not stamped. The suffix is hidden from the state.",
+				Optional:    true,
+			},
+			"require_description_suffix": schema.BoolAttribute{
+				Description: "Whether resources with a description attribute refuse to update or delete objects whose description lacks default_description_suffix, e.g. objects created by hand and imported. Tasks, firewall rules and other objects without a description are not checked.",
+				Optional:    true,
+			},
+			"lock_path": schema.StringAttribute{
+				Description: "File Station path of a lock file, such as '/terraform/apply.lock', taken when the provider is configured so that two runs cannot change the Synology station at the same time. The lock is renewed while the provider runs and released when it stops; a lock left behind by a killed run expires after lock_ttl.",
+				Optional:    true,
+			},
+			"lock_owner": schema.StringAttribute{
+				Description: "Owner written to the lock file, such as a CI pipeline ID. A run may take a lock of its own owner. Defaults to the SYNOLOGY_LOCK_OWNER environment variable or the host name.",
+				Optional:    true,
+			},
+			"lock_ttl": schema.StringAttribute{
+				Description: "How long the lock file is held without being renewed, as a duration such as '5m'. A lock which was not renewed for this long is taken over. Defaults to '2m'.",
+				Optional:    true,
+			},
+			"file_concurrency": schema.Int64Attribute{
+				Description: "How many File Station changes are sent at a time. Changes rejected as busy are retried with an exponential backoff. Defaults to 1 on ARM models and models with less than 2 GB of memory, which reject bursts of File Station operations, and to no limit otherwise.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"upload_bandwidth_limit": schema.Int64Attribute{
+				Description: "Maximum speed in KB/s at which files are uploaded, shared by all uploads in flight, so that applies over slow links such as VPNs do not use up their bandwidth. Resources which upload files can set a limit of their own. Defaults to no limit.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"stop_dependents": schema.BoolAttribute{
+				Description: "Whether deleting a folder first stops the running container projects which bind mount it or a folder within it. Otherwise deleting a folder in use, e.g. by running container projects, mounted remote folders, Web Station virtual hosts or NFS exports, fails, listing them.",
+				Optional:    true,
+			},
+			"user_agent": schema.StringAttribute{
+				Description: "User-Agent sent with every request, such as the name of a CI pipeline, so that the access logs of the Synology station attribute the changes to it. Defaults to the SYNOLOGY_USER_AGENT environment variable or the User-Agent of Go.",
+				Optional:    true,
+			},
+			"launch_app": schema.StringAttribute{
+				Description: "Value of the launchApp parameter added to every request, such as a pipeline ID, so that the access logs of the Synology station attribute the changes to it. Defaults to the SYNOLOGY_LAUNCH_APP environment variable.",
+				Optional:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Whether the provider refuses every request which could change the Synology station, e.g. for audit pipelines. Data sources and refresh work, any create, update or delete fails before it reaches the Synology station.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -177,6 +299,66 @@ func (p *SynologyProvider) Configure(
 				fmt.Sprintf("Unable to create Synology client, got error: %v", err),
 			),
 		)
+		return
+	}
+
+	userAgent := data.UserAgent.ValueString()
+	if userAgent == "" {
+		userAgent = os.Getenv(SYNOLOGY_USER_AGENT_ENV_VAR)
+	}
+	launchApp := data.LaunchApp.ValueString()
+	if launchApp == "" {
+		launchApp = os.Getenv(SYNOLOGY_LAUNCH_APP_ENV_VAR)
+	}
+	c.Client().HTTPClient.Transport = synoclient.NewTag(c.Client().HTTPClient.Transport, userAgent, launchApp)
+
+	c.Client().HTTPClient.Transport = synoclient.NewThrottle(
+		c.Client().HTTPClient.Transport,
+		data.UploadBandwidthLimit.ValueInt64(),
+	)
+
+	recorder, err := synoclient.NewRecorderFromEnv(c.Client().HTTPClient.Transport)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to configure HTTP fixtures", err.Error())
+		return
+	}
+	if recorder != nil {
+		c.Client().HTTPClient.Transport = recorder
+	}
+	if synoclient.TelemetryEnabled() {
+		t := synoclient.NewTelemetry(c.Client().HTTPClient.Transport, otel.GetTracerProvider(), otel.GetMeterProvider())
+		c.Client().HTTPClient.Transport = t
+		c.Client().RequestLogHook = t.RequestLogHook
+	}
+	c.Client().HTTPClient.Transport = synoclient.NewCache(c.Client().HTTPClient.Transport)
+
+	busyTimeout := defaultBusyTimeout
+	if !data.BusyTimeout.IsNull() {
+		// The duration was checked by ValidateConfig.
+		busyTimeout, _ = time.ParseDuration(data.BusyTimeout.ValueString())
+	}
+	c.Client().HTTPClient.Transport = synoclient.NewSystemBusy(c.Client().HTTPClient.Transport, busyTimeout)
+	c.Client().HTTPClient.Transport = synoclient.NewDiagnose(c.Client().HTTPClient.Transport)
+
+	if data.ReadOnly.ValueBool() {
+		c.Client().HTTPClient.Transport = synoclient.NewReadOnly(c.Client().HTTPClient.Transport)
+		c.Client().CheckRetry = synoclient.RetryPolicy(c.Client().CheckRetry)
+	}
+
+	if data.WaitForReady.ValueBool() {
+		timeout := defaultReadyTimeout
+		if !data.ReadyTimeout.IsNull() {
+			// The duration was checked by ValidateConfig.
+			timeout, _ = time.ParseDuration(data.ReadyTimeout.ValueString())
+		}
+
+		if err := waitForReady(ctx, c, timeout); err != nil {
+			resp.Diagnostics.AddError(
+				"Synology station is not ready",
+				fmt.Sprintf("Synology station did not answer within %s, got error: %s", timeout, err),
+			)
+			return
+		}
 	}
 
 	if _, err := c.Login(ctx, api.LoginOptions{
@@ -194,18 +376,78 @@ func (p *SynologyProvider) Configure(
 		}
 	}
 
-	resp.DataSourceData = c
-	resp.ResourceData = c
+	if !resp.Diagnostics.HasError() {
+		concurrency := data.FileConcurrency.ValueInt64()
+		if data.FileConcurrency.IsNull() && lowEndModel(ctx, c) {
+			concurrency = 1
+		}
+		if concurrency > 0 {
+			c.Client().HTTPClient.Transport = synoclient.NewFileQueue(c.Client().HTTPClient.Transport, int(concurrency))
+		}
+	}
+
+	if !data.LockPath.IsNull() && !resp.Diagnostics.HasError() {
+		owner := data.LockOwner.ValueString()
+		if owner == "" {
+			owner = os.Getenv(SYNOLOGY_LOCK_OWNER_ENV_VAR)
+		}
+		if owner == "" {
+			owner = lockOwner()
+		}
+
+		ttl := defaultLockTTL
+		if !data.LockTTL.IsNull() {
+			// The duration was checked by ValidateConfig.
+			ttl, _ = time.ParseDuration(data.LockTTL.ValueString())
+		}
+
+		if err := holdLock(ctx, c.FileStationAPI(), data.LockPath.ValueString(), owner, ttl); err != nil {
+			resp.Diagnostics.AddError(
+				"Synology station is locked",
+				fmt.Sprintf("Unable to take the lock file, got error: %s", err),
+			)
+			return
+		}
+	}
+
+	pc := &synoclient.Client{
+		Api: c,
+		Stamp: synoclient.Stamp{
+			Suffix:   data.DefaultDescriptionSuffix.ValueString(),
+			Required: data.RequireDescriptionSuffix.ValueBool(),
+		},
+		StopDependents: data.StopDependents.ValueBool(),
+		User:           user,
+	}
+	resp.DataSourceData = pc
+	resp.ResourceData = pc
 }
 
 func (p *SynologyProvider) Resources(ctx context.Context) []func() resource.Resource {
 	var resp []func() resource.Resource
 
-	resp = append(resp, NewApiResource, NewPasswordResource)
+	resp = append(resp, NewApiResource, NewPasswordResource, NewWolResource)
 	resp = append(resp, core.Resources()...)
 	resp = append(resp, filestation.Resources()...)
 	resp = append(resp, virtualization.Resources()...)
 	resp = append(resp, container.Resources()...)
+	resp = append(resp, hyperbackup.Resources()...)
+	resp = append(resp, snapshot.Resources()...)
+	resp = append(resp, ssoserver.Resources()...)
+	resp = append(resp, dns.Resources()...)
+	resp = append(resp, dhcp.Resources()...)
+	resp = append(resp, directoryserver.Resources()...)
+	resp = append(resp, ldapserver.Resources()...)
+	resp = append(resp, proxyserver.Resources()...)
+	resp = append(resp, radiusserver.Resources()...)
+	resp = append(resp, mailplus.Resources()...)
+	resp = append(resp, presto.Resources()...)
+	resp = append(resp, webstation.Resources()...)
+	resp = append(resp, logcenter.Resources()...)
+	resp = append(resp, storageanalyzer.Resources()...)
+	resp = append(resp, downloadstation.Resources()...)
+	resp = append(resp, drive.Resources()...)
+	resp = append(resp, surveillance.Resources()...)
 
 	return resp
 }
@@ -217,6 +459,23 @@ func (p *SynologyProvider) DataSources(ctx context.Context) []func() datasource.
 	resp = append(resp, filestation.DataSources()...)
 	resp = append(resp, virtualization.DataSources()...)
 	resp = append(resp, container.DataSources()...)
+	resp = append(resp, hyperbackup.DataSources()...)
+	resp = append(resp, snapshot.DataSources()...)
+	resp = append(resp, ssoserver.DataSources()...)
+	resp = append(resp, dns.DataSources()...)
+	resp = append(resp, dhcp.DataSources()...)
+	resp = append(resp, directoryserver.DataSources()...)
+	resp = append(resp, ldapserver.DataSources()...)
+	resp = append(resp, proxyserver.DataSources()...)
+	resp = append(resp, radiusserver.DataSources()...)
+	resp = append(resp, mailplus.DataSources()...)
+	resp = append(resp, presto.DataSources()...)
+	resp = append(resp, webstation.DataSources()...)
+	resp = append(resp, logcenter.DataSources()...)
+	resp = append(resp, storageanalyzer.DataSources()...)
+	resp = append(resp, downloadstation.DataSources()...)
+	resp = append(resp, drive.DataSources()...)
+	resp = append(resp, surveillance.DataSources()...)
 
 	return resp
 }
@@ -251,6 +510,97 @@ func (p *SynologyProvider) ValidateConfig(
 		)
 		return
 	}
+
+	if !data.ReadyTimeout.IsNull() && !data.ReadyTimeout.IsUnknown() {
+		if _, err := time.ParseDuration(data.ReadyTimeout.ValueString()); err != nil {
+			resp.Diagnostics.Append(
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("ready_timeout"),
+					"invalid provider configuration",
+					"ready_timeout is not a valid duration"),
+			)
+		}
+	}
+
+	if !data.BusyTimeout.IsNull() && !data.BusyTimeout.IsUnknown() {
+		if _, err := time.ParseDuration(data.BusyTimeout.ValueString()); err != nil {
+			resp.Diagnostics.Append(
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("busy_timeout"),
+					"invalid provider configuration",
+					"busy_timeout is not a valid duration"),
+			)
+		}
+	}
+
+	if !data.LockTTL.IsNull() && !data.LockTTL.IsUnknown() {
+		if ttl, err := time.ParseDuration(data.LockTTL.ValueString()); err != nil || ttl <= 0 {
+			resp.Diagnostics.Append(
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("lock_ttl"),
+					"invalid provider configuration",
+					"lock_ttl is not a positive duration"),
+			)
+		}
+	}
+
+	if data.ReadOnly.ValueBool() && !data.LockPath.IsNull() {
+		resp.Diagnostics.Append(
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("lock_path"),
+				"invalid provider configuration",
+				"lock_path cannot be used with read_only as taking the lock writes the lock file"),
+		)
+	}
+
+	if data.RequireDescriptionSuffix.ValueBool() && data.DefaultDescriptionSuffix.IsNull() {
+		resp.Diagnostics.Append(
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("require_description_suffix"),
+				"invalid provider configuration",
+				"require_description_suffix needs default_description_suffix"),
+		)
+	}
+}
+
+// lowEndModel reports whether the NAS has an ARM CPU or little memory. Models
+// whose hardware cannot be looked up are not low-end.
+func lowEndModel(ctx context.Context, c client.Api) bool {
+	info, err := c.CoreAPI().SystemInfo(ctx)
+	if err != nil {
+		return false
+	}
+
+	return lowEndHardware(info.CPUVendor, info.RAMSize)
+}
+
+// armVendors are the CPU vendors reported by the ARM models of Synology.
+var armVendors = []string{"REALTEK", "MARVELL", "ANNAPURNA", "MINDSPEED", "FREESCALE", "STM", "ARM"}
+
+// lowEndHardware reports whether a CPU vendor and memory size in MB belong to
+// a low-end model: a known ARM vendor or less than lowEndMemoryMB. An empty or
+// unknown vendor and an unreported memory size do not count.
+func lowEndHardware(cpuVendor string, ramMB int) bool {
+	vendor := strings.ToUpper(cpuVendor)
+	arm := slices.ContainsFunc(armVendors, func(v string) bool {
+		return strings.Contains(vendor, v)
+	})
+	return arm || (ramMB > 0 && ramMB < lowEndMemoryMB)
+}
+
+// waitForReady polls SYNO.API.Info until the Synology station answers. Only
+// successful responses are cached, so every attempt reaches the NAS.
+func waitForReady(ctx context.Context, c api.Api, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return util.Poll(ctx, 5*time.Second, func() (bool, error) {
+		if _, err := c.GetApiInfo(ctx); err != nil {
+			tflog.Info(ctx, "Waiting for Synology station", map[string]any{"error": err.Error()})
+			return false, nil
+		}
+		return true, nil
+	})
 }
 
 func New() func() provider.Provider {
@@ -1 +1,24 @@
 package provider
+
+import "testing"
+
+func TestLowEndHardware(t *testing.T) {
+	for _, tt := range []struct {
+		vendor string
+		ramMB  int
+		want   bool
+	}{
+		{vendor: "Realtek", ramMB: 2048, want: true},
+		{vendor: "Annapurna Labs", ramMB: 4096, want: true},
+		{vendor: "INTEL", ramMB: 1024, want: true},
+		{vendor: "INTEL", ramMB: 4096, want: false},
+		{vendor: "AMD", ramMB: 8192, want: false},
+		{vendor: "", ramMB: 4096, want: false},
+		{vendor: "Unknown", ramMB: 4096, want: false},
+		{vendor: "", ramMB: 0, want: false},
+	} {
+		if got := lowEndHardware(tt.vendor, tt.ramMB); got != tt.want {
+			t.Errorf("lowEndHardware(%q, %d) = %v, want %v", tt.vendor, tt.ramMB, got, tt.want)
+		}
+	}
+}
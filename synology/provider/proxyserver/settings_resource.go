@@ -0,0 +1,351 @@
+package proxyserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+type ParentProxyModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (m ParentProxyModel) AttrType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":     types.StringType,
+		"port":     types.Int64Type,
+		"username": types.StringType,
+		"password": types.StringType,
+	}
+}
+
+type SettingsResourceModel struct {
+	Port           types.Int64  `tfsdk:"port"`
+	CacheEnabled   types.Bool   `tfsdk:"cache_enabled"`
+	CacheSize      types.Int64  `tfsdk:"cache_size"`
+	AllowedClients types.List   `tfsdk:"allowed_clients"`
+	ParentProxy    types.Object `tfsdk:"parent_proxy"`
+}
+
+var (
+	_ resource.Resource = &SettingsResource{}
+)
+
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{}
+}
+
+type SettingsResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *SettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The settings are left as they are.
+func (p *SettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SettingsResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "settings")
+}
+
+// Read implements resource.Resource.
+func (p *SettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *SettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the settings of the Proxy Server package. Port and cache settings which are not configured keep their current value. There is a single set of settings per NAS; destroying the resource leaves them unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port the proxy listens on.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cache_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether responses are cached on the NAS.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cache_size": schema.Int64Attribute{
+				MarkdownDescription: "The size of the cache in MB.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_clients": schema.ListAttribute{
+				MarkdownDescription: "IP addresses or subnets allowed to use the proxy, e.g. `192.168.1.0/24`. Any client may use the proxy when unset.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"parent_proxy": schema.SingleNestedAttribute{
+				MarkdownDescription: "The proxy requests are forwarded to. Requests are sent directly when unset.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The hostname or IP address of the parent proxy.",
+						Required:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The port of the parent proxy.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
+					},
+					"username": schema.StringAttribute{
+						MarkdownDescription: "The user to authenticate with at the parent proxy.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "The password to authenticate with at the parent proxy. DSM does not return it, so changes made outside Terraform are not detected.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (p *SettingsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The import ID is
+// ignored as there is a single set of settings per NAS.
+func (p *SettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	data := SettingsResourceModel{
+		AllowedClients: types.ListNull(types.StringType),
+		ParentProxy:    types.ObjectNull(ParentProxyModel{}.AttrType()),
+	}
+	resp.Diagnostics.Append(p.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply sends the configured settings and reads back the others.
+func (p *SettingsResource) apply(ctx context.Context, data *SettingsResourceModel) (diags diag.Diagnostics) {
+	settings, err := p.client.ProxyServerGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get proxy server settings", err.Error())
+		return
+	}
+
+	if !data.Port.IsUnknown() {
+		settings.Port = data.Port.ValueInt64()
+	}
+	if !data.CacheEnabled.IsUnknown() {
+		settings.CacheEnable = data.CacheEnabled.ValueBool()
+	}
+	if !data.CacheSize.IsUnknown() {
+		settings.CacheSize = data.CacheSize.ValueInt64()
+	}
+
+	settings.AccessControl = !data.AllowedClients.IsNull()
+	settings.AllowedClients = []string{}
+	if settings.AccessControl {
+		diags.Append(data.AllowedClients.ElementsAs(ctx, &settings.AllowedClients, false)...)
+	}
+
+	settings.ParentEnable = !data.ParentProxy.IsNull()
+	if settings.ParentEnable {
+		var parent ParentProxyModel
+		diags.Append(data.ParentProxy.As(ctx, &parent, basetypes.ObjectAsOptions{})...)
+		settings.ParentHost = parent.Host.ValueString()
+		settings.ParentPort = parent.Port.ValueInt64()
+		settings.ParentUser = parent.Username.ValueString()
+		settings.ParentPassword = parent.Password.ValueString()
+	}
+	if diags.HasError() {
+		return
+	}
+
+	if err := p.client.ProxyServerSet(ctx, *settings); err != nil {
+		diags.AddError("Failed to set proxy server settings", err.Error())
+		return
+	}
+
+	diags.Append(p.read(ctx, data)...)
+	return
+}
+
+func (p *SettingsResource) read(ctx context.Context, data *SettingsResourceModel) (diags diag.Diagnostics) {
+	settings, err := p.client.ProxyServerGet(ctx)
+	if err != nil {
+		diags.AddError("Failed to get proxy server settings", err.Error())
+		return
+	}
+
+	data.Port = types.Int64Value(settings.Port)
+	data.CacheEnabled = types.BoolValue(settings.CacheEnable)
+	data.CacheSize = types.Int64Value(settings.CacheSize)
+
+	data.AllowedClients = types.ListNull(types.StringType)
+	if settings.AccessControl {
+		v, d := types.ListValueFrom(ctx, types.StringType, settings.AllowedClients)
+		diags.Append(d...)
+		data.AllowedClients = v
+	}
+
+	// The password is not returned by DSM, keep the known one.
+	password := types.StringNull()
+	if !data.ParentProxy.IsNull() && !data.ParentProxy.IsUnknown() {
+		var parent ParentProxyModel
+		diags.Append(data.ParentProxy.As(ctx, &parent, basetypes.ObjectAsOptions{})...)
+		password = parent.Password
+	}
+
+	data.ParentProxy = types.ObjectNull(ParentProxyModel{}.AttrType())
+	if settings.ParentEnable {
+		v, d := types.ObjectValueFrom(ctx, ParentProxyModel{}.AttrType(), ParentProxyModel{
+			Host:     types.StringValue(settings.ParentHost),
+			Port:     types.Int64Value(settings.ParentPort),
+			Username: types.StringValue(settings.ParentUser),
+			Password: password,
+		})
+		diags.Append(d...)
+		data.ParentProxy = v
+	}
+
+	return
+}
@@ -0,0 +1,42 @@
+package proxyserver_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SettingsResource struct{}
+
+func TestAccSettingsResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"settings are applied",
+			`
+			resource "synology_proxy_server_settings" "foo" {
+				port            = 3128
+				allowed_clients = ["192.168.1.0/24"]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_proxy_server_settings.foo", "port", "3128"),
+							r.TestCheckResourceAttrSet("synology_proxy_server_settings.foo", "cache_size"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package radiusserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// findClient returns the RADIUS client with the name, or nil if there is
+// none.
+func findClient(ctx context.Context, client *dsm.Client, name string) (*dsm.RADIUSClient, error) {
+	list, err := client.RADIUSClientList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Clients, func(c dsm.RADIUSClient) bool {
+		return c.Name == name
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Clients[i], nil
+}
+
+type ClientResourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Address types.String `tfsdk:"address"`
+	Secret  types.String `tfsdk:"secret"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (m ClientResourceModel) client() dsm.RADIUSClient {
+	return dsm.RADIUSClient{
+		Name:    m.Name.ValueString(),
+		Address: m.Address.ValueString(),
+		Secret:  m.Secret.ValueString(),
+		Enabled: m.Enabled.ValueBool(),
+	}
+}
+
+// set updates m from c. The secret is never read back.
+func (m *ClientResourceModel) set(c dsm.RADIUSClient) {
+	m.Name = types.StringValue(c.Name)
+	m.Address = types.StringValue(c.Address)
+	m.Enabled = types.BoolValue(c.Enabled)
+}
+
+var (
+	_ resource.Resource             = &ClientResource{}
+	_ resource.ResourceWithIdentity = &ClientResource{}
+)
+
+func NewClientResource() resource.Resource {
+	return &ClientResource{}
+}
+
+type ClientResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ClientResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ClientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.RADIUSClientCreate(ctx, data.client()); err != nil {
+		resp.Diagnostics.AddError("Failed to create RADIUS client", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *ClientResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state ClientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := data.client()
+	if data.Secret.Equal(state.Secret) {
+		client.Secret = ""
+	}
+
+	if err := p.client.RADIUSClientSet(ctx, data.Name.ValueString(), client); err != nil {
+		resp.Diagnostics.AddError("Failed to update RADIUS client", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *ClientResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ClientResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.RADIUSClientDelete(ctx, data.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete RADIUS client", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ClientResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "client")
+}
+
+// Read implements resource.Resource.
+func (p *ClientResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ClientResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := findClient(ctx, p.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list RADIUS clients", err.Error())
+		return
+	}
+	if client == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*client)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *ClientResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a client of the RADIUS Server package, a network access server such as a Wi-Fi access point which authenticates its users against the NAS.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the client.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The IP address of the client, or a subnet in CIDR notation such as `192.168.1.0/24` for several clients sharing the secret.",
+				Required:            true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The shared secret the client signs its requests with. Changes made outside of Terraform are not detected.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether requests of the client are answered. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (p *ClientResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The secret is not
+// read back and must be set in the configuration.
+func (p *ClientResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := findClient(ctx, p.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list RADIUS clients", err.Error())
+		return
+	}
+	if client == nil {
+		resp.Diagnostics.AddError("RADIUS client not found", fmt.Sprintf("RADIUS client %s not found", name))
+		return
+	}
+
+	data := ClientResourceModel{Secret: types.StringNull()}
+	data.set(*client)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *ClientResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the client.")
+}
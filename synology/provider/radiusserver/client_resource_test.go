@@ -0,0 +1,42 @@
+package radiusserver_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ClientResource struct{}
+
+func TestAccClientResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"client is created",
+			`
+			resource "synology_radius_client" "foo" {
+				name    = "tf-test"
+				address = "192.168.1.0/24"
+				secret  = "tf-test-secret"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_radius_client.foo", "enabled", "true"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
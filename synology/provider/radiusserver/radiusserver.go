@@ -0,0 +1,24 @@
+// Package radiusserver contains the resources of the RADIUS Server package,
+// which authenticates users of network access servers such as Wi-Fi access
+// points against the NAS.
+package radiusserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func buildName(providerName, resourceName string) string {
+	return providerName + "_radius_" + resourceName
+}
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewSettingsResource,
+		NewClientResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
@@ -0,0 +1,238 @@
+package radiusserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+type SettingsResourceModel struct {
+	Enabled        types.Bool  `tfsdk:"enabled"`
+	AuthPort       types.Int64 `tfsdk:"auth_port"`
+	AccountingPort types.Int64 `tfsdk:"accounting_port"`
+	LocalUsers     types.Bool  `tfsdk:"local_users"`
+	DomainUsers    types.Bool  `tfsdk:"domain_users"`
+	LDAPUsers      types.Bool  `tfsdk:"ldap_users"`
+}
+
+func (m SettingsResourceModel) setting() dsm.RADIUSSetting {
+	return dsm.RADIUSSetting{
+		Enabled:        m.Enabled.ValueBool(),
+		AuthPort:       m.AuthPort.ValueInt64(),
+		AccountingPort: m.AccountingPort.ValueInt64(),
+		LocalUsers:     m.LocalUsers.ValueBool(),
+		DomainUsers:    m.DomainUsers.ValueBool(),
+		LDAPUsers:      m.LDAPUsers.ValueBool(),
+	}
+}
+
+func (m *SettingsResourceModel) set(s dsm.RADIUSSetting) {
+	m.Enabled = types.BoolValue(s.Enabled)
+	m.AuthPort = types.Int64Value(s.AuthPort)
+	m.AccountingPort = types.Int64Value(s.AccountingPort)
+	m.LocalUsers = types.BoolValue(s.LocalUsers)
+	m.DomainUsers = types.BoolValue(s.DomainUsers)
+	m.LDAPUsers = types.BoolValue(s.LDAPUsers)
+}
+
+var (
+	_ resource.Resource = &SettingsResource{}
+)
+
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{}
+}
+
+type SettingsResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.RADIUSSettingSet(ctx, data.setting()); err != nil {
+		resp.Diagnostics.AddError("Failed to set RADIUS Server settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource.
+func (p *SettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.RADIUSSettingSet(ctx, data.setting()); err != nil {
+		resp.Diagnostics.AddError("Failed to set RADIUS Server settings", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The server is disabled, its clients
+// are kept.
+func (p *SettingsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting := data.setting()
+	setting.Enabled = false
+	if err := p.client.RADIUSSettingSet(ctx, setting); err != nil {
+		resp.Diagnostics.AddError("Failed to disable RADIUS Server", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SettingsResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "settings")
+}
+
+// Read implements resource.Resource.
+func (p *SettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setting, err := p.client.RADIUSSettingGet(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get RADIUS Server settings", err.Error())
+		return
+	}
+
+	data.set(*setting)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *SettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	port := []validator.Int64{int64validator.Between(1, 65535)}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the settings of the RADIUS Server package. There are single settings per NAS; destroying the resource disables the server and keeps its clients, see `synology_radius_client`.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the RADIUS server is running. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"auth_port": schema.Int64Attribute{
+				MarkdownDescription: "The UDP port of authentication requests. Defaults to `1812`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1812),
+				Validators:          port,
+			},
+			"accounting_port": schema.Int64Attribute{
+				MarkdownDescription: "The UDP port of accounting requests. Defaults to `1813`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1813),
+				Validators:          port,
+			},
+			"local_users": schema.BoolAttribute{
+				MarkdownDescription: "Whether local DSM users can authenticate. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"domain_users": schema.BoolAttribute{
+				MarkdownDescription: "Whether users of the domain the NAS is joined to can authenticate.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ldap_users": schema.BoolAttribute{
+				MarkdownDescription: "Whether users of the LDAP directory the NAS is bound to can authenticate.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (p *SettingsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
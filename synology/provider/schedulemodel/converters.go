@@ -0,0 +1,151 @@
+package schedulemodel
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/synology-community/go-synology/pkg/api/core"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// taskDateTypeDate is the date_type of schedules running on a date, repeated
+// every repeat_date months, instead of on days of the week.
+const taskDateTypeDate = 1
+
+// taskMonthRepeats maps the month intervals of util.DSMDays to the
+// repeat_date of date schedules.
+var taskMonthRepeats = map[int64]int64{1: 1, 3: 2, 6: 3, 12: 4}
+
+// NewTaskSchedule returns a Task Scheduler schedule running every day.
+func NewTaskSchedule() core.TaskSchedule {
+	return core.TaskSchedule{
+		WeekDay:              "0,1,2,3,4,5,6",
+		MonthlyWeek:          []string{},
+		RepeatMinStoreConfig: []int64{1, 5, 10, 15, 20, 30},
+		RepeatHourStoreConfig: []int64{
+			1,
+			2,
+			3,
+			4,
+			5,
+			6,
+			7,
+			8,
+			9,
+			10,
+			11,
+			12,
+			13,
+			14,
+			15,
+			16,
+			17,
+			18,
+			19,
+			20,
+			21,
+			22,
+			23,
+		},
+	}
+}
+
+// TaskSchedule converts a cron expression into a Task Scheduler schedule.
+// Tasks run at a single time of the day or repeat at an interval between a
+// first and a last time, on days of the week or on a single day of every 1,
+// 3, 6 or 12 months.
+func TaskSchedule(c string) (res core.TaskSchedule, err error) {
+	if c == "" {
+		return
+	}
+
+	s, err := util.ParseStandard(c)
+	if err != nil {
+		return
+	}
+
+	if s.FirstRun < 0 {
+		err = fmt.Errorf(
+			"%q must run at a single time of the day or repeat at an interval between a first and a last time, e.g. \"*/15 8-18 * * *\"",
+			c,
+		)
+		return
+	}
+
+	t := NewTaskSchedule()
+
+	t.Minute = s.FirstRun % 60
+	t.Hour = s.FirstRun / 60
+	t.RepeatDate = s.RepeatDate
+	t.RepeatHour = s.RepeatHour
+	t.RepeatMin = s.RepeatMin
+
+	if s.RepeatMin > 0 || s.RepeatHour > 0 {
+		last := s.LastRun / 60
+		t.LastWorkHour = &last
+	}
+	if s.RepeatMin > 0 && !slices.Contains(t.RepeatMinStoreConfig, s.RepeatMin) {
+		t.RepeatMinStoreConfig = append(t.RepeatMinStoreConfig, s.RepeatMin)
+		slices.Sort(t.RepeatMinStoreConfig)
+	}
+
+	days, err := s.DSMDays()
+	if err != nil {
+		err = fmt.Errorf("%q: %w", c, err)
+		return
+	}
+	if days.MonthInterval > 0 {
+		next := days.NextDate(time.Now().Local())
+		t.DateType = taskDateTypeDate
+		t.Date = fmt.Sprintf("%d-%02d-%02d", next.Year(), next.Month(), next.Day())
+		t.RepeatDate = taskMonthRepeats[days.MonthInterval]
+		return t, nil
+	}
+	if len(days.WeekDays) > 0 {
+		weekDays := make([]string, 0, len(days.WeekDays))
+		for _, d := range days.WeekDays {
+			weekDays = append(weekDays, strconv.FormatInt(d, 10))
+		}
+		t.WeekDay = strings.Join(weekDays, ",")
+	}
+
+	if t.DateType == 0 && t.RepeatDate == 0 {
+		t.RepeatDate = 1001
+	}
+	return t, nil
+}
+
+// CheckTask reports whether a cron expression converts into a Task Scheduler
+// schedule.
+func CheckTask(spec string) error {
+	_, err := TaskSchedule(spec)
+	return err
+}
+
+// BackupSchedule converts a cron expression into a Hyper Backup schedule. The
+// minute must be a single value and the hours evenly spaced.
+func BackupSchedule(spec string) (dsm.BackupSchedule, error) {
+	s, err := ParseDSMSchedule(spec, true)
+	if err != nil {
+		return dsm.BackupSchedule{}, err
+	}
+
+	return dsm.BackupSchedule{
+		Enabled:    true,
+		Hour:       s.Hour,
+		Minute:     s.Minute,
+		RepeatHour: s.RepeatHour,
+		WeekDay:    s.WeekDay(),
+	}, nil
+}
+
+// CheckBackup reports whether a cron expression converts into a Hyper Backup
+// schedule.
+func CheckBackup(spec string) error {
+	_, err := BackupSchedule(spec)
+	return err
+}
@@ -0,0 +1,137 @@
+package schedulemodel
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// DSMSchedule is a cron expression reduced to what DSM backup and replication
+// schedules can express: a start time, an optional repeat interval in hours
+// and the days of the week to run on.
+type DSMSchedule struct {
+	Minute     int64
+	Hour       int64
+	RepeatHour int64
+	WeekDays   []int64
+}
+
+// ParseDSMSchedule parses a standard cron expression into a DSMSchedule. The
+// minute must be a single value. The hours may be a single value or evenly
+// spaced until the end of the day, e.g. `*/4`, unless repeat is false.
+func ParseDSMSchedule(spec string, repeat bool) (DSMSchedule, error) {
+	s, err := util.ParseStandard(spec)
+	if err != nil {
+		return DSMSchedule{}, err
+	}
+
+	minute := uint64(s.Minute & (1<<60 - 1))
+	if bits.OnesCount64(minute) != 1 {
+		return DSMSchedule{}, fmt.Errorf("%q must name a single minute", spec)
+	}
+
+	var hours []int64
+	for h := range int64(24) {
+		if s.Hour&(1<<h) != 0 {
+			hours = append(hours, h)
+		}
+	}
+
+	res := DSMSchedule{
+		Minute: int64(bits.TrailingZeros64(minute)),
+		Hour:   hours[0],
+	}
+
+	if len(hours) > 1 {
+		if !repeat {
+			return DSMSchedule{}, fmt.Errorf("%q must name a single hour", spec)
+		}
+		res.RepeatHour = hours[1] - hours[0]
+		for i := 1; i < len(hours); i++ {
+			if hours[i]-hours[i-1] != res.RepeatHour {
+				return DSMSchedule{}, fmt.Errorf("the hours of %q must be evenly spaced", spec)
+			}
+		}
+		if hours[len(hours)-1]+res.RepeatHour < 24 {
+			return DSMSchedule{}, fmt.Errorf("the hours of %q must repeat until the end of the day", spec)
+		}
+	}
+
+	for d := range int64(7) {
+		if s.Dow&(1<<d) != 0 {
+			res.WeekDays = append(res.WeekDays, d)
+		}
+	}
+
+	return res, nil
+}
+
+// WeekDay returns the days of the week as the comma separated list used by
+// DSM, e.g. `0,6`.
+func (s DSMSchedule) WeekDay() string {
+	days := make([]string, 0, len(s.WeekDays))
+	for _, d := range s.WeekDays {
+		days = append(days, strconv.FormatInt(d, 10))
+	}
+	return strings.Join(days, ",")
+}
+
+// WindowStart returns the start of the window of length d which contains t,
+// if any. Windows open at every start time of s and may span midnight.
+func (s DSMSchedule) WindowStart(t time.Time, d time.Duration) (time.Time, bool) {
+	// A window opening on one of the previous days can still be open.
+	days := int(d/(24*time.Hour)) + 1
+	for i := 0; i <= days; i++ {
+		day := t.AddDate(0, 0, -i)
+		if !s.runsOn(day.Weekday()) {
+			continue
+		}
+		for _, start := range s.starts(day) {
+			if !start.After(t) && t.Before(start.Add(d)) {
+				return start, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// NextStart returns the first start time of s after t.
+func (s DSMSchedule) NextStart(t time.Time) time.Time {
+	for i := 0; i <= 7; i++ {
+		day := t.AddDate(0, 0, i)
+		if !s.runsOn(day.Weekday()) {
+			continue
+		}
+		for _, start := range s.starts(day) {
+			if start.After(t) {
+				return start
+			}
+		}
+	}
+	return time.Time{}
+}
+
+func (s DSMSchedule) runsOn(d time.Weekday) bool {
+	for _, w := range s.WeekDays {
+		if w == int64(d) {
+			return true
+		}
+	}
+	return false
+}
+
+// starts returns the start times of s on the day of t, in the location of t.
+func (s DSMSchedule) starts(t time.Time) []time.Time {
+	var res []time.Time
+	for h := s.Hour; h < 24; h += s.RepeatHour {
+		res = append(res, time.Date(t.Year(), t.Month(), t.Day(), int(h), int(s.Minute), 0, 0, t.Location()))
+		if s.RepeatHour == 0 {
+			break
+		}
+	}
+	return res
+}
@@ -0,0 +1,110 @@
+package schedulemodel
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseDSMSchedule(t *testing.T) {
+	tests := []struct {
+		spec    string
+		repeat  bool
+		want    DSMSchedule
+		weekDay string
+		wantErr bool
+	}{
+		{
+			spec:    "30 3 * * 0",
+			want:    DSMSchedule{Minute: 30, Hour: 3, WeekDays: []int64{0}},
+			weekDay: "0",
+		},
+		{
+			spec:    "0 */4 * * *",
+			repeat:  true,
+			want:    DSMSchedule{Hour: 0, RepeatHour: 4, WeekDays: []int64{0, 1, 2, 3, 4, 5, 6}},
+			weekDay: "0,1,2,3,4,5,6",
+		},
+		{
+			spec:    "15 2-23/3 * * 1-5",
+			repeat:  true,
+			want:    DSMSchedule{Minute: 15, Hour: 2, RepeatHour: 3, WeekDays: []int64{1, 2, 3, 4, 5}},
+			weekDay: "1,2,3,4,5",
+		},
+		{spec: "0 */4 * * *", wantErr: true},
+		{spec: "*/5 1 * * *", wantErr: true},
+		{spec: "0 1,2,5 * * *", repeat: true, wantErr: true},
+		{spec: "0 1-6/2 * * *", repeat: true, wantErr: true},
+		{spec: "not cron", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseDSMSchedule(tt.spec, tt.repeat)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDSMSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDSMSchedule() = %+v, want %+v", got, tt.want)
+			}
+			if got.WeekDay() != tt.weekDay {
+				t.Errorf("WeekDay() = %q, want %q", got.WeekDay(), tt.weekDay)
+			}
+		})
+	}
+}
+
+func TestDSMScheduleWindow(t *testing.T) {
+	// Saturdays at 23:00, open for three hours.
+	s, err := ParseDSMSchedule("0 23 * * 6", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := 3 * time.Hour
+
+	tests := []struct {
+		name  string
+		at    time.Time
+		open  bool
+		start time.Time
+		next  time.Time
+	}{
+		{
+			name: "before the window",
+			at:   time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC),
+			next: time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "at the start",
+			at:    time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC),
+			open:  true,
+			start: time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "after midnight",
+			at:    time.Date(2026, 10, 18, 1, 30, 0, 0, time.UTC),
+			open:  true,
+			start: time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "at the end",
+			at:   time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC),
+			next: time.Date(2026, 10, 24, 23, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, open := s.WindowStart(tt.at, d)
+			if open != tt.open || !start.Equal(tt.start) {
+				t.Errorf("WindowStart() = %v, %v, want %v, %v", start, open, tt.start, tt.open)
+			}
+			if !open {
+				if next := s.NextStart(tt.at); !next.Equal(tt.next) {
+					t.Errorf("NextStart() = %v, want %v", next, tt.next)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Package schedulemodel implements the recurrence attribute shared by the
+// scheduled resources. A recurrence is either a cron expression or a
+// structured time and days, and is converted into the schedule format of
+// each DSM API through a cron expression.
+package schedulemodel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// weekDays are the names of the days attribute in cron order.
+var weekDays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+var clockTime = regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`)
+
+// Model is the recurrence attribute.
+type Model struct {
+	Cron types.String `tfsdk:"cron"`
+
+	At            types.String `tfsdk:"at"`
+	Days          types.Set    `tfsdk:"days"`
+	DayOfMonth    types.Int64  `tfsdk:"day_of_month"`
+	RepeatMinutes types.Int64  `tfsdk:"repeat_minutes"`
+	RepeatHours   types.Int64  `tfsdk:"repeat_hours"`
+	LastHour      types.Int64  `tfsdk:"last_hour"`
+}
+
+// Spec returns the recurrence as a standard cron expression.
+func (m Model) Spec(ctx context.Context) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !m.Cron.IsNull() {
+		return m.Cron.ValueString(), diags
+	}
+
+	at, err := time.Parse("15:04", m.At.ValueString())
+	if err != nil {
+		diags.AddError("Invalid recurrence", fmt.Sprintf("at must be a time like 03:00: %s", err))
+		return "", diags
+	}
+	minute, hour := at.Minute(), at.Hour()
+
+	lastHour := 23
+	if !m.LastHour.IsNull() {
+		lastHour = int(m.LastHour.ValueInt64())
+	}
+
+	minutes := strconv.Itoa(minute)
+	hours := strconv.Itoa(hour)
+	switch {
+	case !m.RepeatMinutes.IsNull():
+		step := int(m.RepeatMinutes.ValueInt64())
+		if 60%step != 0 {
+			diags.AddError("Invalid recurrence", "repeat_minutes must divide an hour, e.g. 5, 15 or 30.")
+			return "", diags
+		}
+		// Runs repeat from the same minute of every hour, so a later minute
+		// would start the first hour before at.
+		if minute >= step {
+			diags.AddError(
+				"Invalid recurrence",
+				fmt.Sprintf("With repeat_minutes %d, the minute of at must be below %d, e.g. %02d:%02d, as runs repeat from the same minute of every hour.", step, step, hour, minute%step),
+			)
+			return "", diags
+		}
+		minutes = fmt.Sprintf("%d-59/%d", minute, step)
+		hours = fmt.Sprintf("%d-%d", hour, max(hour, lastHour))
+	case !m.RepeatHours.IsNull():
+		hours = fmt.Sprintf("%d-%d/%d", hour, max(hour, lastHour), m.RepeatHours.ValueInt64())
+	}
+
+	dom, dow := "*", "*"
+	if !m.DayOfMonth.IsNull() {
+		dom = strconv.FormatInt(m.DayOfMonth.ValueInt64(), 10)
+	}
+	if !m.Days.IsNull() {
+		var names []string
+		diags.Append(m.Days.ElementsAs(ctx, &names, true)...)
+
+		var days []string
+		for i, name := range weekDays {
+			for _, n := range names {
+				if n == name {
+					days = append(days, strconv.Itoa(i))
+				}
+			}
+		}
+		dow = strings.Join(days, ",")
+	}
+
+	return strings.Join([]string{minutes, hours, dom, "*", dow}, " "), diags
+}
+
+// Resolve returns the cron expression of a resource offering both a cron
+// string attribute and a recurrence, empty if neither is set.
+func Resolve(ctx context.Context, cron types.String, recurrence types.Object) (string, diag.Diagnostics) {
+	if recurrence.IsNull() || recurrence.IsUnknown() {
+		return cron.ValueString(), nil
+	}
+
+	var m Model
+	diags := recurrence.As(ctx, &m, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return "", diags
+	}
+
+	spec, d := m.Spec(ctx)
+	diags.Append(d...)
+	return spec, diags
+}
+
+// Attribute returns the recurrence attribute. check validates the resulting
+// cron expression against the schedules the API of the resource supports;
+// conflicting names the cron string attribute of the resource which the
+// recurrence replaces.
+func Attribute(description string, check func(spec string) error, conflicting string) schema.SingleNestedAttribute {
+	exclusive := path.MatchRelative().AtParent()
+
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description + " Either `cron` or `at` must be set.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"cron": schema.StringAttribute{
+				MarkdownDescription: "The schedule expressed in cron, e.g. `0 3 * * 1-5`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(exclusive.AtName("at")),
+				},
+			},
+			"at": schema.StringAttribute{
+				MarkdownDescription: "The time of the first run of the day, e.g. `03:00`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(clockTime, "value must be a time like 03:00"),
+				},
+			},
+			"days": schema.SetAttribute{
+				MarkdownDescription: "The days of the week to run on, `mon` to `sun`. Every day when unset.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(weekDays...)),
+					setvalidator.ConflictsWith(exclusive.AtName("cron"), exclusive.AtName("day_of_month")),
+				},
+			},
+			"day_of_month": schema.Int64Attribute{
+				MarkdownDescription: "The day of the month to run on instead of days of the week.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 31),
+					int64validator.ConflictsWith(exclusive.AtName("cron")),
+				},
+			},
+			"repeat_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Repeat every number of minutes after `at`, until the end of `last_hour`. The minute of `at` must be below it.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 30),
+					int64validator.ConflictsWith(exclusive.AtName("cron"), exclusive.AtName("repeat_hours")),
+				},
+			},
+			"repeat_hours": schema.Int64Attribute{
+				MarkdownDescription: "Repeat every number of hours after `at`, until `last_hour`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 23),
+					int64validator.ConflictsWith(exclusive.AtName("cron")),
+				},
+			},
+			"last_hour": schema.Int64Attribute{
+				MarkdownDescription: "The last hour to repeat in, `23` when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 23),
+					int64validator.AtLeastOneOf(exclusive.AtName("repeat_minutes"), exclusive.AtName("repeat_hours")),
+				},
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRoot(conflicting)),
+			specValidator{check: check},
+		},
+	}
+}
+
+// specValidator checks that the recurrence converts into a cron expression
+// accepted by check.
+type specValidator struct {
+	check func(spec string) error
+}
+
+func (v specValidator) Description(_ context.Context) string {
+	return "value must be a schedule supported by the resource"
+}
+
+func (v specValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v specValidator) ValidateObject(
+	ctx context.Context,
+	req validator.ObjectRequest,
+	resp *validator.ObjectResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var m Model
+	resp.Diagnostics.Append(req.ConfigValue.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || m.Cron.IsUnknown() || m.At.IsUnknown() {
+		return
+	}
+
+	spec, diags := m.Spec(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := v.check(spec); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid schedule", err.Error())
+	}
+}
@@ -0,0 +1,112 @@
+package schedulemodel_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/schedulemodel"
+)
+
+func days(names ...string) types.Set {
+	elements := make([]attr.Value, 0, len(names))
+	for _, n := range names {
+		elements = append(elements, types.StringValue(n))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func TestModelSpec(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Model schedulemodel.Model
+		Spec  string
+	}{
+		{
+			"cron",
+			schedulemodel.Model{Cron: types.StringValue("0 3 * * 1-5")},
+			"0 3 * * 1-5",
+		},
+		{
+			"daily",
+			schedulemodel.Model{At: types.StringValue("03:30")},
+			"30 3 * * *",
+		},
+		{
+			"week days",
+			schedulemodel.Model{At: types.StringValue("22:00"), Days: days("sat", "mon")},
+			"0 22 * * 1,6",
+		},
+		{
+			"day of month",
+			schedulemodel.Model{At: types.StringValue("01:00"), DayOfMonth: types.Int64Value(15)},
+			"0 1 15 * *",
+		},
+		{
+			"repeat minutes",
+			schedulemodel.Model{
+				At:            types.StringValue("08:05"),
+				RepeatMinutes: types.Int64Value(15),
+				LastHour:      types.Int64Value(18),
+			},
+			"5-59/15 8-18 * * *",
+		},
+		{
+			"repeat hours",
+			schedulemodel.Model{At: types.StringValue("00:00"), RepeatHours: types.Int64Value(6)},
+			"0 0-23/6 * * *",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			spec, diags := tt.Model.Spec(context.Background())
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if spec != tt.Spec {
+				t.Errorf("got %q, want %q", spec, tt.Spec)
+			}
+		})
+	}
+}
+
+func TestModelSpec_invalid(t *testing.T) {
+	m := schedulemodel.Model{At: types.StringValue("08:00"), RepeatMinutes: types.Int64Value(7)}
+	if _, diags := m.Spec(context.Background()); !diags.HasError() {
+		t.Error("expected an error for a step which does not divide an hour")
+	}
+}
+
+func TestConverters(t *testing.T) {
+	s, err := schedulemodel.TaskSchedule("5-59/15 8-18 * * 1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Hour != 8 || s.Minute != 5 || s.RepeatMin != 15 || *s.LastWorkHour != 18 || s.WeekDay != "1,2,3,4,5" {
+		t.Errorf("unexpected task schedule %+v", s)
+	}
+
+	b, err := schedulemodel.BackupSchedule("0 0-23/6 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !b.Enabled || b.Hour != 0 || b.RepeatHour != 6 {
+		t.Errorf("unexpected backup schedule %+v", b)
+	}
+
+	if err := schedulemodel.CheckBackup("*/15 * * * *"); err == nil {
+		t.Error("expected backup schedules to reject several minutes")
+	}
+}
+
+func TestModelSpec_repeatMinutesAfterStart(t *testing.T) {
+	m := schedulemodel.Model{
+		At:            types.StringValue("03:45"),
+		RepeatMinutes: types.Int64Value(15),
+		LastHour:      types.Int64Value(5),
+	}
+	if spec, diags := m.Spec(context.Background()); !diags.HasError() {
+		t.Errorf("got %q, expected an error for a start minute which a step would run before", spec)
+	}
+}
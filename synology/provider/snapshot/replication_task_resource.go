@@ -0,0 +1,371 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/schedulemodel"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type ReplicationTaskResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Share                 types.String `tfsdk:"share"`
+	TargetHost            types.String `tfsdk:"target_host"`
+	TargetPort            types.Int64  `tfsdk:"target_port"`
+	TargetUser            types.String `tfsdk:"target_user"`
+	TargetPassword        types.String `tfsdk:"target_password"`
+	TargetVolume          types.String `tfsdk:"target_volume"`
+	Encrypt               types.Bool   `tfsdk:"encrypt"`
+	Schedule              types.String `tfsdk:"schedule"`
+	RetainCount           types.Int64  `tfsdk:"retain_count"`
+	RetainDays            types.Int64  `tfsdk:"retain_days"`
+	RemoveTargetOnDestroy types.Bool   `tfsdk:"remove_target_on_destroy"`
+}
+
+func (m ReplicationTaskResourceModel) plan() (dsm.ReplicationPlan, error) {
+	plan := dsm.ReplicationPlan{
+		PlanID:       m.ID.ValueString(),
+		Share:        m.Share.ValueString(),
+		TargetHost:   m.TargetHost.ValueString(),
+		TargetPort:   m.TargetPort.ValueInt64(),
+		TargetVolume: m.TargetVolume.ValueString(),
+		Encrypt:      m.Encrypt.ValueBool(),
+		Retention: dsm.ReplicationRetention{
+			KeepLatest: m.RetainCount.ValueInt64(),
+			KeepDays:   m.RetainDays.ValueInt64(),
+		},
+	}
+
+	if spec := m.Schedule.ValueString(); spec != "" {
+		s, err := replicationSchedule(spec)
+		if err != nil {
+			return plan, err
+		}
+		plan.Schedule = s
+	}
+
+	return plan, nil
+}
+
+func (m ReplicationTaskResourceModel) credentials() dsm.ReplicationCredentials {
+	return dsm.ReplicationCredentials{
+		Username: m.TargetUser.ValueString(),
+		Password: m.TargetPassword.ValueString(),
+	}
+}
+
+func (m *ReplicationTaskResourceModel) set(plan dsm.ReplicationPlan) {
+	m.ID = types.StringValue(plan.PlanID)
+	m.Share = types.StringValue(plan.Share)
+	m.TargetHost = types.StringValue(plan.TargetHost)
+	m.TargetPort = types.Int64Value(plan.TargetPort)
+	m.TargetVolume = types.StringValue(plan.TargetVolume)
+	m.Encrypt = types.BoolValue(plan.Encrypt)
+	m.RetainCount = types.Int64Value(plan.Retention.KeepLatest)
+	m.RetainDays = types.Int64Value(plan.Retention.KeepDays)
+}
+
+// replicationSchedule converts a cron expression into a replication schedule.
+func replicationSchedule(spec string) (dsm.ReplicationSchedule, error) {
+	s, err := schedulemodel.ParseDSMSchedule(spec, true)
+	if err != nil {
+		return dsm.ReplicationSchedule{}, err
+	}
+
+	return dsm.ReplicationSchedule{
+		Enabled:    true,
+		Minute:     s.Minute,
+		Hour:       s.Hour,
+		RepeatHour: s.RepeatHour,
+		WeekDay:    s.WeekDay(),
+	}, nil
+}
+
+var (
+	_ resource.Resource             = &ReplicationTaskResource{}
+	_ resource.ResourceWithIdentity = &ReplicationTaskResource{}
+)
+
+func NewReplicationTaskResource() resource.Resource {
+	return &ReplicationTaskResource{}
+}
+
+type ReplicationTaskResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ReplicationTaskResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ReplicationTaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan, err := data.plan()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid replication schedule", err.Error())
+		return
+	}
+
+	id, err := p.client.ReplicationPlanCreate(ctx, plan, data.credentials())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create replication task", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// Update implements resource.Resource.
+func (p *ReplicationTaskResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ReplicationTaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan, err := data.plan()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid replication schedule", err.Error())
+		return
+	}
+
+	if err := p.client.ReplicationPlanSet(ctx, plan, data.credentials()); err != nil {
+		resp.Diagnostics.AddError("Failed to update replication task", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *ReplicationTaskResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ReplicationTaskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.ReplicationPlanDelete(ctx, data.ID.ValueString(), data.RemoveTargetOnDestroy.ValueBool()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete replication task", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ReplicationTaskResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "replication_task")
+}
+
+// Read implements resource.Resource.
+func (p *ReplicationTaskResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ReplicationTaskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan, err := p.client.ReplicationPlanGet(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *ReplicationTaskResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Snapshot Replication task which replicates a shared folder to a remote NAS.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the replication plan.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"share": schema.StringAttribute{
+				MarkdownDescription: "The shared folder to replicate.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_host": schema.StringAttribute{
+				MarkdownDescription: "Address of the target NAS.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_port": schema.Int64Attribute{
+				MarkdownDescription: "The DSM HTTPS port of the target NAS.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5001),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"target_user": schema.StringAttribute{
+				MarkdownDescription: "An administrator of the target NAS.",
+				Required:            true,
+			},
+			"target_password": schema.StringAttribute{
+				MarkdownDescription: "The password of `target_user`.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"target_volume": schema.StringAttribute{
+				MarkdownDescription: "The volume of the target NAS receiving the replica, e.g. `/volume1`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encrypt": schema.BoolAttribute{
+				MarkdownDescription: "Whether to encrypt the replication traffic.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Replication schedule expressed in cron, e.g. `0 */4 * * *`. The minute must be a single value and the hours evenly spaced. Replication only runs on demand when unset.",
+				Optional:            true,
+			},
+			"retain_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of latest snapshots to keep on the target, `0` to keep all.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retain_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days to keep snapshots on the target, `0` to keep them forever.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"remove_target_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete the replica on the target when the task is destroyed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (p *ReplicationTaskResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The target
+// credentials are not returned by DSM and have to be set in the configuration.
+func (p *ReplicationTaskResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remove_target_on_destroy"), false)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *ReplicationTaskResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the replication plan.")
+}
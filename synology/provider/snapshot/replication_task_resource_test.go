@@ -0,0 +1,47 @@
+package snapshot_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ReplicationTaskResource struct{}
+
+func TestAccReplicationTaskResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"replicates every four hours",
+			`
+			resource "synology_replication_task" "docker" {
+				share           = "docker"
+				target_host     = "backup.local"
+				target_user     = "admin"
+				target_password = "secret"
+				target_volume   = "/volume1"
+				schedule        = "0 */4 * * *"
+				retain_count    = 24
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_replication_task.docker", "id"),
+							r.TestCheckResourceAttr("synology_replication_task.docker", "encrypt", "true"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
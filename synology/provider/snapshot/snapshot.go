@@ -0,0 +1,26 @@
+// Package snapshot contains the resources of the Snapshot Replication
+// package.
+package snapshot
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// buildName names resources after the feature they manage, e.g.
+// synology_snapshot, without a package prefix.
+func buildName(providerName, resourceName string) string {
+	return providerName + "_" + resourceName
+}
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewSnapshotResource,
+		NewSnapshotRestoreResource,
+		NewReplicationTaskResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
@@ -0,0 +1,321 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type SnapshotResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Share         types.String `tfsdk:"share"`
+	LUN           types.String `tfsdk:"lun"`
+	Description   types.String `tfsdk:"description"`
+	Lock          types.Bool   `tfsdk:"lock"`
+	KeepOnDestroy types.Bool   `tfsdk:"keep_on_destroy"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+}
+
+// identity returns the resource identity, which includes the shared folder
+// since shared folder snapshots are only named after their time.
+func (m SnapshotResourceModel) identity() string {
+	if share := m.Share.ValueString(); share != "" {
+		return share + "/" + m.ID.ValueString()
+	}
+	return m.ID.ValueString()
+}
+
+var (
+	_ resource.Resource             = &SnapshotResource{}
+	_ resource.ResourceWithIdentity = &SnapshotResource{}
+)
+
+func NewSnapshotResource() resource.Resource {
+	return &SnapshotResource{}
+}
+
+type SnapshotResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *SnapshotResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	description := p.stamp.Apply(data.Description.ValueString())
+
+	var id string
+	var err error
+	if share := data.Share.ValueString(); share != "" {
+		id, err = p.client.ShareSnapshotCreate(ctx, share, dsm.ShareSnapshotInfo{
+			Description: description,
+			Lock:        data.Lock.ValueBool(),
+		})
+	} else {
+		id, err = p.client.LUNSnapshotTake(ctx, data.LUN.ValueString(), description, data.Lock.ValueBool())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to take snapshot", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.identity())...)
+}
+
+// Update implements resource.Resource.
+func (p *SnapshotResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state SnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Lock.Equal(state.Lock) {
+		resp.Diagnostics.Append(p.setLock(ctx, plan, plan.Lock.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", plan.identity())...)
+}
+
+// Delete implements resource.Resource.
+func (p *SnapshotResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data SnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.KeepOnDestroy.ValueBool() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Locked snapshots cannot be deleted.
+	if data.Lock.ValueBool() {
+		resp.Diagnostics.Append(p.setLock(ctx, data, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var err error
+	if share := data.Share.ValueString(); share != "" {
+		err = p.client.ShareSnapshotDelete(ctx, share, data.ID.ValueString())
+	} else {
+		err = p.client.LUNSnapshotDelete(ctx, data.ID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete snapshot", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SnapshotResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "snapshot")
+}
+
+// Read implements resource.Resource.
+func (p *SnapshotResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found := false
+	if share := data.Share.ValueString(); share != "" {
+		list, err := p.client.ShareSnapshotList(ctx, share)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list snapshots", err.Error())
+			return
+		}
+		for _, s := range list.Snapshots {
+			if s.Time == data.ID.ValueString() {
+				data.Description = types.StringValue(p.stamp.Strip(s.Description))
+				data.Lock = types.BoolValue(s.Lock)
+				found = true
+			}
+		}
+	} else {
+		list, err := p.client.LUNSnapshotList(ctx, data.LUN.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list snapshots", err.Error())
+			return
+		}
+		for _, s := range list.Snapshots {
+			if s.UUID == data.ID.ValueString() {
+				data.Description = types.StringValue(p.stamp.Strip(s.Description))
+				data.Lock = types.BoolValue(s.Locked)
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.identity())...)
+}
+
+// Schema implements resource.Resource.
+func (p *SnapshotResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	attributes := targetAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the shared folder snapshot, or the UUID of the LUN snapshot.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["description"] = schema.StringAttribute{
+		MarkdownDescription: "Description of the snapshot.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["lock"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether the snapshot is locked, which exempts it from retention policies.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+	attributes["keep_on_destroy"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether to keep the snapshot on the NAS when the resource is destroyed.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+	attributes["triggers"] = schema.MapAttribute{
+		MarkdownDescription: "Arbitrary values which take a new snapshot when changed.",
+		Optional:            true,
+		ElementType:         types.StringType,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Takes a snapshot of a shared folder or iSCSI LUN when created, for instance before a risky change in the same apply. Requires the Snapshot Replication package.",
+
+		Attributes: attributes,
+	}
+}
+
+func (p *SnapshotResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+func (p *SnapshotResource) setLock(ctx context.Context, data SnapshotResourceModel, lock bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var err error
+	if share := data.Share.ValueString(); share != "" {
+		err = p.client.ShareSnapshotSet(ctx, share, data.ID.ValueString(), dsm.ShareSnapshotInfo{
+			Description: p.stamp.Apply(data.Description.ValueString()),
+			Lock:        lock,
+		})
+	} else {
+		err = p.client.LUNSnapshotSet(ctx, data.ID.ValueString(), lock)
+	}
+	if err != nil {
+		diags.AddError("Failed to lock snapshot", err.Error())
+	}
+
+	return diags
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *SnapshotResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the snapshot in the form `<share>/<name>`, or the UUID of the LUN snapshot.")
+}
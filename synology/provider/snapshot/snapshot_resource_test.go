@@ -0,0 +1,43 @@
+package snapshot_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SnapshotResource struct{}
+
+func TestAccSnapshotResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"locked share snapshot",
+			`
+			resource "synology_snapshot" "before" {
+				share       = "docker"
+				description = "Before upgrade"
+				lock        = true
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_snapshot.before", "id"),
+							r.TestCheckResourceAttr("synology_snapshot.before", "lock", "true"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
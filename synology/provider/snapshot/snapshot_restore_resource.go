@@ -0,0 +1,188 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+type SnapshotRestoreResourceModel struct {
+	Share          types.String `tfsdk:"share"`
+	LUN            types.String `tfsdk:"lun"`
+	Snapshot       types.String `tfsdk:"snapshot"`
+	SnapshotBefore types.Bool   `tfsdk:"snapshot_before"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+}
+
+var (
+	_ resource.Resource = &SnapshotRestoreResource{}
+)
+
+func NewSnapshotRestoreResource() resource.Resource {
+	return &SnapshotRestoreResource{}
+}
+
+type SnapshotRestoreResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *SnapshotRestoreResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data SnapshotRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if share := data.Share.ValueString(); share != "" {
+		err = p.client.ShareSnapshotRestore(ctx, share, data.Snapshot.ValueString(), data.SnapshotBefore.ValueBool())
+	} else {
+		if data.SnapshotBefore.ValueBool() {
+			_, err = p.client.LUNSnapshotTake(ctx, data.LUN.ValueString(), "Before restoring "+data.Snapshot.ValueString(), false)
+		}
+		if err == nil {
+			err = p.client.LUNSnapshotRestore(ctx, data.Snapshot.ValueString())
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to restore snapshot", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update implements resource.Resource. Every attribute requires replacement.
+func (p *SnapshotRestoreResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data SnapshotRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource. The restored data is left in place.
+func (p *SnapshotRestoreResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *SnapshotRestoreResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "snapshot_restore")
+}
+
+// Read implements resource.Resource.
+func (p *SnapshotRestoreResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data SnapshotRestoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Schema implements resource.Resource.
+func (p *SnapshotRestoreResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	attributes := targetAttributes()
+	attributes["snapshot"] = schema.StringAttribute{
+		MarkdownDescription: "The snapshot to restore, usually the `id` of a `synology_snapshot`.",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["snapshot_before"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether to take a snapshot of the current state before restoring.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["triggers"] = schema.MapAttribute{
+		MarkdownDescription: "Arbitrary values which restore the snapshot again when changed.",
+		Optional:            true,
+		ElementType:         types.StringType,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reverts a shared folder or iSCSI LUN to a snapshot when created. Destroying the resource does not undo the restore.",
+
+		Attributes: attributes,
+	}
+}
+
+func (p *SnapshotRestoreResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
@@ -0,0 +1,49 @@
+package snapshot_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type SnapshotRestoreResource struct{}
+
+func TestAccSnapshotRestoreResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"restore share snapshot",
+			`
+			resource "synology_snapshot" "before" {
+				share = "docker"
+			}
+
+			resource "synology_snapshot_restore" "rollback" {
+				share    = synology_snapshot.before.share
+				snapshot = synology_snapshot.before.id
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr(
+								"synology_snapshot_restore.rollback",
+								"snapshot_before",
+								"true",
+							),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package snapshot
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// targetAttributes returns the attributes selecting the shared folder or LUN
+// a snapshot belongs to. Exactly one of them must be set.
+func targetAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"share": schema.StringAttribute{
+			MarkdownDescription: "The name of the shared folder. Conflicts with `lun`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("share"), path.MatchRoot("lun")),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"lun": schema.StringAttribute{
+			MarkdownDescription: "The UUID of the iSCSI LUN. Conflicts with `share`.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
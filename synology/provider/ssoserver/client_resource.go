@@ -0,0 +1,273 @@
+package ssoserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type ClientResourceModel struct {
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Name         types.String `tfsdk:"name"`
+	RedirectURIs types.List   `tfsdk:"redirect_uris"`
+}
+
+func (m ClientResourceModel) client(ctx context.Context) (dsm.SSOServerClient, diag.Diagnostics) {
+	c := dsm.SSOServerClient{
+		ClientID: m.ClientID.ValueString(),
+		Name:     m.Name.ValueString(),
+	}
+	diags := m.RedirectURIs.ElementsAs(ctx, &c.RedirectURIs, false)
+	return c, diags
+}
+
+func (m *ClientResourceModel) set(ctx context.Context, c dsm.SSOServerClient) diag.Diagnostics {
+	m.ClientID = types.StringValue(c.ClientID)
+	m.Name = types.StringValue(c.Name)
+
+	v, diags := types.ListValueFrom(ctx, types.StringType, c.RedirectURIs)
+	m.RedirectURIs = v
+	return diags
+}
+
+var (
+	_ resource.Resource             = &ClientResource{}
+	_ resource.ResourceWithIdentity = &ClientResource{}
+)
+
+func NewClientResource() resource.Resource {
+	return &ClientResource{}
+}
+
+type ClientResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ClientResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ClientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := data.client(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := p.client.SSOServerClientCreate(ctx, c)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create SSO Server client", err.Error())
+		return
+	}
+
+	data.ClientID = types.StringValue(res.ClientID)
+	data.ClientSecret = types.StringValue(res.ClientSecret)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "client_id", res.ClientID)...)
+}
+
+// Update implements resource.Resource.
+func (p *ClientResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ClientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, diags := data.client(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.SSOServerClientSet(ctx, c); err != nil {
+		resp.Diagnostics.AddError("Failed to update SSO Server client", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "client_id", data.ClientID.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *ClientResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ClientResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.SSOServerClientDelete(ctx, data.ClientID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete SSO Server client", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ClientResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "client")
+}
+
+// Read implements resource.Resource.
+func (p *ClientResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ClientResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := p.client.SSOServerClientList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list SSO Server clients", err.Error())
+		return
+	}
+
+	for _, c := range list.Clients {
+		if c.ClientID == data.ClientID.ValueString() {
+			resp.Diagnostics.Append(data.set(ctx, c)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "client_id", c.ClientID)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Schema implements resource.Resource.
+func (p *ClientResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Registers an OpenID Connect application with the SSO Server package, so it can authenticate DSM users.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The generated client ID of the application.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The generated client secret of the application. It is only known to resources created by Terraform, not imported ones.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the application.",
+				Required:            true,
+			},
+			"redirect_uris": schema.ListAttribute{
+				MarkdownDescription: "The URIs the application may redirect to after login.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (p *ClientResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *ClientResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "client_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), id)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "client_id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *ClientResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("client_id", "The client ID of the application.")
+}
@@ -0,0 +1,42 @@
+package ssoserver_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ClientResource struct{}
+
+func TestAccClientResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"client is registered",
+			`
+			resource "synology_sso_server_client" "foo" {
+				name          = "grafana"
+				redirect_uris = ["https://grafana.example.com/login/generic_oauth"]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_sso_server_client.foo", "client_id"),
+							r.TestCheckResourceAttrSet("synology_sso_server_client.foo", "client_secret"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,394 @@
+package storageanalyzer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/provider/schedulemodel"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type ReportResourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Shares     types.Set    `tfsdk:"shares"`
+	TopFiles   types.Int64  `tfsdk:"top_files"`
+	ReportPath types.String `tfsdk:"report_path"`
+	Recipients types.Set    `tfsdk:"recipients"`
+	Schedule   types.String `tfsdk:"schedule"`
+
+	SkipRefresh     types.Bool           `tfsdk:"skip_refresh"`
+	RefreshInterval timetypes.GoDuration `tfsdk:"refresh_interval"`
+}
+
+func (m ReportResourceModel) report(ctx context.Context) (dsm.StorageReport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	report := dsm.StorageReport{
+		ID:         m.ID.ValueInt64(),
+		Name:       m.Name.ValueString(),
+		Shares:     []string{},
+		TopN:       m.TopFiles.ValueInt64(),
+		ReportPath: m.ReportPath.ValueString(),
+		Recipients: []string{},
+	}
+	diags.Append(m.Shares.ElementsAs(ctx, &report.Shares, false)...)
+	diags.Append(m.Recipients.ElementsAs(ctx, &report.Recipients, false)...)
+	slices.Sort(report.Shares)
+	slices.Sort(report.Recipients)
+	report.MailEnable = len(report.Recipients) > 0
+
+	if spec := m.Schedule.ValueString(); spec != "" {
+		s, err := schedulemodel.ParseDSMSchedule(spec, true)
+		if err != nil {
+			diags.AddAttributeError(path.Root("schedule"), "Invalid report schedule", err.Error())
+			return report, diags
+		}
+		report.Schedule = dsm.StorageReportSchedule{
+			Enabled:    true,
+			Hour:       s.Hour,
+			Minute:     s.Minute,
+			RepeatHour: s.RepeatHour,
+			WeekDay:    s.WeekDay(),
+		}
+	}
+
+	return report, diags
+}
+
+func (m *ReportResourceModel) set(ctx context.Context, report dsm.StorageReport) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.Int64Value(report.ID)
+	m.Name = types.StringValue(report.Name)
+	m.TopFiles = types.Int64Value(report.TopN)
+	m.ReportPath = types.StringValue(report.ReportPath)
+
+	v, d := types.SetValueFrom(ctx, types.StringType, report.Shares)
+	diags.Append(d...)
+	m.Shares = v
+
+	recipients := []string{}
+	if report.MailEnable {
+		recipients = report.Recipients
+	}
+	v, d = types.SetValueFrom(ctx, types.StringType, recipients)
+	diags.Append(d...)
+	m.Recipients = v
+
+	// DSM stores the schedule in its own format, an unscheduled task is the
+	// only change detected.
+	if !report.Schedule.Enabled {
+		m.Schedule = types.StringNull()
+	}
+
+	return diags
+}
+
+var (
+	_ resource.Resource             = &ReportResource{}
+	_ resource.ResourceWithIdentity = &ReportResource{}
+)
+
+func NewReportResource() resource.Resource {
+	return &ReportResource{}
+}
+
+type ReportResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *ReportResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data ReportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report, diags := data.report(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := p.client.StorageReportCreate(ctx, report)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create storage report", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.MarkRefreshed(ctx, resp.Private)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(id, 10))...)
+}
+
+// Update implements resource.Resource.
+func (p *ReportResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data ReportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report, diags := data.report(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.StorageReportSet(ctx, report); err != nil {
+		resp.Diagnostics.AddError("Failed to update storage report", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.MarkRefreshed(ctx, resp.Private)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
+}
+
+// Delete implements resource.Resource.
+func (p *ReportResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data ReportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.StorageReportDelete(ctx, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete storage report", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *ReportResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "report")
+}
+
+// Read implements resource.Resource.
+func (p *ReportResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data ReportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Listing the reports waits for Storage Analyzer, which is slow on large
+	// volumes.
+	skip, diags := util.SkipRefresh(ctx, req.Private, data.SkipRefresh, data.RefreshInterval)
+	resp.Diagnostics.Append(diags...)
+	if skip || resp.Diagnostics.HasError() {
+		return
+	}
+
+	report, err := p.find(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list storage reports", err.Error())
+		return
+	}
+	if report == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *report)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.MarkRefreshed(ctx, resp.Private)...)
+	resp.Diagnostics.Append(
+		util.SetIdentity(ctx, resp.Identity, "id", strconv.FormatInt(data.ID.ValueInt64(), 10))...)
+}
+
+// Schema implements resource.Resource.
+func (p *ReportResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Storage Analyzer report task listing the usage and largest files of shared folders.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the report task.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the report task.",
+				Required:            true,
+			},
+			"shares": schema.SetAttribute{
+				MarkdownDescription: "The shared folders analyzed, e.g. `/volume1/docker`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"top_files": schema.Int64Attribute{
+				MarkdownDescription: "The number of largest files listed in the report.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(50),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"report_path": schema.StringAttribute{
+				MarkdownDescription: "The folder reports are written to, e.g. `/volume1/reports`.",
+				Required:            true,
+			},
+			"recipients": schema.SetAttribute{
+				MarkdownDescription: "The email addresses the report is sent to. Requires the mail notification settings of DSM.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Report schedule expressed in cron, e.g. `0 3 * * 1`. The minute must be a single value and the hours evenly spaced. The task only runs on demand when unset.",
+				Optional:            true,
+			},
+			"skip_refresh":     util.SkipRefreshAttribute(),
+			"refresh_interval": util.RefreshIntervalAttribute(),
+		},
+	}
+}
+
+func (p *ReportResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState. The schedule is
+// not imported and has to be set in the configuration.
+func (p *ReportResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	importID, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(importID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse ID", err.Error())
+		return
+	}
+
+	report, err := p.find(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list storage reports", err.Error())
+		return
+	}
+	if report == nil {
+		resp.Diagnostics.AddError("Storage report not found", fmt.Sprintf("Storage report %d not found", id))
+		return
+	}
+
+	data := ReportResourceModel{Schedule: types.StringNull()}
+	resp.Diagnostics.Append(data.set(ctx, *report)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", importID)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *ReportResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The ID of the report task.")
+}
+
+func (p *ReportResource) find(ctx context.Context, id int64) (*dsm.StorageReport, error) {
+	list, err := p.client.StorageReportList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Reports, func(r dsm.StorageReport) bool {
+		return r.ID == id
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Reports[i], nil
+}
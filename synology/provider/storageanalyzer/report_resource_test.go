@@ -0,0 +1,55 @@
+package storageanalyzer_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type ReportResource struct{}
+
+func TestAccReportResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"report task is created",
+			`
+			resource "synology_storage_analyzer_report" "foo" {
+				name        = "tf-test"
+				shares      = ["/volume1/docker"]
+				report_path = "/volume1/reports"
+				schedule    = "0 3 * * 1"
+			}`,
+		},
+		{
+			"report task is refreshed daily",
+			`
+			resource "synology_storage_analyzer_report" "foo" {
+				name        = "tf-test"
+				shares      = ["/volume1/docker"]
+				report_path = "/volume1/reports"
+
+				refresh_interval = "24h"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_storage_analyzer_report.foo", "id"),
+							r.TestCheckResourceAttr("synology_storage_analyzer_report.foo", "top_files", "50"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
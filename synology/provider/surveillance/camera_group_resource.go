@@ -0,0 +1,354 @@
+package surveillance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type CameraGroupResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CameraIDs   types.Set    `tfsdk:"camera_ids"`
+}
+
+func (m CameraGroupResourceModel) group(
+	ctx context.Context,
+	stamp synoclient.Stamp,
+) (dsm.SurveillanceCameraGroup, diag.Diagnostics) {
+	group := dsm.SurveillanceCameraGroup{
+		Name:        m.Name.ValueString(),
+		Description: stamp.Apply(m.Description.ValueString()),
+		CameraIDs:   []int64{},
+	}
+	if !m.ID.IsUnknown() {
+		group.ID = m.ID.ValueInt64()
+	}
+	diags := m.CameraIDs.ElementsAs(ctx, &group.CameraIDs, true)
+	slices.Sort(group.CameraIDs)
+
+	return group, diags
+}
+
+// set updates m from g, removing the description stamp of the provider.
+func (m *CameraGroupResourceModel) set(
+	ctx context.Context,
+	g dsm.SurveillanceCameraGroup,
+	stamp synoclient.Stamp,
+) diag.Diagnostics {
+	m.ID = types.Int64Value(g.ID)
+	m.Name = types.StringValue(g.Name)
+	m.Description = types.StringValue(stamp.Strip(g.Description))
+
+	ids := g.CameraIDs
+	if ids == nil {
+		ids = []int64{}
+	}
+	v, diags := types.SetValueFrom(ctx, types.Int64Type, ids)
+	m.CameraIDs = v
+
+	return diags
+}
+
+var (
+	_ resource.Resource             = &CameraGroupResource{}
+	_ resource.ResourceWithIdentity = &CameraGroupResource{}
+)
+
+func NewCameraGroupResource() resource.Resource {
+	return &CameraGroupResource{}
+}
+
+type CameraGroupResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *CameraGroupResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data CameraGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.save(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *CameraGroupResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data CameraGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.ID.ValueInt64())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.save(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *CameraGroupResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data CameraGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.ID.ValueInt64())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.SurveillanceCameraGroupDelete(ctx, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete camera group", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *CameraGroupResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "camera_group")
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// Read implements resource.Resource.
+func (p *CameraGroupResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data CameraGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := p.find(ctx, func(g dsm.SurveillanceCameraGroup) bool {
+		return g.ID == data.ID.ValueInt64()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list camera groups", err.Error())
+		return
+	}
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *group, p.stamp)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *CameraGroupResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A Surveillance Station camera group. Deleting the group keeps its cameras.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the group.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"camera_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the cameras in the group.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (p *CameraGroupResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeSurveillanceManager); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *CameraGroupResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := p.find(ctx, func(g dsm.SurveillanceCameraGroup) bool {
+		return g.Name == name
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list camera groups", err.Error())
+		return
+	}
+	if group == nil {
+		resp.Diagnostics.AddError("Camera group not found", fmt.Sprintf("Camera group %q not found", name))
+		return
+	}
+
+	var data CameraGroupResourceModel
+	resp.Diagnostics.Append(data.set(ctx, *group, p.stamp)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *CameraGroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the camera group.")
+}
+
+func (p *CameraGroupResource) find(
+	ctx context.Context,
+	match func(dsm.SurveillanceCameraGroup) bool,
+) (*dsm.SurveillanceCameraGroup, error) {
+	list, err := p.client.SurveillanceCameraGroupList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Groups, match)
+	if i == -1 {
+		return nil, nil
+	}
+	return &list.Groups[i], nil
+}
+
+// save creates or replaces the group of data and sets its ID.
+func (p *CameraGroupResource) save(ctx context.Context, data *CameraGroupResourceModel) diag.Diagnostics {
+	group, diags := data.group(ctx, p.stamp)
+	if diags.HasError() {
+		return diags
+	}
+
+	saved, err := p.client.SurveillanceCameraGroupSave(ctx, group)
+	if err != nil {
+		diags.AddError("Failed to save camera group", err.Error())
+		return diags
+	}
+	data.ID = types.Int64Value(saved.ID)
+
+	return diags
+}
+
+// checkStamp refuses changes to the camera group with the ID when the
+// provider requires a description stamp the group lacks.
+func (p *CameraGroupResource) checkStamp(ctx context.Context, id int64) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	group, err := p.find(ctx, func(g dsm.SurveillanceCameraGroup) bool {
+		return g.ID == id
+	})
+	if err != nil {
+		diags.AddError("Failed to list camera groups", err.Error())
+		return
+	}
+	if group == nil {
+		return
+	}
+
+	if err := p.stamp.Check("camera group", group.Description); err != nil {
+		diags.AddError("Refusing to modify camera group", err.Error())
+	}
+	return
+}
@@ -0,0 +1,41 @@
+package surveillance_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type CameraGroupResource struct{}
+
+func TestAccCameraGroupResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"group has cameras",
+			`
+			resource "synology_surveillance_camera_group" "foo" {
+				name       = "entrance"
+				camera_ids = [1, 2]
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_surveillance_camera_group.foo", "camera_ids.#", "2"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
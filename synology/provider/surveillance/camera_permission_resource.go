@@ -0,0 +1,338 @@
+package surveillance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type CameraPermissionResourceModel struct {
+	Profile  types.String `tfsdk:"profile"`
+	CameraID types.Int64  `tfsdk:"camera_id"`
+	LiveView types.Bool   `tfsdk:"live_view"`
+	Playback types.Bool   `tfsdk:"playback"`
+	PTZ      types.Bool   `tfsdk:"ptz"`
+	Audio    types.Bool   `tfsdk:"audio"`
+}
+
+func (m CameraPermissionResourceModel) id() string {
+	return fmt.Sprintf("%s/%d", m.Profile.ValueString(), m.CameraID.ValueInt64())
+}
+
+func (m CameraPermissionResourceModel) privilege() dsm.SurveillanceCameraPrivilege {
+	return dsm.SurveillanceCameraPrivilege{
+		CameraID: m.CameraID.ValueInt64(),
+		LiveView: m.LiveView.ValueBool(),
+		Playback: m.Playback.ValueBool(),
+		PTZ:      m.PTZ.ValueBool(),
+		Audio:    m.Audio.ValueBool(),
+	}
+}
+
+func (m *CameraPermissionResourceModel) set(c dsm.SurveillanceCameraPrivilege) {
+	m.CameraID = types.Int64Value(c.CameraID)
+	m.LiveView = types.BoolValue(c.LiveView)
+	m.Playback = types.BoolValue(c.Playback)
+	m.PTZ = types.BoolValue(c.PTZ)
+	m.Audio = types.BoolValue(c.Audio)
+}
+
+var (
+	_ resource.Resource             = &CameraPermissionResource{}
+	_ resource.ResourceWithIdentity = &CameraPermissionResource{}
+)
+
+func NewCameraPermissionResource() resource.Resource {
+	return &CameraPermissionResource{}
+}
+
+type CameraPermissionResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *CameraPermissionResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data CameraPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Update implements resource.Resource.
+func (p *CameraPermissionResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data CameraPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Delete implements resource.Resource. The camera is hidden from the users
+// of the profile.
+func (p *CameraPermissionResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data CameraPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *CameraPermissionResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "camera_permission")
+}
+
+// Read implements resource.Resource.
+func (p *CameraPermissionResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data CameraPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := findProfile(ctx, p.client, func(v dsm.SurveillancePrivilegeProfile) bool {
+		return v.Name == data.Profile.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list privilege profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	i := slices.IndexFunc(profile.Cameras, func(c dsm.SurveillanceCameraPrivilege) bool {
+		return c.CameraID == data.CameraID.ValueInt64()
+	})
+	if i == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(profile.Cameras[i])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Schema implements resource.Resource.
+func (p *CameraPermissionResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants the users of a Surveillance Station privilege profile access to a camera. Cameras of other grants are left untouched; cameras without a grant are hidden from the users of the profile.",
+
+		Attributes: map[string]schema.Attribute{
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The name of the privilege profile.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"camera_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the camera.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"live_view": schema.BoolAttribute{
+				MarkdownDescription: "Whether the users may watch the live view.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"playback": schema.BoolAttribute{
+				MarkdownDescription: "Whether the users may play back recordings.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"ptz": schema.BoolAttribute{
+				MarkdownDescription: "Whether the users may control pan, tilt and zoom.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"audio": schema.BoolAttribute{
+				MarkdownDescription: "Whether the users may listen to the audio.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (p *CameraPermissionResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeSurveillanceManager); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *CameraPermissionResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, camera, _ := strings.Cut(id, "/")
+	cameraID, err := strconv.ParseInt(camera, 10, 64)
+	if name == "" || err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected profile/camera_id, got %q.", id))
+		return
+	}
+
+	profile, err := profileByName(ctx, p.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find privilege profile", err.Error())
+		return
+	}
+
+	i := slices.IndexFunc(profile.Cameras, func(c dsm.SurveillanceCameraPrivilege) bool {
+		return c.CameraID == cameraID
+	})
+	if i == -1 {
+		resp.Diagnostics.AddError("Camera permission not found", fmt.Sprintf("Camera permission %s not found", id))
+		return
+	}
+
+	data := CameraPermissionResourceModel{Profile: types.StringValue(name)}
+	data.set(profile.Cameras[i])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *CameraPermissionResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The grant in the form `profile/camera_id`.")
+}
+
+// apply replaces the grant of the camera of data in its profile, or removes
+// it when grant is false.
+func (p *CameraPermissionResource) apply(ctx context.Context, data CameraPermissionResourceModel, grant bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+
+	profile, err := profileByName(ctx, p.client, data.Profile.ValueString())
+	if err != nil {
+		diags.AddError("Failed to find privilege profile", err.Error())
+		return diags
+	}
+
+	cameras := slices.DeleteFunc(profile.Cameras, func(c dsm.SurveillanceCameraPrivilege) bool {
+		return c.CameraID == data.CameraID.ValueInt64()
+	})
+	if grant {
+		cameras = append(cameras, data.privilege())
+	}
+	profile.Cameras = cameras
+
+	if err := p.client.SurveillanceProfileSet(ctx, *profile); err != nil {
+		diags.AddError("Failed to update privilege profile", err.Error())
+	}
+
+	return diags
+}
@@ -0,0 +1,346 @@
+package surveillance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+// emapsMu serializes the read-modify-write of the items of an e-map.
+var emapsMu sync.Mutex
+
+type EmapPlacementResourceModel struct {
+	Emap     types.String `tfsdk:"emap"`
+	CameraID types.Int64  `tfsdk:"camera_id"`
+	X        types.Int64  `tfsdk:"x"`
+	Y        types.Int64  `tfsdk:"y"`
+}
+
+func (m EmapPlacementResourceModel) id() string {
+	return fmt.Sprintf("%s/%d", m.Emap.ValueString(), m.CameraID.ValueInt64())
+}
+
+func (m EmapPlacementResourceModel) item() dsm.SurveillanceEmapItem {
+	return dsm.SurveillanceEmapItem{
+		Type:   dsm.SurveillanceEmapItemCamera,
+		ItemID: m.CameraID.ValueInt64(),
+		X:      m.X.ValueInt64(),
+		Y:      m.Y.ValueInt64(),
+	}
+}
+
+// isCamera returns whether item places the camera id.
+func isCamera(id int64) func(dsm.SurveillanceEmapItem) bool {
+	return func(item dsm.SurveillanceEmapItem) bool {
+		return item.Type == dsm.SurveillanceEmapItemCamera && item.ItemID == id
+	}
+}
+
+var (
+	_ resource.Resource             = &EmapPlacementResource{}
+	_ resource.ResourceWithIdentity = &EmapPlacementResource{}
+)
+
+func NewEmapPlacementResource() resource.Resource {
+	return &EmapPlacementResource{}
+}
+
+type EmapPlacementResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *EmapPlacementResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data EmapPlacementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Update implements resource.Resource.
+func (p *EmapPlacementResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data EmapPlacementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Delete implements resource.Resource.
+func (p *EmapPlacementResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data EmapPlacementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.apply(ctx, data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *EmapPlacementResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "emap_placement")
+}
+
+// Read implements resource.Resource.
+func (p *EmapPlacementResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data EmapPlacementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emap, err := p.find(ctx, data.Emap.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list e-maps", err.Error())
+		return
+	}
+	if emap == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	i := slices.IndexFunc(emap.Items, isCamera(data.CameraID.ValueInt64()))
+	if i == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.X = types.Int64Value(emap.Items[i].X)
+	data.Y = types.Int64Value(emap.Items[i].Y)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// Schema implements resource.Resource.
+func (p *EmapPlacementResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Places a camera on a Surveillance Station e-map. The e-map and its floor plan image are added in Surveillance Station; other items on the map are left untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"emap": schema.StringAttribute{
+				MarkdownDescription: "The name of the e-map.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"camera_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the camera.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"x": schema.Int64Attribute{
+				MarkdownDescription: "The horizontal position in pixels of the map image, from the left.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"y": schema.Int64Attribute{
+				MarkdownDescription: "The vertical position in pixels of the map image, from the top.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (p *EmapPlacementResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeSurveillanceManager); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *EmapPlacementResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, camera, _ := strings.Cut(id, "/")
+	cameraID, err := strconv.ParseInt(camera, 10, 64)
+	if name == "" || err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected emap/camera_id, got %q.", id))
+		return
+	}
+
+	emap, err := p.find(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list e-maps", err.Error())
+		return
+	}
+
+	i := -1
+	if emap != nil {
+		i = slices.IndexFunc(emap.Items, isCamera(cameraID))
+	}
+	if i == -1 {
+		resp.Diagnostics.AddError("E-map placement not found", fmt.Sprintf("E-map placement %s not found", id))
+		return
+	}
+
+	data := EmapPlacementResourceModel{
+		Emap:     types.StringValue(name),
+		CameraID: types.Int64Value(cameraID),
+		X:        types.Int64Value(emap.Items[i].X),
+		Y:        types.Int64Value(emap.Items[i].Y),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.id())...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *EmapPlacementResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The placement in the form `emap/camera_id`.")
+}
+
+// find returns the e-map called name, or nil.
+func (p *EmapPlacementResource) find(ctx context.Context, name string) (*dsm.SurveillanceEmap, error) {
+	list, err := p.client.SurveillanceEmapList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Emaps, func(e dsm.SurveillanceEmap) bool {
+		return e.Name == name
+	})
+	if i == -1 {
+		return nil, nil
+	}
+	return &list.Emaps[i], nil
+}
+
+// apply replaces the placement of the camera of data on its e-map, or removes
+// it when place is false.
+func (p *EmapPlacementResource) apply(ctx context.Context, data EmapPlacementResourceModel, place bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	emapsMu.Lock()
+	defer emapsMu.Unlock()
+
+	emap, err := p.find(ctx, data.Emap.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list e-maps", err.Error())
+		return diags
+	}
+	if emap == nil {
+		diags.AddError("E-map not found", fmt.Sprintf("E-map %q not found", data.Emap.ValueString()))
+		return diags
+	}
+
+	items := slices.DeleteFunc(emap.Items, isCamera(data.CameraID.ValueInt64()))
+	if place {
+		items = append(items, data.item())
+	}
+
+	if err := p.client.SurveillanceEmapSet(ctx, emap.ID, items); err != nil {
+		diags.AddError("Failed to update e-map", err.Error())
+	}
+
+	return diags
+}
@@ -0,0 +1,43 @@
+package surveillance_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type EmapPlacementResource struct{}
+
+func TestAccEmapPlacementResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"camera is placed",
+			`
+			resource "synology_surveillance_emap_placement" "foo" {
+				emap      = "ground floor"
+				camera_id = 1
+				x         = 120
+				y         = 80
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_surveillance_emap_placement.foo", "x", "120"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package surveillance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type PrivilegeProfileResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// set updates m from p, removing the description stamp of the provider.
+func (m *PrivilegeProfileResourceModel) set(p dsm.SurveillancePrivilegeProfile, stamp synoclient.Stamp) {
+	m.ID = types.Int64Value(p.ID)
+	m.Name = types.StringValue(p.Name)
+	m.Description = types.StringValue(stamp.Strip(p.Description))
+}
+
+var (
+	_ resource.Resource             = &PrivilegeProfileResource{}
+	_ resource.ResourceWithIdentity = &PrivilegeProfileResource{}
+)
+
+func NewPrivilegeProfileResource() resource.Resource {
+	return &PrivilegeProfileResource{}
+}
+
+type PrivilegeProfileResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *PrivilegeProfileResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data PrivilegeProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+
+	profile, err := p.client.SurveillanceProfileCreate(ctx, dsm.SurveillancePrivilegeProfile{
+		Name:        data.Name.ValueString(),
+		Description: p.stamp.Apply(data.Description.ValueString()),
+		Users:       []string{},
+		Cameras:     []dsm.SurveillanceCameraPrivilege{},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create privilege profile", err.Error())
+		return
+	}
+	data.ID = types.Int64Value(profile.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Update implements resource.Resource. The users and cameras of the profile
+// are kept.
+func (p *PrivilegeProfileResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data PrivilegeProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+
+	profile, err := findProfile(ctx, p.client, func(v dsm.SurveillancePrivilegeProfile) bool {
+		return v.ID == data.ID.ValueInt64()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list privilege profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		resp.Diagnostics.AddError(
+			"Privilege profile not found",
+			fmt.Sprintf("Privilege profile %d no longer exists.", data.ID.ValueInt64()),
+		)
+		return
+	}
+
+	if err := p.stamp.Check("privilege profile", profile.Description); err != nil {
+		resp.Diagnostics.AddError("Refusing to modify privilege profile", err.Error())
+		return
+	}
+
+	profile.Name = data.Name.ValueString()
+	profile.Description = p.stamp.Apply(data.Description.ValueString())
+	if err := p.client.SurveillanceProfileSet(ctx, *profile); err != nil {
+		resp.Diagnostics.AddError("Failed to update privilege profile", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *PrivilegeProfileResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data PrivilegeProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.ID.ValueInt64())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.SurveillanceProfileDelete(ctx, data.ID.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete privilege profile", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *PrivilegeProfileResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "privilege_profile")
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// Read implements resource.Resource.
+func (p *PrivilegeProfileResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data PrivilegeProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := findProfile(ctx, p.client, func(v dsm.SurveillancePrivilegeProfile) bool {
+		return v.ID == data.ID.ValueInt64()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list privilege profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*profile, p.stamp)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *PrivilegeProfileResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A Surveillance Station privilege profile. Surveillance Station has its own privilege model: DSM users are assigned to a profile with `synology_surveillance_user` and the cameras of the profile are granted with `synology_surveillance_camera_permission`. Deleting a profile takes Surveillance Station access away from its users.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the profile.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the profile.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the profile.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (p *PrivilegeProfileResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeSurveillanceManager); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *PrivilegeProfileResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	name, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := profileByName(ctx, p.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find privilege profile", err.Error())
+		return
+	}
+
+	var data PrivilegeProfileResourceModel
+	data.set(*profile, p.stamp)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", name)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *PrivilegeProfileResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the privilege profile.")
+}
+
+// checkStamp refuses changes to the privilege profile with the ID when the
+// provider requires a description stamp the profile lacks.
+func (p *PrivilegeProfileResource) checkStamp(ctx context.Context, id int64) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	profile, err := findProfile(ctx, p.client, func(v dsm.SurveillancePrivilegeProfile) bool {
+		return v.ID == id
+	})
+	if err != nil {
+		diags.AddError("Failed to list privilege profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		return
+	}
+
+	if err := p.stamp.Check("privilege profile", profile.Description); err != nil {
+		diags.AddError("Refusing to modify privilege profile", err.Error())
+	}
+	return
+}
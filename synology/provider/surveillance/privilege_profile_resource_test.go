@@ -0,0 +1,41 @@
+package surveillance_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type PrivilegeProfileResource struct{}
+
+func TestAccPrivilegeProfileResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"profile is created",
+			`
+			resource "synology_surveillance_privilege_profile" "foo" {
+				name        = "guards"
+				description = "Security guards"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_surveillance_privilege_profile.foo", "name", "guards"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
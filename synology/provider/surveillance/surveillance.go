@@ -0,0 +1,65 @@
+// Package surveillance contains the resources of the Surveillance Station
+// package.
+package surveillance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// profilesMu serializes the read-modify-write of privilege profiles, which
+// are shared by the profile, user and camera permission resources.
+var profilesMu sync.Mutex
+
+func buildName(providerName, resourceName string) string {
+	return providerName + "_surveillance_" + resourceName
+}
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewPrivilegeProfileResource,
+		NewUserResource,
+		NewCameraPermissionResource,
+		NewCameraGroupResource,
+		NewEmapPlacementResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+// findProfile returns the privilege profile matching match, or nil.
+func findProfile(
+	ctx context.Context,
+	c *dsm.Client,
+	match func(dsm.SurveillancePrivilegeProfile) bool,
+) (*dsm.SurveillancePrivilegeProfile, error) {
+	list, err := c.SurveillanceProfileList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Profiles, match)
+	if i == -1 {
+		return nil, nil
+	}
+	return &list.Profiles[i], nil
+}
+
+// profileByName returns the privilege profile called name.
+func profileByName(ctx context.Context, c *dsm.Client, name string) (*dsm.SurveillancePrivilegeProfile, error) {
+	profile, err := findProfile(ctx, c, func(p dsm.SurveillancePrivilegeProfile) bool {
+		return p.Name == name
+	})
+	if err == nil && profile == nil {
+		err = fmt.Errorf("privilege profile %q not found", name)
+	}
+	return profile, err
+}
@@ -0,0 +1,277 @@
+package surveillance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type UserResourceModel struct {
+	User    types.String `tfsdk:"user"`
+	Profile types.String `tfsdk:"profile"`
+}
+
+var (
+	_ resource.Resource             = &UserResource{}
+	_ resource.ResourceWithIdentity = &UserResource{}
+)
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *UserResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.assign(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "user", data.User.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *UserResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.assign(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "user", data.User.ValueString())...)
+}
+
+// Delete implements resource.Resource. The user is removed from the profile,
+// taking away their Surveillance Station access.
+func (p *UserResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+
+	profile, err := profileByName(ctx, p.client, data.Profile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find privilege profile", err.Error())
+		return
+	}
+
+	profile.Users = slices.DeleteFunc(profile.Users, func(u string) bool {
+		return u == data.User.ValueString()
+	})
+	if err := p.client.SurveillanceProfileSet(ctx, *profile); err != nil {
+		resp.Diagnostics.AddError("Failed to update privilege profile", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *UserResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "user")
+}
+
+// Read implements resource.Resource.
+func (p *UserResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := findProfile(ctx, p.client, func(v dsm.SurveillancePrivilegeProfile) bool {
+		return slices.Contains(v.Users, data.User.ValueString())
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list privilege profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Profile = types.StringValue(profile.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "user", data.User.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *UserResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Gives a DSM user access to Surveillance Station through a privilege profile. A user belongs to a single profile, so changing the profile moves the user. Destroying the resource takes Surveillance Station access away from the user.",
+
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The name of the DSM user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The name of the privilege profile, e.g. `synology_surveillance_privilege_profile.x.name`.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (p *UserResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeSurveillanceManager); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *UserResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	user, diags := util.ImportID(ctx, req, "user")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := findProfile(ctx, p.client, func(v dsm.SurveillancePrivilegeProfile) bool {
+		return slices.Contains(v.Users, user)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list privilege profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		resp.Diagnostics.AddError(
+			"Surveillance Station user not found",
+			fmt.Sprintf("User %q is in no privilege profile.", user),
+		)
+		return
+	}
+
+	data := UserResourceModel{
+		User:    types.StringValue(user),
+		Profile: types.StringValue(profile.Name),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "user", user)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *UserResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("user", "The name of the DSM user.")
+}
+
+// assign adds the user to the profile of data. Surveillance Station moves the
+// user out of their former profile.
+func (p *UserResource) assign(ctx context.Context, data UserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+
+	profile, err := profileByName(ctx, p.client, data.Profile.ValueString())
+	if err != nil {
+		diags.AddError("Failed to find privilege profile", err.Error())
+		return diags
+	}
+
+	if slices.Contains(profile.Users, data.User.ValueString()) {
+		return diags
+	}
+
+	profile.Users = append(profile.Users, data.User.ValueString())
+	if err := p.client.SurveillanceProfileSet(ctx, *profile); err != nil {
+		diags.AddError("Failed to update privilege profile", err.Error())
+	}
+
+	return diags
+}
@@ -0,0 +1,45 @@
+package surveillance_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type UserResource struct{}
+
+func TestAccUserResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"user is assigned",
+			`
+			resource "synology_surveillance_privilege_profile" "foo" {
+				name = "guards"
+			}
+
+			resource "synology_surveillance_user" "foo" {
+				user    = "admin"
+				profile = synology_surveillance_privilege_profile.foo.name
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_surveillance_user.foo", "profile", "guards"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
@@ -18,18 +19,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/synology-community/go-synology"
 	"github.com/synology-community/go-synology/pkg/api/virtualization"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
 	"github.com/synology-community/terraform-provider-synology/synology/provider/virtualization/models"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &GuestResource{}
+var (
+	_ resource.Resource             = &GuestResource{}
+	_ resource.ResourceWithIdentity = &GuestResource{}
+)
 
 func NewGuestResource() resource.Resource {
 	return &GuestResource{}
 }
 
 type GuestResource struct {
-	client virtualization.Api
+	client    virtualization.Api
+	dsmClient *dsm.Client
 }
 
 type GuestIsoModel struct {
@@ -46,12 +54,15 @@ type GuestResourceModel struct {
 	StorageID   types.String `tfsdk:"storage_id"`
 	StorageName types.String `tfsdk:"storage_name"`
 	// AutoRun     types.Int64  `tfsdk:"autorun"`
-	VcpuNum   types.Int64 `tfsdk:"vcpu_num"`
-	VramSize  types.Int64 `tfsdk:"vram_size"`
-	Disks     types.Set   `tfsdk:"disk"`
-	Networks  types.Set   `tfsdk:"network"`
-	IsoImages types.Set   `tfsdk:"iso"`
-	Run       types.Bool  `tfsdk:"run"`
+	VcpuNum     types.Int64 `tfsdk:"vcpu_num"`
+	VramSize    types.Int64 `tfsdk:"vram_size"`
+	Disks       types.Set   `tfsdk:"disk"`
+	Networks    types.Set   `tfsdk:"network"`
+	IsoImages   types.Set   `tfsdk:"iso"`
+	Run         types.Bool  `tfsdk:"run"`
+	ProtectData types.Bool  `tfsdk:"protect_data"`
+
+	Host types.String `tfsdk:"host"`
 }
 
 // Schema implements resource.Resource.
@@ -101,6 +112,16 @@ func (f *GuestResource) Schema(
 				MarkdownDescription: "Run the guest.",
 				Optional:            true,
 			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The name of the Virtual Machine Manager cluster host running the guest. The guest is started on this host when `run` is set. Changing it live-migrates a running guest; a stopped guest is not moved. Leave unset to let the cluster choose.",
+				Optional:            true,
+			},
+			"protect_data": schema.BoolAttribute{
+				MarkdownDescription: "If true, destroying the guest fails instead of deleting its virtual disks. Defaults to `true`, set to `false` and apply before destroying the guest.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"disk": schema.SetNestedBlock{
@@ -310,11 +331,16 @@ func (f *GuestResource) Create(
 	}
 
 	if data.Run.ValueBool() {
-		_ = f.client.GuestPowerOn(c, virtualization.Guest{Name: data.Name.ValueString()})
+		if data.Host.ValueString() != "" {
+			_ = f.dsmClient.GuestPowerOnHost(c, data.Name.ValueString(), data.Host.ValueString())
+		} else {
+			_ = f.client.GuestPowerOn(c, virtualization.Guest{Name: data.Name.ValueString()})
+		}
 	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
 
 	tflog.Trace(ctx, "Guest created")
 }
@@ -330,6 +356,21 @@ func (f *GuestResource) Delete(
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ProtectData.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Guest is protected",
+			fmt.Sprintf(
+				"Guest %q has protect_data enabled, its virtual disks were not deleted. Set protect_data = false and apply before destroying it.",
+				data.Name.ValueString(),
+			),
+		)
+		return
+	}
+
 	_ = f.client.GuestPowerOff(ctx, virtualization.Guest{
 		Name: data.Name.ValueString(),
 	})
@@ -382,6 +423,7 @@ func (f *GuestResource) Read(
 	// }
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
 }
 
 // Update implements resource.Resource.
@@ -390,9 +432,10 @@ func (f *GuestResource) Update(
 	req resource.UpdateRequest,
 	resp *resource.UpdateResponse,
 ) {
-	var data GuestResourceModel
+	var data, state GuestResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	var isoImages []string
 
@@ -434,8 +477,31 @@ func (f *GuestResource) Update(
 		return
 	}
 
+	if host := data.Host.ValueString(); host != "" && host != state.Host.ValueString() {
+		guest, err := f.client.GuestGet(ctx, virtualization.Guest{Name: data.Name.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to get guest",
+				fmt.Sprintf("Unable to get guest, got error: %s", err),
+			)
+			return
+		}
+
+		// Only a running guest can be migrated without shutting it down.
+		if guest.Status == "running" {
+			if err := f.dsmClient.GuestMigrate(ctx, data.Name.ValueString(), host); err != nil {
+				resp.Diagnostics.AddError(
+					"Failed to migrate guest",
+					fmt.Sprintf("Unable to migrate guest to host %q, got error: %s", host, err),
+				)
+				return
+			}
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", data.Name.ValueString())...)
 }
 
 // Metadata implements resource.Resource.
@@ -445,6 +511,7 @@ func (f *GuestResource) Metadata(
 	resp *resource.MetadataResponse,
 ) {
 	resp.TypeName = buildName(req.ProviderTypeName, "guest")
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (f *GuestResource) Configure(
@@ -471,7 +538,13 @@ func (f *GuestResource) Configure(
 		return
 	}
 
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
 	f.client = client.VirtualizationAPI()
+	f.dsmClient = dsm.New(client)
 }
 
 // ValidateConfig.
@@ -508,7 +581,11 @@ func (f *GuestResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	guestName := req.ID
+	guestName, diags := util.ImportID(ctx, req, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	guest, err := f.client.GuestGet(ctx, virtualization.Guest{Name: guestName})
 	if err != nil {
@@ -531,4 +608,15 @@ func (f *GuestResource) ImportState(
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), guest.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("storage_id"), storageID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("storage_name"), storageName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("protect_data"), true)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "name", guestName)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *GuestResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the guest.")
 }
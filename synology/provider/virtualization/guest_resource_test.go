@@ -2,6 +2,7 @@ package virtualization_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -21,6 +22,7 @@ func TestAccGuestResource_basic(t *testing.T) {
 			resource "synology_virtualization_guest" "foo" {
 				name         = "testvm"
 				storage_name = "default"
+				protect_data = false
 
 				vcpu_num  = 4
 				vram_size = 4096
@@ -38,6 +40,25 @@ func TestAccGuestResource_basic(t *testing.T) {
 				}
 			}`,
 		},
+		{
+			"guest pinned to a host",
+			`
+			resource "synology_virtualization_guest" "foo" {
+				name         = "testvm"
+				storage_name = "default"
+				host         = "nas-02"
+				run          = true
+				protect_data = false
+
+				network {
+					name = "default"
+				}
+
+				disk {
+					size = 20000
+				}
+			}`,
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.Name, func(t *testing.T) {
@@ -67,3 +88,43 @@ func TestAccGuestResource_basic(t *testing.T) {
 		})
 	}
 }
+
+func TestAccGuestResource_protectData(t *testing.T) {
+	config := func(protect string) string {
+		return fmt.Sprintf(`
+		resource "synology_virtualization_guest" "foo" {
+			name         = "testvm"
+			storage_name = "default"
+			%s
+
+			network {
+				name = "default"
+			}
+
+			disk {
+				size = 20000
+			}
+		}`, protect)
+	}
+
+	r.UnitTest(t, r.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+		Steps: []r.TestStep{
+			{
+				// Guests are protected unless protect_data is set.
+				Config: config(""),
+				Check:  r.TestCheckResourceAttr("synology_virtualization_guest.foo", "protect_data", "true"),
+			},
+			{
+				Config:      config(""),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Guest is protected"),
+			},
+			{
+				// Lift the protection so that the guest can be destroyed at
+				// the end of the test.
+				Config: config("protect_data = false"),
+			},
+		},
+	})
+}
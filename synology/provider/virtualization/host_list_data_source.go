@@ -0,0 +1,169 @@
+package virtualization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HostListDataSource{}
+
+func NewHostListDataSource() datasource.DataSource {
+	return &HostListDataSource{}
+}
+
+type HostListDataSource struct {
+	client *dsm.Client
+}
+
+type HostListDataSourceModel struct {
+	Host types.List `tfsdk:"host"`
+}
+
+type HostModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Status      types.String `tfsdk:"status"`
+	CPUCores    types.Int64  `tfsdk:"cpu_cores"`
+	FreeCPUCore types.Int64  `tfsdk:"free_cpu_cores"`
+	MemoryMB    types.Int64  `tfsdk:"memory_mb"`
+	FreeMemory  types.Int64  `tfsdk:"free_memory_mb"`
+}
+
+func (m HostModel) ModelType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":             types.StringType,
+		"name":           types.StringType,
+		"status":         types.StringType,
+		"cpu_cores":      types.Int64Type,
+		"free_cpu_cores": types.Int64Type,
+		"memory_mb":      types.Int64Type,
+		"free_memory_mb": types.Int64Type,
+	}}
+}
+
+func (d *HostListDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "host_list")
+}
+
+func (d *HostListDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the hosts of the Virtual Machine Manager cluster with their free resources, e.g. to choose the `host` of a guest.",
+
+		Attributes: map[string]schema.Attribute{
+			"host": schema.ListNestedAttribute{
+				MarkdownDescription: "The hosts of the cluster.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the host.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the host.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the host, e.g. `healthy`.",
+							Computed:            true,
+						},
+						"cpu_cores": schema.Int64Attribute{
+							MarkdownDescription: "The number of CPU cores.",
+							Computed:            true,
+						},
+						"free_cpu_cores": schema.Int64Attribute{
+							MarkdownDescription: "The number of CPU cores not reserved by guests.",
+							Computed:            true,
+						},
+						"memory_mb": schema.Int64Attribute{
+							MarkdownDescription: "The memory in MiB.",
+							Computed:            true,
+						},
+						"free_memory_mb": schema.Int64Attribute{
+							MarkdownDescription: "The memory in MiB not reserved by guests.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HostListDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	hosts, err := d.client.VirtualizationHostList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"API request failed",
+			fmt.Sprintf("Unable to list hosts, got error: %s", err),
+		)
+		return
+	}
+
+	elements := make([]HostModel, 0, len(hosts))
+	for _, h := range hosts {
+		elements = append(elements, HostModel{
+			ID:          types.StringValue(h.ID),
+			Name:        types.StringValue(h.Name),
+			Status:      types.StringValue(h.Status),
+			CPUCores:    types.Int64Value(h.TotalCPUCore),
+			FreeCPUCore: types.Int64Value(h.FreeCPUCore),
+			MemoryMB:    types.Int64Value(h.TotalRAMSize),
+			FreeMemory:  types.Int64Value(h.FreeRAMSize),
+		})
+	}
+
+	var data HostListDataSourceModel
+	v, diags := types.ListValueFrom(ctx, HostModel{}.ModelType(), elements)
+	resp.Diagnostics.Append(diags...)
+	data.Host = v
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *HostListDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = dsm.New(client)
+}
@@ -13,10 +13,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/synology-community/go-synology"
 	"github.com/synology-community/go-synology/pkg/api/virtualization"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ resource.Resource = &ImageResource{}
+var (
+	_ resource.Resource             = &ImageResource{}
+	_ resource.ResourceWithIdentity = &ImageResource{}
+)
 
 func NewImageResource() resource.Resource {
 	return &ImageResource{}
@@ -154,6 +159,7 @@ func (f *ImageResource) Create(
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Name.ValueString())...)
 }
 
 // Delete implements resource.Resource.
@@ -204,6 +210,7 @@ func (f *ImageResource) Read(
 	}
 
 	resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "path", data.Name.ValueString())...)
 }
 
 func (f *ImageResource) getImage(ctx context.Context, name string) (*virtualization.Image, error) {
@@ -269,5 +276,19 @@ func (f *ImageResource) Configure(
 		return
 	}
 
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
 	f.client = client.VirtualizationAPI()
 }
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *ImageResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("name", "The name of the image.")
+}
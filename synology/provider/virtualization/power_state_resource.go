@@ -0,0 +1,271 @@
+package virtualization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/synology-community/go-synology"
+	"github.com/synology-community/go-synology/pkg/api/virtualization"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+const (
+	powerStateRunning   = "running"
+	powerStateShutdown  = "shutdown"
+	powerStateForcedOff = "forced_off"
+)
+
+// powerStateTimeout bounds how long a guest may take to boot or shut down.
+const powerStateTimeout = 10 * time.Minute
+
+type PowerStateResourceModel struct {
+	Guest types.String `tfsdk:"guest"`
+	State types.String `tfsdk:"state"`
+	Host  types.String `tfsdk:"host"`
+}
+
+var (
+	_ resource.Resource             = &PowerStateResource{}
+	_ resource.ResourceWithIdentity = &PowerStateResource{}
+)
+
+func NewPowerStateResource() resource.Resource {
+	return &PowerStateResource{}
+}
+
+type PowerStateResource struct {
+	client    virtualization.Api
+	dsmClient *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (f *PowerStateResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data PowerStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(f.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "guest", data.Guest.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (f *PowerStateResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data PowerStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(f.apply(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "guest", data.Guest.ValueString())...)
+}
+
+// Delete implements resource.Resource. The guest is left in its current power
+// state.
+func (f *PowerStateResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+}
+
+// Metadata implements resource.Resource.
+func (f *PowerStateResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "power_state")
+}
+
+// Read implements resource.Resource.
+func (f *PowerStateResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data PowerStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guest, err := f.client.GuestGet(ctx, virtualization.Guest{Name: data.Guest.ValueString()})
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to get guest", err.Error())
+		return
+	}
+
+	// DSM does not tell how a guest was stopped, so either of the stopped
+	// states matches a stopped guest.
+	if guest.Status == powerStateRunning {
+		data.State = types.StringValue(powerStateRunning)
+	} else if data.State.ValueString() == powerStateRunning {
+		data.State = types.StringValue(powerStateShutdown)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "guest", data.Guest.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (f *PowerStateResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The power state of a Virtual Machine Manager guest, kept apart from the guest definition. Use `depends_on` between power states to change guests in order, e.g. start the database before the application; applying waits until each guest is running or stopped. Terraform always changes dependencies first, so a shutdown in reverse order needs power states depending on each other the other way round. Destroying the resource leaves the guest as it is.",
+
+		Attributes: map[string]schema.Attribute{
+			"guest": schema.StringAttribute{
+				MarkdownDescription: "The name of the guest.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The power state: `running`, `shutdown` to shut the guest down through ACPI or `forced_off` to power it off at once.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(powerStateRunning, powerStateShutdown, powerStateForcedOff),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The name of the cluster host to start the guest on. Leave unset to let the cluster choose.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (f *PowerStateResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	f.client = client.VirtualizationAPI()
+	f.dsmClient = dsm.New(client)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (f *PowerStateResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("guest", "The name of the guest.")
+}
+
+// apply brings the guest into the power state of data and waits until it got
+// there.
+func (f *PowerStateResource) apply(ctx context.Context, data PowerStateResourceModel) (diags diag.Diagnostics) {
+	name := data.Guest.ValueString()
+	want := data.State.ValueString()
+
+	guest, err := f.client.GuestGet(ctx, virtualization.Guest{Name: name})
+	if err != nil {
+		diags.AddError("Failed to get guest", err.Error())
+		return diags
+	}
+
+	running := guest.Status == powerStateRunning
+	if running == (want == powerStateRunning) {
+		return diags
+	}
+
+	switch {
+	case want == powerStateRunning && data.Host.ValueString() != "":
+		err = f.dsmClient.GuestPowerOnHost(ctx, name, data.Host.ValueString())
+	case want == powerStateRunning:
+		err = f.client.GuestPowerOn(ctx, virtualization.Guest{Name: name})
+	case want == powerStateShutdown:
+		err = f.dsmClient.GuestShutdown(ctx, name)
+	default:
+		err = f.client.GuestPowerOff(ctx, virtualization.Guest{Name: name})
+	}
+	if err != nil {
+		diags.AddError("Failed to change power state", fmt.Sprintf("%s: %s", name, err))
+		return diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, powerStateTimeout)
+	defer cancel()
+
+	err = util.Poll(ctx, 5*time.Second, func() (bool, error) {
+		guest, err := f.client.GuestGet(ctx, virtualization.Guest{Name: name})
+		if err != nil {
+			return false, err
+		}
+		return (guest.Status == powerStateRunning) == (want == powerStateRunning), nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to wait for guest",
+			fmt.Sprintf("%s did not reach the state %s: %s", name, want, err),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,52 @@
+package virtualization_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type PowerStateResource struct{}
+
+func TestAccPowerStateResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+		State         string
+	}{
+		{
+			"guest is running",
+			`
+			resource "synology_virtualization_power_state" "foo" {
+				guest = "testvm"
+				state = "running"
+			}`,
+			"running",
+		},
+		{
+			"guest is shut down",
+			`
+			resource "synology_virtualization_power_state" "foo" {
+				guest = "testvm"
+				state = "shutdown"
+			}`,
+			"shutdown",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_virtualization_power_state.foo", "state", tt.State),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -13,6 +13,7 @@ func Resources() []func() resource.Resource {
 	return []func() resource.Resource{
 		NewImageResource,
 		NewGuestResource,
+		NewPowerStateResource,
 	}
 }
 
@@ -20,5 +21,6 @@ func DataSources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewGuestDataSource,
 		NewGuestListDataSource,
+		NewHostListDataSource,
 	}
 }
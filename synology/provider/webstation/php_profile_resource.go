@@ -0,0 +1,410 @@
+package webstation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type PHPProfileResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Version     types.String `tfsdk:"version"`
+	Extensions  types.Set    `tfsdk:"extensions"`
+	Settings    types.Map    `tfsdk:"settings"`
+}
+
+func (m PHPProfileResourceModel) profile(ctx context.Context) (dsm.WebStationPHPProfile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	profile := dsm.WebStationPHPProfile{
+		UUID:        m.ID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Backend:     m.Version.ValueString(),
+		Extensions:  []string{},
+		Settings:    map[string]string{},
+	}
+	diags.Append(m.Extensions.ElementsAs(ctx, &profile.Extensions, false)...)
+	diags.Append(m.Settings.ElementsAs(ctx, &profile.Settings, false)...)
+	slices.Sort(profile.Extensions)
+
+	return profile, diags
+}
+
+// set copies profile into m, removing the description stamp of the provider.
+func (m *PHPProfileResourceModel) set(
+	ctx context.Context,
+	profile dsm.WebStationPHPProfile,
+	stamp synoclient.Stamp,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(profile.UUID)
+	m.Name = types.StringValue(profile.Name)
+	m.Description = types.StringValue(stamp.Strip(profile.Description))
+	m.Version = types.StringValue(profile.Backend)
+
+	extensions := profile.Extensions
+	if extensions == nil {
+		extensions = []string{}
+	}
+	v, d := types.SetValueFrom(ctx, types.StringType, extensions)
+	diags.Append(d...)
+	m.Extensions = v
+
+	// Only the configured settings are kept, DSM returns the defaults of all
+	// others.
+	if !m.Settings.IsNull() && !m.Settings.IsUnknown() {
+		configured := map[string]string{}
+		diags.Append(m.Settings.ElementsAs(ctx, &configured, false)...)
+		settings := map[string]string{}
+		for k := range configured {
+			if v, ok := profile.Settings[k]; ok {
+				settings[k] = v
+			}
+		}
+		v, d := types.MapValueFrom(ctx, types.StringType, settings)
+		diags.Append(d...)
+		m.Settings = v
+	} else {
+		m.Settings = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+
+	return diags
+}
+
+var (
+	_ resource.Resource             = &PHPProfileResource{}
+	_ resource.ResourceWithIdentity = &PHPProfileResource{}
+)
+
+func NewPHPProfileResource() resource.Resource {
+	return &PHPProfileResource{}
+}
+
+type PHPProfileResource struct {
+	client *dsm.Client
+	stamp  synoclient.Stamp
+}
+
+// Create implements resource.Resource.
+func (p *PHPProfileResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data PHPProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, diags := data.profile(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	profile.Description = p.stamp.Apply(profile.Description)
+
+	if err := p.client.WebStationPHPProfileCreate(ctx, profile); err != nil {
+		resp.Diagnostics.AddError("Failed to create PHP profile", err.Error())
+		return
+	}
+
+	// DSM does not return the UUID of the new profile, profile names are
+	// unique.
+	created, err := p.find(ctx, func(pr dsm.WebStationPHPProfile) bool {
+		return pr.Name == profile.Name
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list PHP profiles", err.Error())
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.AddError(
+			"PHP profile not found",
+			fmt.Sprintf("PHP profile %s not found after creation", profile.Name),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(created.UUID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *PHPProfileResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data PHPProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, diags := data.profile(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	profile.Description = p.stamp.Apply(profile.Description)
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, profile.UUID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.WebStationPHPProfileSet(ctx, profile); err != nil {
+		resp.Diagnostics.AddError("Failed to update PHP profile", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *PHPProfileResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data PHPProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(p.checkStamp(ctx, data.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.WebStationPHPProfileDelete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete PHP profile", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *PHPProfileResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "php_profile")
+}
+
+// Read implements resource.Resource.
+func (p *PHPProfileResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data PHPProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := p.find(ctx, func(pr dsm.WebStationPHPProfile) bool {
+		return pr.UUID == data.ID.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list PHP profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, *profile, p.stamp)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *PHPProfileResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a PHP profile of Web Station, which `synology_webstation_vhost` resources can be served with.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the profile.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the profile.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the profile.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The PHP package serving the profile, e.g. `php82`. The package must be installed.",
+				Required:            true,
+			},
+			"extensions": schema.SetAttribute{
+				MarkdownDescription: "The PHP extensions to load, e.g. `curl` or `gd`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"settings": schema.MapAttribute{
+				MarkdownDescription: "`php.ini` settings of the profile, e.g. `memory_limit`. Settings which are not listed keep the DSM defaults.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+		},
+	}
+}
+
+func (p *PHPProfileResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+	p.stamp = synoclient.StampOf(req.ProviderData)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *PHPProfileResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := p.find(ctx, func(pr dsm.WebStationPHPProfile) bool {
+		return pr.UUID == id
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list PHP profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		resp.Diagnostics.AddError("PHP profile not found", fmt.Sprintf("PHP profile %s not found", id))
+		return
+	}
+
+	data := PHPProfileResourceModel{Settings: types.MapNull(types.StringType)}
+	resp.Diagnostics.Append(data.set(ctx, *profile, p.stamp)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *PHPProfileResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The UUID of the profile.")
+}
+
+func (p *PHPProfileResource) find(
+	ctx context.Context,
+	match func(dsm.WebStationPHPProfile) bool,
+) (*dsm.WebStationPHPProfile, error) {
+	list, err := p.client.WebStationPHPProfileList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Profiles, match)
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Profiles[i], nil
+}
+
+// checkStamp refuses changes to the profile with uuid when the provider
+// requires a description stamp the profile lacks.
+func (p *PHPProfileResource) checkStamp(ctx context.Context, uuid string) (diags diag.Diagnostics) {
+	if !p.stamp.Required {
+		return
+	}
+
+	profile, err := p.find(ctx, func(pr dsm.WebStationPHPProfile) bool {
+		return pr.UUID == uuid
+	})
+	if err != nil {
+		diags.AddError("Failed to list PHP profiles", err.Error())
+		return
+	}
+	if profile == nil {
+		return
+	}
+
+	if err := p.stamp.Check("PHP profile", profile.Description); err != nil {
+		diags.AddError("Refusing to modify PHP profile", err.Error())
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package webstation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type PHPProfileResource struct{}
+
+func TestAccPHPProfileResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"PHP profile is created",
+			`
+			resource "synology_webstation_php_profile" "foo" {
+				name       = "tf-test"
+				version    = "php82"
+				extensions = ["curl", "gd"]
+				settings = {
+					memory_limit = "256M"
+				}
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_webstation_php_profile.foo", "id"),
+							r.TestCheckResourceAttr("synology_webstation_php_profile.foo", "settings.memory_limit", "256M"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
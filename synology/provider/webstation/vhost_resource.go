@@ -0,0 +1,485 @@
+package webstation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/go-synology"
+	synoclient "github.com/synology-community/terraform-provider-synology/synology/client"
+	"github.com/synology-community/terraform-provider-synology/synology/client/dsm"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+var vhostBackends = map[string]int{
+	"nginx":    dsm.WebStationBackendNginx,
+	"apache24": dsm.WebStationBackendApache24,
+}
+
+type VHostResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Hostname      types.String `tfsdk:"hostname"`
+	HTTPPort      types.Int64  `tfsdk:"http_port"`
+	HTTPSPort     types.Int64  `tfsdk:"https_port"`
+	DocumentRoot  types.String `tfsdk:"document_root"`
+	Backend       types.String `tfsdk:"backend"`
+	PHPProfile    types.String `tfsdk:"php_profile"`
+	PythonProfile types.String `tfsdk:"python_profile"`
+	HSTS          types.Bool   `tfsdk:"hsts"`
+	CertificateID types.String `tfsdk:"certificate_id"`
+}
+
+func (m VHostResourceModel) vhost() dsm.WebStationVHost {
+	return dsm.WebStationVHost{
+		UUID:    m.ID.ValueString(),
+		FQDN:    m.Hostname.ValueString(),
+		Root:    m.DocumentRoot.ValueString(),
+		Backend: vhostBackends[m.Backend.ValueString()],
+		PHP:     m.PHPProfile.ValueString(),
+		Python:  m.PythonProfile.ValueString(),
+		Port: dsm.WebStationVHostPorts{
+			HTTP:  []int64{m.HTTPPort.ValueInt64()},
+			HTTPS: []int64{m.HTTPSPort.ValueInt64()},
+		},
+		HTTPS: dsm.WebStationVHostHTTPS{HSTS: m.HSTS.ValueBool()},
+	}
+}
+
+func (m *VHostResourceModel) set(vhost dsm.WebStationVHost) {
+	m.ID = types.StringValue(vhost.UUID)
+	m.Hostname = types.StringValue(vhost.FQDN)
+	m.DocumentRoot = types.StringValue(vhost.Root)
+	m.HSTS = types.BoolValue(vhost.HTTPS.HSTS)
+
+	for name, backend := range vhostBackends {
+		if backend == vhost.Backend {
+			m.Backend = types.StringValue(name)
+		}
+	}
+
+	if len(vhost.Port.HTTP) > 0 {
+		m.HTTPPort = types.Int64Value(vhost.Port.HTTP[0])
+	}
+	if len(vhost.Port.HTTPS) > 0 {
+		m.HTTPSPort = types.Int64Value(vhost.Port.HTTPS[0])
+	}
+
+	m.PHPProfile = types.StringNull()
+	if vhost.PHP != "" {
+		m.PHPProfile = types.StringValue(vhost.PHP)
+	}
+	m.PythonProfile = types.StringNull()
+	if vhost.Python != "" {
+		m.PythonProfile = types.StringValue(vhost.Python)
+	}
+}
+
+var (
+	_ resource.Resource                   = &VHostResource{}
+	_ resource.ResourceWithValidateConfig = &VHostResource{}
+	_ resource.ResourceWithIdentity       = &VHostResource{}
+)
+
+func NewVHostResource() resource.Resource {
+	return &VHostResource{}
+}
+
+type VHostResource struct {
+	client *dsm.Client
+}
+
+// Create implements resource.Resource.
+func (p *VHostResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data VHostResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vhost := data.vhost()
+
+	before, err := p.client.WebStationVHostList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list virtual hosts", err.Error())
+		return
+	}
+
+	if err := p.client.WebStationVHostCreate(ctx, vhost); err != nil {
+		resp.Diagnostics.AddError("Failed to create virtual host", err.Error())
+		return
+	}
+
+	// DSM does not return the UUID of the new virtual host, find the new
+	// entry with the same hostname.
+	created, err := p.find(ctx, func(v dsm.WebStationVHost) bool {
+		return v.FQDN == vhost.FQDN &&
+			!slices.ContainsFunc(before.VHosts, func(b dsm.WebStationVHost) bool {
+				return b.UUID == v.UUID
+			})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list virtual hosts", err.Error())
+		return
+	}
+	if created == nil {
+		resp.Diagnostics.AddError(
+			"Virtual host not found",
+			fmt.Sprintf("Virtual host %s not found after creation", vhost.FQDN),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(created.UUID)
+
+	resp.Diagnostics.Append(p.setCertificate(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Update implements resource.Resource.
+func (p *VHostResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data VHostResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.WebStationVHostSet(ctx, data.vhost()); err != nil {
+		resp.Diagnostics.AddError("Failed to update virtual host", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(p.setCertificate(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Delete implements resource.Resource.
+func (p *VHostResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data VHostResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := p.client.WebStationVHostDelete(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete virtual host", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Metadata implements resource.Resource.
+func (p *VHostResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = buildName(req.ProviderTypeName, "vhost")
+}
+
+// Read implements resource.Resource.
+func (p *VHostResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var data VHostResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vhost, err := p.find(ctx, func(v dsm.WebStationVHost) bool {
+		return v.UUID == data.ID.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list virtual hosts", err.Error())
+		return
+	}
+	if vhost == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.set(*vhost)
+	resp.Diagnostics.Append(p.readCertificate(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", data.ID.ValueString())...)
+}
+
+// Schema implements resource.Resource.
+func (p *VHostResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a virtual host of Web Station. Deleting the virtual host keeps its document root.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the virtual host.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname the virtual host is served for, e.g. `www.example.com`.",
+				Required:            true,
+			},
+			"http_port": schema.Int64Attribute{
+				MarkdownDescription: "The HTTP port of the virtual host.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(80),
+			},
+			"https_port": schema.Int64Attribute{
+				MarkdownDescription: "The HTTPS port of the virtual host.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(443),
+			},
+			"document_root": schema.StringAttribute{
+				MarkdownDescription: "The document root of the virtual host, e.g. `/volume1/web/example`.",
+				Required:            true,
+			},
+			"backend": schema.StringAttribute{
+				MarkdownDescription: "The HTTP server serving the virtual host, `nginx` or `apache24`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("nginx"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("nginx", "apache24"),
+				},
+			},
+			"php_profile": schema.StringAttribute{
+				MarkdownDescription: "The ID of the `synology_webstation_php_profile` serving PHP scripts.",
+				Optional:            true,
+			},
+			"python_profile": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Python profile serving Python scripts.",
+				Optional:            true,
+			},
+			"hsts": schema.BoolAttribute{
+				MarkdownDescription: "Whether to send the HSTS header over HTTPS.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"certificate_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the certificate used for HTTPS. Defaults to the certificate DSM assigns to new virtual hosts.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (p *VHostResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data VHostResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PHPProfile.IsNull() && !data.PythonProfile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("python_profile"),
+			"Conflicting script profiles",
+			"A virtual host can be served by either a PHP or a Python profile.",
+		)
+	}
+}
+
+func (p *VHostResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(synology.Api)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected client.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	if err := synoclient.RequireOf(ctx, req.ProviderData, synoclient.PrivilegeAdmin); err != nil {
+		resp.Diagnostics.AddError("Insufficient privileges", err.Error())
+		return
+	}
+
+	p.client = dsm.New(client)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (p *VHostResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, diags := util.ImportID(ctx, req, "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vhost, err := p.find(ctx, func(v dsm.WebStationVHost) bool {
+		return v.UUID == id
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list virtual hosts", err.Error())
+		return
+	}
+	if vhost == nil {
+		resp.Diagnostics.AddError("Virtual host not found", fmt.Sprintf("Virtual host %s not found", id))
+		return
+	}
+
+	var data VHostResourceModel
+	data.set(*vhost)
+	resp.Diagnostics.Append(p.readCertificate(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(util.SetIdentity(ctx, resp.Identity, "id", id)...)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (p *VHostResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = util.StringIdentitySchema("id", "The UUID of the virtual host.")
+}
+
+func (p *VHostResource) find(
+	ctx context.Context,
+	match func(dsm.WebStationVHost) bool,
+) (*dsm.WebStationVHost, error) {
+	list, err := p.client.WebStationVHostList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.VHosts, match)
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.VHosts[i], nil
+}
+
+// bound returns the certificate bound to the virtual host, nil if there is
+// none.
+func (p *VHostResource) bound(ctx context.Context, uuid string) (*dsm.Certificate, error) {
+	list, err := p.client.CertificateList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(list.Certificates, func(c dsm.Certificate) bool {
+		return c.Bound(dsm.WebStationSubscriber, uuid)
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &list.Certificates[i], nil
+}
+
+// readCertificate sets the certificate_id of data to the certificate bound to
+// the virtual host.
+func (p *VHostResource) readCertificate(ctx context.Context, data *VHostResourceModel) (diags diag.Diagnostics) {
+	cert, err := p.bound(ctx, data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list certificates", err.Error())
+		return
+	}
+
+	data.CertificateID = types.StringNull()
+	if cert != nil {
+		data.CertificateID = types.StringValue(cert.ID)
+	}
+
+	return
+}
+
+// setCertificate binds the configured certificate to the virtual host. If no
+// certificate is configured, the one assigned by DSM is read back.
+func (p *VHostResource) setCertificate(ctx context.Context, data *VHostResourceModel) (diags diag.Diagnostics) {
+	if data.CertificateID.IsUnknown() {
+		return p.readCertificate(ctx, data)
+	}
+
+	cert, err := p.bound(ctx, data.ID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to list certificates", err.Error())
+		return
+	}
+
+	var oldID string
+	if cert != nil {
+		oldID = cert.ID
+	}
+	if oldID == data.CertificateID.ValueString() {
+		return
+	}
+
+	service := dsm.CertificateService{
+		DisplayName: data.Hostname.ValueString(),
+		Service:     data.ID.ValueString(),
+		Subscriber:  dsm.WebStationSubscriber,
+		IsPkg:       true,
+	}
+	if err := p.client.CertificateServiceSet(ctx, service, oldID, data.CertificateID.ValueString()); err != nil {
+		diags.AddError("Failed to bind certificate", err.Error())
+	}
+
+	return
+}
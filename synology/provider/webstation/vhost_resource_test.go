@@ -0,0 +1,42 @@
+package webstation_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type VHostResource struct{}
+
+func TestAccVHostResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"virtual host is created",
+			`
+			resource "synology_webstation_vhost" "foo" {
+				hostname      = "tf-test.example.com"
+				document_root = "/volume1/web/tf-test"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttrSet("synology_webstation_vhost.foo", "id"),
+							r.TestCheckResourceAttr("synology_webstation_vhost.foo", "backend", "nginx"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
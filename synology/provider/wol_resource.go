@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/synology-community/terraform-provider-synology/synology/util"
+)
+
+type WolResourceModel struct {
+	MAC           types.String `tfsdk:"mac"`
+	Broadcast     types.String `tfsdk:"broadcast"`
+	Host          types.String `tfsdk:"host"`
+	SkipCertCheck types.Bool   `tfsdk:"skip_cert_check"`
+	Timeout       types.Int64  `tfsdk:"timeout"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+}
+
+var (
+	_ resource.Resource = &WolResource{}
+)
+
+func NewWolResource() resource.Resource {
+	return &WolResource{}
+}
+
+type WolResource struct{}
+
+// Create implements resource.Resource.
+func (a *WolResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data WolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(data.Timeout.ValueInt64())*time.Minute)
+	defer cancel()
+
+	send := func() error {
+		return util.SendMagicPacket(ctx, data.Broadcast.ValueString(), data.MAC.ValueString())
+	}
+	if err := send(); err != nil {
+		resp.Diagnostics.AddError("Failed to send magic packet", err.Error())
+		return
+	}
+
+	if !data.Host.IsNull() {
+		httpClient := &http.Client{
+			Timeout: 10 * time.Second,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: data.SkipCertCheck.ValueBool()}, // #nosec G402
+			},
+		}
+		url := fmt.Sprintf("https://%s/webapi/query.cgi?api=SYNO.API.Info&version=1&method=query", data.Host.ValueString())
+
+		// Packets can get lost while the network interface powers up, so one
+		// is sent with every attempt.
+		err := util.Poll(ctx, 10*time.Second, func() (bool, error) {
+			res, err := httpClient.Get(url)
+			if err != nil {
+				return false, send()
+			}
+			_ = res.Body.Close()
+			return res.StatusCode == http.StatusOK, nil
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to wait for NAS",
+				fmt.Sprintf("%s did not answer after waking it up: %s", data.Host.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete implements resource.Resource.
+func (a *WolResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// Metadata implements resource.Resource.
+func (a *WolResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_wol"
+}
+
+// Read implements resource.Resource.
+func (a *WolResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+// Schema implements resource.Resource.
+func (a *WolResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	replace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Wakes a NAS with a Wake-on-LAN magic packet when created, then waits for its web API to answer. Change `triggers` to wake it again. The packet is sent from the machine running Terraform, which must be in the same broadcast domain. The provider connection is not used, so a provider alias configured for a NAS which is running can wake another one.",
+
+		Attributes: map[string]schema.Attribute{
+			"mac": schema.StringAttribute{
+				MarkdownDescription: "The MAC address of the network interface to wake, e.g. `00:11:32:aa:bb:cc`.",
+				Required:            true,
+				PlanModifiers:       replace,
+			},
+			"broadcast": schema.StringAttribute{
+				MarkdownDescription: "The UDP address the packet is sent to.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("255.255.255.255:9"),
+				PlanModifiers:       replace,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The DSM host in form of `host:port` to wait for. Nothing is awaited when unset.",
+				Optional:            true,
+				PlanModifiers:       replace,
+			},
+			"skip_cert_check": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip SSL certificate checks of `host`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "The number of minutes to wait for `host`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which wake the NAS again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Update implements resource.Resource.
+func (a *WolResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data WolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+package provider_test
+
+import (
+	"testing"
+
+	r "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/synology-community/terraform-provider-synology/synology/acctest"
+)
+
+type WolResource struct{}
+
+func TestAccWolResource_basic(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		ResourceBlock string
+	}{
+		{
+			"magic packet is sent",
+			`
+			resource "synology_wol" "foo" {
+				mac       = "00:11:32:aa:bb:cc"
+				broadcast = "127.255.255.255:9"
+			}`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			r.UnitTest(t, r.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(t),
+				Steps: []r.TestStep{
+					{
+						Config: tt.ResourceBlock,
+						Check: r.ComposeTestCheckFunc(
+							r.TestCheckResourceAttr("synology_wol.foo", "timeout", "10"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package util
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -86,3 +88,11 @@ func String(in string) basetypes.StringValue {
 // 	_ = in.ElementsAs(ctx, &results, false)
 // 	return results
 // }
+
+// UnixTime returns the Unix time t in RFC 3339, or an empty string for zero.
+func UnixTime(t int64) string {
+	if t == 0 {
+		return ""
+	}
+	return time.Unix(t, 0).UTC().Format(time.RFC3339)
+}
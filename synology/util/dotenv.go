@@ -0,0 +1,35 @@
+package util
+
+import (
+	"slices"
+	"strings"
+)
+
+// DotEnv renders env as a Docker Compose .env file, sorted by key. Values are
+// single quoted so they are taken literally; values containing single quotes
+// or newlines are double quoted with escapes instead.
+func DotEnv(env map[string]string) string {
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(dotEnvQuote(env[k]))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func dotEnvQuote(v string) string {
+	if !strings.ContainsAny(v, "'\n\r") {
+		return "'" + v + "'"
+	}
+
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "\n", `\n`, "\r", `\r`)
+	return `"` + r.Replace(v) + `"`
+}
@@ -0,0 +1,25 @@
+package util
+
+import "testing"
+
+func TestDotEnv(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{"empty", map[string]string{}, ""},
+		{"sorted", map[string]string{"B": "2", "A": "1"}, "A='1'\nB='2'\n"},
+		{"literal", map[string]string{"PASSWORD": `p$ss"w\rd`}, "PASSWORD='p$ss\"w\\rd'\n"},
+		{"single quote", map[string]string{"GREETING": `it's $HOME`}, "GREETING=\"it's \\$HOME\"\n"},
+		{"newline", map[string]string{"KEY": "a\nb"}, "KEY=\"a\\nb\"\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DotEnv(tt.env); got != tt.want {
+				t.Errorf("DotEnv() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
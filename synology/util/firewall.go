@@ -0,0 +1,92 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// FirewallMatchesIP reports whether ip is matched by the source of a DSM
+// firewall rule: `all`, or a comma separated list of addresses, subnets such
+// as 192.168.1.0/24 and ranges such as 192.168.1.10-192.168.1.20.
+func FirewallMatchesIP(spec string, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	for _, s := range strings.Split(spec, ",") {
+		s = strings.TrimSpace(s)
+		if s == "all" {
+			return true
+		}
+		if _, subnet, err := net.ParseCIDR(s); err == nil {
+			if subnet.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if from, to, ok := strings.Cut(s, "-"); ok {
+			lo, hi := net.ParseIP(from).To16(), net.ParseIP(to).To16()
+			if lo != nil && hi != nil && bytes.Compare(ip.To16(), lo) >= 0 && bytes.Compare(ip.To16(), hi) <= 0 {
+				return true
+			}
+			continue
+		}
+		if a := net.ParseIP(s); a != nil && a.Equal(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FirewallMatchesPort reports whether port is matched by the ports of a DSM
+// firewall rule: `all`, or a comma separated list of ports and ranges such as
+// 5000-5001.
+func FirewallMatchesPort(spec string, port int) bool {
+	for _, s := range strings.Split(spec, ",") {
+		s = strings.TrimSpace(s)
+		if s == "all" {
+			return true
+		}
+		from, to, ok := strings.Cut(s, "-")
+		if !ok {
+			to = from
+		}
+		lo, err1 := strconv.Atoi(from)
+		hi, err2 := strconv.Atoi(to)
+		if err1 == nil && err2 == nil && lo <= port && port <= hi {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SourceAddr returns the local address used to reach the DSM at u and the
+// port DSM listens on. Behind NAT the NAS sees another address.
+func SourceAddr(ctx context.Context, u *url.URL) (net.IP, int, error) {
+	port := 5000
+	if u.Scheme == "https" {
+		port = 5001
+	}
+	if p := u.Port(); p != "" {
+		var err error
+		if port, err = strconv.Atoi(p); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// Dialing UDP only selects the route, no packet is sent.
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", net.JoinHostPort(u.Hostname(), strconv.Itoa(port)))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer conn.Close()
+
+	return conn.LocalAddr().(*net.UDPAddr).IP, port, nil
+}
@@ -0,0 +1,76 @@
+package util
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"testing"
+)
+
+func TestFirewallMatchesIP(t *testing.T) {
+	tests := []struct {
+		spec string
+		ip   string
+		want bool
+	}{
+		{"all", "192.168.1.5", true},
+		{"192.168.1.5", "192.168.1.5", true},
+		{"192.168.1.6", "192.168.1.5", false},
+		{"192.168.1.0/24", "192.168.1.5", true},
+		{"192.168.2.0/24", "192.168.1.5", false},
+		{"192.168.1.1-192.168.1.10", "192.168.1.5", true},
+		{"192.168.1.6-192.168.1.10", "192.168.1.5", false},
+		{"10.0.0.1, 192.168.1.0/24", "192.168.1.5", true},
+		{"fd00::/8", "fd00::2", true},
+		{"fd00::/8", "192.168.1.5", false},
+	}
+
+	for _, tt := range tests {
+		if got := FirewallMatchesIP(tt.spec, net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("FirewallMatchesIP(%q, %s) = %v, want %v", tt.spec, tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestFirewallMatchesPort(t *testing.T) {
+	tests := []struct {
+		spec string
+		port int
+		want bool
+	}{
+		{"all", 5001, true},
+		{"5001", 5001, true},
+		{"5000", 5001, false},
+		{"5000-5001", 5001, true},
+		{"22,80,443", 5001, false},
+		{"22, 5000-5010", 5001, true},
+	}
+
+	for _, tt := range tests {
+		if got := FirewallMatchesPort(tt.spec, tt.port); got != tt.want {
+			t.Errorf("FirewallMatchesPort(%q, %d) = %v, want %v", tt.spec, tt.port, got, tt.want)
+		}
+	}
+}
+
+func TestSourceAddr(t *testing.T) {
+	tests := []struct {
+		url  string
+		port int
+	}{
+		{"https://127.0.0.1", 5001},
+		{"http://127.0.0.1", 5000},
+		{"https://127.0.0.1:8443", 8443},
+	}
+
+	for _, tt := range tests {
+		u, _ := url.Parse(tt.url)
+		ip, port, err := SourceAddr(context.Background(), u)
+		if err != nil {
+			t.Fatalf("SourceAddr(%s) error = %v", tt.url, err)
+		}
+		if !ip.IsLoopback() || port != tt.port {
+			t.Errorf("SourceAddr(%s) = %s, %d, want loopback, %d", tt.url, ip, port, tt.port)
+		}
+	}
+}
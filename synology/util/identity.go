@@ -0,0 +1,56 @@
+package util
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// StringIdentitySchema returns a resource identity schema made of a single
+// string attribute which is required when importing by identity.
+func StringIdentitySchema(name, description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			name: identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// SetIdentity sets the named identity attribute. It is a no-op for Terraform
+// clients which do not support resource identity.
+func SetIdentity(
+	ctx context.Context,
+	identity *tfsdk.ResourceIdentity,
+	name string,
+	value string,
+) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.SetAttribute(ctx, path.Root(name), value)
+}
+
+// ImportID returns the identifier of the resource being imported, either the
+// import ID or, when importing by identity, the named identity attribute.
+func ImportID(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	name string,
+) (string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, nil
+	}
+
+	var id string
+	diags := req.Identity.GetAttribute(ctx, path.Root(name), &id)
+
+	return id, diags
+}
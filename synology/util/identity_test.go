@@ -0,0 +1,72 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImportID(t *testing.T) {
+	ctx := context.Background()
+	s := StringIdentitySchema("name", "The name.")
+
+	identity := func(v string) *tfsdk.ResourceIdentity {
+		return &tfsdk.ResourceIdentity{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, v),
+			}),
+		}
+	}
+
+	tests := []struct {
+		name string
+		req  resource.ImportStateRequest
+		want string
+	}{
+		{"import id", resource.ImportStateRequest{ID: "foo"}, "foo"},
+		{"import id wins", resource.ImportStateRequest{ID: "foo", Identity: identity("bar")}, "foo"},
+		{"identity", resource.ImportStateRequest{Identity: identity("bar")}, "bar"},
+		{"empty", resource.ImportStateRequest{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := ImportID(ctx, tt.req, "name")
+			if diags.HasError() {
+				t.Fatalf("ImportID() diagnostics = %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("ImportID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetIdentity(t *testing.T) {
+	ctx := context.Background()
+	s := StringIdentitySchema("path", "The path.")
+
+	if diags := SetIdentity(ctx, nil, "path", "/foo"); diags.HasError() {
+		t.Fatalf("SetIdentity() on nil identity diagnostics = %v", diags)
+	}
+
+	identity := &tfsdk.ResourceIdentity{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}
+
+	if diags := SetIdentity(ctx, identity, "path", "/foo"); diags.HasError() {
+		t.Fatalf("SetIdentity() diagnostics = %v", diags)
+	}
+
+	var got string
+	identity.GetAttribute(ctx, path.Root("path"), &got)
+	if got != "/foo" {
+		t.Errorf("identity path = %q, want %q", got, "/foo")
+	}
+}
@@ -0,0 +1,47 @@
+package util
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ModelDiff compares two values of the same tfsdk model struct and returns a
+// "name: current -> planned" line for every attribute which differs, with
+// prefix prepended to the name. Unknown planned values are skipped.
+func ModelDiff(prefix string, current, planned any) []string {
+	cv := reflect.Indirect(reflect.ValueOf(current))
+	pv := reflect.Indirect(reflect.ValueOf(planned))
+	if cv.Type() != pv.Type() || cv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var lines []string
+	for i := range cv.NumField() {
+		name, ok := cv.Type().Field(i).Tag.Lookup("tfsdk")
+		if !ok || name == "-" {
+			continue
+		}
+		c, ok1 := cv.Field(i).Interface().(attr.Value)
+		p, ok2 := pv.Field(i).Interface().(attr.Value)
+		if !ok1 || !ok2 || p.IsUnknown() || c.Equal(p) {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s%s: %s -> %s", prefix, name, c, p))
+	}
+
+	return lines
+}
+
+// PlanPreview returns a warning listing the changes a plan makes on the NAS,
+// as returned by ModelDiff, or nothing if there are none.
+func PlanPreview(lines []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(lines) > 0 {
+		diags.AddWarning("Planned DSM changes", strings.Join(lines, "\n"))
+	}
+	return diags
+}
@@ -0,0 +1,42 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestModelDiff(t *testing.T) {
+	type model struct {
+		Name    types.String `tfsdk:"name"`
+		Enabled types.Bool   `tfsdk:"enabled"`
+		Port    types.Int64  `tfsdk:"port"`
+		Ignored string
+	}
+
+	current := model{
+		Name:    types.StringValue("ssh"),
+		Enabled: types.BoolValue(true),
+		Port:    types.Int64Value(22),
+	}
+	planned := model{
+		Name:    types.StringValue("ssh"),
+		Enabled: types.BoolValue(false),
+		Port:    types.Int64Unknown(),
+		Ignored: "x",
+	}
+
+	got := ModelDiff(`rules["ssh"].`, current, &planned)
+	want := []string{`rules["ssh"].enabled: true -> false`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ModelDiff() = %q, want %q", got, want)
+	}
+
+	if diags := PlanPreview(nil); diags.HasError() || len(diags) != 0 {
+		t.Errorf("PlanPreview(nil) = %v, want none", diags)
+	}
+	if diags := PlanPreview(want); diags.WarningsCount() != 1 {
+		t.Errorf("PlanPreview() = %v, want one warning", diags)
+	}
+}
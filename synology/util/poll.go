@@ -0,0 +1,29 @@
+package util
+
+import (
+	"context"
+	"time"
+)
+
+// Poll calls done every interval until it reports true, returns an error or
+// ctx is cancelled. done is called once immediately.
+func Poll(ctx context.Context, interval time.Duration, done func() (bool, error)) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package util
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// refreshedKey is the private state key holding the time a resource was last
+// read from the NAS, as a JSON string.
+const refreshedKey = "refreshed"
+
+// PrivateState is the private state of a resource, the Private field of the
+// resource requests and responses.
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// SkipRefreshAttribute returns the skip_refresh attribute of resources whose
+// reads are slow.
+func SkipRefreshAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Whether to keep the state instead of reading the NAS on refresh once the resource has been read. Changes made outside of Terraform are not detected.",
+		Optional:            true,
+	}
+}
+
+// RefreshIntervalAttribute returns the refresh_interval attribute of
+// resources whose reads are slow.
+func RefreshIntervalAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The least time between reads of the NAS on refresh, e.g. `6h`. The state is kept on refreshes within the interval.",
+		CustomType:          timetypes.GoDurationType{},
+		Optional:            true,
+	}
+}
+
+// SkipRefresh reports whether a resource can keep its state instead of
+// reading the NAS: skip is set or the last read is less than interval ago.
+// Resources which were never read, e.g. after an import, are always read.
+func SkipRefresh(
+	ctx context.Context,
+	private PrivateState,
+	skip types.Bool,
+	interval timetypes.GoDuration,
+) (bool, diag.Diagnostics) {
+	b, diags := private.GetKey(ctx, refreshedKey)
+	if diags.HasError() || len(b) == 0 {
+		return false, diags
+	}
+
+	var refreshed time.Time
+	if err := refreshed.UnmarshalJSON(b); err != nil {
+		return false, diags
+	}
+
+	if skip.ValueBool() {
+		return true, diags
+	}
+	if interval.IsNull() || interval.IsUnknown() {
+		return false, diags
+	}
+
+	d, dd := interval.ValueGoDuration()
+	diags.Append(dd...)
+	return time.Since(refreshed) < d, diags
+}
+
+// MarkRefreshed records the current time as the time the resource was last
+// read from the NAS.
+func MarkRefreshed(ctx context.Context, private PrivateState) diag.Diagnostics {
+	b, err := time.Now().UTC().MarshalJSON()
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to record the refresh time", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, refreshedKey, b)
+}
@@ -0,0 +1,57 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type privateState map[string][]byte
+
+func (p privateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p privateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestSkipRefresh(t *testing.T) {
+	ctx := context.Background()
+
+	never := privateState{}
+	read := privateState{}
+	if diags := MarkRefreshed(ctx, read); diags.HasError() {
+		t.Fatal(diags)
+	}
+	old := privateState{refreshedKey: []byte(`"2020-01-01T00:00:00Z"`)}
+
+	for _, tt := range []struct {
+		name     string
+		private  privateState
+		skip     types.Bool
+		interval timetypes.GoDuration
+		want     bool
+	}{
+		{"never read", never, types.BoolValue(true), timetypes.NewGoDurationValueFromStringMust("1h"), false},
+		{"skip", old, types.BoolValue(true), timetypes.NewGoDurationNull(), true},
+		{"no interval", read, types.BoolNull(), timetypes.NewGoDurationNull(), false},
+		{"within interval", read, types.BoolNull(), timetypes.NewGoDurationValueFromStringMust("1h"), true},
+		{"interval elapsed", old, types.BoolNull(), timetypes.NewGoDurationValue(time.Hour), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := SkipRefresh(ctx, tt.private, tt.skip, tt.interval)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if got != tt.want {
+				t.Errorf("SkipRefresh() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
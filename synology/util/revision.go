@@ -0,0 +1,73 @@
+package util
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// revisionKey is the private state key holding the revision of the object
+// last read from the NAS, as a JSON string.
+const revisionKey = "revision"
+
+// Revision returns the revision of v, an object as read from the NAS. DSM
+// offers neither revision identifiers nor conditional updates, so the
+// revision is a checksum of the object. Fields which change on their own,
+// such as the next run of a task, must be cleared before.
+func Revision(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// SetRevision records the revision of v as the object last read from the
+// NAS.
+func SetRevision(ctx context.Context, private PrivateState, v any) (diags diag.Diagnostics) {
+	rev, err := Revision(v)
+	if err != nil {
+		diags.AddError("Failed to record the revision", err.Error())
+		return diags
+	}
+
+	b, _ := json.Marshal(rev)
+	return private.SetKey(ctx, revisionKey, b)
+}
+
+// CheckRevision returns an error when current, the object read from the NAS
+// right before an update, differs from the object last read, i.e. it was
+// changed outside of Terraform, e.g. in DSM, since the plan was made. Objects
+// without a recorded revision, e.g. of states written by earlier versions,
+// are not checked.
+func CheckRevision(ctx context.Context, private PrivateState, kind string, current any) diag.Diagnostics {
+	b, diags := private.GetKey(ctx, revisionKey)
+	if diags.HasError() || len(b) == 0 {
+		return diags
+	}
+
+	var want string
+	if err := json.Unmarshal(b, &want); err != nil {
+		return diags
+	}
+
+	rev, err := Revision(current)
+	if err != nil {
+		diags.AddError("Failed to compute the revision", err.Error())
+		return diags
+	}
+
+	if rev != want {
+		diags.AddError(
+			fmt.Sprintf("The %s changed outside of Terraform", kind),
+			fmt.Sprintf("The %s was changed since it was last read, e.g. in DSM. Run terraform apply again to plan against the current settings instead of overwriting them.", kind),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,40 @@
+package util
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCheckRevision(t *testing.T) {
+	ctx := context.Background()
+
+	type share struct {
+		Name       string `json:"name"`
+		RecycleBin bool   `json:"enable_recycle_bin"`
+	}
+	read := share{Name: "docker"}
+
+	private := privateState{}
+	if diags := SetRevision(ctx, private, read); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		private privateState
+		current share
+		wantErr bool
+	}{
+		{"unchanged", private, read, false},
+		{"changed", private, share{Name: "docker", RecycleBin: true}, true},
+		{"never read", privateState{}, share{Name: "docker", RecycleBin: true}, false},
+		{"invalid revision", privateState{revisionKey: []byte("{")}, read, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := CheckRevision(ctx, tt.private, "shared folder docker", tt.current)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("CheckRevision() = %v, want error %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/synology-community/go-synology/pkg/api/core"
+)
+
+// RunRootScript runs script once as root through a temporary scheduled task,
+// for the operations the DSM APIs do not offer, such as file modes. The task
+// is always removed again, failing to remove it is an error as the script
+// would stay on the NAS.
+func RunRootScript(ctx context.Context, c core.Api, name, script string) (diags diag.Diagnostics) {
+	now := time.Now()
+	res, err := c.RootTaskCreate(ctx, core.TaskRequest{
+		Name:      name,
+		RealOwner: "root",
+		Owner:     "root",
+		Type:      "script",
+		Extra:     core.TaskExtra{Script: script},
+		Schedule: core.TaskSchedule{
+			Date:        fmt.Sprintf("%d/%d/%d", now.Year(), now.Month(), now.Day()),
+			WeekDay:     "0,1,2,3,4,5,6",
+			MonthlyWeek: []string{},
+		},
+	})
+	if err != nil {
+		diags.AddError("Failed to create root task", err.Error())
+		return diags
+	}
+
+	defer func() {
+		if err := c.TaskDelete(ctx, *res.ID); err != nil {
+			diags.AddError(
+				"Failed to delete root task",
+				fmt.Sprintf("Remove the scheduled task %q by hand, got error: %s", name, err),
+			)
+		}
+	}()
+
+	if err := c.TaskRun(ctx, *res.ID); err != nil {
+		diags.AddError("Failed to run root task", err.Error())
+		return diags
+	}
+
+	// Give the task time to start before it is removed.
+	time.Sleep(5 * time.Second)
+
+	return diags
+}
+
+// ShellQuote quotes s as a single word of a shell script.
+func ShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
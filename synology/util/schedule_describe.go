@@ -0,0 +1,173 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Describe returns a short English description of the schedule for plans
+// and documentation, e.g. "At 03:00 on weekdays".
+func (s *Schedule) Describe() string {
+	// Descriptors like "@every 10m" have no times.
+	switch {
+	case s.Minute != 0 || s.Hour != 0:
+	case s.RepeatMin > 0:
+		return fmt.Sprintf("Every %s", plural(s.RepeatMin, "minute"))
+	case s.RepeatHour > 0:
+		return fmt.Sprintf("Every %s", plural(s.RepeatHour, "hour"))
+	case s.RepeatDate > 0 && s.RepeatDate != 1001:
+		return fmt.Sprintf("Every %s", plural(s.RepeatDate, "day"))
+	}
+
+	parts := []string{describeTimes(setBits(s.Minute, minutes), setBits(s.Hour, hours))}
+
+	days := setBits(s.Dom, dom)
+	weekdays := setBits(s.Dow, dow)
+	allDays, allWeekdays := len(days) == 31, len(weekdays) == 7
+	switch {
+	case !allDays && !allWeekdays:
+		// Like cron, either restriction selects a day.
+		parts = append(parts, describeDom(days), "or", describeDow(weekdays))
+	case !allDays:
+		parts = append(parts, describeDom(days))
+	case !allWeekdays:
+		parts = append(parts, describeDow(weekdays))
+	}
+
+	if m := setBits(s.Month, months); len(m) != 12 {
+		names := make([]string, 0, len(m))
+		for _, v := range m {
+			names = append(names, time.Month(v).String())
+		}
+		parts = append(parts, "in "+joinAnd(names))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func describeTimes(mins, hrs []int64) string {
+	allHours := len(hrs) == 24
+
+	if allHours && len(mins) == 60 {
+		return "Every minute"
+	}
+	if step, ok := evenStep(mins, minutes); ok && mins[0] < step {
+		if allHours && mins[0] == 0 {
+			return fmt.Sprintf("Every %s", plural(step, "minute"))
+		}
+		if h, ok := stepOf(hrs); len(hrs) == 1 || ok && h == 1 {
+			return fmt.Sprintf("Every %s from %02d:%02d to %02d:%02d",
+				plural(step, "minute"), hrs[0], mins[0], hrs[len(hrs)-1], mins[len(mins)-1])
+		}
+	}
+	if len(mins) == 1 && allHours {
+		return fmt.Sprintf("At minute %d past every hour", mins[0])
+	}
+	if len(mins)*len(hrs) <= 4 {
+		var times []string
+		for _, h := range hrs {
+			for _, m := range mins {
+				times = append(times, fmt.Sprintf("%02d:%02d", h, m))
+			}
+		}
+		return "At " + joinAnd(times)
+	}
+	if step, ok := evenStep(hrs, hours); ok && len(mins) == 1 && len(hrs) > 1 {
+		return fmt.Sprintf("Every %s from %02d:%02d", plural(step, "hour"), hrs[0], mins[0])
+	}
+
+	return fmt.Sprintf("At minutes %s past hours %s", formatRanges(mins), formatRanges(hrs))
+}
+
+func describeDom(days []int64) string {
+	if len(days) == 1 {
+		return fmt.Sprintf("on day %d of the month", days[0])
+	}
+	return fmt.Sprintf("on days %s of the month", formatRanges(days))
+}
+
+func describeDow(days []int64) string {
+	switch formatRanges(days) {
+	case "1-5":
+		return "on weekdays"
+	case "0,6":
+		return "on weekends"
+	}
+
+	names := make([]string, 0, len(days))
+	for _, d := range days {
+		names = append(names, time.Weekday(d).String())
+	}
+	return "on " + joinAnd(names)
+}
+
+// setBits returns the values within the bounds whose bit is set.
+func setBits(field int64, r bounds) []int64 {
+	var res []int64
+	for v := r.min; v <= r.max; v++ {
+		if field&(1<<v) != 0 {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// stepOf returns the step between values if there are several which are
+// evenly spaced.
+func stepOf(values []int64) (int64, bool) {
+	if len(values) < 2 {
+		return 0, false
+	}
+	step := values[1] - values[0]
+	for i := 2; i < len(values); i++ {
+		if values[i]-values[i-1] != step {
+			return 0, false
+		}
+	}
+	return step, true
+}
+
+// evenStep returns the step between values if they are evenly spaced and
+// repeat until the end of the bounds.
+func evenStep(values []int64, r bounds) (int64, bool) {
+	step, ok := stepOf(values)
+	return step, ok && values[len(values)-1]+step > r.max
+}
+
+// formatRanges returns values as a comma separated list, collapsing runs of
+// more than two values into ranges, e.g. "1,8-18".
+func formatRanges(values []int64) string {
+	var parts []string
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		switch {
+		case j-i >= 2:
+			parts = append(parts, fmt.Sprintf("%d-%d", values[i], values[j]))
+		case j > i:
+			parts = append(parts, strconv.FormatInt(values[i], 10), strconv.FormatInt(values[j], 10))
+		default:
+			parts = append(parts, strconv.FormatInt(values[i], 10))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ",")
+}
+
+func joinAnd(items []string) string {
+	if len(items) < 2 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+func plural(n int64, unit string) string {
+	if n == 1 {
+		return unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
@@ -0,0 +1,41 @@
+package util
+
+import "testing"
+
+func TestScheduleDescribe(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"0 3 * * 1-5", "At 03:00 on weekdays"},
+		{"30 22 * * 0,6", "At 22:30 on weekends"},
+		{"0 3,15 * * *", "At 03:00 and 15:00"},
+		{"15 4 * * 1,3,5", "At 04:15 on Monday, Wednesday and Friday"},
+		{"0 0 1 * *", "At 00:00 on day 1 of the month"},
+		{"0 0 1,15 1,7 *", "At 00:00 on days 1,15 of the month in January and July"},
+		{"0 0 1 * 1", "At 00:00 on day 1 of the month or on Monday"},
+		{"*/15 * * * *", "Every 15 minutes"},
+		{"* * * * *", "Every minute"},
+		{"5 * * * *", "At minute 5 past every hour"},
+		{"0 */4 * * *", "Every 4 hours from 00:00"},
+		{"0,30 8-18 * * *", "Every 30 minutes from 08:00 to 18:30"},
+		{"*/15 8-18 * * 1-5", "Every 15 minutes from 08:00 to 18:45 on weekdays"},
+		{"0,20 8-18 * * *", "At minutes 0,20 past hours 8-18"},
+		{"@daily", "At 00:00"},
+		{"@weekly", "At 00:00 on Sunday"},
+		{"@every 10m", "Every 10 minutes"},
+		{"@every 1h", "Every hour"},
+		{"@every 48h", "Every 2 days"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := ParseStandard(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.Describe(); got != tt.want {
+				t.Errorf("Describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
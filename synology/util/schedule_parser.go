@@ -3,6 +3,7 @@ package util
 import (
 	"fmt"
 	"math"
+	"math/bits"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,13 @@ import (
 type Schedule struct {
 	Second, Minute, Hour, Dom, Month, Dow, RepeatHour, RepeatMin, RepeatDate int64
 
+	// FirstRun and LastRun are the first and the last run of the day in
+	// minutes after midnight, for schedules running once a day or every
+	// RepeatMin minutes or RepeatHour hours in between, the schedules DSM
+	// tasks can express. E.g. "*/15 8-18 * * *" runs every 15 minutes from
+	// 08:00 to 18:45. They are -1 for other schedules.
+	FirstRun, LastRun int64
+
 	// Override location for this schedule.
 	Location *time.Location
 }
@@ -85,6 +93,16 @@ var places = []ParseOption{
 	Dow,
 }
 
+// fieldNames names the fields of places in errors.
+var fieldNames = []string{
+	"second",
+	"minute",
+	"hour",
+	"day of month",
+	"month",
+	"day of week",
+}
+
 var defaults = []string{
 	"0",
 	"0",
@@ -94,6 +112,23 @@ var defaults = []string{
 	"*",
 }
 
+// FieldError is the error of a spec with an invalid field. Position is the
+// 1-based position of the field in the spec.
+type FieldError struct {
+	Field    string
+	Position int
+	Value    string
+	Err      error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("invalid %s (field %d) %q: %s", e.Field, e.Position, e.Value, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
 // A custom Parser that can be configured.
 type Parser struct {
 	options   ParseOption
@@ -130,7 +165,11 @@ func (p Parser) Parse(spec string) (*Schedule, error) {
 		return nil, fmt.Errorf("empty spec string")
 	}
 	if spec[0] == '@' && p.options&Descriptor > 0 {
-		return parseDescriptor(spec)
+		s, err := parseDescriptor(spec)
+		if err == nil && s.RepeatMin == 0 && s.RepeatHour == 0 && s.RepeatDate == 1001 {
+			s.setWindow()
+		}
+		return s, err
 	}
 
 	// Figure out how many fields we need
@@ -165,55 +204,99 @@ func (p Parser) Parse(spec string) (*Schedule, error) {
 	}
 
 	// Fill in missing fields
-	fields = expandFields(fields, p.options)
+	fields, positions := expandFields(fields, p.options)
 
 	var err error
-	field := func(field string, r bounds) int64 {
+	field := func(i int, r bounds) int64 {
 		if err != nil {
 			return 0
 		}
-		var bits int64
-		bits, err = getField(field, r)
+		bits, e := getField(fields[i], r)
+		if e != nil {
+			err = &FieldError{Field: fieldNames[i], Position: positions[i], Value: fields[i], Err: e}
+		}
 		return bits
 	}
 
 	var (
-		second     = field(fields[0], seconds)
-		minute     = field(fields[1], minutes)
-		hour       = field(fields[2], hours)
-		dayofmonth = field(fields[3], dom)
-		month      = field(fields[4], months)
-		dayofweek  = field(fields[5], dow)
+		second     = field(0, seconds)
+		minute     = field(1, minutes)
+		hour       = field(2, hours)
+		dayofmonth = field(3, dom)
+		month      = field(4, months)
+		dayofweek  = field(5, dow)
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Schedule{
+	s := &Schedule{
 		Second: second,
 		Minute: minute,
 		Hour:   hour,
 		Dom:    dayofmonth,
 		Month:  month,
 		Dow:    dayofweek,
-	}, nil
+	}
+	s.setWindow()
+	return s, nil
 }
 
-func expandFields(fields []string, options ParseOption) []string {
+// setWindow sets FirstRun, LastRun and the repeat interval from the minutes
+// and hours of s.
+func (s *Schedule) setWindow() {
+	s.FirstRun, s.LastRun = -1, -1
+
+	mins := setBits(s.Minute, minutes)
+	hrs := setBits(s.Hour, hours)
+	if len(mins) == 0 || len(hrs) == 0 {
+		return
+	}
+
+	switch {
+	case len(mins) == 1 && len(hrs) == 1:
+	case len(mins) == 1:
+		step, ok := stepOf(hrs)
+		if !ok {
+			return
+		}
+		s.RepeatHour = step
+	default:
+		// The minutes have to repeat at the same interval across the hours.
+		step, ok := stepOf(mins)
+		if !ok || mins[0]+60-mins[len(mins)-1] != step {
+			return
+		}
+		if h, ok := stepOf(hrs); len(hrs) > 1 && (!ok || h != 1) {
+			return
+		}
+		s.RepeatMin = step
+	}
+
+	s.FirstRun = hrs[0]*60 + mins[0]
+	s.LastRun = hrs[len(hrs)-1]*60 + mins[len(mins)-1]
+}
+
+// expandFields returns the fields of all places, filling in the defaults of
+// the places which are not parsed, and the position of each field in the spec.
+// Defaulted fields have position 0.
+func expandFields(fields []string, options ParseOption) ([]string, []int) {
 	n := 0
 	count := len(fields)
 	expFields := make([]string, len(places))
+	positions := make([]int, len(places))
 	copy(expFields, defaults)
 	for i, place := range places {
 		if options&place > 0 {
 			expFields[i] = fields[n]
 			n++
+			positions[i] = n
 		}
 		if n == count {
 			break
 		}
 	}
-	return expFields
+	return expFields, positions
 }
 
 var standardParser = NewParser(
@@ -248,13 +331,19 @@ func Parse(spec string) (*Schedule, error) {
 
 // getField returns an Int with the bits set representing all of the times that
 // the field represents or error parsing field value.  A "field" is a comma-separated
-// list of "ranges".
+// list of "ranges", each of which may have a step, e.g. "0,*/15,50-55/2".
 func getField(field string, r bounds) (int64, error) {
 	var bits int64
-	ranges := strings.FieldsFunc(field, func(r rune) bool { return r == ',' })
-	for _, expr := range ranges {
+	ranges := strings.Split(field, ",")
+	for i, expr := range ranges {
+		if expr == "" {
+			return bits, fmt.Errorf("empty item %d of list", i+1)
+		}
 		bit, err := getRange(expr, r)
 		if err != nil {
+			if len(ranges) > 1 {
+				err = fmt.Errorf("item %d of list: %w", i+1, err)
+			}
 			return bits, err
 		}
 		bits |= bit
@@ -452,19 +541,222 @@ func parseDescriptor(descriptor string) (*Schedule, error) {
 			return nil, fmt.Errorf("failed to parse duration %s: %s", descriptor, err)
 		}
 		if duration < time.Hour {
+			n := int64(math.Ceil(duration.Minutes()))
 			return &Schedule{
-				RepeatMin: int64(math.Ceil(duration.Minutes())),
+				RepeatMin: n,
+				LastRun:   (24*60 - 1) / n * n,
 			}, nil
 		} else if duration < time.Hour*24 {
+			n := int64(math.Ceil(duration.Hours()))
 			return &Schedule{
-				RepeatHour: int64(math.Ceil(duration.Hours())),
+				RepeatHour: n,
+				LastRun:    (24 - 1) / n * n * 60,
 			}, nil
 		} else {
+			// Runs days apart have no time of the day.
 			return &Schedule{
 				RepeatDate: int64(math.Ceil(duration.Hours() / 24)),
+				FirstRun:   -1,
+				LastRun:    -1,
 			}, nil
 		}
 	}
 
 	return nil, fmt.Errorf("unrecognized descriptor: %s", descriptor)
 }
+
+// ParseInLocation parses a standard spec like ParseStandard, with its times
+// given in loc.
+func ParseInLocation(spec string, loc *time.Location) (*Schedule, error) {
+	s, err := ParseStandard(spec)
+	if err != nil {
+		return nil, err
+	}
+	s.Location = loc
+	return s, nil
+}
+
+// In returns the schedule with its hours and minutes converted to the clock
+// times in loc on the given day, as DSM stores schedules in the local time of
+// the NAS. The offset between the locations depends on the day, so the result
+// has to be converted again after NextOffsetChange. Schedules without a
+// Location or without times, like "@every 10m", are taken to be in loc.
+//
+// It fails for times which do not exist on the day, e.g. during the hour
+// skipped at the start of daylight saving time, and for conversions which
+// cannot be expressed as a set of hours times a set of minutes.
+func (s *Schedule) In(loc *time.Location, day time.Time) (*Schedule, error) {
+	res := *s
+	res.Location = loc
+	if s.Location == nil || s.Location == loc || s.Minute == 0 && s.Hour == 0 {
+		return &res, nil
+	}
+
+	y, m, d := day.In(s.Location).Date()
+	date := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	var hour, minute int64
+	count, shift := 0, 0
+	for h := hours.min; h <= hours.max; h++ {
+		if s.Hour&(1<<h) == 0 {
+			continue
+		}
+		for mi := minutes.min; mi <= minutes.max; mi++ {
+			if s.Minute&(1<<mi) == 0 {
+				continue
+			}
+
+			t := time.Date(y, m, d, int(h), int(mi), 0, 0, s.Location)
+			if t.Hour() != int(h) || t.Minute() != int(mi) {
+				return nil, fmt.Errorf(
+					"%02d:%02d does not exist in %s on %s", h, mi, s.Location, date.Format(time.DateOnly))
+			}
+
+			lt := t.In(loc)
+			ld := time.Date(lt.Year(), lt.Month(), lt.Day(), 0, 0, 0, 0, time.UTC)
+			delta := int(ld.Sub(date) / (24 * time.Hour))
+			if count > 0 && delta != shift {
+				return nil, fmt.Errorf("the times fall on different days in %s", loc)
+			}
+			shift = delta
+
+			hour |= 1 << lt.Hour()
+			minute |= 1 << lt.Minute()
+			count++
+		}
+	}
+
+	if bits.OnesCount64(uint64(hour))*bits.OnesCount64(uint64(minute)) != count {
+		return nil, fmt.Errorf("the times cannot be expressed as hours and minutes in %s", loc)
+	}
+	res.Hour = hour
+	res.Minute = minute
+	res.RepeatMin, res.RepeatHour = 0, 0
+	res.setWindow()
+
+	if shift != 0 {
+		if s.Dom&toInt64(starBit) == 0 {
+			return nil, fmt.Errorf("the times move to another day of the month in %s", loc)
+		}
+		res.Dow = shiftDow(s.Dow, shift)
+	}
+
+	return &res, nil
+}
+
+// shiftDow returns the days of the week set in days moved by n days, keeping
+// the star bit.
+func shiftDow(days int64, n int) int64 {
+	res := days & toInt64(starBit)
+	for d := dow.min; d <= dow.max; d++ {
+		if days&(1<<d) != 0 {
+			res |= 1 << ((d + int64(n) + 7) % 7)
+		}
+	}
+	return res
+}
+
+// NextOffsetChange returns the first time after t at which the offset between
+// the locations a and b changes, or the zero time if it does not change within
+// a year.
+func NextOffsetChange(a, b *time.Location, t time.Time) time.Time {
+	diff := func(t time.Time) int {
+		_, oa := t.In(a).Zone()
+		_, ob := t.In(b).Zone()
+		return oa - ob
+	}
+
+	d := diff(t)
+	end := t.AddDate(1, 0, 0)
+	for lo := t; lo.Before(end); lo = lo.Add(time.Hour) {
+		hi := lo.Add(time.Hour)
+		if diff(hi) == d {
+			continue
+		}
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if diff(mid) == d {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		return hi.Truncate(time.Second)
+	}
+	return time.Time{}
+}
+
+// DSMDays are the days a DSM task runs on. DSM runs tasks either on days of
+// the week, or on a day of the month every MonthInterval months starting
+// with FirstMonth, but not on both as cron does.
+type DSMDays struct {
+	WeekDays []int64
+
+	MonthDay      int64
+	FirstMonth    time.Month
+	MonthInterval int64
+}
+
+// DSMDays maps the days of s to the days of a DSM task:
+//
+//   - a day of the week restriction, e.g. "0 3 * * 1-5", runs on those days
+//   - a single day of the month, e.g. "0 3 1 * *", runs on that day of every
+//     month, or of every 3, 6 or 12 months, e.g. "0 3 1 1,7 *"
+//
+// It fails for restrictions of both the day of the month and the day of the
+// week, for several days of the month and for months DSM cannot repeat on.
+func (s *Schedule) DSMDays() (DSMDays, error) {
+	days := setBits(s.Dom, dom)
+	weekDays := setBits(s.Dow, dow)
+	monthList := setBits(s.Month, months)
+	allDays, allWeekDays, allMonths := len(days) == 31, len(weekDays) == 7, len(monthList) == 12
+
+	switch {
+	case allDays && allMonths:
+		return DSMDays{WeekDays: weekDays}, nil
+	case allDays:
+		return DSMDays{}, fmt.Errorf("the months can only be restricted for a day of the month")
+	case !allWeekDays:
+		return DSMDays{}, fmt.Errorf(
+			"DSM runs tasks either on days of the week or on a day of the month, not on both")
+	case len(days) != 1:
+		return DSMDays{}, fmt.Errorf("only a single day of the month is supported")
+	}
+
+	res := DSMDays{MonthDay: days[0], FirstMonth: time.Month(monthList[0]), MonthInterval: 1}
+	if !allMonths {
+		step, ok := stepOf(monthList)
+		if len(monthList) == 1 {
+			step, ok = 12, true
+		}
+		// The months have to repeat at the same interval across the years.
+		if !ok || monthList[0]+12-monthList[len(monthList)-1] != step || (step != 3 && step != 6 && step != 12) {
+			return DSMDays{}, fmt.Errorf("the months must repeat every 1, 3, 6 or 12 months")
+		}
+		res.MonthInterval = step
+	}
+
+	return res, nil
+}
+
+// NextDate returns the first day on or after t which d runs on in date mode,
+// or the zero time if there is none within four years or d runs on days of
+// the week.
+func (d DSMDays) NextDate(t time.Time) time.Time {
+	if d.MonthInterval == 0 {
+		return time.Time{}
+	}
+
+	y, m, day := t.Date()
+	today := time.Date(y, m, day, 0, 0, 0, 0, t.Location())
+	for i := 0; i < 48; i++ {
+		month := time.Date(y, m+time.Month(i), 1, 0, 0, 0, 0, t.Location())
+		if (int64(month.Month())-int64(d.FirstMonth)+12)%d.MonthInterval != 0 {
+			continue
+		}
+		if next := month.AddDate(0, 0, int(d.MonthDay)-1); next.Month() == month.Month() && !next.Before(today) {
+			return next
+		}
+	}
+	return time.Time{}
+}
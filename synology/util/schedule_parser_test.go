@@ -0,0 +1,323 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestParseStandard(t *testing.T) {
+	tests := []struct {
+		spec   string
+		minute int64
+		hour   int64
+	}{
+		{spec: "30 3 * * *", minute: 1 << 30, hour: 1 << 3},
+		{spec: "0,*/20 1-3 * * *", minute: 1<<0 | 1<<20 | 1<<40, hour: 1<<1 | 1<<2 | 1<<3},
+		{spec: "5,50-55/2 0-12/6,23 * * *", minute: 1<<5 | 1<<50 | 1<<52 | 1<<54, hour: 1<<0 | 1<<6 | 1<<12 | 1<<23},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := ParseStandard(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.Minute &^ toInt64(starBit); got != tt.minute {
+				t.Errorf("Minute = %b, want %b", got, tt.minute)
+			}
+			if got := s.Hour &^ toInt64(starBit); got != tt.hour {
+				t.Errorf("Hour = %b, want %b", got, tt.hour)
+			}
+		})
+	}
+}
+
+func TestParseStandardFieldError(t *testing.T) {
+	tests := []struct {
+		spec     string
+		field    string
+		position int
+		message  string
+	}{
+		{
+			spec:     "0 24 * * *",
+			field:    "hour",
+			position: 2,
+			message:  `invalid hour (field 2) "24": end of range (24) above maximum (23): 24`,
+		},
+		{
+			spec:     "0 0 0 * *",
+			field:    "day of month",
+			position: 3,
+			message:  `invalid day of month (field 3) "0": beginning of range (0) below minimum (1): 0`,
+		},
+		{
+			spec:     "0,15,60 * * * *",
+			field:    "minute",
+			position: 1,
+			message:  `invalid minute (field 1) "0,15,60": item 3 of list: end of range (60) above maximum (59): 60`,
+		},
+		{
+			spec:     "0 0 * * 1,,5",
+			field:    "day of week",
+			position: 5,
+			message:  `invalid day of week (field 5) "1,,5": empty item 2 of list`,
+		},
+		{
+			spec:     "0 0 * foo *",
+			field:    "month",
+			position: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			_, err := ParseStandard(tt.spec)
+			var fe *FieldError
+			if !errors.As(err, &fe) {
+				t.Fatalf("ParseStandard() error = %v, want a FieldError", err)
+			}
+			if fe.Field != tt.field || fe.Position != tt.position {
+				t.Errorf("FieldError = %s at %d, want %s at %d", fe.Field, fe.Position, tt.field, tt.position)
+			}
+			if tt.message != "" && err.Error() != tt.message {
+				t.Errorf("Error() = %q, want %q", err.Error(), tt.message)
+			}
+		})
+	}
+}
+
+// TestParseStandardProperties checks that every value within the bounds of a
+// field is accepted and every value above them is reported for that field.
+func TestParseStandardProperties(t *testing.T) {
+	fields := []bounds{minutes, hours, dom, months, dow}
+
+	inBounds := func(field uint8, value uint8) bool {
+		i := int(field) % len(fields)
+		b := fields[i]
+		v := b.min + int64(value)%(b.max-b.min+1)
+
+		spec := specWith(i, fmt.Sprint(v))
+		s, err := ParseStandard(spec)
+		if err != nil {
+			t.Logf("%s: %v", spec, err)
+			return false
+		}
+		got := []int64{s.Minute, s.Hour, s.Dom, s.Month, s.Dow}[i]
+		return got == 1<<v
+	}
+	if err := quick.Check(inBounds, nil); err != nil {
+		t.Error(err)
+	}
+
+	aboveBounds := func(field uint8, value uint8) bool {
+		i := int(field) % len(fields)
+		v := fields[i].max + 1 + int64(value)
+
+		_, err := ParseStandard(specWith(i, fmt.Sprint(v)))
+		var fe *FieldError
+		return errors.As(err, &fe) && fe.Position == i+1 && fe.Field == fieldNames[i+1]
+	}
+	if err := quick.Check(aboveBounds, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// specWith returns a standard spec with the field at index i set to value and
+// every other field set to "*".
+func specWith(i int, value string) string {
+	fields := []string{"*", "*", "*", "*", "*"}
+	fields[i] = value
+	return strings.Join(fields, " ")
+}
+
+func TestScheduleIn(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	winter := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2026, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		spec    string
+		from    *time.Location
+		to      *time.Location
+		day     time.Time
+		hour    int64
+		minute  int64
+		dow     int64
+		wantErr bool
+	}{
+		{name: "winter", spec: "0 3 * * *", from: time.UTC, to: berlin, day: winter, hour: 1 << 4, minute: 1 << 0, dow: all(dow)},
+		{name: "summer", spec: "0 3 * * *", from: time.UTC, to: berlin, day: summer, hour: 1 << 5, minute: 1 << 0, dow: all(dow)},
+		{name: "next day", spec: "30 23 * * 5", from: time.UTC, to: berlin, day: winter, hour: 1 << 0, minute: 1 << 30, dow: 1 << 6},
+		{name: "previous day", spec: "30 0 * * 0", from: berlin, to: time.UTC, day: winter, hour: 1 << 23, minute: 1 << 30, dow: 1 << 6},
+		{name: "half hour offset", spec: "15 1,2 * * *", from: time.UTC, to: kolkata, day: winter, hour: 1<<6 | 1<<7, minute: 1 << 45, dow: all(dow)},
+		{name: "skipped hour", spec: "30 2 * * *", from: berlin, to: time.UTC, day: time.Date(2026, 3, 29, 12, 0, 0, 0, time.UTC), wantErr: true},
+		{name: "split minutes", spec: "0,30 3 * * *", from: time.UTC, to: kolkata, day: winter, wantErr: true},
+		{name: "split days", spec: "0 0,12 * * *", from: berlin, to: time.UTC, day: winter, wantErr: true},
+		{name: "day of month", spec: "30 23 1 * *", from: time.UTC, to: berlin, day: winter, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseInLocation(tt.spec, tt.from)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := s.In(tt.to, tt.day)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("In() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Hour != tt.hour || got.Minute != tt.minute || got.Dow != tt.dow {
+				t.Errorf("In() = %b %b %b, want %b %b %b", got.Hour, got.Minute, got.Dow, tt.hour, tt.minute, tt.dow)
+			}
+			if got.Location != tt.to {
+				t.Errorf("Location = %v, want %v", got.Location, tt.to)
+			}
+		})
+	}
+}
+
+func TestNextOffsetChange(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NextOffsetChange(berlin, time.UTC, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 3, 29, 1, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("NextOffsetChange() = %v, want %v", got, want)
+	}
+
+	if got := NextOffsetChange(time.UTC, time.UTC, time.Now()); !got.IsZero() {
+		t.Errorf("NextOffsetChange() = %v, want zero", got)
+	}
+}
+
+func TestScheduleWindow(t *testing.T) {
+	tests := []struct {
+		spec       string
+		first      int64
+		last       int64
+		repeatMin  int64
+		repeatHour int64
+	}{
+		{spec: "30 3 * * *", first: 3*60 + 30, last: 3*60 + 30},
+		{spec: "*/15 8-18 * * 1-5", first: 8 * 60, last: 18*60 + 45, repeatMin: 15},
+		{spec: "5-55/10 9 * * *", first: 9*60 + 5, last: 9*60 + 55, repeatMin: 10},
+		{spec: "0 */4 * * *", first: 0, last: 20 * 60, repeatHour: 4},
+		{spec: "45 6-22/8 * * *", first: 6*60 + 45, last: 22*60 + 45, repeatHour: 8},
+		{spec: "@daily", first: 0, last: 0},
+		{spec: "@every 10m", first: 0, last: 23*60 + 50, repeatMin: 10},
+		{spec: "@every 6h", first: 0, last: 18 * 60, repeatHour: 6},
+		{spec: "0,20 * * * *", first: -1, last: -1},
+		{spec: "*/15 8,12 * * *", first: -1, last: -1},
+		{spec: "0 1,2,5 * * *", first: -1, last: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := ParseStandard(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.FirstRun != tt.first || s.LastRun != tt.last {
+				t.Errorf("window = %d-%d, want %d-%d", s.FirstRun, s.LastRun, tt.first, tt.last)
+			}
+			if s.RepeatMin != tt.repeatMin || s.RepeatHour != tt.repeatHour {
+				t.Errorf("repeat = %d min %d h, want %d min %d h", s.RepeatMin, s.RepeatHour, tt.repeatMin, tt.repeatHour)
+			}
+		})
+	}
+
+	// Intervals of a day or more have no window.
+	days := func(hours uint16) bool {
+		spec := fmt.Sprintf("@every %dh", 24+int(hours))
+		s, err := ParseStandard(spec)
+		if err != nil {
+			t.Logf("%s: %v", spec, err)
+			return false
+		}
+		return s.FirstRun == -1 && s.LastRun == -1
+	}
+	if err := quick.Check(days, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestScheduleDSMDays(t *testing.T) {
+	from := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		spec    string
+		want    DSMDays
+		next    time.Time
+		wantErr bool
+	}{
+		{spec: "0 3 * * 1-5", want: DSMDays{WeekDays: []int64{1, 2, 3, 4, 5}}},
+		{spec: "0 3 * * *", want: DSMDays{WeekDays: []int64{0, 1, 2, 3, 4, 5, 6}}},
+		{
+			spec: "0 3 1 * *",
+			want: DSMDays{MonthDay: 1, FirstMonth: time.January, MonthInterval: 1},
+			next: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			spec: "0 3 14 * *",
+			want: DSMDays{MonthDay: 14, FirstMonth: time.January, MonthInterval: 1},
+			next: time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			spec: "0 3 15 1,7 *",
+			want: DSMDays{MonthDay: 15, FirstMonth: time.January, MonthInterval: 6},
+			next: time.Date(2027, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			spec: "0 3 31 2-11/3 *",
+			want: DSMDays{MonthDay: 31, FirstMonth: time.February, MonthInterval: 3},
+			next: time.Date(2027, 5, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			spec: "0 3 1 3 *",
+			want: DSMDays{MonthDay: 1, FirstMonth: time.March, MonthInterval: 12},
+			next: time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{spec: "0 3 1 * 1", wantErr: true},
+		{spec: "0 3 1,15 * *", wantErr: true},
+		{spec: "0 3 * 6 *", wantErr: true},
+		{spec: "0 3 1 1,2 *", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := ParseStandard(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := s.DSMDays()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DSMDays() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DSMDays() = %+v, want %+v", got, tt.want)
+			}
+			if next := got.NextDate(from); !next.Equal(tt.next) {
+				t.Errorf("NextDate() = %v, want %v", next, tt.next)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package util
 
 import (
 	"fmt"
+	"net"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -77,3 +78,21 @@ func MarshalURL(r any) (url.Values, error) {
 
 	return ret, nil
 }
+
+// ServiceURL returns the URL of a service listening on host and port. The
+// port is left out when it is the default port of protocol.
+func ServiceURL(protocol, host string, port int64) string {
+	if protocol == "" {
+		protocol = "http"
+	}
+
+	u := url.URL{Scheme: protocol, Host: host, Path: "/"}
+	if strings.Contains(host, ":") {
+		u.Host = "[" + host + "]"
+	}
+	if (protocol != "http" || port != 80) && (protocol != "https" || port != 443) && port != 0 {
+		u.Host = net.JoinHostPort(host, strconv.FormatInt(port, 10))
+	}
+
+	return u.String()
+}
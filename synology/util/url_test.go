@@ -0,0 +1,26 @@
+package util
+
+import "testing"
+
+func TestServiceURL(t *testing.T) {
+	tests := []struct {
+		protocol string
+		host     string
+		port     int64
+		want     string
+	}{
+		{"https", "nas.synology.me", 443, "https://nas.synology.me/"},
+		{"http", "nas.synology.me", 80, "http://nas.synology.me/"},
+		{"https", "nas.synology.me", 8443, "https://nas.synology.me:8443/"},
+		{"http", "nas.synology.me", 443, "http://nas.synology.me:443/"},
+		{"", "192.168.1.2", 8080, "http://192.168.1.2:8080/"},
+		{"http", "fd00::2", 8080, "http://[fd00::2]:8080/"},
+		{"https", "fd00::2", 443, "https://[fd00::2]/"},
+	}
+
+	for _, tt := range tests {
+		if got := ServiceURL(tt.protocol, tt.host, tt.port); got != tt.want {
+			t.Errorf("ServiceURL(%q, %q, %d) = %q, want %q", tt.protocol, tt.host, tt.port, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+)
+
+// MagicPacket returns the Wake-on-LAN packet for a MAC address: six 0xff
+// bytes followed by the address repeated sixteen times.
+func MagicPacket(mac string) ([]byte, error) {
+	hw, err := net.ParseMAC(mac)
+	if err != nil {
+		return nil, err
+	}
+	if len(hw) != 6 {
+		return nil, fmt.Errorf("%q is not an EUI-48 MAC address", mac)
+	}
+
+	packet := bytes.Repeat([]byte{0xff}, 6)
+	for range 16 {
+		packet = append(packet, hw...)
+	}
+	return packet, nil
+}
+
+// SendMagicPacket sends the Wake-on-LAN packet for mac to the UDP address
+// addr, usually a broadcast address such as 255.255.255.255:9.
+func SendMagicPacket(ctx context.Context, addr, mac string) error {
+	packet, err := MagicPacket(mac)
+	if err != nil {
+		return err
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", addr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	_, err = conn.Write(packet)
+	return err
+}
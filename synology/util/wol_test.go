@@ -0,0 +1,32 @@
+package util
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestMagicPacket(t *testing.T) {
+	packet, err := MagicPacket("00:11:32:aa:bb:cc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(packet) != 102 {
+		t.Fatalf("len(packet) = %d, want 102", len(packet))
+	}
+	if !bytes.Equal(packet[:6], bytes.Repeat([]byte{0xff}, 6)) {
+		t.Errorf("packet does not start with the sync stream: %x", packet[:6])
+	}
+	mac := []byte{0x00, 0x11, 0x32, 0xaa, 0xbb, 0xcc}
+	for i := range 16 {
+		if got := packet[6+i*6 : 12+i*6]; !bytes.Equal(got, mac) {
+			t.Errorf("repetition %d = %x, want %x", i, got, mac)
+		}
+	}
+
+	for _, mac := range []string{"not a mac", "00:00:5e:00:53:00:00:01"} {
+		if _, err := MagicPacket(mac); err == nil {
+			t.Errorf("MagicPacket(%q) returned no error", mac)
+		}
+	}
+}